@@ -36,11 +36,13 @@ type Account struct {
 }
 
 const (
-	MimetypeDataWithValidator = "data/validator"
-	MimetypeTypedData         = "data/typed"
-	MimetypeClique            = "application/x-clique-header"
-	MimetypeDemocracy         = "application/x-democracy-header"
-	MimetypeTextPlain         = "text/plain"
+	MimetypeDataWithValidator    = "data/validator"
+	MimetypeTypedData            = "data/typed"
+	MimetypeClique               = "application/x-clique-header"
+	MimetypeDemocracy            = "application/x-democracy-header"
+	MimetypeDemocracyAttestation = "application/x-democracy-attestation"
+	MimetypeDemocracyRecord      = "application/x-democracy-validator-record"
+	MimetypeTextPlain            = "text/plain"
 )
 
 // Wallet represents a software or hardware wallet that might contain one or more
@@ -167,7 +167,9 @@ func (api *ExternalSigner) SignData(account accounts.Account, mimeType string, d
 		return nil, err
 	}
 	// If V is on 27/28-form, convert to 0/1 for Clique/Democracy
-	if (mimeType == accounts.MimetypeClique || mimeType == accounts.MimetypeDemocracy) && (res[64] == 27 || res[64] == 28) {
+	if (mimeType == accounts.MimetypeClique || mimeType == accounts.MimetypeDemocracy || mimeType == accounts.MimetypeDemocracyAttestation ||
+		mimeType == accounts.MimetypeDemocracyRecord) &&
+		(res[64] == 27 || res[64] == 28) {
 		res[64] -= 27 // Transform V from 27/28 to 0/1 for Clique/Democracy use
 	}
 	return res, nil
@@ -0,0 +1,83 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package keystore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/QEasyWeb3/QEasyChain/crypto/bls"
+)
+
+// blsKeyVersion is the version of the encrypted BLS key format.
+const blsKeyVersion = 1
+
+// ErrBLSKeyMismatch is returned if a decrypted BLS secret key doesn't match the public
+// key stored along with it.
+var ErrBLSKeyMismatch = errors.New("bls secret key doesn't match its public key")
+
+// encryptedBLSKeyJSON is the encrypted form of a BLS secret key. The public key is kept
+// in the clear, so that the key can be identified without being decrypted.
+type encryptedBLSKeyJSON struct {
+	PublicKey string     `json:"pubkey"`
+	Crypto    CryptoJSON `json:"crypto"`
+	Version   int        `json:"version"`
+}
+
+// EncryptBLSKey encrypts a BLS secret key using the specified scrypt parameters into a
+// json blob that can be decrypted later on.
+func EncryptBLSKey(key *bls.SecretKey, auth string, scryptN, scryptP int) ([]byte, error) {
+	cryptoStruct, err := EncryptDataV3(key.Bytes(), []byte(auth), scryptN, scryptP)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&encryptedBLSKeyJSON{
+		PublicKey: hex.EncodeToString(key.PublicKey().Bytes()),
+		Crypto:    cryptoStruct,
+		Version:   blsKeyVersion,
+	})
+}
+
+// DecryptBLSKey decrypts a BLS secret key from a json blob, returning it.
+func DecryptBLSKey(keyjson []byte, auth string) (*bls.SecretKey, error) {
+	var k encryptedBLSKeyJSON
+	if err := json.Unmarshal(keyjson, &k); err != nil {
+		return nil, err
+	}
+	if k.Version != blsKeyVersion {
+		return nil, fmt.Errorf("version not supported: %v", k.Version)
+	}
+	keyBytes, err := DecryptDataV3(k.Crypto, auth)
+	if err != nil {
+		return nil, err
+	}
+	key, err := bls.SecretKeyFromBytes(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := hex.DecodeString(k.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(pubkey, key.PublicKey().Bytes()) {
+		return nil, ErrBLSKeyMismatch
+	}
+	return key, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package keystore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/crypto/bls"
+)
+
+// Tests that a BLS key can be encrypted and decrypted, and that the secret key is
+// checked against the public key stored in the clear.
+func TestBLSKeyEncryptDecrypt(t *testing.T) {
+	key, err := bls.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyjson, err := EncryptBLSKey(key, "password", veryLightScryptN, veryLightScryptP)
+	if err != nil {
+		t.Fatalf("failed to encrypt key: %v", err)
+	}
+	if bytes.Contains(keyjson, []byte(hex.EncodeToString(key.Bytes()))) {
+		t.Fatal("secret key stored in the clear")
+	}
+	if _, err := DecryptBLSKey(keyjson, "bad"); err == nil {
+		t.Error("json key decrypted with bad password")
+	}
+	dec, err := DecryptBLSKey(keyjson, "password")
+	if err != nil {
+		t.Fatalf("json key failed to decrypt: %v", err)
+	}
+	if !bytes.Equal(dec.Bytes(), key.Bytes()) {
+		t.Fatal("decrypted key mismatch")
+	}
+	// Swap the public key for another one
+	other, _ := bls.GenerateKey()
+	var k encryptedBLSKeyJSON
+	if err := json.Unmarshal(keyjson, &k); err != nil {
+		t.Fatal(err)
+	}
+	k.PublicKey = hex.EncodeToString(other.PublicKey().Bytes())
+	tampered, _ := json.Marshal(&k)
+	if _, err := DecryptBLSKey(tampered, "password"); err != ErrBLSKeyMismatch {
+		t.Errorf("tampered public key error mismatch: have %v, want %v", err, ErrBLSKeyMismatch)
+	}
+}
@@ -0,0 +1,422 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package web3signer implements an account backend signing through a remote HTTP
+// signer speaking the eth1 API of Web3Signer, so that the validator keys can be
+// kept on a separate hardened host.
+//
+// Any failure to reach the remote signer is returned as an error, nothing is ever
+// signed locally.
+package web3signer
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/QEasyWeb3/QEasyChain"
+	"github.com/QEasyWeb3/QEasyChain/accounts"
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/common/hexutil"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+	"github.com/QEasyWeb3/QEasyChain/event"
+	"github.com/QEasyWeb3/QEasyChain/log"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+)
+
+const (
+	// DefaultTimeout is the time allowed to the remote signer to answer a request.
+	DefaultTimeout = 5 * time.Second
+
+	upcheckPath    = "/upcheck"
+	publicKeysPath = "/api/v1/eth1/publicKeys"
+	signPath       = "/api/v1/eth1/sign/"
+
+	// maxResponseSize caps the responses read from the remote signer.
+	maxResponseSize = 1024 * 1024
+)
+
+var (
+	// ErrNotSupported is returned for the operations the remote signer can't do.
+	ErrNotSupported = errors.New("operation not supported on remote signers")
+
+	// ErrUnknownAccount is returned if the remote signer doesn't hold the key of an account.
+	ErrUnknownAccount = errors.New("unknown account on remote signer")
+
+	// errInvalidSignature is returned if the remote signer answers with a signature
+	// not made by the requested account.
+	errInvalidSignature = errors.New("invalid signature from remote signer")
+)
+
+// Config is the configuration of the connection to a remote signer.
+type Config struct {
+	Endpoint string        // Base URL of the remote signer, e.g. https://signer:9000
+	Timeout  time.Duration // Time allowed for each request, DefaultTimeout if zero
+	CAFile   string        // PEM file of the certificate authorities to verify the signer with, system roots if empty
+	CertFile string        // PEM file of the client certificate for mutual TLS, if any
+	KeyFile  string        // PEM file of the client key for mutual TLS, if any
+}
+
+// Backend is an accounts.Backend holding a single remote signer wallet.
+type Backend struct {
+	signers []accounts.Wallet
+}
+
+// NewBackend connects to a remote signer, failing if it can't be reached.
+func NewBackend(config *Config) (*Backend, error) {
+	signer, err := NewSigner(config)
+	if err != nil {
+		return nil, err
+	}
+	return &Backend{
+		signers: []accounts.Wallet{signer},
+	}, nil
+}
+
+// Wallets implements accounts.Backend.
+func (b *Backend) Wallets() []accounts.Wallet {
+	return b.signers
+}
+
+// Subscribe implements accounts.Backend. The remote signer is never added or removed.
+func (b *Backend) Subscribe(sink chan<- accounts.WalletEvent) event.Subscription {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		return nil
+	})
+}
+
+// Signer is an accounts.Wallet forwarding all the signing requests to a remote signer.
+type Signer struct {
+	client   *http.Client
+	endpoint string
+
+	cacheMu sync.RWMutex
+	cache   map[common.Address]string // Public key identifiers of the accounts held by the signer
+}
+
+// NewSigner creates a wallet backed by a remote signer, and checks that it's up.
+func NewSigner(config *Config) (*Signer, error) {
+	client, err := newClient(config)
+	if err != nil {
+		return nil, err
+	}
+	s := &Signer{
+		client:   client,
+		endpoint: strings.TrimSuffix(config.Endpoint, "/"),
+	}
+	if err := s.upcheck(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// newClient creates the HTTP client to talk to the remote signer with.
+func newClient(config *Config) (*http.Client, error) {
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if config.CAFile != "" {
+		pem, err := ioutil.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates in %s", config.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.CertFile != "" || config.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig, Proxy: http.ProxyFromEnvironment},
+	}, nil
+}
+
+// URL implements accounts.Wallet, returning the endpoint of the remote signer.
+func (s *Signer) URL() accounts.URL {
+	return accounts.URL{
+		Scheme: "web3signer",
+		Path:   s.endpoint,
+	}
+}
+
+// Status implements accounts.Wallet, checking whether the remote signer is up.
+func (s *Signer) Status() (string, error) {
+	if err := s.upcheck(); err != nil {
+		return "offline", err
+	}
+	return "ok", nil
+}
+
+// Open implements accounts.Wallet, but is a noop for remote signers.
+func (s *Signer) Open(passphrase string) error {
+	return nil
+}
+
+// Close implements accounts.Wallet, but is a noop for remote signers.
+func (s *Signer) Close() error {
+	return nil
+}
+
+// Accounts implements accounts.Wallet, retrieving the accounts held by the remote signer.
+func (s *Signer) Accounts() []accounts.Account {
+	keys, err := s.listAccounts()
+	if err != nil {
+		log.Error("Remote signer account listing failed", "endpoint", s.endpoint, "err", err)
+		return nil
+	}
+	accnts := make([]accounts.Account, 0, len(keys))
+	for addr := range keys {
+		accnts = append(accnts, accounts.Account{Address: addr, URL: s.URL()})
+	}
+	s.cacheMu.Lock()
+	s.cache = keys
+	s.cacheMu.Unlock()
+	return accnts
+}
+
+// Contains implements accounts.Wallet, returning whether the remote signer holds
+// the key of an account.
+func (s *Signer) Contains(account accounts.Account) bool {
+	if account.URL != (accounts.URL{}) && account.URL != s.URL() {
+		return false
+	}
+	_, err := s.identifier(account.Address)
+	return err == nil
+}
+
+// Derive implements accounts.Wallet, but is not supported by remote signers.
+func (s *Signer) Derive(path accounts.DerivationPath, pin bool) (accounts.Account, error) {
+	return accounts.Account{}, ErrNotSupported
+}
+
+// SelfDerive implements accounts.Wallet, but is not supported by remote signers.
+func (s *Signer) SelfDerive(bases []accounts.DerivationPath, chain ethereum.ChainStateReader) {
+	log.Error("Operation SelfDerive not supported on remote signers")
+}
+
+// SignData implements accounts.Wallet, signing keccak256(data) remotely. The
+// signature is returned with V in the 0/1 form.
+func (s *Signer) SignData(account accounts.Account, mimeType string, data []byte) ([]byte, error) {
+	return s.sign(account.Address, data)
+}
+
+// SignDataWithPassphrase implements accounts.Wallet, but is not supported by remote signers.
+func (s *Signer) SignDataWithPassphrase(account accounts.Account, passphrase, mimeType string, data []byte) ([]byte, error) {
+	return nil, ErrNotSupported
+}
+
+// SignText implements accounts.Wallet, signing the hash of the text wrapped into
+// the Ethereum signed message prefix remotely.
+func (s *Signer) SignText(account accounts.Account, text []byte) ([]byte, error) {
+	_, msg := accounts.TextAndHash(text)
+	return s.sign(account.Address, []byte(msg))
+}
+
+// SignTextWithPassphrase implements accounts.Wallet, but is not supported by remote signers.
+func (s *Signer) SignTextWithPassphrase(account accounts.Account, passphrase string, text []byte) ([]byte, error) {
+	return nil, ErrNotSupported
+}
+
+// SignTx implements accounts.Wallet, signing a transaction remotely with the latest
+// signer of the given chain.
+func (s *Signer) SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signer := types.LatestSignerForChainID(chainID)
+	payload, err := signingPayload(signer, tx)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := s.sign(account.Address, payload)
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSignature(signer, sig)
+}
+
+// SignTxWithPassphrase implements accounts.Wallet, but is not supported by remote signers.
+func (s *Signer) SignTxWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return nil, ErrNotSupported
+}
+
+// sign asks the remote signer to sign keccak256(data) with the key of an account,
+// and checks that the signature really comes from it.
+func (s *Signer) sign(addr common.Address, data []byte) ([]byte, error) {
+	id, err := s.identifier(addr)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(map[string]string{"data": hexutil.Encode(data)})
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.do(http.MethodPost, signPath+id, body)
+	if err != nil {
+		return nil, err
+	}
+	// The signature may be returned as plain text or as a JSON string
+	sig, err := hexutil.Decode(strings.Trim(strings.TrimSpace(string(res)), `"`))
+	if err != nil || len(sig) != crypto.SignatureLength {
+		return nil, errInvalidSignature
+	}
+	if sig[crypto.RecoveryIDOffset] == 27 || sig[crypto.RecoveryIDOffset] == 28 {
+		sig[crypto.RecoveryIDOffset] -= 27 // Transform V from 27/28 to 0/1
+	}
+	pub, err := crypto.SigToPub(crypto.Keccak256(data), sig)
+	if err != nil || crypto.PubkeyToAddress(*pub) != addr {
+		return nil, errInvalidSignature
+	}
+	return sig, nil
+}
+
+// identifier retrieves the public key the remote signer identifies an account by.
+func (s *Signer) identifier(addr common.Address) (string, error) {
+	s.cacheMu.RLock()
+	id, ok := s.cache[addr]
+	s.cacheMu.RUnlock()
+	if ok {
+		return id, nil
+	}
+	// The account may have been added to the signer since the last listing
+	s.Accounts()
+
+	s.cacheMu.RLock()
+	defer s.cacheMu.RUnlock()
+	if id, ok := s.cache[addr]; ok {
+		return id, nil
+	}
+	return "", ErrUnknownAccount
+}
+
+// listAccounts retrieves the public keys held by the remote signer, by address.
+func (s *Signer) listAccounts() (map[common.Address]string, error) {
+	res, err := s.do(http.MethodGet, publicKeysPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	if err := json.Unmarshal(res, &keys); err != nil {
+		return nil, err
+	}
+	accnts := make(map[common.Address]string, len(keys))
+	for _, key := range keys {
+		blob, err := hexutil.Decode(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %q: %v", key, err)
+		}
+		// The public keys may be returned without the uncompressed point prefix
+		if len(blob) == 64 {
+			blob = append([]byte{0x04}, blob...)
+		}
+		pub, err := crypto.UnmarshalPubkey(blob)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %q: %v", key, err)
+		}
+		accnts[crypto.PubkeyToAddress(*pub)] = key
+	}
+	return accnts, nil
+}
+
+// upcheck checks whether the remote signer is up.
+func (s *Signer) upcheck() error {
+	_, err := s.do(http.MethodGet, upcheckPath, nil)
+	return err
+}
+
+// do sends a request to the remote signer, and returns the body of the response.
+func (s *Signer) do(method string, path string, body []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, s.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	blob, err := ioutil.ReadAll(io.LimitReader(res.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("remote signer %s %s failed: %s: %s", method, path, res.Status, strings.TrimSpace(string(blob)))
+	}
+	return blob, nil
+}
+
+// signingPayload returns the data whose keccak256 hash is signed for a transaction,
+// as the remote signer hashes the data itself.
+func signingPayload(signer types.Signer, tx *types.Transaction) ([]byte, error) {
+	var (
+		payload []byte
+		err     error
+	)
+	chainID := signer.ChainID()
+	switch {
+	case tx.Type() == types.LegacyTxType && chainID == nil:
+		payload, err = rlp.EncodeToBytes([]interface{}{
+			tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(),
+		})
+	case tx.Type() == types.LegacyTxType:
+		payload, err = rlp.EncodeToBytes([]interface{}{
+			tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(), chainID, uint(0), uint(0),
+		})
+	case tx.Type() == types.AccessListTxType && chainID != nil:
+		payload, err = rlp.EncodeToBytes([]interface{}{
+			chainID, tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(), tx.AccessList(),
+		})
+		payload = append([]byte{tx.Type()}, payload...)
+	case tx.Type() == types.DynamicFeeTxType && chainID != nil:
+		payload, err = rlp.EncodeToBytes([]interface{}{
+			chainID, tx.Nonce(), tx.GasTipCap(), tx.GasFeeCap(), tx.Gas(), tx.To(), tx.Value(), tx.Data(), tx.AccessList(),
+		})
+		payload = append([]byte{tx.Type()}, payload...)
+	default:
+		return nil, fmt.Errorf("unsupported tx type %d", tx.Type())
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Never ask for a signature over anything else than what the signer hashes
+	if crypto.Keccak256Hash(payload) != signer.Hash(tx) {
+		return nil, fmt.Errorf("unsupported transaction signing payload")
+	}
+	return payload, nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package web3signer
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/QEasyWeb3/QEasyChain/accounts"
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/common/hexutil"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+)
+
+// stubSigner is a minimal remote signer holding a single key.
+type stubSigner struct {
+	key   *ecdsa.PrivateKey
+	delay time.Duration // Delay before answering signing requests
+}
+
+func (s *stubSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	pubkey := hexutil.Encode(crypto.FromECDSAPub(&s.key.PublicKey)[1:])
+	switch {
+	case r.URL.Path == upcheckPath:
+		fmt.Fprint(w, "OK")
+	case r.URL.Path == publicKeysPath:
+		json.NewEncoder(w).Encode([]string{pubkey})
+	case r.URL.Path == signPath+pubkey && r.Method == http.MethodPost:
+		time.Sleep(s.delay)
+		var req struct {
+			Data hexutil.Bytes `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		sig, err := crypto.Sign(crypto.Keccak256(req.Data), s.key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		sig[crypto.RecoveryIDOffset] += 27
+		fmt.Fprint(w, hexutil.Encode(sig))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newStubSigner(t *testing.T) (*stubSigner, common.Address) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &stubSigner{key: key}, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func TestSigner(t *testing.T) {
+	stub, addr := newStubSigner(t)
+	srv := httptest.NewServer(stub)
+	defer srv.Close()
+
+	signer, err := NewSigner(&Config{Endpoint: srv.URL})
+	if err != nil {
+		t.Fatalf("failed to connect to signer: %v", err)
+	}
+	account := accounts.Account{Address: addr}
+	if !signer.Contains(account) {
+		t.Fatalf("account %x not found", addr)
+	}
+	if signer.Contains(accounts.Account{Address: common.HexToAddress("0x01")}) {
+		t.Fatalf("unknown account found")
+	}
+	// Data signatures must be recoverable like local ones
+	data := []byte("democracy header")
+	sig, err := signer.SignData(account, accounts.MimetypeDemocracy, data)
+	if err != nil {
+		t.Fatalf("failed to sign data: %v", err)
+	}
+	if sig[crypto.RecoveryIDOffset] > 1 {
+		t.Fatalf("signature V not in 0/1 form: %d", sig[crypto.RecoveryIDOffset])
+	}
+	pub, err := crypto.SigToPub(crypto.Keccak256(data), sig)
+	if err != nil || crypto.PubkeyToAddress(*pub) != addr {
+		t.Fatalf("data signer mismatch: %v", err)
+	}
+	// Transactions of every type must recover to the account
+	chainID := big.NewInt(1337)
+	to := common.HexToAddress("0x02")
+	for _, inner := range []types.TxData{
+		&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1), Gas: 21000, To: &to, Value: big.NewInt(1)},
+		&types.AccessListTx{ChainID: chainID, Nonce: 2, GasPrice: big.NewInt(1), Gas: 21000, To: &to},
+		&types.DynamicFeeTx{ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2), Gas: 21000, To: &to},
+	} {
+		tx, err := signer.SignTx(account, types.NewTx(inner), chainID)
+		if err != nil {
+			t.Fatalf("failed to sign tx: %v", err)
+		}
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), tx)
+		if err != nil || sender != addr {
+			t.Fatalf("tx sender mismatch: have %x, want %x, err %v", sender, addr, err)
+		}
+	}
+	if _, err := signer.SignData(accounts.Account{Address: common.HexToAddress("0x01")}, "", data); err != ErrUnknownAccount {
+		t.Fatalf("unknown account error mismatch: have %v, want %v", err, ErrUnknownAccount)
+	}
+}
+
+func TestSignerFailClosed(t *testing.T) {
+	stub, addr := newStubSigner(t)
+	srv := httptest.NewServer(stub)
+
+	signer, err := NewSigner(&Config{Endpoint: srv.URL, Timeout: 100 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("failed to connect to signer: %v", err)
+	}
+	account := accounts.Account{Address: addr}
+	if !signer.Contains(account) {
+		t.Fatalf("account %x not found", addr)
+	}
+	// A slow signer must time out
+	stub.delay = time.Second
+	if _, err := signer.SignData(account, "", []byte("data")); err == nil {
+		t.Fatalf("signed through a timed out signer")
+	}
+	// A dead signer must refuse everything
+	srv.Close()
+	if _, err := signer.SignData(account, "", []byte("data")); err == nil {
+		t.Fatalf("signed through a dead signer")
+	}
+	if _, err := signer.Status(); err == nil {
+		t.Fatalf("dead signer reported up")
+	}
+	if _, err := NewSigner(&Config{Endpoint: srv.URL}); err == nil {
+		t.Fatalf("connected to a dead signer")
+	}
+}
+
+func TestSignerTLS(t *testing.T) {
+	stub, addr := newStubSigner(t)
+	srv := httptest.NewTLSServer(stub)
+	defer srv.Close()
+
+	// Without the certificate of the signer the connection must be refused
+	if _, err := NewSigner(&Config{Endpoint: srv.URL}); err == nil {
+		t.Fatalf("connected to an untrusted signer")
+	}
+	ca := filepath.Join(t.TempDir(), "ca.pem")
+	blob := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := ioutil.WriteFile(ca, blob, 0600); err != nil {
+		t.Fatal(err)
+	}
+	signer, err := NewSigner(&Config{Endpoint: srv.URL, CAFile: ca})
+	if err != nil {
+		t.Fatalf("failed to connect to trusted signer: %v", err)
+	}
+	if _, err := signer.SignText(accounts.Account{Address: addr}, []byte("hello")); err != nil {
+		t.Fatalf("failed to sign text: %v", err)
+	}
+	if !strings.HasPrefix(signer.URL().String(), "web3signer://") {
+		t.Fatalf("unexpected signer url: %v", signer.URL())
+	}
+}
@@ -40,6 +40,8 @@ import (
 	"github.com/QEasyWeb3/QEasyChain/cmd/utils"
 	"github.com/QEasyWeb3/QEasyChain/common"
 	"github.com/QEasyWeb3/QEasyChain/common/hexutil"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/slashing"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
 	"github.com/QEasyWeb3/QEasyChain/core/types"
 	"github.com/QEasyWeb3/QEasyChain/crypto"
 	"github.com/QEasyWeb3/QEasyChain/internal/ethapi"
@@ -83,6 +85,10 @@ var (
 		Name:  "advanced",
 		Usage: "If enabled, issues warnings instead of rejections for suspicious requests. Default off",
 	}
+	slashingFlag = cli.BoolFlag{
+		Name:  "slashing-protection",
+		Usage: "Keep a slashing-protection history of the Democracy validators, refusing to sign conflicting headers and attestations",
+	}
 	acceptFlag = cli.BoolFlag{
 		Name:  "suppress-bootwarn",
 		Usage: "If set, does not show the warning during boot",
@@ -242,6 +248,7 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			stdiouiFlag,
 			testFlag,
 			advancedMode,
+			slashingFlag,
 			acceptFlag,
 		},
 	},
@@ -271,6 +278,7 @@ func init() {
 		stdiouiFlag,
 		testFlag,
 		advancedMode,
+		slashingFlag,
 		acceptFlag,
 	}
 	app.Action = signer
@@ -628,6 +636,17 @@ func signer(c *cli.Context) error {
 	am := core.StartClefAccountManager(ksLoc, nousb, lightKdf, scpath)
 	apiImpl := core.NewSignerAPI(am, chainId, nousb, ui, db, advanced, pwStorage)
 
+	// Keep a slashing-protection history of the Democracy validators if requested, so
+	// that nothing conflicting is signed, whatever the rules say
+	if c.GlobalBool(slashingFlag.Name) {
+		slashingDB, err := rawdb.NewLevelDBDatabase(filepath.Join(configDir, "slashing-protection"), 16, 16, "clef/slashing/", false)
+		if err != nil {
+			utils.Fatalf("Could not open slashing-protection database: %v", err)
+		}
+		defer slashingDB.Close()
+		apiImpl.EnableSlashingProtection(slashing.New(slashingDB))
+	}
+
 	// Establish the bidirectional communication, by creating a new UI backend and registering
 	// it with the UI.
 	ui.RegisterUIServer(core.NewUIServerAPI(apiImpl))
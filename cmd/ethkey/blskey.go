@@ -0,0 +1,111 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/QEasyWeb3/QEasyChain/accounts/keystore"
+	"github.com/QEasyWeb3/QEasyChain/cmd/utils"
+	"github.com/QEasyWeb3/QEasyChain/common/hexutil"
+	"github.com/QEasyWeb3/QEasyChain/crypto/bls"
+	"gopkg.in/urfave/cli.v1"
+)
+
+const defaultBLSKeyfileName = "blskey"
+
+type outputBLSKey struct {
+	PublicKey string
+	Proof     string
+}
+
+var commandGenerateBLS = cli.Command{
+	Name:      "generate-bls",
+	Usage:     "generate a new BLS key for aggregate attestations",
+	ArgsUsage: "[ <keyfile> ]",
+	Description: `
+Generate a new BLS secret key encrypted with a passphrase, or inspect an existing
+one, and print its public key together with the proof of possession that has to be
+passed to registerBLSPublicKey of the staking system contract.
+
+The key file is used by the node through --miner.blskey, unlocked with the
+passphrase of --miner.blspassword.`,
+	Flags: []cli.Flag{
+		passphraseFlag,
+		jsonFlag,
+		cli.BoolFlag{
+			Name:  "lightkdf",
+			Usage: "use less secure scrypt parameters",
+		},
+	},
+	Action: func(ctx *cli.Context) error {
+		keyfilepath := ctx.Args().First()
+		if keyfilepath == "" {
+			keyfilepath = defaultBLSKeyfileName
+		}
+		var (
+			key *bls.SecretKey
+			err error
+		)
+		if _, err = os.Stat(keyfilepath); err == nil {
+			keyjson, err := ioutil.ReadFile(keyfilepath)
+			if err != nil {
+				utils.Fatalf("Failed to read the keyfile at '%s': %v", keyfilepath, err)
+			}
+			passphrase := getPassphrase(ctx, false)
+			if key, err = keystore.DecryptBLSKey(keyjson, passphrase); err != nil {
+				utils.Fatalf("Error decrypting BLS key: %v", err)
+			}
+		} else if os.IsNotExist(err) {
+			if key, err = bls.GenerateKey(); err != nil {
+				utils.Fatalf("Failed to generate random BLS key: %v", err)
+			}
+			passphrase := getPassphrase(ctx, true)
+			scryptN, scryptP := keystore.StandardScryptN, keystore.StandardScryptP
+			if ctx.Bool("lightkdf") {
+				scryptN, scryptP = keystore.LightScryptN, keystore.LightScryptP
+			}
+			keyjson, err := keystore.EncryptBLSKey(key, passphrase, scryptN, scryptP)
+			if err != nil {
+				utils.Fatalf("Error encrypting BLS key: %v", err)
+			}
+			if err := os.MkdirAll(filepath.Dir(keyfilepath), 0700); err != nil {
+				utils.Fatalf("Could not create directory %s", filepath.Dir(keyfilepath))
+			}
+			if err := ioutil.WriteFile(keyfilepath, keyjson, 0600); err != nil {
+				utils.Fatalf("Failed to write BLS key to %s: %v", keyfilepath, err)
+			}
+		} else {
+			utils.Fatalf("Error checking if keyfile exists: %v", err)
+		}
+
+		out := outputBLSKey{
+			PublicKey: hexutil.Encode(key.PublicKey().Bytes()),
+			Proof:     hexutil.Encode(key.ProvePossession().Bytes()),
+		}
+		if ctx.Bool(jsonFlag.Name) {
+			mustPrintJSON(out)
+		} else {
+			fmt.Println("Public key:", out.PublicKey)
+			fmt.Println("Proof:     ", out.Proof)
+		}
+		return nil
+	},
+}
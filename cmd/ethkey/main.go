@@ -42,6 +42,7 @@ func init() {
 		commandChangePassphrase,
 		commandSignMessage,
 		commandVerifyMessage,
+		commandGenerateBLS,
 	}
 	cli.CommandHelpTemplate = flags.OriginCommandHelpTemplate
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/QEasyWeb3/QEasyChain/accounts/keystore"
 	"github.com/QEasyWeb3/QEasyChain/accounts/scwallet"
 	"github.com/QEasyWeb3/QEasyChain/accounts/usbwallet"
+	"github.com/QEasyWeb3/QEasyChain/accounts/web3signer"
 	"github.com/QEasyWeb3/QEasyChain/cmd/utils"
 	"github.com/QEasyWeb3/QEasyChain/eth/catalyst"
 	"github.com/QEasyWeb3/QEasyChain/eth/ethconfig"
@@ -292,6 +293,16 @@ func setAccountManagerBackends(stack *node.Node) error {
 		}
 	}
 
+	if conf.RemoteSigner != nil {
+		log.Info("Using remote signer", "url", conf.RemoteSigner.Endpoint)
+		remote, err := web3signer.NewBackend(conf.RemoteSigner)
+		if err != nil {
+			return fmt.Errorf("error connecting to remote signer: %v", err)
+		}
+		am.AddBackend(remote)
+		return nil
+	}
+
 	// For now, we're using EITHER external signer OR local signers.
 	// If/when we implement some form of lockfile for USB and keystore wallets,
 	// we can have both, but it's very confusing for the user to see the same
@@ -68,6 +68,11 @@ var (
 		utils.MinFreeDiskSpaceFlag,
 		utils.KeyStoreDirFlag,
 		utils.ExternalSignerFlag,
+		utils.RemoteSignerFlag,
+		utils.RemoteSignerTimeoutFlag,
+		utils.RemoteSignerCAFlag,
+		utils.RemoteSignerCertFlag,
+		utils.RemoteSignerKeyFlag,
 		utils.NoUSBFlag,
 		utils.USBFlag,
 		utils.SmartCardDaemonPathFlag,
@@ -92,11 +97,15 @@ var (
 		utils.TxPoolGlobalQueueFlag,
 		utils.TxPoolLifetimeFlag,
 		utils.TxPoolReannounceCheckFlag,
+		utils.AttestationJournalFlag,
+		utils.AttestationRejournalFlag,
 		utils.SyncModeFlag,
 		utils.ExitWhenSyncedFlag,
 		utils.GCModeFlag,
 		utils.SnapshotFlag,
 		utils.TxLookupLimitFlag,
+		utils.ValidatorPerformanceIndexFlag,
+		utils.PunishmentHistoryIndexFlag,
 		utils.LightServeFlag,
 		utils.LightIngressFlag,
 		utils.LightEgressFlag,
@@ -108,6 +117,7 @@ var (
 		utils.UltraLightOnlyAnnounceFlag,
 		utils.LightNoSyncServeFlag,
 		utils.WhitelistFlag,
+		utils.SyncCheckpointFlag,
 		utils.BloomFilterSizeFlag,
 		utils.CacheFlag,
 		utils.CacheDatabaseFlag,
@@ -128,6 +138,10 @@ var (
 		utils.MinerGasLimitFlag,
 		utils.MinerGasPriceFlag,
 		utils.MinerEtherbaseFlag,
+		utils.MinerBLSKeyFlag,
+		utils.MinerBLSPasswordFlag,
+		utils.MinerDoppelgangerFlag,
+		utils.MinerConsensusKeyFlag,
 		utils.MinerExtraDataFlag,
 		utils.MinerRecommitIntervalFlag,
 		utils.MinerNoVerifyFlag,
@@ -138,6 +152,7 @@ var (
 		utils.NodeKeyFileFlag,
 		utils.NodeKeyHexFlag,
 		utils.DNSDiscoveryFlag,
+		utils.ValidatorMeshFlag,
 		utils.MainnetFlag,
 		utils.DeveloperFlag,
 		utils.DeveloperPeriodFlag,
@@ -218,6 +233,8 @@ func init() {
 		// See accountcmd.go:
 		accountCommand,
 		walletCommand,
+		// See validatorcmd.go:
+		validatorCommand,
 		// See consolecmd.go:
 		consoleCommand,
 		attachCommand,
@@ -47,10 +47,13 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.ExitWhenSyncedFlag,
 			utils.GCModeFlag,
 			utils.TxLookupLimitFlag,
+			utils.ValidatorPerformanceIndexFlag,
+			utils.PunishmentHistoryIndexFlag,
 			utils.EthStatsURLFlag,
 			utils.IdentityFlag,
 			utils.LightKDFFlag,
 			utils.WhitelistFlag,
+			utils.SyncCheckpointFlag,
 		},
 	},
 	{
@@ -105,6 +108,13 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.TxPoolReannounceCheckFlag,
 		},
 	},
+	{
+		Name: "ATTESTATION POOL",
+		Flags: []cli.Flag{
+			utils.AttestationJournalFlag,
+			utils.AttestationRejournalFlag,
+		},
+	},
 	{
 		Name: "PERFORMANCE TUNING",
 		Flags: []cli.Flag{
@@ -125,6 +135,11 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.UnlockedAccountFlag,
 			utils.PasswordFileFlag,
 			utils.ExternalSignerFlag,
+			utils.RemoteSignerFlag,
+			utils.RemoteSignerTimeoutFlag,
+			utils.RemoteSignerCAFlag,
+			utils.RemoteSignerCertFlag,
+			utils.RemoteSignerKeyFlag,
 			utils.InsecureUnlockAllowedFlag,
 		},
 	},
@@ -163,6 +178,7 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 		Flags: []cli.Flag{
 			utils.BootnodesFlag,
 			utils.DNSDiscoveryFlag,
+			utils.ValidatorMeshFlag,
 			utils.ListenPortFlag,
 			utils.MaxPeersFlag,
 			utils.MaxPendingPeersFlag,
@@ -184,6 +200,10 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.MinerGasPriceFlag,
 			utils.MinerGasLimitFlag,
 			utils.MinerEtherbaseFlag,
+			utils.MinerBLSKeyFlag,
+			utils.MinerBLSPasswordFlag,
+			utils.MinerDoppelgangerFlag,
+			utils.MinerConsensusKeyFlag,
 			utils.MinerExtraDataFlag,
 			utils.MinerRecommitIntervalFlag,
 			utils.MinerNoVerifyFlag,
@@ -0,0 +1,138 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+
+	"github.com/QEasyWeb3/QEasyChain/cmd/utils"
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/slashing"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/log"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	validatorCommand = cli.Command{
+		Name:      "validator",
+		Usage:     "Manage the data of the local Democracy validators",
+		ArgsUsage: "",
+		Category:  "ACCOUNT COMMANDS",
+		Subcommands: []cli.Command{
+			{
+				Name:      "slashing-protection",
+				Usage:     "Move the slashing-protection history of validators between machines",
+				ArgsUsage: "",
+				Subcommands: []cli.Command{
+					slashingExportCmd,
+					slashingImportCmd,
+				},
+			},
+		},
+	}
+	slashingExportCmd = cli.Command{
+		Action:    utils.MigrateFlags(exportSlashingProtection),
+		Name:      "export",
+		Usage:     "Export the slashing-protection history in EIP-3076 format",
+		ArgsUsage: "<file>",
+		Flags: []cli.Flag{
+			utils.DataDirFlag,
+			utils.AncientFlag,
+			utils.TestnetFlag,
+		},
+		Description: `
+geth validator slashing-protection export <file>
+writes the headers and votes signed by every local validator into an EIP-3076
+interchange file, to be imported on the machine the validator keys are moved to.
+The slot of the format holds the header number, and the epochs of the votes hold
+the source and target block numbers.`,
+	}
+	slashingImportCmd = cli.Command{
+		Action:    utils.MigrateFlags(importSlashingProtection),
+		Name:      "import",
+		Usage:     "Import the slashing-protection history from an EIP-3076 file",
+		ArgsUsage: "<file>",
+		Flags: []cli.Flag{
+			utils.DataDirFlag,
+			utils.AncientFlag,
+			utils.TestnetFlag,
+		},
+		Description: `
+geth validator slashing-protection import <file>
+merges the signing history of an EIP-3076 interchange file into the local
+slashing-protection database. Nothing at or below the highest imported header
+or vote will be signed afterwards. The node must not be running.`,
+	}
+)
+
+func exportSlashingProtection(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.New("need the output file as argument")
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, true)
+	defer db.Close()
+
+	genesis := rawdb.ReadCanonicalHash(db, 0)
+	if genesis == (common.Hash{}) {
+		return errors.New("missing genesis block")
+	}
+	ic := slashing.New(db).Export(genesis)
+	blob, err := json.MarshalIndent(ic, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(ctx.Args().First(), blob, 0600); err != nil {
+		return err
+	}
+	log.Info("Exported slashing-protection history", "validators", len(ic.Data), "file", ctx.Args().First())
+	return nil
+}
+
+func importSlashingProtection(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.New("need the input file as argument")
+	}
+	blob, err := ioutil.ReadFile(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	ic := new(slashing.Interchange)
+	if err := json.Unmarshal(blob, ic); err != nil {
+		return err
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, false)
+	defer db.Close()
+
+	genesis := rawdb.ReadCanonicalHash(db, 0)
+	if genesis == (common.Hash{}) {
+		return errors.New("missing genesis block")
+	}
+	if err := slashing.New(db).Import(ic, genesis); err != nil {
+		return err
+	}
+	log.Info("Imported slashing-protection history", "validators", len(ic.Data), "file", ctx.Args().First())
+	return nil
+}
@@ -35,6 +35,7 @@ import (
 
 	"github.com/QEasyWeb3/QEasyChain/accounts"
 	"github.com/QEasyWeb3/QEasyChain/accounts/keystore"
+	"github.com/QEasyWeb3/QEasyChain/accounts/web3signer"
 	"github.com/QEasyWeb3/QEasyChain/common"
 	"github.com/QEasyWeb3/QEasyChain/common/fdlimit"
 	"github.com/QEasyWeb3/QEasyChain/consensus"
@@ -223,6 +224,14 @@ var (
 		Usage: "Number of recent blocks to maintain transactions index for (default = about one year, 0 = entire chain)",
 		Value: ethconfig.Defaults.TxLookupLimit,
 	}
+	ValidatorPerformanceIndexFlag = cli.BoolFlag{
+		Name:  "perfindex",
+		Usage: "Index the validator performance in the background, making democracy_getValidatorPerformance cheap over large ranges",
+	}
+	PunishmentHistoryIndexFlag = cli.BoolFlag{
+		Name:  "punishindex",
+		Usage: "Index the executed punishments in the background, making democracy_getPunishmentHistory cheap over large ranges",
+	}
 	LightKDFFlag = cli.BoolFlag{
 		Name:  "lightkdf",
 		Usage: "Reduce key-derivation RAM & CPU usage at some expense of KDF strength",
@@ -231,6 +240,10 @@ var (
 		Name:  "whitelist",
 		Usage: "Comma separated block number-to-hash mappings to enforce (<number>=<hash>)",
 	}
+	SyncCheckpointFlag = cli.StringFlag{
+		Name:  "sync.checkpoint",
+		Usage: "Trusted finalized epoch checkpoint block to sync from, rejecting peers without it (<number>:<hash>)",
+	}
 	BloomFilterSizeFlag = cli.Uint64Flag{
 		Name:  "bloomfilter.size",
 		Usage: "Megabytes of memory allocated to bloom-filter for pruning",
@@ -405,6 +418,16 @@ var (
 		Usage: "Time interval to regenerate the trie cache journal",
 		Value: ethconfig.Defaults.TrieCleanCacheRejournal,
 	}
+	AttestationJournalFlag = cli.StringFlag{
+		Name:  "attestation.journal",
+		Usage: "Disk journal for the attestation pool to survive node restarts",
+		Value: ethconfig.Defaults.AttestationJournal,
+	}
+	AttestationRejournalFlag = cli.DurationFlag{
+		Name:  "attestation.rejournal",
+		Usage: "Time interval to regenerate the attestation journal",
+		Value: ethconfig.Defaults.AttestationRejournal,
+	}
 	CacheGCFlag = cli.IntFlag{
 		Name:  "cache.gc",
 		Usage: "Percentage of cache memory allowance to use for trie pruning (default = 25% full mode, 0% archive mode)",
@@ -456,6 +479,22 @@ var (
 		Usage: "Public address for block mining rewards (default = first account)",
 		Value: "0",
 	}
+	MinerBLSKeyFlag = cli.StringFlag{
+		Name:  "miner.blskey",
+		Usage: "File holding the encrypted BLS key used to sign aggregate attestations after the Jupiter fork",
+	}
+	MinerBLSPasswordFlag = cli.StringFlag{
+		Name:  "miner.blspassword",
+		Usage: "File holding the passphrase of the BLS key",
+	}
+	MinerDoppelgangerFlag = cli.Uint64Flag{
+		Name:  "miner.doppelganger",
+		Usage: "Number of blocks to watch the network for the validator key being active elsewhere, before attesting and sealing (0 = disabled)",
+	}
+	MinerConsensusKeyFlag = cli.StringFlag{
+		Name:  "miner.consensuskey",
+		Usage: "Account of the rotated consensus key to seal blocks and sign attestations with (default = etherbase)",
+	}
 	MinerExtraDataFlag = cli.StringFlag{
 		Name:  "miner.extradata",
 		Usage: "Block extra data set by the miner (default = client version)",
@@ -485,6 +524,28 @@ var (
 		Usage: "External signer (url or path to ipc file)",
 		Value: "",
 	}
+	RemoteSignerFlag = cli.StringFlag{
+		Name:  "signer.remote",
+		Usage: "Web3Signer-compatible remote signer holding the validator keys (url)",
+		Value: "",
+	}
+	RemoteSignerTimeoutFlag = cli.DurationFlag{
+		Name:  "signer.remote.timeout",
+		Usage: "Time allowed to the remote signer to answer a request",
+		Value: web3signer.DefaultTimeout,
+	}
+	RemoteSignerCAFlag = cli.StringFlag{
+		Name:  "signer.remote.tls.ca",
+		Usage: "PEM file of the certificate authorities to verify the remote signer with",
+	}
+	RemoteSignerCertFlag = cli.StringFlag{
+		Name:  "signer.remote.tls.cert",
+		Usage: "PEM file of the client certificate to authenticate to the remote signer with",
+	}
+	RemoteSignerKeyFlag = cli.StringFlag{
+		Name:  "signer.remote.tls.key",
+		Usage: "PEM file of the client key to authenticate to the remote signer with",
+	}
 	VMEnableDebugFlag = cli.BoolFlag{
 		Name:  "vmdebug",
 		Usage: "Record information useful for VM and contract debugging",
@@ -670,6 +731,10 @@ var (
 		Name:  "discovery.dns",
 		Usage: "Sets DNS discovery entry points (use \"\" to disable DNS)",
 	}
+	ValidatorMeshFlag = cli.BoolFlag{
+		Name:  "validator.mesh",
+		Usage: "Advertises the local validator in the node record and keeps direct connections to the current validators",
+	}
 
 	// ATM the url is left to the user and deployment to
 	JSpathFlag = DirectoryFlag{
@@ -1207,6 +1272,7 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 	if ctx.GlobalIsSet(ExternalSignerFlag.Name) {
 		cfg.ExternalSigner = ctx.GlobalString(ExternalSignerFlag.Name)
 	}
+	setRemoteSigner(ctx, cfg)
 
 	if ctx.GlobalIsSet(KeyStoreDirFlag.Name) {
 		cfg.KeyStoreDir = ctx.GlobalString(KeyStoreDirFlag.Name)
@@ -1231,6 +1297,20 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 	}
 }
 
+// setRemoteSigner creates the remote signer configuration from the set command line
+// flags, if the remote signer is enabled.
+func setRemoteSigner(ctx *cli.Context, cfg *node.Config) {
+	if ctx.GlobalIsSet(RemoteSignerFlag.Name) {
+		cfg.RemoteSigner = &web3signer.Config{
+			Endpoint: ctx.GlobalString(RemoteSignerFlag.Name),
+			Timeout:  ctx.GlobalDuration(RemoteSignerTimeoutFlag.Name),
+			CAFile:   ctx.GlobalString(RemoteSignerCAFlag.Name),
+			CertFile: ctx.GlobalString(RemoteSignerCertFlag.Name),
+			KeyFile:  ctx.GlobalString(RemoteSignerKeyFlag.Name),
+		}
+	}
+}
+
 func setSmartCard(ctx *cli.Context, cfg *node.Config) {
 	// Skip enabling smartcards if no path is set
 	path := ctx.GlobalString(SmartCardDaemonPathFlag.Name)
@@ -1375,6 +1455,22 @@ func setMiner(ctx *cli.Context, cfg *miner.Config) {
 	if ctx.GlobalIsSet(MinerNoVerifyFlag.Name) {
 		cfg.Noverify = ctx.GlobalBool(MinerNoVerifyFlag.Name)
 	}
+	if ctx.GlobalIsSet(MinerBLSKeyFlag.Name) {
+		cfg.BLSKeyFile = ctx.GlobalString(MinerBLSKeyFlag.Name)
+	}
+	if ctx.GlobalIsSet(MinerBLSPasswordFlag.Name) {
+		cfg.BLSPasswordFile = ctx.GlobalString(MinerBLSPasswordFlag.Name)
+	}
+	if ctx.GlobalIsSet(MinerDoppelgangerFlag.Name) {
+		cfg.DoppelgangerBlocks = ctx.GlobalUint64(MinerDoppelgangerFlag.Name)
+	}
+	if ctx.GlobalIsSet(MinerConsensusKeyFlag.Name) {
+		key := ctx.GlobalString(MinerConsensusKeyFlag.Name)
+		if !common.IsHexAddress(key) {
+			Fatalf("Invalid consensus key account: %s", key)
+		}
+		cfg.ConsensusKey = common.HexToAddress(key)
+	}
 	if ctx.GlobalIsSet(LegacyMinerGasTargetFlag.Name) {
 		log.Warn("The generic --miner.gastarget flag is deprecated and will be removed in the future!")
 	}
@@ -1403,6 +1499,39 @@ func setWhitelist(ctx *cli.Context, cfg *ethconfig.Config) {
 	}
 }
 
+func setSyncCheckpoint(ctx *cli.Context, cfg *ethconfig.Config) {
+	checkpoint := ctx.GlobalString(SyncCheckpointFlag.Name)
+	if checkpoint == "" {
+		return
+	}
+	parts := strings.Split(checkpoint, ":")
+	if len(parts) != 2 {
+		Fatalf("Invalid sync checkpoint: %s", checkpoint)
+	}
+	number, err := strconv.ParseUint(parts[0], 0, 64)
+	if err != nil {
+		Fatalf("Invalid sync checkpoint block number %s: %v", parts[0], err)
+	}
+	if number == 0 {
+		Fatalf("Invalid sync checkpoint block number %s: genesis can't be a checkpoint", parts[0])
+	}
+	var hash common.Hash
+	if err = hash.UnmarshalText([]byte(parts[1])); err != nil {
+		Fatalf("Invalid sync checkpoint hash %s: %v", parts[1], err)
+	}
+	cp := &params.SyncCheckpoint{Number: number, Hash: hash}
+
+	// Reject a checkpoint the snapshots can't be anchored to. The chain config of
+	// networks not selected by flag is only known once the database is opened, the
+	// checkpoint is validated against it there too.
+	if cfg.Genesis != nil {
+		if err := cp.Validate(cfg.Genesis.Config); err != nil {
+			Fatalf("Invalid sync checkpoint %s: %v", checkpoint, err)
+		}
+	}
+	cfg.SyncCheckpoint = cp
+}
+
 // CheckExclusive verifies that only a single instance of the provided flags was
 // set by the user. Each flag might optionally be followed by a string type to
 // specialize it further.
@@ -1449,7 +1578,7 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	// Avoid conflicting network flags
 	CheckExclusive(ctx, MainnetFlag, DeveloperFlag, TestnetFlag)
 	CheckExclusive(ctx, LightServeFlag, SyncModeFlag, "light")
-	CheckExclusive(ctx, DeveloperFlag, ExternalSignerFlag) // Can't use both ephemeral unlocked and external signer
+	CheckExclusive(ctx, DeveloperFlag, ExternalSignerFlag, RemoteSignerFlag) // Can't use both ephemeral unlocked and external signers
 	if ctx.GlobalString(GCModeFlag.Name) == "archive" && ctx.GlobalUint64(TxLookupLimitFlag.Name) != 0 {
 		ctx.GlobalSet(TxLookupLimitFlag.Name, "0")
 		log.Warn("Disable transaction unindexing for archive node")
@@ -1521,6 +1650,12 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.GlobalIsSet(TxLookupLimitFlag.Name) {
 		cfg.TxLookupLimit = ctx.GlobalUint64(TxLookupLimitFlag.Name)
 	}
+	if ctx.GlobalIsSet(ValidatorPerformanceIndexFlag.Name) {
+		cfg.ValidatorPerformanceIndex = ctx.GlobalBool(ValidatorPerformanceIndexFlag.Name)
+	}
+	if ctx.GlobalIsSet(PunishmentHistoryIndexFlag.Name) {
+		cfg.PunishmentHistoryIndex = ctx.GlobalBool(PunishmentHistoryIndexFlag.Name)
+	}
 	if ctx.GlobalIsSet(CacheFlag.Name) || ctx.GlobalIsSet(CacheTrieFlag.Name) {
 		cfg.TrieCleanCache = ctx.GlobalInt(CacheFlag.Name) * ctx.GlobalInt(CacheTrieFlag.Name) / 100
 	}
@@ -1530,6 +1665,12 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.GlobalIsSet(CacheTrieRejournalFlag.Name) {
 		cfg.TrieCleanCacheRejournal = ctx.GlobalDuration(CacheTrieRejournalFlag.Name)
 	}
+	if ctx.GlobalIsSet(AttestationJournalFlag.Name) {
+		cfg.AttestationJournal = ctx.GlobalString(AttestationJournalFlag.Name)
+	}
+	if ctx.GlobalIsSet(AttestationRejournalFlag.Name) {
+		cfg.AttestationRejournal = ctx.GlobalDuration(AttestationRejournalFlag.Name)
+	}
 	if ctx.GlobalIsSet(CacheFlag.Name) || ctx.GlobalIsSet(CacheGCFlag.Name) {
 		cfg.TrieDirtyCache = ctx.GlobalInt(CacheFlag.Name) * ctx.GlobalInt(CacheGCFlag.Name) / 100
 	}
@@ -1577,6 +1718,9 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 			cfg.EthDiscoveryURLs = SplitAndTrim(urls)
 		}
 	}
+	if ctx.GlobalIsSet(ValidatorMeshFlag.Name) {
+		cfg.ValidatorMesh = ctx.GlobalBool(ValidatorMeshFlag.Name)
+	}
 	// Override any default configs for hard coded networks.
 	switch {
 	case ctx.GlobalBool(MainnetFlag.Name):
@@ -1642,6 +1786,7 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 			SetDNSDiscoveryDefaults(cfg, params.MainnetGenesisHash)
 		}
 	}
+	setSyncCheckpoint(ctx, cfg)
 }
 
 // SetDNSDiscoveryDefaults configures DNS discovery with the given URL if
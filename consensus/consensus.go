@@ -62,6 +62,22 @@ type ChainReader interface {
 	GetBlock(hash common.Hash, number uint64) *types.Block
 }
 
+// AttestationReader defines a small collection of methods needed to access the locally
+// collected attestations when assembling finality certificates or proofs.
+type AttestationReader interface {
+	// LastValidJustifiedOrFinalized retrieves the latest justified or finalized block.
+	LastValidJustifiedOrFinalized() (*types.RangeEdge, error)
+
+	// GetHistoryAttestations retrieves the attestations collected for a block.
+	GetHistoryAttestations(num *big.Int, hash common.Hash) ([]*types.Attestation, error)
+
+	// GetAggregateAttestations retrieves the aggregate attestations stored for a block height.
+	GetAggregateAttestations(number uint64) []*types.AggregateAttestation
+
+	// GetBlockStatus retrieves the justified or finalized status of a block.
+	GetBlockStatus(number uint64, hash common.Hash) uint8
+}
+
 // Engine is an algorithm agnostic consensus engine.
 type Engine interface {
 	// Author retrieves the Ethereum address of the account that minted the given
@@ -146,18 +162,46 @@ type Democracy interface {
 	PreHandle(chain ChainHeaderReader, header *types.Header, state *state.StateDB) error
 
 	// VerifyAttestation checks whether an attestation is valid,
-	// and if it's valid, return the signer,
+	// and if it's valid, return the validator signing with the active key,
 	// and a threshold that indicates how many attestations can finalize a block.
 	VerifyAttestation(chain ChainHeaderReader, a *types.Attestation) (common.Address, int, error)
 
+	// ValidatorOfKey returns the validator signing with the given consensus key at the
+	// given block, or the key itself if it doesn't belong to an authorized validator.
+	ValidatorOfKey(chain ChainHeaderReader, key common.Address, hash common.Hash, number uint64) (common.Address, error)
+
 	// CurrentValidator Get the verifier address in the current consensus
 	CurrentValidator() common.Address
 	MaxValidators() uint8
 
 	// Attest trys to give an attestation on current chain when a ChainHeadEvent is fired.
 	Attest(chain ChainHeaderReader, headerNum *big.Int, source, target *types.RangeEdge) (*types.Attestation, error)
-	CurrentNeedHandleHeight(headerNum uint64) (uint64, error)
-	AttestationDelay() uint64
+	CurrentNeedHandleHeight(chain ChainHeaderReader, head *types.Header) (uint64, error)
+
+	// AttestAggregate trys to give a BLS attestation, wrapped in an aggregate of a single
+	// signer, after the Jupiter hard-fork.
+	AttestAggregate(chain ChainHeaderReader, source, target *types.RangeEdge) (*types.AggregateAttestation, error)
+
+	// VerifyAggregateAttestation checks whether an aggregate attestation is valid,
+	// and if it's valid, return the signers,
+	// and a threshold that indicates how many signers can justify a block.
+	VerifyAggregateAttestation(chain ChainHeaderReader, a *types.AggregateAttestation) ([]common.Address, int, error)
+
+	// VerifyAggregateVoteEvidence checks that two aggregate attestations of the evidence are
+	// both signed by its defendant and break the CasperFFG rules, and returns the rule broken.
+	VerifyAggregateVoteEvidence(chain ChainHeaderReader, e *types.AggregateVoteEvidence) (int, error)
+
+	// AttestationDelay returns the number of blocks the attestations lag behind the
+	// given header, as governed on top of it.
+	AttestationDelay(chain ChainHeaderReader, header *types.Header) uint64
+
+	// ContinuousInturn returns the number of continuous blocks sealed by an in-turn
+	// validator on top of the given header.
+	ContinuousInturn(chain ChainHeaderReader, header *types.Header) uint64
+
+	// EpochAt returns the index of the epoch the given block belongs to, the checkpoint
+	// block which started it, and the length of the epochs from there on.
+	EpochAt(chain ChainHeaderReader, hash common.Hash, number uint64) (uint64, uint64, uint64, error)
 
 	// IsReadyAttest Whether it meets the conditions for executing interest
 	IsReadyAttest() bool
@@ -165,13 +209,20 @@ type Democracy interface {
 	AttestationStatus() uint8
 	StartAttestation()
 
+	// ObserveSignature inspects the signer of a header or attestation seen on the network,
+	// to detect the local validator key being active on another machine.
+	ObserveSignature(signer common.Address, number uint64, timestamp uint64)
+
 	// AttestationThreshold Get the attestation threshold at the specified height
 	AttestationThreshold(chain ChainHeaderReader, hash common.Hash, number uint64) (int, error)
 
 	Validators(chain ChainHeaderReader, hash common.Hash, number uint64) ([]common.Address, error)
 
+	// FinalityCertificates retrieves the finality certificates embedded in a header.
+	FinalityCertificates(header *types.Header) ([]*types.FinalityCertificate, error)
+
 	// CalculateGasPool calculate the expected max gas used for a block
-	CalculateGasPool(header *types.Header) uint64
+	CalculateGasPool(chain ChainHeaderReader, header *types.Header) uint64
 
 	GetDb() ethdb.Database
 
@@ -184,6 +235,10 @@ type Democracy interface {
 
 	ApplyDoubleSignPunishTx(evm *vm.EVM, sender common.Address, tx *types.Transaction) (ret []byte, vmerr error, err error)
 
+	// CheckDoubleSeal inspects a sealed header received from the network, and records
+	// the evidence if its validator already sealed a different header at the same height.
+	CheckDoubleSeal(chain ChainHeaderReader, header *types.Header)
+
 	// IsSysTransaction checks whether a specific transaction is a system transaction.
 	IsSysTransaction(sender common.Address, tx *types.Transaction, header *types.Header) bool
 
@@ -17,11 +17,14 @@
 package democracy
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/common/hexutil"
 	"github.com/QEasyWeb3/QEasyChain/consensus"
 	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
 	"github.com/QEasyWeb3/QEasyChain/rpc"
 )
 
@@ -145,3 +148,225 @@ func (api *API) Status() (*status, error) {
 		NumBlocks:     numBlocks,
 	}, nil
 }
+
+// FinalityAttestation is a single validator signature on a (source,target) pair.
+type FinalityAttestation struct {
+	Source    *types.RangeEdge `json:"source"`
+	Target    *types.RangeEdge `json:"target"`
+	Signer    common.Address   `json:"signer"`    // Consensus key recovered from the signature
+	Validator common.Address   `json:"validator"` // Validator signing with the consensus key
+	Signature hexutil.Bytes    `json:"signature"` // 65 byte [R || S || V] secp256k1 signature
+}
+
+// FinalityAggregate is the aggregate BLS signature of a set of validators on a (source,target) pair.
+type FinalityAggregate struct {
+	Source    *types.RangeEdge `json:"source"`
+	Target    *types.RangeEdge `json:"target"`
+	Signers   []common.Address `json:"signers"`
+	Bitfield  hexutil.Bytes    `json:"bitfield"`
+	Signature hexutil.Bytes    `json:"signature"`
+}
+
+// FinalityProof holds everything needed to check offline that a block was justified
+// or finalized: the attestations collected for it, and the validator set and
+// threshold they were counted against.
+type FinalityProof struct {
+	Number       uint64                 `json:"number"`
+	Hash         common.Hash            `json:"hash"`
+	Status       uint8                  `json:"status"` // BasJustified/BasFinalized
+	Validators   []common.Address       `json:"validators"`
+	Threshold    int                    `json:"threshold"`
+	Attestations []*FinalityAttestation `json:"attestations"`
+	Aggregates   []*FinalityAggregate   `json:"aggregates,omitempty"`
+}
+
+// errFinalityProofUnavailable is returned if the attestations that justified a block are
+// neither collected locally anymore nor embedded in the chain.
+var errFinalityProofUnavailable = errors.New("finality proof not available")
+
+// finalityChain is implemented by the chains tracking the justified and finalized blocks.
+type finalityChain interface {
+	// CurrentSafeBlock retrieves the last justified or finalized block of the canonical chain.
+	CurrentSafeBlock() *types.Block
+
+	// CurrentFinalizedBlock retrieves the last finalized block of the canonical chain.
+	CurrentFinalizedBlock() *types.Block
+}
+
+// headerByNumber retrieves the canonical header of a block number, resolving the latest,
+// pending, safe and finalized tags.
+func (api *API) headerByNumber(number rpc.BlockNumber) *types.Header {
+	switch number {
+	case rpc.LatestBlockNumber, rpc.PendingBlockNumber:
+		return api.chain.CurrentHeader()
+	case rpc.SafeBlockNumber, rpc.FinalizedBlockNumber:
+		chain, ok := api.chain.(finalityChain)
+		if !ok {
+			return nil
+		}
+		block := chain.CurrentFinalizedBlock()
+		if number == rpc.SafeBlockNumber {
+			block = chain.CurrentSafeBlock()
+		}
+		if block == nil {
+			return nil
+		}
+		return block.Header()
+	}
+	if number < 0 {
+		return nil
+	}
+	return api.chain.GetHeaderByNumber(uint64(number))
+}
+
+// GetFinalityProof retrieves the attestations that justified or finalized the given block,
+// together with the validator set and threshold used to count them. The attestations are
+// taken from the ones collected locally for the recent blocks, and from the finality
+// certificate embedded in the chain for the older ones.
+func (api *API) GetFinalityProof(blockNrOrHash rpc.BlockNumberOrHash) (*FinalityProof, error) {
+	reader, ok := api.chain.(consensus.AttestationReader)
+	if !ok {
+		return nil, errors.New("attestations not available")
+	}
+	var header *types.Header
+	if hash, ok := blockNrOrHash.Hash(); ok {
+		header = api.chain.GetHeaderByHash(hash)
+		if header != nil && blockNrOrHash.RequireCanonical {
+			if canonical := api.chain.GetHeaderByNumber(header.Number.Uint64()); canonical == nil || canonical.Hash() != hash {
+				return nil, errors.New("hash is not currently canonical")
+			}
+		}
+	} else if number, ok := blockNrOrHash.Number(); ok {
+		header = api.headerByNumber(number)
+	}
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+	number, hash := header.Number.Uint64(), header.Hash()
+	status := reader.GetBlockStatus(number, hash)
+	if status != types.BasJustified && status != types.BasFinalized {
+		return nil, errors.New("block is neither justified nor finalized")
+	}
+	snap, err := api.democracy.snapshot(api.chain, number, hash, nil)
+	if err != nil {
+		return nil, err
+	}
+	validators := snap.validators()
+	proof := &FinalityProof{
+		Number:       number,
+		Hash:         hash,
+		Status:       status,
+		Validators:   validators,
+		Threshold:    attestationThreshold(len(validators)),
+		Attestations: make([]*FinalityAttestation, 0),
+	}
+	if attestations, err := reader.GetHistoryAttestations(header.Number, hash); err == nil {
+		proof.addAttestations(attestations, snap)
+	}
+	for _, a := range reader.GetAggregateAttestations(number) {
+		if a.TargetRangeEdge.Hash != hash {
+			continue
+		}
+		signers := make([]common.Address, 0, a.Count())
+		for _, index := range a.SignerIndexes() {
+			if index < len(validators) {
+				signers = append(signers, validators[index])
+			}
+		}
+		proof.Aggregates = append(proof.Aggregates, &FinalityAggregate{
+			Source:    a.SourceRangeEdge,
+			Target:    a.TargetRangeEdge,
+			Signers:   signers,
+			Bitfield:  a.Signers,
+			Signature: a.Signature,
+		})
+	}
+	if !proof.complete() {
+		if cert := api.finalityCertificate(header); cert != nil {
+			proof.Attestations = proof.Attestations[:0]
+			proof.addAttestations(cert.Attestations(), snap)
+		}
+	}
+	if !proof.complete() {
+		return nil, errFinalityProofUnavailable
+	}
+	return proof, nil
+}
+
+// finalityCertificate searches the canonical blocks following the given one for the finality
+// certificate targeting it.
+func (api *API) finalityCertificate(header *types.Header) *types.FinalityCertificate {
+	var (
+		number = header.Number.Uint64()
+		hash   = header.Hash()
+		head   = api.chain.CurrentHeader().Number.Uint64()
+	)
+	for n := number + 1; n <= number+maxFinalityCertificateGap && n <= head; n++ {
+		h := api.chain.GetHeaderByNumber(n)
+		if h == nil {
+			return nil
+		}
+		certs, err := api.democracy.FinalityCertificates(h)
+		if err != nil {
+			continue
+		}
+		for _, cert := range certs {
+			if cert.TargetRangeEdge.Hash == hash {
+				return cert
+			}
+		}
+	}
+	return nil
+}
+
+// addAttestations adds the attestations signed by the validators of the snapshot to the proof.
+func (proof *FinalityProof) addAttestations(attestations []*types.Attestation, snap *Snapshot) {
+	for _, a := range attestations {
+		signer, err := a.RecoverSigner()
+		if err != nil {
+			continue
+		}
+		validator, ok := snap.validatorOf(signer)
+		if !ok {
+			continue
+		}
+		sig := make([]byte, crypto.SignatureLength)
+		a.R.FillBytes(sig[:32])
+		a.S.FillBytes(sig[32:64])
+		sig[64] = a.V
+		proof.Attestations = append(proof.Attestations, &FinalityAttestation{
+			Source:    a.SourceRangeEdge,
+			Target:    a.TargetRangeEdge,
+			Signer:    signer,
+			Validator: validator,
+			Signature: sig,
+		})
+	}
+}
+
+// complete checks whether the proof holds the signatures of at least the threshold of the
+// validators on a single (source,target) pair.
+func (proof *FinalityProof) complete() bool {
+	signers := make(map[common.Hash]map[common.Address]struct{})
+	add := func(source, target *types.RangeEdge, validator common.Address) bool {
+		signHash := types.AttestationSignHash(source, target)
+		if signers[signHash] == nil {
+			signers[signHash] = make(map[common.Address]struct{})
+		}
+		signers[signHash][validator] = struct{}{}
+		return len(signers[signHash]) >= proof.Threshold
+	}
+	for _, a := range proof.Attestations {
+		if add(a.Source, a.Target, a.Validator) {
+			return true
+		}
+	}
+	for _, a := range proof.Aggregates {
+		for _, validator := range a.Signers {
+			if add(a.Source, a.Target, validator) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,207 @@
+package democracy
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+	"github.com/QEasyWeb3/QEasyChain/params"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+	"github.com/QEasyWeb3/QEasyChain/rpc"
+)
+
+// testFinalityChain is a canonical chain of headers tracking the status of its blocks and
+// the attestations collected for them, as seen by the consensus engine.
+type testFinalityChain struct {
+	config       *params.ChainConfig
+	headers      []*types.Header
+	status       map[common.Hash]uint8
+	attestations map[common.Hash][]*types.Attestation
+	aggregates   map[uint64][]*types.AggregateAttestation
+	safe         uint64
+	finalized    uint64
+}
+
+func (c *testFinalityChain) Config() *params.ChainConfig  { return c.config }
+func (c *testFinalityChain) CurrentHeader() *types.Header { return c.headers[len(c.headers)-1] }
+func (c *testFinalityChain) GetHeaderByNumber(number uint64) *types.Header {
+	if number >= uint64(len(c.headers)) {
+		return nil
+	}
+	return c.headers[number]
+}
+func (c *testFinalityChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	if header := c.GetHeaderByNumber(number); header != nil && header.Hash() == hash {
+		return header
+	}
+	return nil
+}
+func (c *testFinalityChain) GetHeaderByHash(hash common.Hash) *types.Header {
+	for _, header := range c.headers {
+		if header.Hash() == hash {
+			return header
+		}
+	}
+	return nil
+}
+func (c *testFinalityChain) LastValidJustifiedOrFinalized() (*types.RangeEdge, error) {
+	return nil, errors.New("not implemented")
+}
+func (c *testFinalityChain) GetHistoryAttestations(num *big.Int, hash common.Hash) ([]*types.Attestation, error) {
+	if attestations, ok := c.attestations[hash]; ok {
+		return attestations, nil
+	}
+	return nil, errors.New("no attestations")
+}
+func (c *testFinalityChain) GetAggregateAttestations(number uint64) []*types.AggregateAttestation {
+	return c.aggregates[number]
+}
+func (c *testFinalityChain) GetBlockStatus(number uint64, hash common.Hash) uint8 {
+	return c.status[hash]
+}
+func (c *testFinalityChain) CurrentSafeBlock() *types.Block {
+	return types.NewBlockWithHeader(c.headers[c.safe])
+}
+func (c *testFinalityChain) CurrentFinalizedBlock() *types.Block {
+	return types.NewBlockWithHeader(c.headers[c.finalized])
+}
+
+// newTestFinalityChain creates a chain of the given length after the Mars hard-fork, where the
+// header at certified+gap embeds a finality certificate of the given attestations if any.
+func newTestFinalityChain(t *testing.T, length int, certified uint64, gap uint64, sign func(*types.Header) []*types.Attestation) *testFinalityChain {
+	chain := &testFinalityChain{
+		config:       &params.ChainConfig{MarsBlock: big.NewInt(0), Democracy: &params.DemocracyConfig{Epoch: 200}},
+		status:       make(map[common.Hash]uint8),
+		attestations: make(map[common.Hash][]*types.Attestation),
+		aggregates:   make(map[uint64][]*types.AggregateAttestation),
+	}
+	var certs []*types.FinalityCertificate
+	for i := 0; i < length; i++ {
+		header := &types.Header{Number: big.NewInt(int64(i)), Difficulty: diffInTurn}
+		if i > 0 {
+			header.ParentHash = chain.headers[i-1].Hash()
+		}
+		if uint64(i) == certified+gap && sign != nil {
+			cert, err := types.NewFinalityCertificate(sign(chain.headers[certified]))
+			if err != nil {
+				t.Fatalf("failed to create certificate: %v", err)
+			}
+			certs = []*types.FinalityCertificate{cert}
+		} else {
+			certs = []*types.FinalityCertificate{}
+		}
+		enc, err := rlp.EncodeToBytes(certs)
+		if err != nil {
+			t.Fatalf("failed to encode certificates: %v", err)
+		}
+		header.Extra = append(append(make([]byte, extraVanity), enc...), make([]byte, extraSeal)...)
+		chain.headers = append(chain.headers, header)
+	}
+	return chain
+}
+
+// newTestValidators creates a sorted set of validator keys.
+func newTestValidators(t *testing.T, n int) ([]*ecdsa.PrivateKey, []common.Address) {
+	keys := make([]*ecdsa.PrivateKey, n)
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatalf("failed to generate key: %v", err)
+		}
+		keys[i] = key
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := crypto.PubkeyToAddress(keys[i].PublicKey), crypto.PubkeyToAddress(keys[j].PublicKey)
+		return bytes.Compare(a[:], b[:]) < 0
+	})
+	addrs := make([]common.Address, n)
+	for i, key := range keys {
+		addrs[i] = crypto.PubkeyToAddress(key.PublicKey)
+	}
+	return keys, addrs
+}
+
+// signTestAttestations signs attestations of the given (source,target) pair with every key.
+func signTestAttestations(t *testing.T, keys []*ecdsa.PrivateKey, source, target *types.Header) []*types.Attestation {
+	sourceEdge := &types.RangeEdge{Hash: source.Hash(), Number: source.Number}
+	targetEdge := &types.RangeEdge{Hash: target.Hash(), Number: target.Number}
+	attestations := make([]*types.Attestation, 0, len(keys))
+	for _, key := range keys {
+		sig, err := crypto.Sign(types.AttestationSignHash(sourceEdge, targetEdge).Bytes(), key)
+		if err != nil {
+			t.Fatalf("failed to sign attestation: %v", err)
+		}
+		attestations = append(attestations, types.NewAttestation(sourceEdge, targetEdge, sig))
+	}
+	return attestations
+}
+
+// newTestFinalityAPI creates an API on the chain whose snapshots at the given blocks are
+// authorized by the validators.
+func newTestFinalityAPI(chain *testFinalityChain, validators []common.Address, numbers ...uint64) *API {
+	engine := New(&params.ChainConfig{MarsBlock: big.NewInt(0), Democracy: &params.DemocracyConfig{Epoch: 200}}, rawdb.NewMemoryDatabase())
+	for _, number := range numbers {
+		header := chain.headers[number]
+		engine.recents.Add(header.Hash(), newSnapshot(chain.config, engine.signatures, number, header.Hash(), validators))
+	}
+	return &API{chain: chain, democracy: engine}
+}
+
+// Tests that the finality proof of a block is built from the locally collected attestations
+// of the recent blocks, and from the certificate embedded in the chain for the older ones.
+func TestGetFinalityProof(t *testing.T) {
+	keys, validators := newTestValidators(t, 4)
+	threshold := attestationThreshold(len(validators))
+
+	chain := newTestFinalityChain(t, 20, 5, 3, func(target *types.Header) []*types.Attestation {
+		return signTestAttestations(t, keys[:threshold], &types.Header{Number: big.NewInt(0)}, target)
+	})
+	api := newTestFinalityAPI(chain, validators, 5, 6, 7)
+
+	justified, recent, missing := chain.headers[5], chain.headers[6], chain.headers[7]
+	chain.status[justified.Hash()] = types.BasFinalized
+	chain.status[recent.Hash()] = types.BasJustified
+	chain.status[missing.Hash()] = types.BasJustified
+	chain.attestations[recent.Hash()] = signTestAttestations(t, keys, justified, recent)
+	chain.attestations[missing.Hash()] = signTestAttestations(t, keys[:threshold-1], recent, missing)
+	chain.safe, chain.finalized = 6, 5
+
+	// A block whose attestations were evicted is proven by the certificate
+	proof, err := api.GetFinalityProof(rpc.BlockNumberOrHashWithNumber(rpc.FinalizedBlockNumber))
+	if err != nil {
+		t.Fatalf("failed to retrieve proof of certified block: %v", err)
+	}
+	if proof.Hash != justified.Hash() || len(proof.Attestations) != threshold {
+		t.Fatalf("certified proof mismatch: hash %x, %d attestations", proof.Hash, len(proof.Attestations))
+	}
+	for i, a := range proof.Attestations {
+		if a.Validator != validators[i] {
+			t.Errorf("certified attestation %d signer mismatch: have %x, want %x", i, a.Validator, validators[i])
+		}
+	}
+	// A recent block is proven by the collected attestations
+	proof, err = api.GetFinalityProof(rpc.BlockNumberOrHashWithNumber(rpc.SafeBlockNumber))
+	if err != nil {
+		t.Fatalf("failed to retrieve proof of recent block: %v", err)
+	}
+	if proof.Hash != recent.Hash() || len(proof.Attestations) != len(keys) {
+		t.Fatalf("recent proof mismatch: hash %x, %d attestations", proof.Hash, len(proof.Attestations))
+	}
+	// Blocks without enough attestations anywhere can't be proven
+	if _, err := api.GetFinalityProof(rpc.BlockNumberOrHashWithHash(missing.Hash(), false)); err != errFinalityProofUnavailable {
+		t.Fatalf("unprovable block error mismatch: have %v, want %v", err, errFinalityProofUnavailable)
+	}
+	if _, err := api.GetFinalityProof(rpc.BlockNumberOrHashWithNumber(8)); err == nil {
+		t.Fatalf("proof of unjustified block retrieved")
+	}
+	if _, err := api.GetFinalityProof(rpc.BlockNumberOrHashWithNumber(100)); err != errUnknownBlock {
+		t.Fatalf("unknown block error mismatch: have %v, want %v", err, errUnknownBlock)
+	}
+}
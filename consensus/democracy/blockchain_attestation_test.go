@@ -6,15 +6,18 @@ package democracy
 import (
 	"bytes"
 	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/contracts/system"
 	"github.com/QEasyWeb3/QEasyChain/core"
 	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
 	"github.com/QEasyWeb3/QEasyChain/core/types"
 	"github.com/QEasyWeb3/QEasyChain/core/vm"
 	"github.com/QEasyWeb3/QEasyChain/crypto"
 	"github.com/QEasyWeb3/QEasyChain/params"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"math/big"
 	"testing"
+	"time"
 )
 
 // Tests that Democracy signer voting is evaluated correctly for various simple and
@@ -422,6 +425,15 @@ func TestCalculateCurrentEpochIndex(t *testing.T) {
 	require.True(t, index == 1)
 }
 
+// Tests that the safe and finalized blocks are only resolved from the checkpoints tracked
+// by Casper-FFG.
+func TestCurrentSafeAndFinalizedBlock(t *testing.T) {
+	chain, err := MakeFakeChain()
+	require.NoError(t, err)
+	require.Nil(t, chain.CurrentSafeBlock())
+	require.Nil(t, chain.CurrentFinalizedBlock())
+}
+
 func TestVerifyValidLimit(t *testing.T) {
 	chain, err := MakeFakeChain()
 	require.NoError(t, err)
@@ -554,17 +566,18 @@ func TestIsDoubleSignPunishTransaction(t *testing.T) {
 		Coinbase:   common.HexToAddress("0x352BbF453fFdcba6b126a73eD684260D7968dDc8"),
 	}
 
-	abi := system.ABI(system.StakingContract)
+	abi := system.ABI(system.SysContractName, system.ContractV0)
 
 	data, err := abi.Pack("doubleSignPunish", common.BigToHash(big.NewInt(886)), header.Coinbase)
 	assert.NoError(t, err)
 
-	tx := types.NewTransaction(0, system.StakingContract, uint256Max, 0, common.Big0, data)
-	check := (&Chaos{}).IsDoubleSignPunishTransaction(header.Coinbase, tx, header)
+	engine := New(params.AllDemocracyProtocolChanges, rawdb.NewMemoryDatabase())
+	tx := types.NewTransaction(0, system.SystemContract, uint256Max, 0, common.Big0, data)
+	check := engine.IsDoubleSignPunishTransaction(header.Coinbase, tx, header)
 	assert.False(t, check)
 
 	tx = types.NewTransaction(0, doubleSignIdentity, uint256Max, 0, common.Big0, data)
-	check = (&Chaos{}).IsDoubleSignPunishTransaction(header.Coinbase, tx, header)
+	check = engine.IsDoubleSignPunishTransaction(header.Coinbase, tx, header)
 	assert.True(t, check)
 }
 
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"github.com/QEasyWeb3/QEasyChain/contracts/system"
 	"io"
+	"math"
 	"math/big"
 	"math/rand"
 	"sync"
@@ -31,11 +32,13 @@ import (
 	"github.com/QEasyWeb3/QEasyChain/accounts"
 	"github.com/QEasyWeb3/QEasyChain/common"
 	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/slashing"
 	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/systemcontract"
 	"github.com/QEasyWeb3/QEasyChain/consensus/misc"
 	"github.com/QEasyWeb3/QEasyChain/core/state"
 	"github.com/QEasyWeb3/QEasyChain/core/types"
 	"github.com/QEasyWeb3/QEasyChain/crypto"
+	"github.com/QEasyWeb3/QEasyChain/crypto/bls"
 	"github.com/QEasyWeb3/QEasyChain/ethdb"
 	"github.com/QEasyWeb3/QEasyChain/log"
 	"github.com/QEasyWeb3/QEasyChain/params"
@@ -59,7 +62,7 @@ const (
 
 // Democracy proof-of-stake-authority protocol constants.
 var (
-	BlockReward = big.NewInt(1e+18) // Block reward in wei for successfully sealing a block.
+	BlockReward = big.NewInt(1e+18) // Block reward in wei for successfully sealing a block, unless a reward schedule is active.
 	epochLength = uint64(30000)     // Default number of blocks after which to checkpoint and reset the pending votes
 
 	extraVanity = 32                     // Fixed number of extra-data prefix bytes reserved for validator vanity
@@ -143,7 +146,8 @@ var (
 	// errInvalidValidatorLen is returned if validators length is zero or bigger than maxValidators.
 	errInvalidValidatorsLength = errors.New("Invalid validators length")
 
-	// errInvalidCoinbase is returned if the coinbase isn't the validator of the block.
+	// errInvalidCoinbase is returned if the coinbase isn't the validator of the block,
+	// i.e. the block isn't sealed by the active consensus key of the coinbase.
 	errInvalidCoinbase = errors.New("Invalid coin base")
 
 	// CasperFFG
@@ -157,11 +161,22 @@ var (
 
 	// errInvalidProposalCount is returned when the count of proposalTxs doesn't not match
 	errInvalidProposalCount = errors.New("invalid proposal tx count")
+
+	// errNoStateAccess is returned if the state of a block is needed by an engine
+	// which wasn't given any way to retrieve it.
+	errNoStateAccess = errors.New("no access to the state")
+
+	// errUnderivableCheckpoint is returned if the snapshot of the trusted checkpoint can't
+	// be derived from the epoch leading to it.
+	errUnderivableCheckpoint = errors.New("trusted checkpoint snapshot not derivable")
 )
 
 // StateFn gets state by the state root hash.
 type StateFn func(hash common.Hash) (*state.StateDB, error)
 
+// HeaderStateFn gets the state of a header, which light clients retrieve on demand.
+type HeaderStateFn func(header *types.Header) (*state.StateDB, error)
+
 // ValidatorFn hashes and signs the data to be signed by a backing account.
 type ValidatorFn func(validator accounts.Account, mimeType string, message []byte) ([]byte, error)
 type SignTxFn func(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
@@ -205,16 +220,26 @@ type Democracy struct {
 	accessLock      sync.Mutex // Make sure only get accesslist once for each block
 	eventCheckRules *lru.Cache // eventCheckRules caches recent EventCheckRules to speed up log validation
 	rulesLock       sync.Mutex // Make sure only get eventCheckRules once for each block
+	blsKeys         *lru.Cache // blsKeys caches the registered BLS public keys of recent validator sets
+	recentSeals     *lru.Cache // recentSeals caches the headers recently sealed by each validator to detect double seals
+
+	slashing     *slashing.Database // Slashing-protection database refusing to sign conflicting headers and votes
+	doppelganger doppelganger       // Detection of the local validator key being active on another machine
+
+	trusted *params.SyncCheckpoint // Trusted finalized block the snapshots are anchored to, if any
 
 	signer types.Signer // the signer instance to recover tx sender
 
-	validator common.Address // Ethereum address of the signing key
-	signFn    ValidatorFn    // Validator function to authorize hashes with
-	signTxFn  SignTxFn
-	isReady   bool         // isReady indicates whether the engine is ready for mining
-	lock      sync.RWMutex // Protects the validator fields
+	validator  common.Address // Ethereum address of the validator identity
+	signingKey common.Address // Ethereum address of the consensus key, the validator itself until rotated (after Saturn)
+	signFn     ValidatorFn    // Validator function to authorize hashes with
+	signTxFn   SignTxFn
+	blsKey     *bls.SecretKey // BLS key to sign aggregate attestations with (after Jupiter)
+	isReady    bool           // isReady indicates whether the engine is ready for mining
+	lock       sync.RWMutex   // Protects the validator fields
 
-	stateFn StateFn // Function to get state by state root
+	stateFn       StateFn       // Function to get state by state root
+	headerStateFn HeaderStateFn // Function to get the state of a header, used instead of stateFn if set
 
 	chain consensus.ChainHeaderReader
 
@@ -242,6 +267,8 @@ func New(chainConfig *params.ChainConfig, db ethdb.Database) *Democracy {
 	signatures, _ := lru.NewARC(inmemorySignatures)
 	accesslist, _ := lru.New(inmemoryAccesslist)
 	eventCheckRules, _ := lru.New(inmemoryAccesslist)
+	blsKeys, _ := lru.New(inmemoryBLSKeys)
+	recentSeals, _ := lru.New(inmemoryRecentSeals)
 
 	return &Democracy{
 		chainConfig:     chainConfig,
@@ -251,7 +278,11 @@ func New(chainConfig *params.ChainConfig, db ethdb.Database) *Democracy {
 		signatures:      signatures,
 		accesslist:      accesslist,
 		eventCheckRules: eventCheckRules,
+		blsKeys:         blsKeys,
+		recentSeals:     recentSeals,
+		slashing:        slashing.New(db),
 		signer:          types.LatestSignerForChainID(chainConfig.ChainID),
+		trusted:         conf.Checkpoint,
 	}
 }
 
@@ -268,6 +299,138 @@ func (c *Democracy) SetStateFn(fn StateFn) {
 	c.stateFn = fn
 }
 
+// SetHeaderStateFn sets the function to get the state of a header, for the nodes that
+// don't keep the state locally.
+func (c *Democracy) SetHeaderStateFn(fn HeaderStateFn) {
+	c.headerStateFn = fn
+}
+
+// stateOf retrieves the state of a header.
+func (c *Democracy) stateOf(header *types.Header) (*state.StateDB, error) {
+	if c.headerStateFn != nil {
+		return c.headerStateFn(header)
+	}
+	if c.stateFn == nil {
+		return nil, errNoStateAccess
+	}
+	return c.stateFn(header.Root)
+}
+
+// SetTrustedCheckpoint anchors the snapshots to the given trusted finalized block instead
+// of the genesis. The headers leading to it are authenticated by the downloader linking
+// their hash chain to it, they aren't verified by the engine.
+func (c *Democracy) SetTrustedCheckpoint(checkpoint *params.SyncCheckpoint) {
+	c.trusted = checkpoint
+}
+
+// isTrusted checks whether the given block is the trusted finalized block.
+func (c *Democracy) isTrusted(number uint64, hash common.Hash) bool {
+	return c.trusted != nil && c.trusted.Number == number && c.trusted.Hash == hash
+}
+
+// checkpointSnapshot creates the snapshot of the canonical checkpoint with the given index
+// out of the validators, keys and parameters announced on chain, without the history of
+// the recent validators, the certified block and the inactivity leak.
+func (c *Democracy) checkpointSnapshot(chain consensus.ChainHeaderReader, index uint64) (*Snapshot, error) {
+	_, number, _, err := c.canonicalCheckpoint(chain, index, math.MaxUint64)
+	if err != nil {
+		return nil, err
+	}
+	checkpoint := chain.GetHeaderByNumber(number)
+	if checkpoint == nil {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	// The validators of the epoch are the ones announced a checkpoint before (look-back)
+	announcer := checkpoint
+	if index > 0 {
+		_, announced, _, err := c.canonicalCheckpoint(chain, index-1, math.MaxUint64)
+		if err != nil {
+			return nil, err
+		}
+		if announcer = chain.GetHeaderByNumber(announced); announcer == nil {
+			return nil, consensus.ErrUnknownAncestor
+		}
+	}
+	validators, err := extraValidators(c.chainConfig, announcer)
+	if err != nil {
+		return nil, err
+	}
+	snap := newSnapshot(c.chainConfig, c.signatures, number, checkpoint.Hash(), validators)
+	snap.Epoch, snap.EpochKnown = index, true
+	if number > 0 {
+		cp, err := extraConsensusParams(c.chainConfig, checkpoint)
+		if err != nil {
+			return nil, err
+		}
+		if cp != nil {
+			snap.Params, snap.ParamsNumber = cp, number
+		}
+		if c.chainConfig.IsSaturn(checkpoint.Number) {
+			if snap.Keys, err = extraConsensusKeys(c.chainConfig, checkpoint); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return snap, nil
+}
+
+// trustedSnapshot creates the snapshot of the trusted checkpoint by replaying the epochs
+// leading to it from the previous checkpoints, until the recent validators are covered.
+// This fills in the recent validators, the certified block and the inactivity leak
+// participation as if the snapshots were applied from the genesis block.
+//
+// Unless the replay starts at the genesis block, the highest certified block before it and
+// the inactivity counts aren't known though, so while the inactivity leak is active the
+// checkpoint is refused unless a finality certificate was carried during the replay and
+// the chain doesn't leak at it.
+func (c *Democracy) trustedSnapshot(chain consensus.ChainHeaderReader, number uint64, hash common.Hash) (*Snapshot, error) {
+	// The epochs are walked along the canonical chain, so the checkpoint has to be part of it
+	checkpoint := chain.GetHeaderByNumber(number)
+	if checkpoint == nil || checkpoint.Hash() != hash {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	index, _, _, err := c.canonicalCheckpoint(chain, math.MaxUint64, number-1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		base, err := c.checkpointSnapshot(chain, index)
+		if err != nil {
+			return nil, err
+		}
+		headers := make([]*types.Header, 0, number-base.Number-1)
+		for n := base.Number + 1; n < number; n++ {
+			header := chain.GetHeaderByNumber(n)
+			if header == nil {
+				return nil, consensus.ErrUnknownAncestor
+			}
+			headers = append(headers, header)
+		}
+		snap, err := base.apply(headers, chain, nil)
+		if err != nil {
+			return nil, err
+		}
+		// Replay further back if the recent validators reach beyond the base checkpoint
+		window := uint64(len(snap.Validators)/2+1) * snap.consensusParams().ContinuousInturn
+		if index > 0 && uint64(len(headers)) < window {
+			index--
+			continue
+		}
+		if !snap.isCheckpoint(number) {
+			return nil, fmt.Errorf("%w: block %d isn't a checkpoint", errUnderivableCheckpoint, number)
+		}
+		if base.Number > 0 && snap.leakActive(number) {
+			if snap.Certified == 0 {
+				return nil, fmt.Errorf("%w: no finality certificate since checkpoint %d", errUnderivableCheckpoint, base.Number)
+			}
+			if snap.leaking(number) {
+				return nil, fmt.Errorf("%w: chain leaking since block %d", errUnderivableCheckpoint, snap.Certified)
+			}
+		}
+		return snap.apply([]*types.Header{checkpoint}, chain, nil)
+	}
+}
+
 // Author implements consensus.Engine, returning the Ethereum address recovered
 // from the signature in the header's extra-data section.
 func (c *Democracy) Author(header *types.Header) (common.Address, error) {
@@ -322,11 +485,19 @@ func (c *Democracy) verifyHeader(chain consensus.ChainHeaderReader, header *type
 	if len(header.Extra) < extraVanity+extraSeal {
 		return errMissingSignature
 	}
-	// check extra data
-	isEpoch := number%c.config.Epoch == 0
+	// check extra data, after the Uranus hard-fork checkpoints are marked by the
+	// consensus parameters section, which is checked against the snapshot later on
+	_, consensusParams, _, validators, err := splitExtra(chain.Config(), header)
+	if err != nil {
+		return err
+	}
+	isEpoch := len(consensusParams) > 0
+	if !chain.Config().IsUranus(header.Number) {
+		isEpoch = number%c.config.Epoch == 0
+	}
 
 	// Ensure that the extra-data contains a validator list on checkpoint, but none otherwise
-	validatorsBytes := len(header.Extra) - extraVanity - extraSeal
+	validatorsBytes := len(validators)
 	if !isEpoch && validatorsBytes != 0 {
 		return errExtraValidators
 	}
@@ -381,7 +552,11 @@ func (c *Democracy) verifyCascadingFields(chain consensus.ChainHeaderReader, hea
 		return consensus.ErrUnknownAncestor
 	}
 
-	if parent.Time+c.config.Period > header.Time {
+	snap, err := c.snapshot(chain, number-1, header.ParentHash, parents)
+	if err != nil {
+		return err
+	}
+	if parent.Time+snap.consensusParams().Period > header.Time {
 		return ErrInvalidTimestamp
 	}
 
@@ -424,23 +599,66 @@ func (c *Democracy) snapshot(chain consensus.ChainHeaderReader, number uint64, h
 			if s, err := loadSnapshot(c.chainConfig, c.signatures, c.db, hash); err == nil {
 				log.Trace("Loaded voting snapshot from disk", "number", number, "hash", hash)
 				snap = s
+				if !snap.EpochKnown {
+					// Stored before the epochs were counted
+					c.deriveEpoch(chain, snap)
+				}
 				break
 			}
 		}
+		// If we're at the trusted finalized block, derive its snapshot from the epoch
+		// leading to it instead of applying the headers from the genesis block.
+		if number > 0 && c.isTrusted(number, hash) {
+			var err error
+			if snap, err = c.trustedSnapshot(chain, number, hash); err != nil {
+				return nil, err
+			}
+			if len(snap.changes) > 0 {
+				if err := writeValidatorSetChanges(c.db, snap.changes); err != nil {
+					return nil, err
+				}
+				snap.changes = nil
+			}
+			if err := snap.store(c.db); err != nil {
+				return nil, err
+			}
+			log.Info("Stored trusted checkpoint snapshot to disk", "number", number, "hash", hash)
+			break
+		}
 		// If we're at the genesis, snapshot the initial state. Alternatively if we're
 		// at a checkpoint block without a parent (light client CHT), or we have piled
 		// up more headers than allowed to be reorged (chain reinit from a freezer),
 		// consider the checkpoint trusted and snapshot it.
-		if number == 0 || (number%c.config.Epoch == 0 && (len(headers) > params.FullImmutabilityThreshold || chain.GetHeaderByNumber(number-1) == nil)) {
+		// After the Uranus hard-fork the epoch may be changed by governance, so the
+		// checkpoint is recognized by the consensus parameters it announces.
+		mayCheckpoint := number%c.config.Epoch == 0 || c.chainConfig.IsUranus(new(big.Int).SetUint64(number))
+		if number == 0 || (mayCheckpoint && (len(headers) > params.FullImmutabilityThreshold || chain.GetHeaderByNumber(number-1) == nil)) {
 			checkpoint := chain.GetHeaderByNumber(number)
+			var cp *systemcontract.ConsensusParams
+			if checkpoint != nil && number > 0 && c.chainConfig.IsUranus(checkpoint.Number) {
+				var err error
+				if cp, err = extraConsensusParams(c.chainConfig, checkpoint); err != nil {
+					return nil, err
+				}
+				if cp == nil {
+					checkpoint = nil
+				}
+			}
 			if checkpoint != nil {
 				hash := checkpoint.Hash()
 
-				validators := make([]common.Address, (len(checkpoint.Extra)-extraVanity-extraSeal)/common.AddressLength)
-				for i := 0; i < len(validators); i++ {
-					copy(validators[i][:], checkpoint.Extra[extraVanity+i*common.AddressLength:])
+				validators, err := extraValidators(c.chainConfig, checkpoint)
+				if err != nil {
+					return nil, err
 				}
 				snap = newSnapshot(c.chainConfig, c.signatures, number, hash, validators)
+				c.deriveEpoch(chain, snap)
+				if cp != nil {
+					snap.Params, snap.ParamsNumber = cp, number
+				}
+				if snap.Keys, err = extraConsensusKeys(c.chainConfig, checkpoint); err != nil {
+					return nil, err
+				}
 				if err := snap.store(c.db); err != nil {
 					return nil, err
 				}
@@ -475,6 +693,12 @@ func (c *Democracy) snapshot(chain consensus.ChainHeaderReader, number uint64, h
 	if err != nil {
 		return nil, err
 	}
+	if len(snap.changes) > 0 {
+		if err := writeValidatorSetChanges(c.db, snap.changes); err != nil {
+			return nil, err
+		}
+		snap.changes = nil
+	}
 	c.recents.Add(snap.Hash, snap)
 
 	// If we've generated a new checkpoint snapshot, save to disk
@@ -518,27 +742,39 @@ func (c *Democracy) verifySeal(chain consensus.ChainHeaderReader, header *types.
 		return err
 	}
 
-	// Resolve the authorization key and check against validators
+	// Resolve the authorization key and check against the active key of the validator
 	signer, err := ecrecover(header, c.signatures)
 	if err != nil {
 		return err
 	}
-	if signer != header.Coinbase {
+	validator := header.Coinbase
+	if signer != snap.consensusKey(validator) {
 		return errInvalidCoinbase
 	}
 
-	if _, ok := snap.Validators[signer]; !ok {
+	if _, ok := snap.Validators[validator]; !ok {
 		return errUnauthorizedValidator
 	}
+	c.ObserveSignature(validator, number, header.Time)
+
+	// Ensure that the consensus parameters are announced exactly on checkpoints
+	if err := c.verifyConsensusParams(header, snap); err != nil {
+		return err
+	}
 
 	// Validator is among recents, only fail if the current block doesn't shift it out
-	if snap.SignedRecently(number, signer) {
+	if snap.SignedRecently(number, validator) {
 		return errRecentlySigned
 	}
 
+	// Ensure that the embedded finality certificates are backed by enough attestations
+	if err := c.verifyFinalityCertificates(chain, header, parents, snap); err != nil {
+		return err
+	}
+
 	// Ensure that the difficulty corresponds to the turn-ness of the signer
 	if !c.fakeDiff {
-		inturn := snap.inturn(header.Number.Uint64(), signer)
+		inturn := snap.inturn(header.Number.Uint64(), validator)
 		if inturn && header.Difficulty.Cmp(diffInTurn) != 0 {
 			return errWrongDifficulty
 		}
@@ -546,6 +782,8 @@ func (c *Democracy) verifySeal(chain consensus.ChainHeaderReader, header *types.
 			return errWrongDifficulty
 		}
 	}
+	// The header is fully verified, remember its seal to catch double seals
+	c.recordSeal(chain, header, validator)
 
 	return nil
 }
@@ -572,8 +810,41 @@ func (c *Democracy) Prepare(chain consensus.ChainHeaderReader, header *types.Hea
 	}
 	header.Extra = header.Extra[:extraVanity]
 
-	if number%c.config.Epoch == 0 {
-		newSortedValidators, err := c.getTopValidators(chain, header)
+	if c.chainConfig.IsMars(header.Number) {
+		assembled := c.assembleFinalityCertificates(chain, header, snap)
+		if assembled == nil {
+			assembled = c.assembleParticipationCertificate(chain, header, snap)
+		}
+		certs, err := rlp.EncodeToBytes(assembled)
+		if err != nil {
+			return err
+		}
+		header.Extra = append(header.Extra, certs...)
+	}
+	if snap.isCheckpoint(number) {
+		next, err := c.nextConsensusParams(chain, header, snap)
+		if err != nil {
+			return err
+		}
+		if c.chainConfig.IsUranus(header.Number) {
+			nextBytes, err := rlp.EncodeToBytes(next)
+			if err != nil {
+				return err
+			}
+			header.Extra = append(header.Extra, nextBytes...)
+		}
+		if c.chainConfig.IsSaturn(header.Number) {
+			keys, err := c.nextConsensusKeys(chain, header, snap)
+			if err != nil {
+				return err
+			}
+			keysBytes, err := rlp.EncodeToBytes(keys)
+			if err != nil {
+				return err
+			}
+			header.Extra = append(header.Extra, keysBytes...)
+		}
+		newSortedValidators, err := c.getTopValidators(chain, header, next.MaxValidators)
 		if err != nil {
 			return err
 		}
@@ -592,7 +863,7 @@ func (c *Democracy) Prepare(chain consensus.ChainHeaderReader, header *types.Hea
 	if parent == nil {
 		return consensus.ErrUnknownAncestor
 	}
-	header.Time = parent.Time + c.config.Period
+	header.Time = parent.Time + snap.consensusParams().Period
 	if header.Time < uint64(time.Now().Unix()) {
 		header.Time = uint64(time.Now().Unix())
 	}
@@ -642,6 +913,7 @@ func (c *Democracy) FinalizeAndAssemble(chain consensus.ChainHeaderReader, heade
 
 // prepareFinalize does some preparing jobs before finalize, including:
 // * lazy punish
+// * split block fee (after the activation of the fee policy)
 // * distribute block fee
 // * update validators
 // * decrease missed blocks counter
@@ -656,28 +928,52 @@ func (c *Democracy) prepareFinalize(chain consensus.ChainHeaderReader, header *t
 			return err
 		}
 	}
+	// split the fees before the block reward joins them
+	var splitTxs []*types.Transaction
+	if len(proposalTxs) > 0 {
+		governanceTxs := make([]*types.Transaction, 0, len(proposalTxs))
+		for _, tx := range proposalTxs {
+			if isFeeSplitTransaction(tx) {
+				splitTxs = append(splitTxs, tx)
+			} else {
+				governanceTxs = append(governanceTxs, tx)
+			}
+		}
+		proposalTxs = governanceTxs
+	}
+	if err := c.processFeeSplit(chain, header, state, txs, receipts, splitTxs, mined); err != nil {
+		return err
+	}
 	// execute block reward tx.
-	if len(*txs) > 0 || BlockReward.Uint64() > 0 {
-		state.AddBalance(consensus.FeeRecoder, BlockReward)
+	if reward := c.blockReward(header.Number); len(*txs) > 0 || reward.Sign() > 0 {
+		state.AddBalance(consensus.FeeRecoder, reward)
 		if err := c.tryDistributeBlockFee(chain, header, state); err != nil {
 			return err
 		}
 	}
 	// do epoch thing at the end, because it will update active validators
-	if header.Number.Uint64()%c.config.Epoch == 0 {
+	snap, err := c.snapshot(chain, header.Number.Uint64()-1, header.ParentHash, nil)
+	if err != nil {
+		return err
+	}
+	if snap.isCheckpoint(header.Number.Uint64()) {
 		vmCtx := &systemcontract.CallContext{
 			Statedb:      state,
 			Header:       header,
 			ChainContext: newChainContext(chain, c),
 			ChainConfig:  c.chainConfig,
 		}
-		if err := c.updateValidators(vmCtx, chain, mined); err != nil {
+		if err := c.updateValidators(vmCtx, chain, snap, mined); err != nil {
 			return err
 		}
 		//  decrease validator missed blocks counter at epoch
 		if err := systemcontract.DecreaseMissedBlocksCounter(vmCtx); err != nil {
 			return err
 		}
+		// punish the validators not attesting while finality stalls
+		if err := c.punishInactiveValidators(vmCtx, snap); err != nil {
+			return err
+		}
 	}
 	// punish double sign
 	if err := c.punishDoubleSign(chain, header, state, txs, receipts, punishTxs, mined); err != nil {
@@ -689,9 +985,29 @@ func (c *Democracy) prepareFinalize(chain consensus.ChainHeaderReader, header *t
 }
 
 // updateValidators updates validators info to system contracts
-func (c *Democracy) updateValidators(vmCtx *systemcontract.CallContext, chain consensus.ChainHeaderReader, mined bool) error {
+func (c *Democracy) updateValidators(vmCtx *systemcontract.CallContext, chain consensus.ChainHeaderReader, snap *Snapshot, mined bool) error {
 	header := vmCtx.Header
-	newValidators, err := c.getTopValidators(chain, header)
+	next, err := c.nextConsensusParams(chain, header, snap)
+	if err != nil {
+		return err
+	}
+	if !mined && c.chainConfig.IsUranus(header.Number) {
+		// check whether consensus parameters are the same in header
+		cp, err := extraConsensusParams(c.chainConfig, header)
+		if err != nil {
+			return err
+		}
+		if cp == nil || *cp != *next {
+			return errMismatchingConsensusParams
+		}
+	}
+	if !mined && c.chainConfig.IsSaturn(header.Number) {
+		// check whether consensus keys are the same in header
+		if err := c.verifyConsensusKeys(chain, header, snap); err != nil {
+			return err
+		}
+	}
+	newValidators, err := c.getTopValidators(chain, header, next.MaxValidators)
 	if err != nil {
 		return err
 	}
@@ -701,7 +1017,11 @@ func (c *Democracy) updateValidators(vmCtx *systemcontract.CallContext, chain co
 		for i, validator := range newValidators {
 			copy(validatorsBytes[i*common.AddressLength:], validator.Bytes())
 		}
-		if !bytes.Equal(header.Extra[extraVanity:len(header.Extra)-extraSeal], validatorsBytes) {
+		_, _, _, extraValidatorsBytes, err := splitExtra(c.chainConfig, header)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(extraValidatorsBytes, validatorsBytes) {
 			return errInvalidExtraValidators
 		}
 	}
@@ -740,18 +1060,8 @@ func (c *Democracy) tryLazyPunish(chain consensus.ChainHeaderReader, header *typ
 	if err != nil {
 		return err
 	}
-	validators := snap.validators()
-	continuousBlocks := params.ContinousInturn
-	outTurnValidator := validators[number%(uint64(len(validators))*continuousBlocks)/continuousBlocks]
 	// check sigend recently or not
-	signedRecently := false
-	for _, recent := range snap.Recents {
-		if recent == outTurnValidator {
-			signedRecently = true
-			break
-		}
-	}
-	if !signedRecently {
+	if outTurnValidator, lazy := snap.lazyValidator(number); lazy {
 		return systemcontract.LazyPunish(&systemcontract.CallContext{
 			Statedb:      state,
 			Header:       header,
@@ -764,7 +1074,7 @@ func (c *Democracy) tryLazyPunish(chain consensus.ChainHeaderReader, header *typ
 }
 
 // call this at epoch block to get top validators based on the state of epoch block - 1
-func (c *Democracy) getTopValidators(chain consensus.ChainHeaderReader, header *types.Header) ([]common.Address, error) {
+func (c *Democracy) getTopValidators(chain consensus.ChainHeaderReader, header *types.Header, maxValidators uint8) ([]common.Address, error) {
 	parent := chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
 	if parent == nil {
 		return []common.Address{}, consensus.ErrUnknownAncestor
@@ -777,7 +1087,7 @@ func (c *Democracy) getTopValidators(chain consensus.ChainHeaderReader, header *
 		Statedb:      stateDb,
 		Header:       parent,
 		ChainContext: newChainContext(chain, c),
-		ChainConfig:  c.chainConfig})
+		ChainConfig:  c.chainConfig}, maxValidators)
 }
 
 // Authorize injects a private key into the consensus engine to mint new blocks with.
@@ -786,6 +1096,7 @@ func (c *Democracy) Authorize(validator common.Address, signFn ValidatorFn, sign
 	defer c.lock.Unlock()
 
 	c.validator = validator
+	c.signingKey = validator
 	c.signFn = signFn
 	c.signTxFn = signTxFn
 	c.isReady = true
@@ -806,24 +1117,34 @@ func (c *Democracy) Seal(chain consensus.ChainHeaderReader, block *types.Block,
 	if number == 0 {
 		return errUnknownBlock
 	}
-	// For 0-period chains, refuse to seal empty blocks (no reward but would spin sealing)
-	if c.config.Period == 0 && len(block.Transactions()) == 0 {
-		log.Info("Sealing paused, waiting for transactions")
-		return nil
-	}
 	// Don't hold the val fields for the entire sealing procedure
 	c.lock.RLock()
-	val, signFn := c.validator, c.signFn
+	val, key, signFn := c.validator, c.signingKey, c.signFn
 	c.lock.RUnlock()
 
-	// Bail out if we're unauthorized to sign a block
 	snap, err := c.snapshot(chain, number-1, header.ParentHash, nil)
 	if err != nil {
 		return err
 	}
+	// For 0-period chains, refuse to seal empty blocks (no reward but would spin sealing)
+	if snap.consensusParams().Period == 0 && len(block.Transactions()) == 0 {
+		log.Info("Sealing paused, waiting for transactions")
+		return nil
+	}
+	// Bail out if we're unauthorized to sign a block
 	if _, authorized := snap.Validators[val]; !authorized {
 		return errUnauthorizedValidator
 	}
+	// A rotated consensus key can only seal once it's active
+	if snap.consensusKey(val) != key {
+		log.Info("Waiting for the consensus key to become active", "validator", val, "key", key)
+		return nil
+	}
+	// Don't sign anything while the validator key may be active on another machine
+	if !c.doppelgangerSafe() {
+		log.Info("Waiting for doppelganger detection")
+		return nil
+	}
 	// If we're amongst the recent validators, wait for the next block
 	if snap.SignedRecently(number, val) {
 		log.Info("Signed recently, must wait for others")
@@ -844,7 +1165,7 @@ func (c *Democracy) Seal(chain consensus.ChainHeaderReader, block *types.Block,
 		log.Trace("Out-of-turn signing requested", "wiggle", common.PrettyDuration(wiggle))
 	}
 	// Sign all the things!
-	sigHash, err := signFn(accounts.Account{Address: val}, accounts.MimetypeDemocracy, DemocracyRLP(header))
+	sigHash, err := signFn(accounts.Account{Address: key}, accounts.MimetypeDemocracy, DemocracyRLP(header))
 	if err != nil {
 		return err
 	}
@@ -858,6 +1179,12 @@ func (c *Democracy) Seal(chain consensus.ChainHeaderReader, block *types.Block,
 		case <-time.After(delay):
 		}
 
+		// Only release the header if it doesn't conflict with anything signed before,
+		// the discarded sealing attempts at the same height are never published.
+		if err := c.slashing.CheckAndRecordBlock(val, number, SealHash(header)); err != nil {
+			log.Error("Refused to release sealed block", "number", number, "sealHash", SealHash(header), "err", err)
+			return
+		}
 		select {
 		case results <- block.WithSeal(header):
 		default:
@@ -961,6 +1288,10 @@ func (c *Democracy) PreHandle(chain consensus.ChainHeaderReader, header *types.H
 	// handle all hardForks
 	for _, hardFork := range []systemcontract.Hardfork{
 		{Name: systemcontract.Earth, Number: c.chainConfig.EarthBlock},
+		{Name: systemcontract.Jupiter, Number: c.chainConfig.JupiterBlock},
+		{Name: systemcontract.Saturn, Number: c.chainConfig.SaturnBlock},
+		{Name: systemcontract.Uranus, Number: c.chainConfig.UranusBlock},
+		{Name: systemcontract.Neptune, Number: c.chainConfig.NeptuneBlock},
 	} {
 		if hardFork.Number != nil && hardFork.Number.Cmp(header.Number) == 0 {
 			if err := systemcontract.ApplySystemContractUpgrade(hardFork.Name, state, header,
@@ -972,10 +1303,12 @@ func (c *Democracy) PreHandle(chain consensus.ChainHeaderReader, header *types.H
 	return nil
 }
 
-// CalculateGasPool determines gas limit of each block
-func (c *Democracy) CalculateGasPool(header *types.Header) uint64 {
-	idxInturn := header.Number.Uint64() % params.ContinousInturn
-	if idxInturn == 0 || idxInturn == params.ContinousInturn-1 {
+// CalculateGasPool determines gas limit of each block, based on the consensus parameters
+// active on top of its parent
+func (c *Democracy) CalculateGasPool(chain consensus.ChainHeaderReader, header *types.Header) uint64 {
+	continuousInturn := c.consensusParamsAt(chain, header.ParentHash, header.Number.Uint64()-1).ContinuousInturn
+	idxInturn := header.Number.Uint64() % continuousInturn
+	if idxInturn == 0 || idxInturn == continuousInturn-1 {
 		return header.GasLimit / 2
 	}
 	return header.GasLimit
@@ -986,7 +1319,7 @@ func (c *Democracy) ExtraValidateOfTx(sender common.Address, tx *types.Transacti
 	// Miner should not call the following funcs through transaction:
 	// "doubleSignPunish(bytes32,address)": "01036cae",
 	// "lazyPunish(address)": "e818ef86",
-	if sender == header.Coinbase {
+	if sender == header.Coinbase || c.isBlockSigner(c.chain, sender, header) {
 		contract := system.GetContractAddressByConfig(system.SysContractName, header.Number, c.chainConfig)
 		if tx.To() != nil && *(tx.To()) == contract {
 			if len(tx.Data()) >= 4 {
@@ -0,0 +1,199 @@
+package democracy
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/QEasyWeb3/QEasyChain/accounts"
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/systemcontract"
+	"github.com/QEasyWeb3/QEasyChain/contracts/system"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/state"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/core/vm"
+	"github.com/QEasyWeb3/QEasyChain/log"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+)
+
+// aggregateVotePunishPrefix is the leading byte of the payload of a punish transaction
+// holding the evidence of two conflicting BLS aggregate attestations.
+const aggregateVotePunishPrefix = byte(0x02)
+
+var (
+	// errInvalidAggregateVoteEvidence is returned if an aggregate vote evidence doesn't prove
+	// that an authorized validator signed two aggregates breaking the CasperFFG rules.
+	errInvalidAggregateVoteEvidence = errors.New("invalid aggregate vote evidence")
+
+	// errAggregateVotePunished is returned if an aggregate vote punish transaction replays
+	// an evidence that was already punished.
+	errAggregateVotePunished = errors.New("aggregate vote already punished")
+)
+
+// VerifyAggregateVoteEvidence checks that both aggregates of the evidence are valid, signed by
+// the defendant and break the CasperFFG rules together, and returns the rule broken.
+func (c *Democracy) VerifyAggregateVoteEvidence(chain consensus.ChainHeaderReader, e *types.AggregateVoteEvidence) (int, error) {
+	if err := e.SanityCheck(); err != nil {
+		return types.PunishNone, err
+	}
+	rule := c.VerifyCasperFFGRule(e.Before.SourceRangeEdge.Number.Uint64(), e.Before.TargetRangeEdge.Number.Uint64(),
+		e.After.SourceRangeEdge.Number.Uint64(), e.After.TargetRangeEdge.Number.Uint64())
+	if rule == types.PunishNone {
+		return types.PunishNone, errInvalidAggregateVoteEvidence
+	}
+	for _, a := range []*types.AggregateAttestation{e.Before, e.After} {
+		signers, _, err := c.VerifyAggregateAttestation(chain, a)
+		if err != nil {
+			return types.PunishNone, err
+		}
+		signed := false
+		for _, signer := range signers {
+			if signer == e.Defendant {
+				signed = true
+				break
+			}
+		}
+		if !signed {
+			return types.PunishNone, errInvalidAggregateVoteEvidence
+		}
+	}
+	return rule, nil
+}
+
+// proposeAggregateVotePunish adds a punish transaction for every pending aggregate vote evidence
+// into the block being mined.
+func (c *Democracy) proposeAggregateVotePunish(chain consensus.ChainHeaderReader, header *types.Header,
+	state *state.StateDB, txs *[]*types.Transaction, receipts *[]*types.Receipt) error {
+	evidences := rawdb.ReadAllAggregateVoteEvidence(c.db)
+	for _, e := range evidences {
+		// Evidences can only be included after both targets are sealed
+		if e.Number() >= header.Number.Uint64() {
+			continue
+		}
+		rule, err := c.VerifyAggregateVoteEvidence(chain, e)
+		if err != nil {
+			rawdb.DeleteAggregateVoteEvidence(c.db, e)
+			continue
+		}
+		punished, err := c.IsDoubleSignPunished(chain, header, state, e.Hash())
+		if err != nil {
+			log.Error("IsDoubleSignPunished error", "error", err.Error())
+			return err
+		}
+		if punished {
+			rawdb.DeleteAggregateVoteEvidence(c.db, e)
+			continue
+		}
+		tx, receipt, err := c.executeAggregateVotePunish(chain, header, state, e, rule, len(evidences))
+		if err != nil {
+			log.Error("executeAggregateVotePunish error", "error", err.Error())
+			return err
+		}
+		*txs = append(*txs, tx)
+		*receipts = append(*receipts, receipt)
+		log.Debug("executeAggregateVotePunish", "Violator", e.Defendant, "Number", header.Number.Uint64())
+	}
+	return nil
+}
+
+// executeAggregateVotePunish assembles and executes a punish transaction of an aggregate vote evidence.
+func (c *Democracy) executeAggregateVotePunish(chain consensus.ChainHeaderReader, header *types.Header,
+	state *state.StateDB, e *types.AggregateVoteEvidence, rule int, totalTxIndex int) (*types.Transaction, *types.Receipt, error) {
+	if c.signTxFn == nil {
+		return nil, nil, errors.New("signTxFn not set")
+	}
+	data, err := encodeAggregateVotePunish(e)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce := state.GetNonce(c.signingKey)
+
+	// Special to address for filtering transactions
+	tx := types.NewTransaction(nonce, doubleSignIdentity, uint256Max, 0, common.Big0, data)
+	tx, err = c.signTxFn(accounts.Account{Address: c.signingKey}, tx, chain.Config().ChainID)
+	if err != nil {
+		return nil, nil, err
+	}
+	//add nonce for validator
+	state.SetNonce(c.signingKey, nonce+1)
+	receipt, err := c.executePunishMsg(chain, header, state, c.validator, e.Defendant, big.NewInt(int64(rule)),
+		data, e.Hash(), totalTxIndex, tx.Hash(), common.Hash{})
+	return tx, receipt, err
+}
+
+// replayAggregateVotePunish verifies and executes an aggregate vote punish transaction of a received
+// block. If the execution fails, the whole block is discarded.
+func (c *Democracy) replayAggregateVotePunish(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, totalTxIndex int, tx *types.Transaction) (*types.Receipt, error) {
+	log.Debug("replayAggregateVotePunish", "Number", header.Number.Uint64())
+	sender, err := types.Sender(c.signer, tx)
+	if err != nil {
+		return nil, err
+	}
+	if !c.isBlockSigner(chain, sender, header) {
+		return nil, errors.New("invalid sender for system transaction")
+	}
+	e, err := decodeAggregateVotePunish(tx.Data())
+	if err != nil {
+		return nil, err
+	}
+	if e.Number() >= header.Number.Uint64() {
+		return nil, errInvalidAggregateVoteEvidence
+	}
+	rule, err := c.VerifyAggregateVoteEvidence(chain, e)
+	if err != nil {
+		return nil, err
+	}
+	if b, err := c.IsDoubleSignPunished(chain, header, state, e.Hash()); err != nil || b {
+		return nil, errAggregateVotePunished
+	}
+	nonce := state.GetNonce(sender)
+	//add nonce for validator
+	state.SetNonce(sender, nonce+1)
+	return c.executePunishMsg(chain, header, state, header.Coinbase, e.Defendant, big.NewInt(int64(rule)),
+		tx.Data(), e.Hash(), totalTxIndex, tx.Hash(), header.Hash())
+}
+
+// applyAggregateVotePunishTx applies an aggregate vote punish transaction with the given evm, for tracing.
+func (c *Democracy) applyAggregateVotePunishTx(evm *vm.EVM, sender common.Address, tx *types.Transaction) error {
+	e, err := decodeAggregateVotePunish(tx.Data())
+	if err != nil {
+		return err
+	}
+	if err := e.SanityCheck(); err != nil {
+		return err
+	}
+	// The evidence was verified when the block was imported
+	nonce := evm.StateDB.GetNonce(sender)
+	//add nonce for validator
+	evm.StateDB.SetNonce(sender, nonce+1)
+	evm.TxContext = vm.TxContext{
+		Origin:   sender,
+		GasPrice: new(big.Int),
+	}
+	return systemcontract.DoubleSignPunishWithGivenEVM(evm, system.LocalAddress, e.Hash(), e.Defendant)
+}
+
+// isAggregateVotePunishData reports whether the payload of a punish transaction holds an aggregate vote evidence.
+func isAggregateVotePunishData(data []byte) bool {
+	return len(data) > 0 && data[0] == aggregateVotePunishPrefix
+}
+
+func encodeAggregateVotePunish(e *types.AggregateVoteEvidence) ([]byte, error) {
+	enc, err := rlp.EncodeToBytes(e)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{aggregateVotePunishPrefix}, enc...), nil
+}
+
+func decodeAggregateVotePunish(data []byte) (*types.AggregateVoteEvidence, error) {
+	if !isAggregateVotePunishData(data) {
+		return nil, errInvalidAggregateVoteEvidence
+	}
+	e := new(types.AggregateVoteEvidence)
+	if err := rlp.DecodeBytes(data[1:], e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
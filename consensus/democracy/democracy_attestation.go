@@ -43,7 +43,17 @@ func (c *Democracy) punishDoubleSign(chain consensus.ChainHeaderReader, header *
 			// execute the doubleSignPunish
 			// If one transaction fails to execute, the whole block will be discarded
 			tx := punishTxs[int(i)]
-			receipt, err := c.replayDoubleSignPunish(chain, header, state, totalTxIndex, tx)
+			var (
+				receipt *types.Receipt
+				err     error
+			)
+			if isDoubleSealPunishData(tx.Data()) {
+				receipt, err = c.replayDoubleSealPunish(chain, header, state, totalTxIndex, tx)
+			} else if isAggregateVotePunishData(tx.Data()) {
+				receipt, err = c.replayAggregateVotePunish(chain, header, state, totalTxIndex, tx)
+			} else {
+				receipt, err = c.replayDoubleSignPunish(chain, header, state, totalTxIndex, tx)
+			}
 			if err != nil {
 				return err
 			}
@@ -84,6 +94,14 @@ func (c *Democracy) punishDoubleSign(chain consensus.ChainHeaderReader, header *
 				}
 			}
 		}
+		// Add penalty transactions for sealing conflicting headers
+		if err := c.proposeDoubleSealPunish(chain, header, state, txs, receipts); err != nil {
+			return err
+		}
+		// Add penalty transactions for signing conflicting aggregate attestations
+		if err := c.proposeAggregateVotePunish(chain, header, state, txs, receipts); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -93,6 +111,12 @@ func (c *Democracy) AttestationStatus() uint8 {
 }
 
 func (c *Democracy) StartAttestation() {
+	// Keep waiting while the validator key may be active on another machine,
+	// the chain retries on every new head as long as the attestation is pending
+	if !c.doppelgangerSafe() {
+		c.attestationStatus = types.AttestationPending
+		return
+	}
 	c.attestationStatus = types.AttestationStart
 }
 
@@ -105,7 +129,7 @@ func (c *Democracy) ClearAllViolateCasperFFGPunish() {
 }
 
 // VerifyAttestation checks whether an attestation is valid,
-// and if it's valid, return the signer,
+// and if it's valid, return the validator signing with the active key,
 // and a threshold that indicates how many attestations can justify a block.
 func (c *Democracy) VerifyAttestation(chain consensus.ChainHeaderReader, a *types.Attestation) (common.Address, int, error) {
 	header := chain.GetHeader(a.TargetRangeEdge.Hash, a.TargetRangeEdge.Number.Uint64())
@@ -123,10 +147,11 @@ func (c *Democracy) VerifyAttestation(chain consensus.ChainHeaderReader, a *type
 		return common.Address{}, 0, err
 	}
 
-	if !snap.IsAuthorized(signer) {
+	validator, ok := snap.validatorOf(signer)
+	if !ok {
 		return common.Address{}, 0, errIsNotValidator
 	}
-	return signer, attestationThreshold(snap.Len()), nil
+	return validator, attestationThreshold(snap.Len()), nil
 }
 
 func attestationThreshold(valsCnt int) int {
@@ -138,7 +163,7 @@ func (c *Democracy) CurrentValidator() common.Address {
 }
 
 func (c *Democracy) MaxValidators() uint8 {
-	return system.MaxValidators
+	return c.currentConsensusParams().MaxValidators
 }
 
 func (c *Democracy) Attest(chain consensus.ChainHeaderReader, headerNum *big.Int, source, target *types.RangeEdge) (*types.Attestation, error) {
@@ -148,14 +173,21 @@ func (c *Democracy) Attest(chain consensus.ChainHeaderReader, headerNum *big.Int
 	if !c.IsAuthorizedAtHeight(chain, c.validator, target.Number.Uint64()) {
 		return nil, errIsNotAuthorizedAtHeight
 	}
+	if key, _ := c.activeKeyAtHeight(chain, c.validator, target.Number.Uint64()); key != c.signingKey {
+		return nil, errInactiveConsensusKey
+	}
 	return c.makeNewAttestation(source, target)
 }
 
 // keccak256(abi.encode(s,t,h(s),h(t)) , where s is the hash of the last justified block,
 //t is the hash of the current block to vote, and h(s) h(T) are the corresponding block numbers respectively.
 func (c *Democracy) makeNewAttestation(sourceRangeEdge *types.RangeEdge, targetRangeEdge *types.RangeEdge) (*types.Attestation, error) {
+	if err := c.slashing.CheckAndRecordVote(c.validator, sourceRangeEdge.Number.Uint64(), targetRangeEdge.Number.Uint64(),
+		types.AttestationSignHash(sourceRangeEdge, targetRangeEdge)); err != nil {
+		return nil, err
+	}
 	// because the sign function is `Wallet.SignData`，so we should pass the data to it, not the hash.
-	sig, err := c.signFn(accounts.Account{Address: c.validator}, "", types.AttestationData(sourceRangeEdge, targetRangeEdge))
+	sig, err := c.signFn(accounts.Account{Address: c.signingKey}, accounts.MimetypeDemocracyAttestation, types.AttestationData(sourceRangeEdge, targetRangeEdge))
 	if err != nil {
 		return nil, errSignFailed
 	}
@@ -187,16 +219,22 @@ func (c *Democracy) IsAuthorizedByHeader(chain consensus.ChainHeaderReader, val
 	return snap.IsAuthorized(val)
 }
 
-func (c *Democracy) CurrentNeedHandleHeight(headerNum uint64) (uint64, error) {
+func (c *Democracy) CurrentNeedHandleHeight(chain consensus.ChainHeaderReader, head *types.Header) (uint64, error) {
 	// Witness voting is postponed for two heights(config.AttestationDelay).
-	if headerNum <= c.config.AttestationDelay {
+	headerNum := head.Number.Uint64()
+	attestationDelay := c.AttestationDelay(chain, head)
+	if headerNum <= attestationDelay {
 		return 0, errors.New("execution height not reached")
 	}
-	return headerNum - c.config.AttestationDelay, nil
+	return headerNum - attestationDelay, nil
+}
+
+func (c *Democracy) AttestationDelay(chain consensus.ChainHeaderReader, header *types.Header) uint64 {
+	return c.consensusParamsAt(chain, header.Hash(), header.Number.Uint64()).AttestationDelay
 }
 
-func (c *Democracy) AttestationDelay() uint64 {
-	return c.config.AttestationDelay
+func (c *Democracy) ContinuousInturn(chain consensus.ChainHeaderReader, header *types.Header) uint64 {
+	return c.consensusParamsAt(chain, header.Hash(), header.Number.Uint64()).ContinuousInturn
 }
 
 func (c *Democracy) IsReadyAttest() bool {
@@ -255,7 +293,7 @@ func (c *Democracy) executeDoubleSignPunish(chain consensus.ChainHeaderReader, h
 	if err != nil {
 		return nil, nil, err
 	}
-	p.Defendant = signer
+	p.Defendant = c.parentValidatorOf(chain, header, signer)
 
 	pRLP, err := rlp.EncodeToBytes(p)
 	if err != nil {
@@ -263,17 +301,17 @@ func (c *Democracy) executeDoubleSignPunish(chain consensus.ChainHeaderReader, h
 	}
 	copy(p.Data, pRLP)
 	//make system governance transaction
-	nonce := state.GetNonce(c.validator)
+	nonce := state.GetNonce(c.signingKey)
 
 	// Special to address for filtering transactions
 	tx := types.NewTransaction(nonce, doubleSignIdentity, uint256Max, 0, common.Big0, pRLP)
-	tx, err = c.signTxFn(accounts.Account{Address: c.validator}, tx, chain.Config().ChainID)
+	tx, err = c.signTxFn(accounts.Account{Address: c.signingKey}, tx, chain.Config().ChainID)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	//add nonce for validator
-	state.SetNonce(c.validator, nonce+1)
+	state.SetNonce(c.signingKey, nonce+1)
 	receipt, err := c.executeDoubleSignPunishMsg(chain, header, state, p, totalTxIndex, tx.Hash(), common.Hash{})
 
 	return tx, receipt, err
@@ -287,7 +325,7 @@ func (c *Democracy) replayDoubleSignPunish(chain consensus.ChainHeaderReader, he
 	if err != nil {
 		return nil, err
 	}
-	if sender != header.Coinbase {
+	if !c.isBlockSigner(chain, sender, header) {
 		return nil, errors.New("invalid sender for system transaction")
 	}
 	var p types.ViolateCasperFFGPunish
@@ -305,7 +343,7 @@ func (c *Democracy) replayDoubleSignPunish(chain consensus.ChainHeaderReader, he
 	if err != nil {
 		return nil, err
 	}
-	if signer != p.Defendant {
+	if c.parentValidatorOf(chain, header, signer) != p.Defendant {
 		return nil, errors.New("transaction signature does not match")
 	}
 	if types.PunishNone == c.VerifyCasperFFGRule(p.Before.SourceRangeEdge.Number.Uint64(), p.Before.TargetRangeEdge.Number.Uint64(),
@@ -331,17 +369,24 @@ func (c *Democracy) IsDoubleSignPunished(chain consensus.ChainHeaderReader, head
 
 // Execute multi sign penalty transaction in EVM
 func (c *Democracy) executeDoubleSignPunishMsg(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, p *types.ViolateCasperFFGPunish, totalTxIndex int, txHash, bHash common.Hash) (*types.Receipt, error) {
+	return c.executePunishMsg(chain, header, state, p.Plaintiff, p.Defendant, p.PunishType, p.Data, p.Hash(), totalTxIndex, txHash, bHash)
+}
+
+// Execute a penalty transaction of the given type in EVM
+func (c *Democracy) executePunishMsg(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB,
+	plaintiff, defendant common.Address, punishType *big.Int, punishData []byte, punishHash common.Hash,
+	totalTxIndex int, txHash, bHash common.Hash) (*types.Receipt, error) {
 	var receipt *types.Receipt
 
 	state.Prepare(txHash, totalTxIndex)
 	topics := []common.Hash{
 		executedDoubleSignPunishEventSig,
-		p.Plaintiff.Hash(),
-		p.Defendant.Hash(),
-		common.BigToHash(p.PunishType),
+		plaintiff.Hash(),
+		defendant.Hash(),
+		common.BigToHash(punishType),
 	}
 	// build data
-	data := buildDoubleSignPunishExecutedEventData(p)
+	data := buildPunishExecutedEventData(punishData)
 	contract := system.GetContractAddressByConfig(system.SysContractName, header.Number, c.chainConfig)
 	pLog := &types.Log{
 		Address:     contract,
@@ -357,13 +402,13 @@ func (c *Democracy) executeDoubleSignPunishMsg(chain consensus.ChainHeaderReader
 		Header:       header,
 		ChainContext: newChainContext(chain, c),
 		ChainConfig:  c.chainConfig,
-	}, p.Hash(), p.Defendant)
+	}, punishHash, defendant)
 	if err != nil {
 		return nil, err
 	}
 
 	receipt = types.NewReceipt([]byte{}, err != nil, header.GasUsed)
-	log.Info("executeDoubleSignPunishMsg", "Plaintiff", p.Plaintiff, "Defendant", p.Defendant, "pushHash", p.Hash().String(), "success", true)
+	log.Info("executePunishMsg", "Plaintiff", plaintiff, "Defendant", defendant, "punishType", punishType, "pushHash", punishHash.String(), "success", true)
 
 	receipt.Logs = state.GetLogs(txHash, bHash)
 	receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
@@ -382,7 +427,7 @@ func (c *Democracy) IsDoubleSignPunishTransaction(sender common.Address, tx *typ
 		return false
 	}
 	to := tx.To()
-	if sender == header.Coinbase &&
+	if c.isBlockSigner(c.chain, sender, header) &&
 		*to == doubleSignIdentity &&
 		tx.Value().Cmp(uint256Max) == 0 &&
 		tx.Gas() == 0 &&
@@ -394,6 +439,14 @@ func (c *Democracy) IsDoubleSignPunishTransaction(sender common.Address, tx *typ
 
 // ApplyDoubleSignPunishTx TODO
 func (c *Democracy) ApplyDoubleSignPunishTx(evm *vm.EVM, sender common.Address, tx *types.Transaction) (ret []byte, vmerr error, err error) {
+	if isDoubleSealPunishData(tx.Data()) {
+		err = c.applyDoubleSealPunishTx(evm, sender, tx)
+		return
+	}
+	if isAggregateVotePunishData(tx.Data()) {
+		err = c.applyAggregateVotePunishTx(evm, sender, tx)
+		return
+	}
 	p := &types.ViolateCasperFFGPunish{}
 	if err = rlp.DecodeBytes(tx.Data(), p); err != nil {
 		return
@@ -410,11 +463,15 @@ func (c *Democracy) ApplyDoubleSignPunishTx(evm *vm.EVM, sender common.Address,
 }
 
 func buildDoubleSignPunishExecutedEventData(p *types.ViolateCasperFFGPunish) []byte {
-	doubleSignPunishDataLen := ((len(p.Data) + common.HashLength - 1) / common.HashLength) * common.HashLength
+	return buildPunishExecutedEventData(p.Data)
+}
+
+func buildPunishExecutedEventData(punishData []byte) []byte {
+	doubleSignPunishDataLen := ((len(punishData) + common.HashLength - 1) / common.HashLength) * common.HashLength
 	dataLen := 2*common.HashLength + doubleSignPunishDataLen
 	data := make([]byte, dataLen)
 	copy(data[:common.HashLength], common.BytesToHash([]byte{0x20}).Bytes())
-	copy(data[common.HashLength:2*common.HashLength], common.BigToHash(big.NewInt(int64(len(p.Data)))).Bytes())
-	copy(data[2*common.HashLength:], p.Data)
+	copy(data[common.HashLength:2*common.HashLength], common.BigToHash(big.NewInt(int64(len(punishData)))).Bytes())
+	copy(data[2*common.HashLength:], punishData)
 	return data
 }
@@ -0,0 +1,179 @@
+package democracy
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/systemcontract"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/crypto/bls"
+	"github.com/QEasyWeb3/QEasyChain/log"
+)
+
+const inmemoryBLSKeys = 64 // Number of recent validator BLS key sets to keep in memory
+
+var (
+	// errBLSNotActivated is returned if an aggregate attestation is created or received
+	// for a block before the Jupiter hard-fork.
+	errBLSNotActivated = errors.New("bls attestations not activated")
+
+	// errMissingBLSKey is returned if the local validator has no BLS key configured,
+	// or the key doesn't match the one registered in the staking contract.
+	errMissingBLSKey = errors.New("bls key of the validator missing or not registered")
+
+	// errInvalidAggregateSigners is returned if the signer bitfield of an aggregate
+	// attestation doesn't match the validator set of the target.
+	errInvalidAggregateSigners = errors.New("invalid signers of aggregate attestation")
+
+	// errInvalidAggregateSignature is returned if the aggregate signature doesn't verify
+	// against the public keys of the signers.
+	errInvalidAggregateSignature = errors.New("invalid aggregate attestation signature")
+)
+
+// AuthorizeBLS injects a BLS secret key into the consensus engine to sign aggregate attestations with.
+func (c *Democracy) AuthorizeBLS(key *bls.SecretKey) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.blsKey = key
+}
+
+// validatorBLSKeys retrieves the registered BLS public keys of the validators authorized at the
+// given block, ordered like the sorted validator set. Validators without a valid registered key
+// are left as nil.
+func (c *Democracy) validatorBLSKeys(chain consensus.ChainHeaderReader, header *types.Header, snap *Snapshot) ([]*bls.PublicKey, error) {
+	hash := header.Hash()
+	if keys, ok := c.blsKeys.Get(hash); ok {
+		return keys.([]*bls.PublicKey), nil
+	}
+	statedb, err := c.stateOf(header)
+	if err != nil {
+		return nil, err
+	}
+	ctx := &systemcontract.CallContext{
+		Statedb:      statedb,
+		Header:       header,
+		ChainContext: newChainContext(chain, c),
+		ChainConfig:  c.chainConfig,
+	}
+	validators := snap.validators()
+	keys := make([]*bls.PublicKey, len(validators))
+	for i, validator := range validators {
+		pubkeyBytes, proofBytes, err := systemcontract.GetValidatorBLSPublicKey(ctx, validator)
+		if err != nil {
+			return nil, err
+		}
+		if len(pubkeyBytes) == 0 {
+			continue
+		}
+		pubkey, err := bls.PublicKeyFromBytes(pubkeyBytes)
+		if err != nil {
+			log.Debug("Invalid registered BLS public key", "validator", validator, "err", err)
+			continue
+		}
+		proof, err := bls.SignatureFromBytes(proofBytes)
+		if err != nil || !pubkey.VerifyPossession(proof) {
+			log.Debug("Invalid BLS proof of possession", "validator", validator)
+			continue
+		}
+		keys[i] = pubkey
+	}
+	c.blsKeys.Add(hash, keys)
+	return keys, nil
+}
+
+// AttestAggregate creates an aggregate attestation holding the BLS signature of the local validator.
+func (c *Democracy) AttestAggregate(chain consensus.ChainHeaderReader, source, target *types.RangeEdge) (*types.AggregateAttestation, error) {
+	if !c.chainConfig.IsJupiter(target.Number) {
+		return nil, errBLSNotActivated
+	}
+	if !c.IsReadyAttest() {
+		return nil, errIsNotReadyAttest
+	}
+	c.lock.RLock()
+	validator, key := c.validator, c.blsKey
+	c.lock.RUnlock()
+	if key == nil {
+		return nil, errMissingBLSKey
+	}
+	header := chain.GetHeader(target.Hash, target.Number.Uint64())
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+	snap, err := c.snapshot(chain, header.Number.Uint64(), header.Hash(), nil)
+	if err != nil {
+		return nil, err
+	}
+	index := -1
+	for i, v := range snap.validators() {
+		if v == validator {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, errIsNotAuthorizedAtHeight
+	}
+	keys, err := c.validatorBLSKeys(chain, header, snap)
+	if err != nil {
+		return nil, err
+	}
+	if keys[index] == nil || !bytes.Equal(keys[index].Bytes(), key.PublicKey().Bytes()) {
+		return nil, errMissingBLSKey
+	}
+	if err := c.slashing.CheckAndRecordVote(validator, source.Number.Uint64(), target.Number.Uint64(),
+		types.AttestationSignHash(source, target)); err != nil {
+		return nil, err
+	}
+	sig := key.Sign(types.AttestationData(source, target))
+	return types.NewAggregateAttestation(source, target, index, snap.Len(), sig.Bytes())
+}
+
+// VerifyAggregateAttestation checks whether an aggregate attestation is valid,
+// and if it's valid, return the signers and a threshold that indicates how many
+// signers can justify a block.
+func (c *Democracy) VerifyAggregateAttestation(chain consensus.ChainHeaderReader, a *types.AggregateAttestation) ([]common.Address, int, error) {
+	if err := a.SanityCheck(); err != nil {
+		return nil, 0, err
+	}
+	if !c.chainConfig.IsJupiter(a.TargetRangeEdge.Number) {
+		return nil, 0, errBLSNotActivated
+	}
+	header := chain.GetHeader(a.TargetRangeEdge.Hash, a.TargetRangeEdge.Number.Uint64())
+	if header == nil {
+		return nil, 0, errUnknownBlock
+	}
+	snap, err := c.snapshot(chain, header.Number.Uint64(), header.Hash(), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	validators := snap.validators()
+	if len(a.Signers) != (len(validators)+7)/8 {
+		return nil, 0, errInvalidAggregateSigners
+	}
+	keys, err := c.validatorBLSKeys(chain, header, snap)
+	if err != nil {
+		return nil, 0, err
+	}
+	var (
+		signers []common.Address
+		pubkeys []*bls.PublicKey
+	)
+	for _, index := range a.SignerIndexes() {
+		if index >= len(validators) || keys[index] == nil {
+			return nil, 0, errInvalidAggregateSigners
+		}
+		signers = append(signers, validators[index])
+		pubkeys = append(pubkeys, keys[index])
+	}
+	sig, err := bls.SignatureFromBytes(a.Signature)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !bls.FastAggregateVerify(pubkeys, types.AttestationData(a.SourceRangeEdge, a.TargetRangeEdge), sig) {
+		return nil, 0, errInvalidAggregateSignature
+	}
+	return signers, attestationThreshold(len(validators)), nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package democracy
+
+import (
+	"sync"
+	"time"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/log"
+)
+
+// doppelganger watches the network for signatures of the local validator for a number
+// of blocks after it is authorized. Any signature made after the watch started can only
+// come from the same key being active on another machine, in which case attesting and
+// sealing are refused, so the validator doesn't get punished for conflicting signatures.
+type doppelganger struct {
+	validator common.Address // Validator being watched for
+	blocks    uint64         // Number of blocks to watch the network for, 0 if disabled
+	since     uint64         // Unix time the watch started at, only later signatures are from a doppelganger
+	until     uint64         // Block number the watch ends at, 0 until a block made after the start is seen
+	detected  bool           // Whether a doppelganger has been seen
+	lock      sync.Mutex
+}
+
+// StartDoppelgangerDetection starts watching the network for signatures of the local
+// validator during the given number of blocks, before it's allowed to attest or seal.
+// The detection is run only once for a validator.
+func (c *Democracy) StartDoppelgangerDetection(blocks uint64) {
+	c.lock.RLock()
+	validator := c.validator
+	c.lock.RUnlock()
+
+	d := &c.doppelganger
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.validator == validator {
+		return
+	}
+	d.validator, d.blocks, d.since, d.until, d.detected = validator, blocks, uint64(time.Now().Unix()), 0, false
+	log.Info("Started doppelganger detection", "validator", validator, "blocks", blocks)
+}
+
+// ObserveSignature inspects the signer of a header or an attestation seen on the network
+// for a block of a given number and time.
+func (c *Democracy) ObserveSignature(signer common.Address, number uint64, timestamp uint64) {
+	d := &c.doppelganger
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.blocks == 0 || timestamp < d.since {
+		return
+	}
+	// The watch begins once a block made after the start is seen, so that
+	// the blocks the node catches up with don't count
+	if d.until == 0 {
+		d.until = number + d.blocks
+	}
+	if signer == d.validator && !d.detected {
+		d.detected = true
+		log.Error("Doppelganger detected, the validator key is active on another machine. Refusing to attest and seal",
+			"validator", signer, "number", number)
+	}
+}
+
+// doppelgangerSafe checks whether the doppelganger detection is over without any
+// signature of the local validator seen on the network.
+func (c *Democracy) doppelgangerSafe() bool {
+	d := &c.doppelganger
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.blocks == 0 {
+		return true
+	}
+	if d.detected || c.chain == nil {
+		return false
+	}
+	// The watch is over once the chain reached its end, or once the network had the time
+	// to seal that many blocks, in case the chain is waiting for the local validator to
+	// seal, like a network of a single validator does
+	head := c.chain.CurrentHeader()
+	period := c.consensusParamsAt(c.chain, head.Hash(), head.Number.Uint64()).Period
+	if (d.until == 0 || head.Number.Uint64() < d.until) && uint64(time.Now().Unix()) < d.since+d.blocks*period {
+		return false
+	}
+	d.blocks = 0
+	log.Info("Finished doppelganger detection", "validator", d.validator)
+	return true
+}
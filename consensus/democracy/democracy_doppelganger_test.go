@@ -0,0 +1,104 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package democracy
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/systemcontract"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+)
+
+// newTestDoppelgangerEngine creates an engine authorized for a validator of a short
+// chain, watching the network for the given number of blocks.
+func newTestDoppelgangerEngine(t *testing.T, blocks uint64) (*Democracy, *testFinalityChain, common.Address) {
+	key, _ := crypto.GenerateKey()
+	chain, _ := newTestParamsChain(t, 3, key, func(uint64) *systemcontract.ConsensusParams { return nil })
+
+	engine := New(chain.config, rawdb.NewMemoryDatabase())
+	engine.SetChain(chain)
+	engine.Authorize(crypto.PubkeyToAddress(key.PublicKey), nil, nil)
+	engine.StartDoppelgangerDetection(blocks)
+	return engine, chain, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+// extendTestChain appends unsealed headers to a test chain up to the given number.
+func extendTestChain(chain *testFinalityChain, number uint64) {
+	for parent := chain.CurrentHeader(); parent.Number.Uint64() < number; parent = chain.CurrentHeader() {
+		chain.headers = append(chain.headers, &types.Header{Number: new(big.Int).Add(parent.Number, common.Big1),
+			ParentHash: parent.Hash(), Time: parent.Time + 3})
+	}
+}
+
+// Tests that attesting and sealing are allowed once the network was watched during the
+// configured number of blocks without any signature of the local validator.
+func TestDoppelgangerWatch(t *testing.T) {
+	engine, chain, _ := newTestDoppelgangerEngine(t, 2)
+	since := engine.doppelganger.since
+
+	// Signatures made before the start are the ones of the previous run of the validator
+	engine.ObserveSignature(common.Address{0x1}, 1, since-1)
+	if engine.StartAttestation(); engine.doppelgangerSafe() || engine.AttestationStatus() != types.AttestationPending {
+		t.Fatalf("attestation started before the watch")
+	}
+	// The watch begins with the first block made after the start
+	engine.ObserveSignature(common.Address{0x1}, 3, since)
+	extendTestChain(chain, 4)
+	if engine.StartAttestation(); engine.AttestationStatus() != types.AttestationPending {
+		t.Fatalf("attestation started during the watch")
+	}
+	extendTestChain(chain, 5)
+	if engine.StartAttestation(); engine.AttestationStatus() != types.AttestationStart {
+		t.Fatalf("attestation not started after the watch")
+	}
+	// The watch is over for good
+	engine.ObserveSignature(engine.validator, 6, since+10)
+	if !engine.doppelgangerSafe() {
+		t.Fatalf("signature seen after the watch refused")
+	}
+}
+
+// Tests that a signature of the local validator made after the start blocks attesting and
+// sealing for good.
+func TestDoppelgangerDetected(t *testing.T) {
+	engine, chain, validator := newTestDoppelgangerEngine(t, 2)
+
+	engine.ObserveSignature(validator, 3, engine.doppelganger.since)
+	extendTestChain(chain, 10)
+	engine.doppelganger.since = 0
+
+	if engine.StartAttestation(); engine.doppelgangerSafe() || engine.AttestationStatus() != types.AttestationPending {
+		t.Fatalf("attestation started with a doppelganger")
+	}
+}
+
+// Tests that the watch ends once the network had the time to seal the watched blocks, even
+// if no block was seen at all, like on a network waiting for the local validator to seal.
+func TestDoppelgangerTimeout(t *testing.T) {
+	engine, _, _ := newTestDoppelgangerEngine(t, 2)
+	if engine.doppelgangerSafe() {
+		t.Fatalf("watch over right after the start")
+	}
+	engine.doppelganger.since -= 2 * engine.config.Period
+	if !engine.doppelgangerSafe() {
+		t.Fatalf("watch not over after the watched blocks period")
+	}
+}
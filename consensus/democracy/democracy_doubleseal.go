@@ -0,0 +1,262 @@
+package democracy
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/QEasyWeb3/QEasyChain/accounts"
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/systemcontract"
+	"github.com/QEasyWeb3/QEasyChain/contracts/system"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/state"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/core/vm"
+	"github.com/QEasyWeb3/QEasyChain/log"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+)
+
+const (
+	inmemoryRecentSeals = 1024 // Number of recent sealed headers to keep in memory to detect double seals
+
+	// doubleSealPunishPrefix is the leading byte of the payload of a double seal punish
+	// transaction. Casper-FFG punish payloads are plain rlp lists, which always start
+	// with a byte of 0xc0 or above, so the two kinds of punishments can't be mixed up.
+	doubleSealPunishPrefix = byte(0x01)
+)
+
+var (
+	// errInvalidDoubleSealEvidence is returned if a double seal evidence doesn't prove
+	// that an authorized validator sealed two headers at the same height.
+	errInvalidDoubleSealEvidence = errors.New("invalid double seal evidence")
+
+	// errDoubleSealPunished is returned if a double seal punish transaction replays an
+	// evidence that was already punished.
+	errDoubleSealPunished = errors.New("double seal already punished")
+)
+
+// sealKey identifies the header sealed by a validator at a given height.
+type sealKey struct {
+	signer common.Address
+	number uint64
+}
+
+// CheckDoubleSeal inspects a sealed header received from the network, and records a
+// double seal evidence if its signer already sealed a different header at the same height.
+func (c *Democracy) CheckDoubleSeal(chain consensus.ChainHeaderReader, header *types.Header) {
+	number := header.Number.Uint64()
+	if number == 0 {
+		return
+	}
+	// Only headers close to the local head are tracked
+	if current := chain.CurrentHeader().Number.Uint64(); number > current+1 || number+inmemoryRecentSeals < current {
+		return
+	}
+	signer, err := ecrecover(header, c.signatures)
+	if err != nil {
+		return
+	}
+	if key, ok := c.activeKeyAtHeight(chain, header.Coinbase, number-1); !ok || signer != key {
+		return
+	}
+	c.recordSeal(chain, header, header.Coinbase)
+}
+
+// recordSeal remembers the header sealed by a validator at its height. If the validator
+// already sealed a different header at the same height, the evidence is persisted so the
+// next block proposed locally punishes it.
+func (c *Democracy) recordSeal(chain consensus.ChainHeaderReader, header *types.Header, signer common.Address) {
+	key := sealKey{signer: signer, number: header.Number.Uint64()}
+	prev, ok := c.recentSeals.Get(key)
+	if !ok {
+		c.recentSeals.Add(key, types.CopyHeader(header))
+		return
+	}
+	prevHeader := prev.(*types.Header)
+	if SealHash(prevHeader) == SealHash(header) {
+		return
+	}
+	evidence := types.NewDoubleSealEvidence(prevHeader, header)
+	if _, err := c.verifyDoubleSealEvidence(chain, evidence); err != nil {
+		log.Debug("Discarded double seal evidence", "validator", signer, "number", key.number, "err", err)
+		return
+	}
+	if err := rawdb.WriteDoubleSealEvidence(c.db, evidence); err != nil {
+		return
+	}
+	log.Warn("Detected double seal", "validator", signer, "number", key.number,
+		"hashA", evidence.HeaderA.Hash(), "hashB", evidence.HeaderB.Hash())
+}
+
+// verifyDoubleSealEvidence checks that both headers of the evidence are different headers
+// sealed by the active key of the same validator, which was authorized at their height,
+// and returns the validator.
+func (c *Democracy) verifyDoubleSealEvidence(chain consensus.ChainHeaderReader, e *types.DoubleSealEvidence) (common.Address, error) {
+	if err := e.SanityCheck(); err != nil {
+		return common.Address{}, err
+	}
+	if SealHash(e.HeaderA) == SealHash(e.HeaderB) {
+		return common.Address{}, errInvalidDoubleSealEvidence
+	}
+	signerA, err := ecrecover(e.HeaderA, c.signatures)
+	if err != nil {
+		return common.Address{}, err
+	}
+	signerB, err := ecrecover(e.HeaderB, c.signatures)
+	if err != nil {
+		return common.Address{}, err
+	}
+	validator := e.HeaderA.Coinbase
+	if signerA != signerB || validator != e.HeaderB.Coinbase {
+		return common.Address{}, errInvalidDoubleSealEvidence
+	}
+	key, ok := c.activeKeyAtHeight(chain, validator, e.Number()-1)
+	if !ok {
+		return common.Address{}, errIsNotAuthorizedAtHeight
+	}
+	if signerA != key {
+		return common.Address{}, errInvalidDoubleSealEvidence
+	}
+	return validator, nil
+}
+
+// proposeDoubleSealPunish adds a punish transaction for every pending double seal evidence
+// into the block being mined.
+func (c *Democracy) proposeDoubleSealPunish(chain consensus.ChainHeaderReader, header *types.Header,
+	state *state.StateDB, txs *[]*types.Transaction, receipts *[]*types.Receipt) error {
+	evidences := rawdb.ReadAllDoubleSealEvidence(c.db)
+	for _, e := range evidences {
+		// Evidences can only be included after both headers are sealed
+		if e.Number() >= header.Number.Uint64() {
+			continue
+		}
+		defendant, err := c.verifyDoubleSealEvidence(chain, e)
+		if err != nil {
+			rawdb.DeleteDoubleSealEvidence(c.db, e)
+			continue
+		}
+		punished, err := c.IsDoubleSignPunished(chain, header, state, e.Hash())
+		if err != nil {
+			log.Error("IsDoubleSignPunished error", "error", err.Error())
+			return err
+		}
+		if punished {
+			rawdb.DeleteDoubleSealEvidence(c.db, e)
+			continue
+		}
+		tx, receipt, err := c.executeDoubleSealPunish(chain, header, state, e, defendant, len(evidences))
+		if err != nil {
+			log.Error("executeDoubleSealPunish error", "error", err.Error())
+			return err
+		}
+		*txs = append(*txs, tx)
+		*receipts = append(*receipts, receipt)
+		log.Debug("executeDoubleSealPunish", "Violator", defendant, "Number", header.Number.Uint64())
+	}
+	return nil
+}
+
+// executeDoubleSealPunish assembles and executes a punish transaction of a double seal evidence.
+func (c *Democracy) executeDoubleSealPunish(chain consensus.ChainHeaderReader, header *types.Header,
+	state *state.StateDB, e *types.DoubleSealEvidence, defendant common.Address, totalTxIndex int) (*types.Transaction, *types.Receipt, error) {
+	if c.signTxFn == nil {
+		return nil, nil, errors.New("signTxFn not set")
+	}
+	data, err := encodeDoubleSealPunish(e)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce := state.GetNonce(c.signingKey)
+
+	// Special to address for filtering transactions
+	tx := types.NewTransaction(nonce, doubleSignIdentity, uint256Max, 0, common.Big0, data)
+	tx, err = c.signTxFn(accounts.Account{Address: c.signingKey}, tx, chain.Config().ChainID)
+	if err != nil {
+		return nil, nil, err
+	}
+	//add nonce for validator
+	state.SetNonce(c.signingKey, nonce+1)
+	receipt, err := c.executePunishMsg(chain, header, state, c.validator, defendant, big.NewInt(types.PunishDoubleSeal),
+		data, e.Hash(), totalTxIndex, tx.Hash(), common.Hash{})
+	return tx, receipt, err
+}
+
+// replayDoubleSealPunish verifies and executes a double seal punish transaction of a received block.
+// If the execution fails, the whole block is discarded.
+func (c *Democracy) replayDoubleSealPunish(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, totalTxIndex int, tx *types.Transaction) (*types.Receipt, error) {
+	log.Debug("replayDoubleSealPunish", "Number", header.Number.Uint64())
+	sender, err := types.Sender(c.signer, tx)
+	if err != nil {
+		return nil, err
+	}
+	if !c.isBlockSigner(chain, sender, header) {
+		return nil, errors.New("invalid sender for system transaction")
+	}
+	e, err := decodeDoubleSealPunish(tx.Data())
+	if err != nil {
+		return nil, err
+	}
+	// The local record of the evidence is kept until the punishment is part of the
+	// canonical state, proposeDoubleSealPunish clears it from there
+	if e.Number() >= header.Number.Uint64() {
+		return nil, errInvalidDoubleSealEvidence
+	}
+	defendant, err := c.verifyDoubleSealEvidence(chain, e)
+	if err != nil {
+		return nil, err
+	}
+	if b, err := c.IsDoubleSignPunished(chain, header, state, e.Hash()); err != nil || b {
+		return nil, errDoubleSealPunished
+	}
+	nonce := state.GetNonce(sender)
+	//add nonce for validator
+	state.SetNonce(sender, nonce+1)
+	return c.executePunishMsg(chain, header, state, header.Coinbase, defendant, big.NewInt(types.PunishDoubleSeal),
+		tx.Data(), e.Hash(), totalTxIndex, tx.Hash(), header.Hash())
+}
+
+// applyDoubleSealPunishTx applies a double seal punish transaction with the given evm, for tracing.
+func (c *Democracy) applyDoubleSealPunishTx(evm *vm.EVM, sender common.Address, tx *types.Transaction) error {
+	e, err := decodeDoubleSealPunish(tx.Data())
+	if err != nil {
+		return err
+	}
+	if err := e.SanityCheck(); err != nil {
+		return err
+	}
+	// The evidence was verified when the block was imported
+	defendant := e.HeaderA.Coinbase
+	nonce := evm.StateDB.GetNonce(sender)
+	//add nonce for validator
+	evm.StateDB.SetNonce(sender, nonce+1)
+	evm.TxContext = vm.TxContext{
+		Origin:   sender,
+		GasPrice: new(big.Int),
+	}
+	return systemcontract.DoubleSignPunishWithGivenEVM(evm, system.LocalAddress, e.Hash(), defendant)
+}
+
+// isDoubleSealPunishData reports whether the payload of a punish transaction holds a double seal evidence.
+func isDoubleSealPunishData(data []byte) bool {
+	return len(data) > 0 && data[0] == doubleSealPunishPrefix
+}
+
+func encodeDoubleSealPunish(e *types.DoubleSealEvidence) ([]byte, error) {
+	enc, err := rlp.EncodeToBytes(e)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{doubleSealPunishPrefix}, enc...), nil
+}
+
+func decodeDoubleSealPunish(data []byte) (*types.DoubleSealEvidence, error) {
+	if !isDoubleSealPunishData(data) {
+		return nil, errInvalidDoubleSealEvidence
+	}
+	e := new(types.DoubleSealEvidence)
+	if err := rlp.DecodeBytes(data[1:], e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
@@ -0,0 +1,70 @@
+package democracy
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+)
+
+// sealTestHeader seals a copy of the header with the key, overwriting the seal at the end
+// of its extra data.
+func sealTestHeader(t *testing.T, header *types.Header, key *ecdsa.PrivateKey) *types.Header {
+	header = types.CopyHeader(header)
+	sig, err := crypto.Sign(SealHash(header).Bytes(), key)
+	if err != nil {
+		t.Fatalf("failed to seal header: %v", err)
+	}
+	copy(header.Extra[len(header.Extra)-extraSeal:], sig)
+	return header
+}
+
+// malleateTestSeal returns a copy of the header carrying the high-S twin of its seal,
+// which recovers to the same signer.
+func malleateTestSeal(header *types.Header) *types.Header {
+	header = types.CopyHeader(header)
+	seal := header.Extra[len(header.Extra)-extraSeal:]
+	s := new(big.Int).Sub(crypto.S256().Params().N, new(big.Int).SetBytes(seal[32:64]))
+	s.FillBytes(seal[32:64])
+	seal[64] ^= 1
+	return header
+}
+
+// Tests that a double seal evidence is only accepted for two different headers sealed by
+// the key of an authorized validator.
+func TestVerifyDoubleSealEvidence(t *testing.T) {
+	keys, validators := newTestValidators(t, 3)
+	chain := newTestFinalityChain(t, 10, 0, 0, nil)
+	engine := newTestFinalityAPI(chain, validators, 4).democracy
+
+	unsealed := &types.Header{Number: big.NewInt(5), ParentHash: chain.headers[4].Hash(), Coinbase: validators[0],
+		Difficulty: diffInTurn, Extra: make([]byte, extraVanity+extraSeal), Time: 1}
+	a := sealTestHeader(t, unsealed, keys[0])
+	unsealed.Time = 2
+	b := sealTestHeader(t, unsealed, keys[0])
+
+	validator, err := engine.verifyDoubleSealEvidence(chain, types.NewDoubleSealEvidence(a, b))
+	if err != nil {
+		t.Fatalf("valid evidence rejected: %v", err)
+	}
+	if validator != validators[0] {
+		t.Fatalf("validator mismatch: have %x, want %x", validator, validators[0])
+	}
+	// Two seals of the same header aren't a double seal
+	if _, err := engine.verifyDoubleSealEvidence(chain, types.NewDoubleSealEvidence(a, malleateTestSeal(a))); err == nil {
+		t.Fatal("evidence of a resealed header accepted")
+	}
+	// Headers sealed by another key than the one of their validator
+	if _, err := engine.verifyDoubleSealEvidence(chain, types.NewDoubleSealEvidence(a, sealTestHeader(t, b, keys[1]))); err == nil {
+		t.Fatal("evidence sealed by two signers accepted")
+	}
+	other, _ := newTestValidators(t, 1)
+	unsealed.Coinbase = crypto.PubkeyToAddress(other[0].PublicKey)
+	forged := sealTestHeader(t, unsealed, other[0])
+	unsealed.Time = 3
+	if _, err := engine.verifyDoubleSealEvidence(chain, types.NewDoubleSealEvidence(forged, sealTestHeader(t, unsealed, other[0]))); err != errIsNotAuthorizedAtHeight {
+		t.Fatal("evidence of an unauthorized signer accepted")
+	}
+}
@@ -0,0 +1,282 @@
+package democracy
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/log"
+	"github.com/QEasyWeb3/QEasyChain/params"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+)
+
+const (
+	maxFinalityCertificates   = 1   // Max finality certificates allowed in a single header
+	maxFinalityCertificateGap = 256 // Max distance between a header and the target of its finality certificates
+)
+
+var (
+	// errInvalidFinalityCertificates is returned if the finality certificates section
+	// of the extra-data can't be decoded or holds too many certificates.
+	errInvalidFinalityCertificates = errors.New("invalid finality certificates in extra data field")
+
+	// errInvalidFinalityCertificateTarget is returned if the target of a finality
+	// certificate is not an ancestor of the header or was already certified.
+	errInvalidFinalityCertificateTarget = errors.New("invalid finality certificate target")
+
+	// errInvalidFinalityCertificateSource is returned if the source of a finality
+	// certificate is not an ancestor of its target.
+	errInvalidFinalityCertificateSource = errors.New("invalid finality certificate source")
+
+	// errInsufficientFinalityCertificate is returned if a finality certificate is
+	// signed by less validators than the attestation threshold.
+	errInsufficientFinalityCertificate = errors.New("insufficient signatures in finality certificate")
+)
+
+// splitExtra splits the extra-data of a header (without vanity and seal) into the
+// finality certificates section, the consensus parameters section, the consensus keys
+// section and the validators section. Before the Mars hard-fork the whole data belongs
+// to the validators section, only checkpoint headers after the Uranus hard-fork carry
+// consensus parameters, and only the ones after the Saturn hard-fork consensus keys.
+// The genesis extra-data never carries certificates, whatever the forks active at it.
+func splitExtra(config *params.ChainConfig, header *types.Header) ([]byte, []byte, []byte, []byte, error) {
+	if len(header.Extra) < extraVanity+extraSeal {
+		return nil, nil, nil, nil, errMissingSignature
+	}
+	data := header.Extra[extraVanity : len(header.Extra)-extraSeal]
+	if header.Number.Sign() == 0 || !config.IsMars(header.Number) {
+		return nil, nil, nil, data, nil
+	}
+	_, _, rest, err := rlp.Split(data)
+	if err != nil {
+		return nil, nil, nil, nil, errInvalidFinalityCertificates
+	}
+	certs := data[:len(data)-len(rest)]
+	if len(rest) == 0 {
+		return certs, nil, nil, rest, nil
+	}
+	var cp, keys []byte
+	if config.IsUranus(header.Number) {
+		_, _, validators, err := rlp.Split(rest)
+		if err != nil {
+			return nil, nil, nil, nil, errInvalidConsensusParams
+		}
+		cp, rest = rest[:len(rest)-len(validators)], validators
+	}
+	if config.IsSaturn(header.Number) {
+		_, _, validators, err := rlp.Split(rest)
+		if err != nil {
+			return nil, nil, nil, nil, errInvalidConsensusKeys
+		}
+		keys, rest = rest[:len(rest)-len(validators)], validators
+	}
+	return certs, cp, keys, rest, nil
+}
+
+// extraValidators retrieves the validator list carried by the extra-data of a checkpoint header.
+func extraValidators(config *params.ChainConfig, header *types.Header) ([]common.Address, error) {
+	_, _, _, validatorsBytes, err := splitExtra(config, header)
+	if err != nil {
+		return nil, err
+	}
+	if len(validatorsBytes)%common.AddressLength != 0 {
+		return nil, errInvalidCheckpointValidators
+	}
+	validators := make([]common.Address, len(validatorsBytes)/common.AddressLength)
+	for i := 0; i < len(validators); i++ {
+		copy(validators[i][:], validatorsBytes[i*common.AddressLength:])
+	}
+	return validators, nil
+}
+
+// decodeFinalityCertificates retrieves the finality certificates embedded in the extra-data of a header.
+func decodeFinalityCertificates(config *params.ChainConfig, header *types.Header) ([]*types.FinalityCertificate, error) {
+	certsBytes, _, _, _, err := splitExtra(config, header)
+	if err != nil || len(certsBytes) == 0 {
+		return nil, err
+	}
+	var certs []*types.FinalityCertificate
+	if err := rlp.DecodeBytes(certsBytes, &certs); err != nil {
+		return nil, errInvalidFinalityCertificates
+	}
+	if len(certs) > maxFinalityCertificates {
+		return nil, errInvalidFinalityCertificates
+	}
+	for _, cert := range certs {
+		if cert.SourceRangeEdge == nil || cert.SourceRangeEdge.Number == nil ||
+			cert.TargetRangeEdge == nil || cert.TargetRangeEdge.Number == nil {
+			return nil, errInvalidFinalityCertificates
+		}
+	}
+	return certs, nil
+}
+
+// FinalityCertificates returns the finality certificates embedded in the given header.
+func (c *Democracy) FinalityCertificates(header *types.Header) ([]*types.FinalityCertificate, error) {
+	return decodeFinalityCertificates(c.chainConfig, header)
+}
+
+// ancestor retrieves the ancestor of a header at the given height, searching the
+// optional batch of parents (ascending order) before reaching out to the database.
+func ancestor(chain consensus.ChainHeaderReader, header *types.Header, number uint64, parents []*types.Header) *types.Header {
+	for header != nil && header.Number.Uint64() > number {
+		var (
+			parent       *types.Header
+			parentNumber = header.Number.Uint64() - 1
+		)
+		if len(parents) > 0 && parents[0].Number.Uint64() <= parentNumber {
+			if idx := parentNumber - parents[0].Number.Uint64(); idx < uint64(len(parents)) && parents[idx].Hash() == header.ParentHash {
+				parent = parents[idx]
+			}
+		}
+		if parent == nil {
+			parent = chain.GetHeader(header.ParentHash, parentNumber)
+		}
+		header = parent
+	}
+	return header
+}
+
+// parentsUpTo cuts the batch of parents so that it ends at the given height.
+func parentsUpTo(parents []*types.Header, number uint64) []*types.Header {
+	for i := len(parents) - 1; i >= 0; i-- {
+		if parents[i].Number.Uint64() == number {
+			return parents[:i+1]
+		}
+	}
+	return nil
+}
+
+// verifyFinalityCertificates checks that every finality certificate in the header targets
+// an uncertified ancestor, and is signed by at least the attestation threshold of the
+// validators authorized at the target. While the chain leaks, certificates of the current
+// epoch signed by less validators are accepted as participation certificates.
+func (c *Democracy) verifyFinalityCertificates(chain consensus.ChainHeaderReader, header *types.Header, parents []*types.Header, snap *Snapshot) error {
+	certs, err := decodeFinalityCertificates(c.chainConfig, header)
+	if err != nil {
+		return err
+	}
+	number := header.Number.Uint64()
+	for _, cert := range certs {
+		source, target := cert.SourceRangeEdge, cert.TargetRangeEdge
+		if target.Number.Uint64() >= number || target.Number.Uint64() <= snap.Certified ||
+			number-target.Number.Uint64() > maxFinalityCertificateGap {
+			return errInvalidFinalityCertificateTarget
+		}
+		if source.Number.Uint64() >= target.Number.Uint64() {
+			return errInvalidFinalityCertificateSource
+		}
+		targetHeader := ancestor(chain, header, target.Number.Uint64(), parents)
+		if targetHeader == nil || targetHeader.Hash() != target.Hash {
+			return errInvalidFinalityCertificateTarget
+		}
+		if source.Number.Uint64() > 0 {
+			sourceHeader := ancestor(chain, targetHeader, source.Number.Uint64(), parents)
+			if sourceHeader == nil || sourceHeader.Hash() != source.Hash {
+				return errInvalidFinalityCertificateSource
+			}
+		}
+		signers, err := cert.RecoverSigners()
+		if err != nil {
+			return err
+		}
+		targetSnap, err := c.snapshot(chain, target.Number.Uint64(), target.Hash, parentsUpTo(parents, target.Number.Uint64()))
+		if err != nil {
+			return err
+		}
+		for _, signer := range signers {
+			if _, ok := targetSnap.validatorOf(signer); !ok {
+				return errIsNotValidator
+			}
+		}
+		if len(signers) < attestationThreshold(targetSnap.Len()) && !snap.isParticipationCertificate(number, target.Number.Uint64(), len(signers)) {
+			return errInsufficientFinalityCertificate
+		}
+	}
+	return nil
+}
+
+// assembleFinalityCertificates builds the finality certificates for a new header out of the
+// locally collected attestations of the latest justified block, if it's not certified yet.
+func (c *Democracy) assembleFinalityCertificates(chain consensus.ChainHeaderReader, header *types.Header, snap *Snapshot) []*types.FinalityCertificate {
+	reader, ok := chain.(consensus.AttestationReader)
+	if !ok {
+		return nil
+	}
+	target, err := reader.LastValidJustifiedOrFinalized()
+	if err != nil || target.Number.Uint64() == 0 {
+		return nil
+	}
+	number := header.Number.Uint64()
+	if target.Number.Uint64() >= number || target.Number.Uint64() <= snap.Certified ||
+		number-target.Number.Uint64() > maxFinalityCertificateGap {
+		return nil
+	}
+	parent := chain.GetHeader(header.ParentHash, number-1)
+	if targetHeader := ancestor(chain, parent, target.Number.Uint64(), nil); targetHeader == nil || targetHeader.Hash() != target.Hash {
+		return nil
+	}
+	targetSnap, err := c.snapshot(chain, target.Number.Uint64(), target.Hash, nil)
+	if err != nil {
+		return nil
+	}
+	attestations, err := reader.GetHistoryAttestations(target.Number, target.Hash)
+	if err != nil {
+		return nil
+	}
+	threshold := attestationThreshold(targetSnap.Len())
+	for _, group := range groupAttestations(attestations, targetSnap) {
+		if len(group) < threshold {
+			continue
+		}
+		source := group[0].SourceRangeEdge
+		if source.Number.Uint64() > 0 {
+			if sourceHeader := ancestor(chain, parent, source.Number.Uint64(), nil); sourceHeader == nil || sourceHeader.Hash() != source.Hash {
+				continue
+			}
+		}
+		cert, err := types.NewFinalityCertificate(group[:threshold])
+		if err != nil {
+			log.Warn("Failed to assemble finality certificate", "number", target.Number, "err", err)
+			return nil
+		}
+		return []*types.FinalityCertificate{cert}
+	}
+	return nil
+}
+
+// groupAttestations groups the attestations signed by the validators of the given snapshot
+// by their (source,target) pair, dropping the duplicates. Every group is sorted by hash to
+// keep the certificates deterministic regardless of the order attestations arrived in.
+func groupAttestations(attestations []*types.Attestation, snap *Snapshot) map[common.Hash][]*types.Attestation {
+	var (
+		groups = make(map[common.Hash][]*types.Attestation)
+		seen   = make(map[common.Hash]map[common.Address]struct{})
+	)
+	for _, a := range attestations {
+		signer, err := a.RecoverSigner()
+		if err != nil {
+			continue
+		}
+		if _, ok := snap.validatorOf(signer); !ok {
+			continue
+		}
+		signHash := a.SignHash()
+		if seen[signHash] == nil {
+			seen[signHash] = make(map[common.Address]struct{})
+		}
+		if _, ok := seen[signHash][signer]; ok {
+			continue
+		}
+		seen[signHash][signer] = struct{}{}
+		groups[signHash] = append(groups[signHash], a)
+	}
+	for _, group := range groups {
+		sort.Slice(group, func(i, j int) bool {
+			return group[i].Hash().Big().Cmp(group[j].Hash().Big()) < 0
+		})
+	}
+	return groups
+}
@@ -0,0 +1,53 @@
+package democracy
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/params"
+)
+
+// Tests that the validators of the genesis are parsed from its plain extra-data even
+// if the Mars hard-fork is active from the genesis on.
+func TestSplitExtraGenesis(t *testing.T) {
+	config := &params.ChainConfig{
+		MarsBlock:    big.NewInt(0),
+		JupiterBlock: big.NewInt(0),
+		SaturnBlock:  big.NewInt(0),
+		Democracy:    &params.DemocracyConfig{Epoch: 200},
+	}
+	validators := []common.Address{{0x01}, {0x02}, {0x03}}
+
+	extra := make([]byte, extraVanity)
+	for _, validator := range validators {
+		extra = append(extra, validator.Bytes()...)
+	}
+	extra = append(extra, make([]byte, extraSeal)...)
+
+	genesis := &types.Header{Number: big.NewInt(0), Extra: extra}
+	certs, cp, keys, validatorsBytes, err := splitExtra(config, genesis)
+	if err != nil {
+		t.Fatalf("failed to split genesis extra-data: %v", err)
+	}
+	if len(certs) != 0 || len(cp) != 0 || len(keys) != 0 {
+		t.Errorf("genesis sections mismatch: certs %x, params %x, keys %x", certs, cp, keys)
+	}
+	if !bytes.Equal(validatorsBytes, extra[extraVanity:len(extra)-extraSeal]) {
+		t.Errorf("genesis validators mismatch: have %x, want %x", validatorsBytes, extra[extraVanity:len(extra)-extraSeal])
+	}
+	parsed, err := extraValidators(config, genesis)
+	if err != nil {
+		t.Fatalf("failed to parse genesis validators: %v", err)
+	}
+	if len(parsed) != len(validators) {
+		t.Fatalf("genesis validator count mismatch: have %d, want %d", len(parsed), len(validators))
+	}
+	for i := range validators {
+		if parsed[i] != validators[i] {
+			t.Errorf("genesis validator %d mismatch: have %x, want %x", i, parsed[i], validators[i])
+		}
+	}
+}
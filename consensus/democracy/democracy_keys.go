@@ -0,0 +1,267 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package democracy
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/systemcontract"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/log"
+	"github.com/QEasyWeb3/QEasyChain/params"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+)
+
+var (
+	// errInvalidConsensusKeys is returned if the consensus keys section of the extra-data
+	// can't be decoded, isn't sorted by validator, or reuses a key or an identity.
+	errInvalidConsensusKeys = errors.New("invalid consensus keys in extra data field")
+
+	// errMismatchingConsensusKeys is returned if a checkpoint block announces consensus
+	// keys different than the ones the local node read from the staking contract.
+	errMismatchingConsensusKeys = errors.New("mismatching consensus keys on checkpoint block")
+
+	// errInactiveConsensusKey is returned if the local validator is asked to attest with
+	// a consensus key which isn't active yet, or not anymore.
+	errInactiveConsensusKey = errors.New("consensus key of the validator not active")
+)
+
+// consensusKey is the key a validator rotated to, as announced by a checkpoint header.
+type consensusKey struct {
+	Validator common.Address // Staking identity of the validator
+	Key       common.Address // Address of the key sealing blocks and signing attestations
+}
+
+// extraConsensusKeys retrieves the consensus keys announced by the extra-data of a checkpoint
+// header after the Saturn hard-fork, or nil if the header doesn't carry any. Validators that
+// never rotated their key aren't part of the announcement.
+func extraConsensusKeys(config *params.ChainConfig, header *types.Header) (map[common.Address]common.Address, error) {
+	_, _, keysBytes, _, err := splitExtra(config, header)
+	if err != nil || len(keysBytes) == 0 {
+		return nil, err
+	}
+	var entries []consensusKey
+	if err := rlp.DecodeBytes(keysBytes, &entries); err != nil {
+		return nil, errInvalidConsensusKeys
+	}
+	keys := make(map[common.Address]common.Address, len(entries))
+	used := make(map[common.Address]struct{}, len(entries))
+	for i, entry := range entries {
+		if i > 0 && bytes.Compare(entries[i-1].Validator[:], entry.Validator[:]) >= 0 {
+			return nil, errInvalidConsensusKeys
+		}
+		if entry.Key == (common.Address{}) || entry.Key == entry.Validator {
+			return nil, errInvalidConsensusKeys
+		}
+		if _, ok := used[entry.Key]; ok {
+			return nil, errInvalidConsensusKeys
+		}
+		used[entry.Key] = struct{}{}
+		keys[entry.Validator] = entry.Key
+	}
+	for key := range used {
+		if _, ok := keys[key]; ok {
+			return nil, errInvalidConsensusKeys
+		}
+	}
+	return keys, nil
+}
+
+// nextConsensusKeys returns the consensus keys a checkpoint header announces for the
+// validators active during the next epoch, based on the state of the checkpoint block - 1.
+// The validators of the next epoch are the ones announced a checkpoint ago (look-back).
+// Keys colliding with the identity or the key of another validator are ignored.
+func (c *Democracy) nextConsensusKeys(chain consensus.ChainHeaderReader, header *types.Header, snap *Snapshot) ([]consensusKey, error) {
+	number := header.Number.Uint64()
+	epoch := snap.consensusParams().Epoch
+	if number < epoch {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	checkpoint := ancestor(chain, header, number-epoch, nil)
+	if checkpoint == nil {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	validators, err := extraValidators(c.chainConfig, checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	parent := chain.GetHeader(header.ParentHash, number-1)
+	if parent == nil {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	stateDb, err := c.stateFn(parent.Root)
+	if err != nil {
+		return nil, err
+	}
+	vmCtx := &systemcontract.CallContext{
+		Statedb:      stateDb,
+		Header:       parent,
+		ChainContext: newChainContext(chain, c),
+		ChainConfig:  c.chainConfig,
+	}
+	used := make(map[common.Address]struct{}, len(validators))
+	for _, validator := range validators {
+		used[validator] = struct{}{}
+	}
+	// validators are sorted in ascending order, so are the announced keys
+	keys := make([]consensusKey, 0)
+	for _, validator := range validators {
+		key, err := systemcontract.GetConsensusKey(vmCtx, validator)
+		if err != nil {
+			return nil, err
+		}
+		if key == validator {
+			continue
+		}
+		if _, ok := used[key]; ok {
+			log.Warn("Ignored colliding consensus key", "validator", validator, "key", key)
+			continue
+		}
+		used[key] = struct{}{}
+		keys = append(keys, consensusKey{Validator: validator, Key: key})
+	}
+	return keys, nil
+}
+
+// verifyConsensusKeys checks that a checkpoint header announces the same consensus keys
+// as the ones the local node read from the staking contract.
+func (c *Democracy) verifyConsensusKeys(chain consensus.ChainHeaderReader, header *types.Header, snap *Snapshot) error {
+	next, err := c.nextConsensusKeys(chain, header, snap)
+	if err != nil {
+		return err
+	}
+	keys, err := extraConsensusKeys(c.chainConfig, header)
+	if err != nil {
+		return err
+	}
+	if keys == nil || len(keys) != len(next) {
+		return errMismatchingConsensusKeys
+	}
+	for _, entry := range next {
+		if keys[entry.Validator] != entry.Key {
+			return errMismatchingConsensusKeys
+		}
+	}
+	return nil
+}
+
+// consensusKey returns the address of the key the given validator signs with.
+func (s *Snapshot) consensusKey(validator common.Address) common.Address {
+	if key, ok := s.Keys[validator]; ok {
+		return key
+	}
+	return validator
+}
+
+// validatorOf returns the authorized validator signing with the given consensus key.
+func (s *Snapshot) validatorOf(key common.Address) (common.Address, bool) {
+	for validator, k := range s.Keys {
+		if k == key {
+			_, ok := s.Validators[validator]
+			return validator, ok
+		}
+	}
+	if _, ok := s.Keys[key]; ok {
+		// The identity of a validator which rotated its key can't sign anymore
+		return common.Address{}, false
+	}
+	_, ok := s.Validators[key]
+	return key, ok
+}
+
+// AuthorizeConsensusKey sets the key the local validator seals blocks and signs attestations
+// with, if it differs from the address of the validator.
+func (c *Democracy) AuthorizeConsensusKey(key common.Address) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.signingKey = key
+}
+
+// CurrentConsensusKey returns the key the local validator seals blocks and signs
+// attestations with.
+func (c *Democracy) CurrentConsensusKey() common.Address {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.signingKey
+}
+
+// ValidatorOfKey returns the validator signing with the given consensus key at the given
+// block. The key is returned as is if it doesn't belong to an authorized validator.
+func (c *Democracy) ValidatorOfKey(chain consensus.ChainHeaderReader, key common.Address, hash common.Hash, number uint64) (common.Address, error) {
+	if chain.GetHeader(hash, number) == nil {
+		return common.Address{}, errUnknownBlock
+	}
+	snap, err := c.snapshot(chain, number, hash, nil)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if validator, ok := snap.validatorOf(key); ok {
+		return validator, nil
+	}
+	return key, nil
+}
+
+// isBlockSigner checks whether the sender of a system transaction is the key sealing the
+// given header, as known by the given chain. Before the Saturn hard-fork it's the coinbase
+// of the header. The methods of the engine called without a chain pass the local one.
+func (c *Democracy) isBlockSigner(chain consensus.ChainHeaderReader, sender common.Address, header *types.Header) bool {
+	if !c.chainConfig.IsSaturn(header.Number) {
+		return sender == header.Coinbase
+	}
+	if chain == nil {
+		return false
+	}
+	snap, err := c.snapshot(chain, header.Number.Uint64()-1, header.ParentHash, nil)
+	if err != nil {
+		return false
+	}
+	return sender == snap.consensusKey(header.Coinbase)
+}
+
+// activeKeyAtHeight returns the consensus key of a validator at the given height, and
+// whether the validator is authorized at that height.
+func (c *Democracy) activeKeyAtHeight(chain consensus.ChainHeaderReader, validator common.Address, height uint64) (common.Address, bool) {
+	h := chain.GetHeaderByNumber(height)
+	if h == nil {
+		return common.Address{}, false
+	}
+	snap, err := c.snapshot(chain, height, h.Hash(), nil)
+	if err != nil {
+		return common.Address{}, false
+	}
+	return snap.consensusKey(validator), snap.IsAuthorized(validator)
+}
+
+// parentValidatorOf returns the validator signing with the given consensus key in the
+// snapshot the given header is built on, or the key itself if it doesn't belong to any
+// authorized validator. Unlike ValidatorOfKey the result only depends on the chain the
+// header is built on, so it's safe to use while executing blocks.
+func (c *Democracy) parentValidatorOf(chain consensus.ChainHeaderReader, header *types.Header, key common.Address) common.Address {
+	snap, err := c.snapshot(chain, header.Number.Uint64()-1, header.ParentHash, nil)
+	if err != nil {
+		return key
+	}
+	if validator, ok := snap.validatorOf(key); ok {
+		return validator
+	}
+	return key
+}
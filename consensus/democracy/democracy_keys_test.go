@@ -0,0 +1,117 @@
+package democracy
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+	"github.com/QEasyWeb3/QEasyChain/params"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// newTestKeysChain creates a chain after the Mars and Saturn hard-forks sealed by a single
+// validator, whose first checkpoint at block 4 announces the rotation to the consensus key.
+// The blocks after the checkpoint are sealed with the consensus key.
+func newTestKeysChain(t *testing.T, length int, identity, key *ecdsa.PrivateKey) *testFinalityChain {
+	validator := crypto.PubkeyToAddress(identity.PublicKey)
+	chain := &testFinalityChain{
+		config: &params.ChainConfig{MarsBlock: big.NewInt(0), SaturnBlock: big.NewInt(0),
+			Democracy: &params.DemocracyConfig{Period: 3, Epoch: 4}},
+		status: make(map[common.Hash]uint8),
+	}
+	genesis := &types.Header{Number: big.NewInt(0), Difficulty: diffInTurn,
+		Extra: append(append(make([]byte, extraVanity), validator.Bytes()...), make([]byte, extraSeal)...)}
+	chain.headers = append(chain.headers, genesis)
+
+	certs, _ := rlp.EncodeToBytes([]*types.FinalityCertificate{})
+	keys, _ := rlp.EncodeToBytes([]consensusKey{{Validator: validator, Key: crypto.PubkeyToAddress(key.PublicKey)}})
+	for i := 1; i < length; i++ {
+		extra, signer := certs, identity
+		if i == 4 {
+			extra = append(append(append([]byte{}, certs...), keys...), validator.Bytes()...)
+		}
+		if i > 4 {
+			signer = key
+		}
+		header := &types.Header{Number: big.NewInt(int64(i)), ParentHash: chain.headers[i-1].Hash(),
+			Coinbase: validator, Difficulty: diffInTurn, Time: uint64(i) * 3,
+			Extra: append(append(make([]byte, extraVanity), extra...), make([]byte, extraSeal)...)}
+		chain.headers = append(chain.headers, sealTestHeader(t, header, signer))
+	}
+	return chain
+}
+
+// Tests that the consensus key announced by a checkpoint seals the blocks of the next epoch
+// instead of the identity of the validator.
+func TestSnapshotConsensusKeys(t *testing.T) {
+	identity, _ := crypto.GenerateKey()
+	key, _ := crypto.GenerateKey()
+	validator, signer := crypto.PubkeyToAddress(identity.PublicKey), crypto.PubkeyToAddress(key.PublicKey)
+
+	chain := newTestKeysChain(t, 7, identity, key)
+	sigcache, _ := lru.NewARC(inmemorySignatures)
+	genesis := newSnapshot(chain.config, sigcache, 0, chain.headers[0].Hash(), []common.Address{validator})
+
+	snap, err := genesis.apply(chain.headers[1:], chain, nil)
+	if err != nil {
+		t.Fatalf("failed to apply headers: %v", err)
+	}
+	if have := snap.consensusKey(validator); have != signer {
+		t.Fatalf("consensus key mismatch: have %x, want %x", have, signer)
+	}
+	if have, ok := snap.validatorOf(signer); !ok || have != validator {
+		t.Fatalf("validator of key mismatch: have %x (%v), want %x", have, ok, validator)
+	}
+	if _, ok := snap.validatorOf(validator); ok {
+		t.Fatalf("rotated identity still signing")
+	}
+	// The identity can't seal anymore once the key is active
+	forged := sealTestHeader(t, chain.headers[5], identity)
+	if _, err := genesis.apply(append(append([]*types.Header{}, chain.headers[1:5]...), forged), chain, nil); err != errInvalidCoinbase {
+		t.Fatalf("identity seal error mismatch: have %v, want %v", err, errInvalidCoinbase)
+	}
+	// The key can't seal before it's announced
+	early := sealTestHeader(t, chain.headers[3], key)
+	if _, err := genesis.apply(append(append([]*types.Header{}, chain.headers[1:3]...), early), chain, nil); err != errInvalidCoinbase {
+		t.Fatalf("early key seal error mismatch: have %v, want %v", err, errInvalidCoinbase)
+	}
+}
+
+// Tests that the engine verifies the seals against the active consensus key, and resolves
+// the validators of the keys and the senders of system transactions with the given chain.
+func TestVerifySealConsensusKeys(t *testing.T) {
+	identity, _ := crypto.GenerateKey()
+	key, _ := crypto.GenerateKey()
+	validator, signer := crypto.PubkeyToAddress(identity.PublicKey), crypto.PubkeyToAddress(key.PublicKey)
+
+	chain := newTestKeysChain(t, 7, identity, key)
+	engine := New(chain.config, rawdb.NewMemoryDatabase())
+
+	for _, header := range chain.headers[1:] {
+		if err := engine.verifySeal(chain, header, nil); err != nil {
+			t.Fatalf("block %d: failed to verify seal: %v", header.Number, err)
+		}
+	}
+	if err := engine.verifySeal(chain, sealTestHeader(t, chain.headers[6], identity), nil); err != errInvalidCoinbase {
+		t.Fatalf("identity seal error mismatch: have %v, want %v", err, errInvalidCoinbase)
+	}
+	head := chain.CurrentHeader()
+	if have, err := engine.ValidatorOfKey(chain, signer, head.Hash(), head.Number.Uint64()); err != nil || have != validator {
+		t.Fatalf("validator of key mismatch: have %x (%v), want %x", have, err, validator)
+	}
+	if _, err := engine.ValidatorOfKey(chain, signer, common.Hash{0x1}, head.Number.Uint64()); err != errUnknownBlock {
+		t.Fatalf("unknown block error mismatch: have %v, want %v", err, errUnknownBlock)
+	}
+	// The senders of system transactions are checked with the chain given, the engine has none
+	if !engine.isBlockSigner(chain, signer, head) || engine.isBlockSigner(chain, validator, head) {
+		t.Fatalf("block signer mismatch")
+	}
+	if engine.isBlockSigner(nil, signer, head) {
+		t.Fatalf("block signer resolved without a chain")
+	}
+}
@@ -0,0 +1,169 @@
+package democracy
+
+import (
+	"errors"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/systemcontract"
+	"github.com/QEasyWeb3/QEasyChain/contracts/system"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/log"
+	"github.com/QEasyWeb3/QEasyChain/params"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+)
+
+var (
+	// errInvalidConsensusParams is returned if the consensus parameters section of the
+	// extra-data can't be decoded, holds unusable values, or is present on a
+	// non-checkpoint block.
+	errInvalidConsensusParams = errors.New("invalid consensus parameters in extra data field")
+
+	// errMissingConsensusParams is returned if a checkpoint block after the Uranus
+	// hard-fork doesn't announce the consensus parameters of the next epoch.
+	errMissingConsensusParams = errors.New("checkpoint block misses consensus parameters")
+
+	// errMismatchingConsensusParams is returned if a checkpoint block announces consensus
+	// parameters different than the ones the local node read from the OnChainDao contract.
+	errMismatchingConsensusParams = errors.New("mismatching consensus parameters on checkpoint block")
+)
+
+// defaultConsensusParams returns the consensus parameters defined by the chain config,
+// which are active until the first checkpoint after the Uranus hard-fork.
+func defaultConsensusParams(config *params.DemocracyConfig) *systemcontract.ConsensusParams {
+	epoch := config.Epoch
+	if epoch <= 1 {
+		epoch = epochLength
+	}
+	return &systemcontract.ConsensusParams{
+		Period:           config.Period,
+		Epoch:            epoch,
+		AttestationDelay: config.AttestationDelay,
+		ContinuousInturn: params.ContinousInturn,
+		MaxValidators:    system.MaxValidators,
+	}
+}
+
+// mergeConsensusParams overrides the active consensus parameters with the governed ones.
+// Parameters that are not governed, or governed to an unusable value, are kept.
+func mergeConsensusParams(active, governed *systemcontract.ConsensusParams) *systemcontract.ConsensusParams {
+	merged := *active
+	if governed.Period > 0 {
+		merged.Period = governed.Period
+	}
+	if governed.Epoch > 1 {
+		merged.Epoch = governed.Epoch
+	}
+	if governed.AttestationDelay > 0 {
+		merged.AttestationDelay = governed.AttestationDelay
+	}
+	if governed.ContinuousInturn > 0 {
+		merged.ContinuousInturn = governed.ContinuousInturn
+	}
+	if governed.MaxValidators > 0 {
+		merged.MaxValidators = governed.MaxValidators
+	}
+	return &merged
+}
+
+// extraConsensusParams retrieves the consensus parameters announced by the extra-data of
+// a checkpoint header, or nil if the header doesn't carry any.
+func extraConsensusParams(config *params.ChainConfig, header *types.Header) (*systemcontract.ConsensusParams, error) {
+	_, paramsBytes, _, _, err := splitExtra(config, header)
+	if err != nil || len(paramsBytes) == 0 {
+		return nil, err
+	}
+	cp := new(systemcontract.ConsensusParams)
+	if err := rlp.DecodeBytes(paramsBytes, cp); err != nil {
+		return nil, errInvalidConsensusParams
+	}
+	if cp.Epoch <= 1 || cp.ContinuousInturn == 0 || cp.MaxValidators == 0 {
+		return nil, errInvalidConsensusParams
+	}
+	return cp, nil
+}
+
+// verifyConsensusParams checks that a header after the Uranus hard-fork announces
+// consensus parameters if and only if it is a checkpoint block.
+func (c *Democracy) verifyConsensusParams(header *types.Header, snap *Snapshot) error {
+	if !c.chainConfig.IsUranus(header.Number) {
+		return nil
+	}
+	cp, err := extraConsensusParams(c.chainConfig, header)
+	if err != nil {
+		return err
+	}
+	checkpoint := snap.isCheckpoint(header.Number.Uint64())
+	if checkpoint && cp == nil {
+		return errMissingConsensusParams
+	}
+	if !checkpoint && cp != nil {
+		return errInvalidConsensusParams
+	}
+	return nil
+}
+
+// nextConsensusParams returns the consensus parameters a checkpoint header announces for
+// the next epoch, based on the state of the checkpoint block - 1. Before the Uranus
+// hard-fork the active parameters are never changed, and neither are they on the fork
+// block, as the OnChainDao contract only gets its parameters on the fork block itself.
+func (c *Democracy) nextConsensusParams(chain consensus.ChainHeaderReader, header *types.Header, snap *Snapshot) (*systemcontract.ConsensusParams, error) {
+	active := snap.consensusParams()
+	if !c.chainConfig.IsUranus(header.Number) || c.chainConfig.UranusBlock.Cmp(header.Number) == 0 {
+		return active, nil
+	}
+	parent := chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	if parent == nil {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	stateDb, err := c.stateFn(parent.Root)
+	if err != nil {
+		return nil, err
+	}
+	governed, err := systemcontract.GetConsensusParams(&systemcontract.CallContext{
+		Statedb:      stateDb,
+		Header:       parent,
+		ChainContext: newChainContext(chain, c),
+		ChainConfig:  c.chainConfig})
+	if err != nil {
+		return nil, err
+	}
+	next := mergeConsensusParams(active, governed)
+	if *next != *active {
+		log.Info("Consensus parameters changed by governance", "number", header.Number, "period", next.Period, "epoch", next.Epoch,
+			"attestationDelay", next.AttestationDelay, "continuousInturn", next.ContinuousInturn, "maxValidators", next.MaxValidators)
+	}
+	return next, nil
+}
+
+// currentConsensusParams returns the consensus parameters active on top of the current
+// chain head, falling back to the ones defined by the chain config.
+func (c *Democracy) currentConsensusParams() *systemcontract.ConsensusParams {
+	if c.chain != nil {
+		if head := c.chain.CurrentHeader(); head != nil {
+			return c.consensusParamsAt(c.chain, head.Hash(), head.Number.Uint64())
+		}
+	}
+	return defaultConsensusParams(c.config)
+}
+
+// consensusParamsAt returns the consensus parameters active on top of the given block,
+// falling back to the ones defined by the chain config if its snapshot is unavailable.
+func (c *Democracy) consensusParamsAt(chain consensus.ChainHeaderReader, hash common.Hash, number uint64) *systemcontract.ConsensusParams {
+	snap, err := c.snapshot(chain, number, hash, nil)
+	if err != nil {
+		log.Warn("Failed to retrieve consensus parameters", "number", number, "hash", hash, "err", err)
+		return defaultConsensusParams(c.config)
+	}
+	return snap.consensusParams()
+}
+
+// EpochAt returns the index of the epoch the given block belongs to, the checkpoint
+// block which started it, and the length of the epochs from there on.
+func (c *Democracy) EpochAt(chain consensus.ChainHeaderReader, hash common.Hash, number uint64) (uint64, uint64, uint64, error) {
+	snap, err := c.snapshot(chain, number, hash, nil)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return snap.Epoch, snap.lastCheckpoint(number), snap.consensusParams().Epoch, nil
+}
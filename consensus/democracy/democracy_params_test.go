@@ -0,0 +1,190 @@
+package democracy
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/systemcontract"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+	"github.com/QEasyWeb3/QEasyChain/params"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// newTestParamsChain creates a chain after the Mars and Uranus hard-forks sealed by a single
+// validator, whose checkpoint headers announce the consensus parameters returned by the
+// given function, or the active ones if it returns nil.
+func newTestParamsChain(t *testing.T, length int, key *ecdsa.PrivateKey, announce func(number uint64) *systemcontract.ConsensusParams) (*testFinalityChain, *Snapshot) {
+	validator := crypto.PubkeyToAddress(key.PublicKey)
+	chain := &testFinalityChain{
+		config: &params.ChainConfig{MarsBlock: big.NewInt(0), UranusBlock: big.NewInt(0),
+			Democracy: &params.DemocracyConfig{Period: 3, Epoch: 4, AttestationDelay: 2}},
+		status: make(map[common.Hash]uint8),
+	}
+	genesis := &types.Header{Number: big.NewInt(0), Difficulty: diffInTurn,
+		Extra: append(append(make([]byte, extraVanity), validator.Bytes()...), make([]byte, extraSeal)...)}
+	chain.headers = append(chain.headers, genesis)
+
+	sigcache, _ := lru.NewARC(inmemorySignatures)
+	snap := newSnapshot(chain.config, sigcache, 0, genesis.Hash(), []common.Address{validator})
+	active := snap.consensusParams()
+	for i := 1; i < length; i++ {
+		number := uint64(i)
+		extra, err := rlp.EncodeToBytes([]*types.FinalityCertificate{})
+		if err != nil {
+			t.Fatalf("failed to encode certificates: %v", err)
+		}
+		checkpoint := number >= snap.ParamsNumber && (number-snap.ParamsNumber)%active.Epoch == 0
+		if checkpoint {
+			if next := announce(number); next != nil {
+				active = next
+			}
+			enc, err := rlp.EncodeToBytes(active)
+			if err != nil {
+				t.Fatalf("failed to encode consensus params: %v", err)
+			}
+			extra = append(append(extra, enc...), validator.Bytes()...)
+			snap.ParamsNumber = number
+		}
+		header := &types.Header{Number: new(big.Int).SetUint64(number), ParentHash: chain.headers[i-1].Hash(),
+			Coinbase: validator, Difficulty: diffInTurn, Time: number * active.Period,
+			Extra: append(append(make([]byte, extraVanity), extra...), make([]byte, extraSeal)...)}
+		chain.headers = append(chain.headers, sealTestHeader(t, header, key))
+	}
+	return chain, newSnapshot(chain.config, sigcache, 0, genesis.Hash(), []common.Address{validator})
+}
+
+// Tests that the consensus parameters announced by a checkpoint become active on it, and that
+// the next checkpoints are counted with the announced epoch length.
+func TestSnapshotConsensusParams(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	governed := &systemcontract.ConsensusParams{Period: 2, Epoch: 3, AttestationDelay: 5, ContinuousInturn: 2, MaxValidators: 7}
+	chain, genesis := newTestParamsChain(t, 14, key, func(number uint64) *systemcontract.ConsensusParams {
+		if number == 4 {
+			return governed
+		}
+		return nil
+	})
+	snap, err := genesis.apply(chain.headers[1:], chain, nil)
+	if err != nil {
+		t.Fatalf("failed to apply headers: %v", err)
+	}
+	if *snap.consensusParams() != *governed {
+		t.Fatalf("active params mismatch: have %+v, want %+v", snap.consensusParams(), governed)
+	}
+	// Checkpoints at 4, 7, 10 and 13
+	if snap.ParamsNumber != 13 || snap.Epoch != 4 {
+		t.Fatalf("checkpoint mismatch: have params at %d and epoch %d, want 13 and 4", snap.ParamsNumber, snap.Epoch)
+	}
+	for number, want := range map[uint64]bool{15: false, 16: true, 17: false, 19: true} {
+		if have := snap.isCheckpoint(number); have != want {
+			t.Errorf("block %d checkpoint mismatch: have %v, want %v", number, have, want)
+		}
+	}
+	if checkpoint := snap.lastCheckpoint(15); checkpoint != 13 {
+		t.Errorf("last checkpoint mismatch: have %d, want 13", checkpoint)
+	}
+	// The engine reads the parameters of the snapshot a header is built on
+	engine := New(chain.config, rawdb.NewMemoryDatabase())
+	engine.recents.Add(snap.Hash, snap)
+	head := chain.CurrentHeader()
+
+	if delay := engine.AttestationDelay(chain, head); delay != governed.AttestationDelay {
+		t.Errorf("attestation delay mismatch: have %d, want %d", delay, governed.AttestationDelay)
+	}
+	if inturn := engine.ContinuousInturn(chain, head); inturn != governed.ContinuousInturn {
+		t.Errorf("continuous inturn mismatch: have %d, want %d", inturn, governed.ContinuousInturn)
+	}
+	// Block 14 is the first of the in-turn blocks of its validator, 15 isn't
+	child := &types.Header{Number: big.NewInt(14), ParentHash: head.Hash(), GasLimit: 1000}
+	if gas := engine.CalculateGasPool(chain, child); gas != 500 {
+		t.Errorf("gas pool of first in-turn block mismatch: have %d, want 500", gas)
+	}
+	index, checkpoint, length, err := engine.EpochAt(chain, head.Hash(), head.Number.Uint64())
+	if err != nil || index != 4 || checkpoint != 13 || length != governed.Epoch {
+		t.Errorf("epoch mismatch: have %d/%d/%d (%v), want 4/13/%d", index, checkpoint, length, err, governed.Epoch)
+	}
+}
+
+// Tests that consensus parameters are required on checkpoints after the Uranus hard-fork, and
+// refused everywhere else.
+func TestVerifyConsensusParams(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	chain, genesis := newTestParamsChain(t, 6, key, func(uint64) *systemcontract.ConsensusParams { return nil })
+	engine := New(chain.config, rawdb.NewMemoryDatabase())
+
+	snap, err := genesis.apply(chain.headers[1:4], chain, nil)
+	if err != nil {
+		t.Fatalf("failed to apply headers: %v", err)
+	}
+	checkpoint := chain.headers[4]
+	if err := engine.verifyConsensusParams(checkpoint, snap); err != nil {
+		t.Fatalf("valid checkpoint rejected: %v", err)
+	}
+	// Strip the parameters and the validators from the checkpoint
+	stripped := types.CopyHeader(checkpoint)
+	certs, _, _, _, _ := splitExtra(chain.config, checkpoint)
+	stripped.Extra = append(append(make([]byte, extraVanity), certs...), make([]byte, extraSeal)...)
+	if err := engine.verifyConsensusParams(stripped, snap); err != errMissingConsensusParams {
+		t.Fatalf("checkpoint without params error mismatch: have %v, want %v", err, errMissingConsensusParams)
+	}
+	if snap, err = snap.apply(chain.headers[4:5], chain, nil); err != nil {
+		t.Fatalf("failed to apply checkpoint: %v", err)
+	}
+	announcing := types.CopyHeader(chain.headers[5])
+	announcing.Extra = checkpoint.Extra
+	if err := engine.verifyConsensusParams(announcing, snap); err != errInvalidConsensusParams {
+		t.Fatalf("non-checkpoint with params error mismatch: have %v, want %v", err, errInvalidConsensusParams)
+	}
+}
+
+// Tests that the governed parameters only override the active ones when they are usable.
+func TestMergeConsensusParams(t *testing.T) {
+	active := &systemcontract.ConsensusParams{Period: 3, Epoch: 200, AttestationDelay: 2, ContinuousInturn: 4, MaxValidators: 21}
+
+	merged := mergeConsensusParams(active, &systemcontract.ConsensusParams{Epoch: 1})
+	if *merged != *active {
+		t.Fatalf("unusable params merged: %+v", merged)
+	}
+	merged = mergeConsensusParams(active, &systemcontract.ConsensusParams{Period: 1, MaxValidators: 11})
+	want := *active
+	want.Period, want.MaxValidators = 1, 11
+	if *merged != want {
+		t.Fatalf("merged params mismatch: have %+v, want %+v", merged, want)
+	}
+}
+
+// Tests that the consensus keys section is split apart after the Saturn hard-fork, whether
+// the consensus parameters section precedes it or not.
+func TestSplitExtraSections(t *testing.T) {
+	certs, _ := rlp.EncodeToBytes([]*types.FinalityCertificate{})
+	cp, _ := rlp.EncodeToBytes(&systemcontract.ConsensusParams{Period: 3, Epoch: 200, AttestationDelay: 2, ContinuousInturn: 4, MaxValidators: 21})
+	keys, _ := rlp.EncodeToBytes([]consensusKey{{Validator: common.Address{0x1}, Key: common.Address{0x2}}})
+	validators := common.Address{0x1}.Bytes()
+
+	tests := []struct {
+		uranus *big.Int
+		extra  [][]byte
+		cp     []byte
+	}{
+		{nil, [][]byte{certs, keys, validators}, nil},
+		{big.NewInt(0), [][]byte{certs, cp, keys, validators}, cp},
+	}
+	for i, tt := range tests {
+		config := &params.ChainConfig{MarsBlock: big.NewInt(0), SaturnBlock: big.NewInt(0), UranusBlock: tt.uranus}
+		header := &types.Header{Number: big.NewInt(1), Extra: append(append(make([]byte, extraVanity), bytes.Join(tt.extra, nil)...), make([]byte, extraSeal)...)}
+
+		haveCerts, haveCp, haveKeys, haveValidators, err := splitExtra(config, header)
+		if err != nil {
+			t.Fatalf("test %d: failed to split extra: %v", i, err)
+		}
+		if !bytes.Equal(haveCerts, certs) || !bytes.Equal(haveCp, tt.cp) || !bytes.Equal(haveKeys, keys) || !bytes.Equal(haveValidators, validators) {
+			t.Errorf("test %d: sections mismatch: %x, %x, %x, %x", i, haveCerts, haveCp, haveKeys, haveValidators)
+		}
+	}
+}
@@ -128,13 +128,13 @@ func (c *Democracy) executeProposal(chain consensus.ChainHeaderReader, header *t
 		return nil, nil, err
 	}
 	//make system governance transaction
-	nonce := state.GetNonce(c.validator)
+	nonce := state.GetNonce(c.signingKey)
 	tx := types.NewTransaction(nonce, proposalTxMark, common.Big0, header.GasLimit, new(big.Int), propRLP)
-	if tx, err = c.signTxFn(accounts.Account{Address: c.validator}, tx, chain.Config().ChainID); err != nil {
+	if tx, err = c.signTxFn(accounts.Account{Address: c.signingKey}, tx, chain.Config().ChainID); err != nil {
 		return nil, nil, err
 	}
 	//add nonce for validator
-	state.SetNonce(c.validator, nonce+1)
+	state.SetNonce(c.signingKey, nonce+1)
 	receipt := c.executeProposalMsg(chain, header, state, prop, totalTxIndex, tx.Hash(), common.Hash{})
 
 	return tx, receipt, nil
@@ -145,7 +145,7 @@ func (c *Democracy) replayProposal(chain consensus.ChainHeaderReader, header *ty
 	if err != nil {
 		return nil, err
 	}
-	if sender != header.Coinbase {
+	if !c.isBlockSigner(chain, sender, header) {
 		return nil, errors.New("invalid sender for system governance transaction")
 	}
 	propRLP, err := rlp.EncodeToBytes(prop)
@@ -238,11 +238,11 @@ func (c *Democracy) IsSysTransaction(sender common.Address, tx *types.Transactio
 		return false
 	}
 	to := tx.To()
-	if sender == header.Coinbase && *to == proposalTxMark && tx.GasPrice().Sign() == 0 {
+	if c.isBlockSigner(c.chain, sender, header) && (*to == proposalTxMark || *to == feeSplitTxMark) && tx.GasPrice().Sign() == 0 {
 		return true
 	}
 	// Make sure the miner can NOT call the system contract through a normal transaction.
-	if sender == header.Coinbase {
+	if sender == header.Coinbase || c.isBlockSigner(c.chain, sender, header) {
 		contract := system.GetContractAddressByConfig(system.OnChainDaoContractName, header.Number, c.chainConfig)
 		if *to == contract {
 			return true
@@ -256,6 +256,11 @@ func (c *Democracy) IsSysTransaction(sender common.Address, tx *types.Transactio
 // ApplyProposalTx applies a system-transaction using a given evm,
 // the main purpose of this method is for tracing a system-transaction.
 func (c *Democracy) ApplyProposalTx(evm *vm.EVM, state *state.StateDB, txIndex int, sender common.Address, tx *types.Transaction) (ret []byte, vmerr error, err error) {
+	if isFeeSplitTransaction(tx) {
+		state.Prepare(tx.Hash(), txIndex)
+		err = applyFeeSplitTx(evm, sender, tx)
+		return
+	}
 	var prop = &systemcontract.Proposal{}
 	if err = rlp.DecodeBytes(tx.Data(), prop); err != nil {
 		return
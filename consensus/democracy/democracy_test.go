@@ -10,6 +10,7 @@ import (
 	"github.com/QEasyWeb3/QEasyChain/accounts/abi"
 	"github.com/QEasyWeb3/QEasyChain/common"
 	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/systemcontract"
+	"github.com/QEasyWeb3/QEasyChain/contracts/system"
 	"github.com/QEasyWeb3/QEasyChain/core"
 	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
 	"github.com/QEasyWeb3/QEasyChain/core/state"
@@ -98,7 +99,7 @@ func (ap *testerAccountPool) checkpoint(header *types.Header, signers []string)
 	for i, signer := range signers {
 		auths[i] = ap.address(signer)
 	}
-	sort.Sort(validatorsAscending(auths))
+	sort.Sort(systemcontract.AddrAscend(auths))
 	for i, auth := range auths {
 		copy(header.Extra[extraVanity+i*common.AddressLength:], auth.Bytes())
 	}
@@ -150,7 +151,7 @@ func (ap *testerAccountPool) genTx(change testerValidatorChange, nonce uint64, s
 		if err != nil {
 			return nil, err
 		}
-		return types.SignTx(types.NewTransaction(nonce, systemcontract.ValidatorsV1ContractAddr, nil, 3000000, big.NewInt(params.GWei), data), signer, ap.admin)
+		return types.SignTx(types.NewTransaction(nonce, system.SystemContract, nil, 3000000, big.NewInt(params.GWei), data), signer, ap.admin)
 	case validatorInc:
 		method := "addMargin"
 		data, err := votepoolV2abi.Pack(method)
@@ -348,11 +349,6 @@ func TestDemocracy(t *testing.T) {
 			Epoch:            epoch,
 			SysContractAdmin: accounts.adminAddr,
 		}
-		if tt.waterdropBlock > 0 {
-			config.WaterdropBlock = big.NewInt(int64(tt.waterdropBlock))
-		} else {
-			config.WaterdropBlock = nil
-		}
 		// Create the genesis block with the initial set of signers
 		genesis := core.BasicDemocracyGenesisBlock(&config, signers, accounts.adminAddr)
 		// Create a pristine blockchain with the genesis injected
@@ -528,11 +524,11 @@ func TestDemocracy(t *testing.T) {
 
 func TestBuildExecutedProposalLogData(t *testing.T) {
 	tests := []struct {
-		prop   *Proposal
+		prop   *systemcontract.Proposal
 		expect []byte
 	}{
 		{
-			prop: &Proposal{
+			prop: &systemcontract.Proposal{
 				Id:     new(big.Int),
 				Action: new(big.Int),
 				Data:   nil,
@@ -540,7 +536,7 @@ func TestBuildExecutedProposalLogData(t *testing.T) {
 			expect: common.Hex2Bytes("000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000000"),
 		},
 		{
-			prop: &Proposal{
+			prop: &systemcontract.Proposal{
 				Id:     big.NewInt(1),
 				Action: new(big.Int),
 				Data:   nil,
@@ -548,7 +544,7 @@ func TestBuildExecutedProposalLogData(t *testing.T) {
 			expect: common.Hex2Bytes("000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000000"),
 		},
 		{
-			prop: &Proposal{
+			prop: &systemcontract.Proposal{
 				Id:     big.NewInt(2),
 				Action: big.NewInt(1),
 				Data:   nil,
@@ -556,7 +552,7 @@ func TestBuildExecutedProposalLogData(t *testing.T) {
 			expect: common.Hex2Bytes("000100000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000000"),
 		},
 		{
-			prop: &Proposal{
+			prop: &systemcontract.Proposal{
 				Id:     big.NewInt(2),
 				Action: big.NewInt(0),
 				Data:   common.Hex2Bytes("a9059cbb00000000000000000000000066cee42a790238e0d5f5f24b8a3f928948b6822c00000000000000000000000000000000000000000000000000000006b10a44b6"),
@@ -564,7 +560,7 @@ func TestBuildExecutedProposalLogData(t *testing.T) {
 			expect: common.Hex2Bytes("000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000044a9059cbb00000000000000000000000066cee42a790238e0d5f5f24b8a3f928948b6822c00000000000000000000000000000000000000000000000000000006b10a44b600000000000000000000000000000000000000000000000000000000"),
 		},
 		{
-			prop: &Proposal{
+			prop: &systemcontract.Proposal{
 				Id:     big.NewInt(2),
 				Action: big.NewInt(0),
 				Data:   common.Hex2Bytes("a9059cbb"),
@@ -573,7 +569,7 @@ func TestBuildExecutedProposalLogData(t *testing.T) {
 		},
 	}
 	for i, tt := range tests {
-		data := buildExecutedProposalLogData(tt.prop)
+		data := buildProposalExecutedEventData(tt.prop)
 		if len(data) != len(tt.expect) {
 			t.Errorf("case %d: len mismatch, want: %d, got: %d\n", i, len(tt.expect), len(data))
 		} else if !bytes.Equal(data, tt.expect) {
@@ -0,0 +1,226 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package democracy
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/QEasyWeb3/QEasyChain/accounts"
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/contracts/system"
+	"github.com/QEasyWeb3/QEasyChain/core/state"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/core/vm"
+	"github.com/QEasyWeb3/QEasyChain/log"
+	"github.com/QEasyWeb3/QEasyChain/params"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+)
+
+// event FeeSplit(uint256 priorityFee, uint256 baseFee, uint256 validators, uint256 communityPool, uint256 burn)
+// event signature:  crypto.Keccak256([]byte("FeeSplit(uint256,uint256,uint256,uint256,uint256)"))
+// "0x1f798049541c93843cef4a64652d3707a2d974a0a804178734c9a52717444438"
+var (
+	feeSplitTxMark     = common.HexToAddress("0x000000000000000000000000000000000000FFFE")
+	feeSplitEventSig   = common.HexToHash("0x1f798049541c93843cef4a64652d3707a2d974a0a804178734c9a52717444438")
+	errInvalidFeeSplit = errors.New("invalid fee split transaction")
+	errMissingFeeSplit = errors.New("missing fee split transaction")
+)
+
+// feeSplit is the split of the fees of a block following the fee policy of the chain.
+type feeSplit struct {
+	PriorityFee   *big.Int // Priority fees paid to the block
+	BaseFee       *big.Int // Base fees burned by the block
+	Validators    *big.Int // Share of the fees distributed to the validators
+	CommunityPool *big.Int // Share of the fees sent to the community pool contract
+	Burn          *big.Int // Share of the fees burned
+}
+
+// splitFee splits a fee along the given shares, returning the share of the validators,
+// of the community pool and of the burn.
+func splitFee(fee *big.Int, shares params.FeeShares, leftoverToValidators bool) (*big.Int, *big.Int, *big.Int) {
+	var (
+		validators = new(big.Int)
+		community  = new(big.Int)
+		burn       = new(big.Int)
+		total      = new(big.Int).SetUint64(shares.Validators)
+	)
+	total.Add(total, new(big.Int).SetUint64(shares.CommunityPool))
+	total.Add(total, new(big.Int).SetUint64(shares.Burn))
+	if total.Sign() > 0 {
+		validators.Div(validators.Mul(fee, new(big.Int).SetUint64(shares.Validators)), total)
+		community.Div(community.Mul(fee, new(big.Int).SetUint64(shares.CommunityPool)), total)
+		burn.Div(burn.Mul(fee, new(big.Int).SetUint64(shares.Burn)), total)
+	}
+	leftover := new(big.Int).Sub(fee, validators)
+	leftover.Sub(leftover, community)
+	leftover.Sub(leftover, burn)
+	if leftoverToValidators {
+		validators.Add(validators, leftover)
+	} else {
+		burn.Add(burn, leftover)
+	}
+	return validators, community, burn
+}
+
+// blockFeeSplit computes the split of the fees paid by the given transactions of a
+// block, or nil if the fee policy isn't active or the block paid no fees. Both the
+// priority fees and the base fees were collected by FeeRecoder when the transactions
+// were executed, on top of any balance it held before.
+func (c *Democracy) blockFeeSplit(header *types.Header, txs []*types.Transaction, receipts []*types.Receipt) *feeSplit {
+	policy := c.config.FeePolicy
+	if !c.config.IsFeePolicy(header.Number) {
+		return nil
+	}
+	split := &feeSplit{
+		PriorityFee: new(big.Int),
+		BaseFee:     new(big.Int),
+	}
+	for i, tx := range txs {
+		if i >= len(receipts) {
+			break
+		}
+		tip := tx.EffectiveGasTipValue(header.BaseFee)
+		split.PriorityFee.Add(split.PriorityFee, tip.Mul(tip, new(big.Int).SetUint64(receipts[i].GasUsed)))
+	}
+	if header.BaseFee != nil {
+		split.BaseFee.Mul(header.BaseFee, new(big.Int).SetUint64(header.GasUsed))
+	}
+	if split.PriorityFee.Sign() == 0 && split.BaseFee.Sign() == 0 {
+		return nil
+	}
+	validators, community, burn := splitFee(split.PriorityFee, policy.PriorityFee, true)
+	baseValidators, baseCommunity, baseBurn := splitFee(split.BaseFee, policy.BaseFee, false)
+
+	split.Validators = validators.Add(validators, baseValidators)
+	split.CommunityPool = community.Add(community, baseCommunity)
+	split.Burn = burn.Add(burn, baseBurn)
+	return split
+}
+
+// applyFeeSplit moves the fees of a block from FeeRecoder to their destinations, and
+// burns the rest. The share of the validators is left in FeeRecoder, to be distributed
+// along with the block reward.
+func applyFeeSplit(state vm.StateDB, split *feeSplit) {
+	state.SubBalance(consensus.FeeRecoder, new(big.Int).Add(split.CommunityPool, split.Burn))
+	state.AddBalance(system.CommunityPoolContract, split.CommunityPool)
+}
+
+// feeSplitLog creates the log reporting the share of every destination of the fees.
+func feeSplitLog(split *feeSplit, number uint64) *types.Log {
+	return &types.Log{
+		Address:     feeSplitTxMark,
+		Topics:      []common.Hash{feeSplitEventSig},
+		Data:        buildFeeSplitEventData(split),
+		BlockNumber: number,
+	}
+}
+
+// processFeeSplit splits the fees of the block following the fee policy of the chain,
+// through a system transaction whose receipt logs the share of every destination.
+func (c *Democracy) processFeeSplit(chain consensus.ChainHeaderReader, header *types.Header,
+	state *state.StateDB, txs *[]*types.Transaction, receipts *[]*types.Receipt, splitTxs []*types.Transaction, mined bool) error {
+	// Skip unauthorized validator mining
+	if mined && c.signTxFn == nil {
+		return nil
+	}
+	split := c.blockFeeSplit(header, *txs, *receipts)
+	if split == nil {
+		if len(splitTxs) > 0 {
+			return errInvalidFeeSplit
+		}
+		return nil
+	}
+	data, err := rlp.EncodeToBytes(split)
+	if err != nil {
+		return err
+	}
+	var tx *types.Transaction
+	if mined {
+		nonce := state.GetNonce(c.signingKey)
+		tx = types.NewTransaction(nonce, feeSplitTxMark, common.Big0, 0, new(big.Int), data)
+		if tx, err = c.signTxFn(accounts.Account{Address: c.signingKey}, tx, chain.Config().ChainID); err != nil {
+			return err
+		}
+		state.SetNonce(c.signingKey, nonce+1)
+	} else {
+		if len(splitTxs) == 0 {
+			return errMissingFeeSplit
+		}
+		if len(splitTxs) > 1 || !bytes.Equal(splitTxs[0].Data(), data) {
+			return errInvalidFeeSplit
+		}
+		tx = splitTxs[0]
+		sender, err := types.Sender(c.signer, tx)
+		if err != nil {
+			return err
+		}
+		if !c.isBlockSigner(chain, sender, header) {
+			return errInvalidFeeSplit
+		}
+		state.SetNonce(sender, state.GetNonce(sender)+1)
+	}
+	var bHash common.Hash
+	if !mined {
+		bHash = header.Hash()
+	}
+	state.Prepare(tx.Hash(), len(*txs))
+	applyFeeSplit(state, split)
+	state.AddLog(feeSplitLog(split, header.Number.Uint64()))
+	log.Debug("Split block fees", "number", header.Number, "priorityFee", split.PriorityFee, "baseFee", split.BaseFee,
+		"validators", split.Validators, "communityPool", split.CommunityPool, "burn", split.Burn)
+
+	receipt := types.NewReceipt([]byte{}, false, header.GasUsed)
+	receipt.Logs = state.GetLogs(tx.Hash(), bHash)
+	receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+	receipt.TxHash = tx.Hash()
+	receipt.BlockHash = bHash
+	receipt.BlockNumber = header.Number
+	receipt.TransactionIndex = uint(state.TxIndex())
+
+	*txs = append(*txs, tx)
+	*receipts = append(*receipts, receipt)
+	return nil
+}
+
+func buildFeeSplitEventData(split *feeSplit) []byte {
+	data := make([]byte, 0, 5*common.HashLength)
+	for _, amount := range []*big.Int{split.PriorityFee, split.BaseFee, split.Validators, split.CommunityPool, split.Burn} {
+		data = append(data, common.BigToHash(amount).Bytes()...)
+	}
+	return data
+}
+
+// isFeeSplitTransaction checks whether a system transaction splits the fees of the block.
+func isFeeSplitTransaction(tx *types.Transaction) bool {
+	return tx.To() != nil && *tx.To() == feeSplitTxMark
+}
+
+// applyFeeSplitTx applies a fee split transaction using a given evm, the main purpose
+// of this method is for tracing a system-transaction.
+func applyFeeSplitTx(evm *vm.EVM, sender common.Address, tx *types.Transaction) error {
+	split := new(feeSplit)
+	if err := rlp.DecodeBytes(tx.Data(), split); err != nil {
+		return err
+	}
+	evm.StateDB.SetNonce(sender, evm.StateDB.GetNonce(sender)+1)
+	applyFeeSplit(evm.StateDB, split)
+	evm.StateDB.AddLog(feeSplitLog(split, evm.Context.BlockNumber.Uint64()))
+	return nil
+}
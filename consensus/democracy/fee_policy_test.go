@@ -0,0 +1,165 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package democracy
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/contracts/system"
+	"github.com/QEasyWeb3/QEasyChain/core"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/state"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/core/vm"
+	"github.com/QEasyWeb3/QEasyChain/params"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+)
+
+// testFeePolicy splits the priority fees in thirds, and burns half of the base fees.
+var testFeePolicy = &params.FeePolicy{
+	Block:       big.NewInt(1),
+	PriorityFee: params.FeeShares{Validators: 1, CommunityPool: 1, Burn: 1},
+	BaseFee:     params.FeeShares{CommunityPool: 1, Burn: 1},
+}
+
+// Tests that the fees of a block are split from the fees paid by its transactions only,
+// whatever the balance of FeeRecoder, and that the base fees are burned by the split.
+func TestBlockFeeSplit(t *testing.T) {
+	config := &params.ChainConfig{ChainID: big.NewInt(1), Democracy: &params.DemocracyConfig{Epoch: 200, FeePolicy: testFeePolicy}}
+	engine := New(config, rawdb.NewMemoryDatabase())
+
+	header := &types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(10), GasUsed: 30000}
+	txs := []*types.Transaction{
+		types.NewTx(&types.DynamicFeeTx{GasTipCap: big.NewInt(3), GasFeeCap: big.NewInt(20), Gas: 21000}),
+		types.NewTx(&types.LegacyTx{GasPrice: big.NewInt(12), Gas: 21000}),
+	}
+	receipts := []*types.Receipt{{GasUsed: 21000}, {GasUsed: 9000}}
+
+	if split := engine.blockFeeSplit(&types.Header{Number: big.NewInt(0), BaseFee: big.NewInt(10), GasUsed: 30000}, txs, receipts); split != nil {
+		t.Fatalf("fees split before the activation of the policy: %+v", split)
+	}
+	if split := engine.blockFeeSplit(&types.Header{Number: big.NewInt(1)}, nil, nil); split != nil {
+		t.Fatalf("fees split without fees: %+v", split)
+	}
+	split := engine.blockFeeSplit(header, txs, receipts)
+	want := &feeSplit{
+		PriorityFee:   big.NewInt(3*21000 + 2*9000),
+		BaseFee:       big.NewInt(10 * 30000),
+		Validators:    big.NewInt(27000),
+		CommunityPool: big.NewInt(27000 + 150000),
+		Burn:          big.NewInt(27000 + 150000),
+	}
+	have, _ := rlp.EncodeToBytes(split)
+	if enc, _ := rlp.EncodeToBytes(want); !bytes.Equal(have, enc) {
+		t.Fatalf("split mismatch: have %+v, want %+v", split, want)
+	}
+	// The balance held by FeeRecoder before the block is left to the validators
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	statedb.AddBalance(consensus.FeeRecoder, new(big.Int).Add(big.NewInt(5), new(big.Int).Add(want.PriorityFee, want.BaseFee)))
+	applyFeeSplit(statedb, split)
+
+	if balance := statedb.GetBalance(consensus.FeeRecoder); balance.Cmp(big.NewInt(5+27000)) != 0 {
+		t.Errorf("validators balance mismatch: have %v, want %d", balance, 5+27000)
+	}
+	if balance := statedb.GetBalance(system.CommunityPoolContract); balance.Cmp(want.CommunityPool) != 0 {
+		t.Errorf("community pool balance mismatch: have %v, want %v", balance, want.CommunityPool)
+	}
+}
+
+// Tests that the split leftovers of the priority fees go to the validators, and the ones
+// of the base fees are burned.
+func TestSplitFee(t *testing.T) {
+	shares := params.FeeShares{Validators: 1, CommunityPool: 1, Burn: 1}
+	if validators, community, burn := splitFee(big.NewInt(10), shares, true); validators.Int64() != 4 || community.Int64() != 3 || burn.Int64() != 3 {
+		t.Errorf("priority fee split mismatch: have %v/%v/%v, want 4/3/3", validators, community, burn)
+	}
+	if validators, community, burn := splitFee(big.NewInt(10), shares, false); validators.Int64() != 3 || community.Int64() != 3 || burn.Int64() != 4 {
+		t.Errorf("base fee split mismatch: have %v/%v/%v, want 3/3/4", validators, community, burn)
+	}
+	if validators, _, burn := splitFee(big.NewInt(10), params.FeeShares{}, false); validators.Sign() != 0 || burn.Int64() != 10 {
+		t.Errorf("unweighted base fee split mismatch: have %v validators, %v burned", validators, burn)
+	}
+}
+
+// Tests that the base fees are only collected by the transactions while a fee policy
+// is active, and burned otherwise.
+func TestCollectBaseFee(t *testing.T) {
+	for _, policy := range []*params.FeePolicy{nil, testFeePolicy} {
+		config := &params.ChainConfig{ChainID: big.NewInt(1), HomesteadBlock: big.NewInt(0), EIP150Block: big.NewInt(0),
+			EIP155Block: big.NewInt(0), EIP158Block: big.NewInt(0), ByzantiumBlock: big.NewInt(0), ConstantinopleBlock: big.NewInt(0),
+			PetersburgBlock: big.NewInt(0), IstanbulBlock: big.NewInt(0), BerlinBlock: big.NewInt(0), LondonBlock: big.NewInt(0),
+			Democracy: &params.DemocracyConfig{Epoch: 200, FeePolicy: policy}}
+
+		statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		sender, to := common.Address{0x1}, common.Address{0x2}
+		statedb.AddBalance(sender, big.NewInt(params.Ether))
+
+		evm := vm.NewEVM(vm.BlockContext{CanTransfer: core.CanTransfer, Transfer: core.Transfer, GasLimit: 1000000,
+			BlockNumber: big.NewInt(1), BaseFee: big.NewInt(10), Difficulty: common.Big1}, vm.TxContext{}, statedb, config, vm.Config{})
+		msg := types.NewMessage(sender, &to, 0, common.Big0, 21000, big.NewInt(13), big.NewInt(20), big.NewInt(3), nil, nil, false)
+		if _, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(1000000)); err != nil {
+			t.Fatalf("failed to apply message: %v", err)
+		}
+		want := big.NewInt(3 * 21000)
+		if policy != nil {
+			want.Add(want, big.NewInt(10*21000))
+		}
+		if balance := statedb.GetBalance(consensus.FeeRecoder); balance.Cmp(want) != 0 {
+			t.Errorf("policy %v: collected fees mismatch: have %v, want %v", policy != nil, balance, want)
+		}
+	}
+}
+
+// Tests that tracing a fee split transaction logs the shares like the block did.
+func TestApplyFeeSplitTx(t *testing.T) {
+	split := &feeSplit{PriorityFee: big.NewInt(3), BaseFee: big.NewInt(4), Validators: big.NewInt(1), CommunityPool: big.NewInt(2), Burn: big.NewInt(4)}
+	data, _ := rlp.EncodeToBytes(split)
+	tx := types.NewTransaction(0, feeSplitTxMark, common.Big0, 0, new(big.Int), data)
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	statedb.AddBalance(consensus.FeeRecoder, big.NewInt(7))
+	statedb.Prepare(tx.Hash(), 0)
+	evm := vm.NewEVM(vm.BlockContext{BlockNumber: big.NewInt(5)}, vm.TxContext{}, statedb, params.TestChainConfig, vm.Config{})
+
+	if err := applyFeeSplitTx(evm, common.Address{0x1}, tx); err != nil {
+		t.Fatalf("failed to apply fee split: %v", err)
+	}
+	logs := statedb.GetLogs(tx.Hash(), common.Hash{})
+	if len(logs) != 1 || logs[0].Topics[0] != feeSplitEventSig || !bytes.Equal(logs[0].Data, buildFeeSplitEventData(split)) || logs[0].BlockNumber != 5 {
+		t.Fatalf("fee split log mismatch: %v", logs)
+	}
+	if balance := statedb.GetBalance(consensus.FeeRecoder); balance.Cmp(split.Validators) != 0 {
+		t.Errorf("validators balance mismatch: have %v, want %v", balance, split.Validators)
+	}
+}
+
+// Tests that fee policies without activation block are rejected when the chain config
+// is loaded.
+func TestCheckFeePolicy(t *testing.T) {
+	config := &params.ChainConfig{Democracy: &params.DemocracyConfig{Epoch: 200, FeePolicy: &params.FeePolicy{}}}
+	if err := config.CheckConfigForkOrder(); err == nil {
+		t.Errorf("fee policy without activation block accepted")
+	}
+	config.Democracy.FeePolicy = testFeePolicy
+	if err := config.CheckConfigForkOrder(); err != nil {
+		t.Errorf("valid fee policy rejected: %v", err)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package democracy
+
+import (
+	"math/big"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/systemcontract"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/log"
+)
+
+// maxParticipationSearch is the max number of recent blocks searched for attestations
+// when assembling a participation certificate.
+const maxParticipationSearch = 16
+
+// leakActive checks whether the inactivity leak is configured and active at the given block.
+// Participation is only recorded by the finality certificates after the Mars hard-fork, and
+// the inactive validators can only be punished after the Neptune hard-fork.
+func (s *Snapshot) leakActive(number uint64) bool {
+	leak := s.config.Democracy.InactivityLeak
+	if leak == nil || leak.Block == nil || !leak.Block.IsUint64() || number < leak.Block.Uint64() {
+		return false
+	}
+	num := new(big.Int).SetUint64(number)
+	return s.config.IsMars(num) && s.config.IsNeptune(num)
+}
+
+// leaking checks whether the chain leaks at the given block, that is no finality certificate
+// made it on chain for the configured number of epochs since the leak was activated.
+func (s *Snapshot) leaking(number uint64) bool {
+	if !s.leakActive(number) {
+		return false
+	}
+	leak := s.config.Democracy.InactivityLeak
+	since := s.Certified
+	if block := leak.Block.Uint64(); block > since {
+		since = block
+	}
+	return number-since > leak.Epochs*s.consensusParams().Epoch
+}
+
+// lastCheckpoint returns the latest checkpoint not after the given block, counting the
+// epochs from the checkpoint which announced the active consensus parameters.
+func (s *Snapshot) lastCheckpoint(number uint64) uint64 {
+	if number < s.ParamsNumber {
+		return s.ParamsNumber
+	}
+	return number - (number-s.ParamsNumber)%s.consensusParams().Epoch
+}
+
+// isParticipationCertificate checks whether a certificate carried by the given block only
+// records the validators still attesting while the chain leaks: it's signed by less
+// validators than the attestation threshold, and targets a block of the current epoch.
+func (s *Snapshot) isParticipationCertificate(number uint64, target uint64, signers int) bool {
+	if signers == 0 || signers >= attestationThreshold(s.Len()) {
+		return false
+	}
+	return s.leaking(number) && target >= s.lastCheckpoint(number-1)
+}
+
+// recordParticipation marks the validators signing a certificate as active during the
+// current epoch.
+func (s *Snapshot) recordParticipation(signers []common.Address) {
+	for _, signer := range signers {
+		validator, ok := s.validatorOf(signer)
+		if !ok {
+			continue
+		}
+		if s.Participants == nil {
+			s.Participants = make(map[common.Address]struct{})
+		}
+		s.Participants[validator] = struct{}{}
+	}
+}
+
+// inactiveValidators returns the validators which didn't sign any certificate during the
+// epoch ending at the given checkpoint while the chain leaked, and the ones among them
+// to eject since they stayed inactive for the configured number of leaking epochs.
+func (s *Snapshot) inactiveValidators(number uint64) ([]common.Address, []common.Address) {
+	if !s.isCheckpoint(number) || !s.leaking(number) {
+		return nil, nil
+	}
+	var (
+		leak     = s.config.Democracy.InactivityLeak
+		inactive []common.Address
+		ejected  []common.Address
+	)
+	for _, validator := range s.validators() {
+		if _, ok := s.Participants[validator]; ok {
+			continue
+		}
+		inactive = append(inactive, validator)
+		if leak.EjectAfter > 0 && s.Inactivity[validator]+1 >= leak.EjectAfter {
+			ejected = append(ejected, validator)
+		}
+	}
+	return inactive, ejected
+}
+
+// closeParticipation counts the consecutive leaking epochs every validator was inactive
+// in at the given checkpoint, and starts recording the participation of the next epoch.
+// Validators attesting again, or any validator once the chain stops leaking, start over.
+func (s *Snapshot) closeParticipation(number uint64) {
+	inactive, _ := s.inactiveValidators(number)
+
+	var inactivity map[common.Address]uint64
+	if len(inactive) > 0 {
+		inactivity = make(map[common.Address]uint64, len(inactive))
+		for _, validator := range inactive {
+			inactivity[validator] = s.Inactivity[validator] + 1
+		}
+	}
+	s.Inactivity = inactivity
+	s.Participants = nil
+}
+
+// assembleParticipationCertificate builds a participation certificate for a new header out
+// of the largest group of locally collected attestations targeting a recent block of the
+// current epoch, if the chain leaks. The certificate doesn't justify its target, it records
+// the validators still attesting so that they are spared from the inactivity penalties.
+func (c *Democracy) assembleParticipationCertificate(chain consensus.ChainHeaderReader, header *types.Header, snap *Snapshot) []*types.FinalityCertificate {
+	number := header.Number.Uint64()
+	if !snap.leaking(number) {
+		return nil
+	}
+	reader, ok := chain.(consensus.AttestationReader)
+	if !ok {
+		return nil
+	}
+	parent := chain.GetHeader(header.ParentHash, number-1)
+	if parent == nil {
+		return nil
+	}
+	from := snap.lastCheckpoint(snap.Number)
+	if from <= snap.Certified {
+		from = snap.Certified + 1
+	}
+	if number > maxFinalityCertificateGap && from < number-maxFinalityCertificateGap {
+		from = number - maxFinalityCertificateGap
+	}
+	var best []*types.Attestation
+	for target, i := parent, 0; target != nil && target.Number.Uint64() >= from && i < maxParticipationSearch; i++ {
+		if attestations, err := reader.GetHistoryAttestations(target.Number, target.Hash()); err == nil {
+			for _, group := range groupAttestations(attestations, snap) {
+				if len(group) <= len(best) || group[0].TargetRangeEdge.Hash != target.Hash() {
+					continue
+				}
+				source := group[0].SourceRangeEdge
+				if source.Number.Uint64() >= target.Number.Uint64() {
+					continue
+				}
+				if source.Number.Uint64() > 0 {
+					if sourceHeader := ancestor(chain, target, source.Number.Uint64(), nil); sourceHeader == nil || sourceHeader.Hash() != source.Hash {
+						continue
+					}
+				}
+				best = group
+			}
+		}
+		target = chain.GetHeader(target.ParentHash, target.Number.Uint64()-1)
+	}
+	if len(best) == 0 {
+		return nil
+	}
+	cert, err := types.NewFinalityCertificate(best)
+	if err != nil {
+		log.Warn("Failed to assemble participation certificate", "number", number, "err", err)
+		return nil
+	}
+	return []*types.FinalityCertificate{cert}
+}
+
+// punishInactiveValidators punishes the validators which didn't attest during the epoch
+// ending at the checkpoint being finalized while the chain leaked, and ejects the ones
+// which stayed inactive for too long. The snapshot is the one of the checkpoint's parent.
+func (c *Democracy) punishInactiveValidators(ctx *systemcontract.CallContext, snap *Snapshot) error {
+	number := ctx.Header.Number.Uint64()
+	inactive, ejected := snap.inactiveValidators(number)
+	for _, validator := range inactive {
+		if err := systemcontract.InactivityPunish(ctx, validator); err != nil {
+			return err
+		}
+	}
+	for _, validator := range ejected {
+		if err := systemcontract.EjectValidator(ctx, validator); err != nil {
+			return err
+		}
+	}
+	if len(inactive) > 0 {
+		log.Info("Punished inactive validators", "number", number, "certified", snap.Certified,
+			"inactive", len(inactive), "ejected", len(ejected))
+	}
+	return nil
+}
@@ -0,0 +1,325 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package democracy
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/core"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/ethdb"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+	"github.com/QEasyWeb3/QEasyChain/rpc"
+)
+
+const (
+	performanceSectionSize = 4096                       // Number of blocks aggregated into a single indexed section
+	performanceConfirms    = 256                        // Number of confirmations before a section is indexed
+	performanceThrottling  = 100 * time.Millisecond     // Time to wait between indexing two consecutive sections
+	performanceScanLimit   = 4 * performanceSectionSize // Max number of unindexed blocks scanned by a single query
+)
+
+// ValidatorPerformance counts the duties a validator was assigned and fulfilled over a
+// range of blocks.
+type ValidatorPerformance struct {
+	InturnSlots        uint64 `json:"inturnSlots"`        // Blocks the validator was in-turn for
+	BlocksSealed       uint64 `json:"blocksSealed"`       // Blocks sealed by the validator, in-turn or not
+	OutOfTurnBlocks    uint64 `json:"outOfTurnBlocks"`    // Blocks sealed by the validator while out-of-turn
+	MissedSlots        uint64 `json:"missedSlots"`        // In-turn blocks sealed by another validator
+	LazyPunishes       uint64 `json:"lazyPunishes"`       // Missed slots the validator was lazy-punished for
+	Attestations       uint64 `json:"attestations"`       // Certified blocks the validator attested to (after Mars)
+	DoubleSignPunishes uint64 `json:"doubleSignPunishes"` // Double sign punishments against the validator
+}
+
+func (p *ValidatorPerformance) add(other *ValidatorPerformance) {
+	p.InturnSlots += other.InturnSlots
+	p.BlocksSealed += other.BlocksSealed
+	p.OutOfTurnBlocks += other.OutOfTurnBlocks
+	p.MissedSlots += other.MissedSlots
+	p.LazyPunishes += other.LazyPunishes
+	p.Attestations += other.Attestations
+	p.DoubleSignPunishes += other.DoubleSignPunishes
+}
+
+// performanceSet is the performance of every validator active over a range of blocks.
+type performanceSet map[common.Address]*ValidatorPerformance
+
+func (s performanceSet) get(validator common.Address) *ValidatorPerformance {
+	p, ok := s[validator]
+	if !ok {
+		p = new(ValidatorPerformance)
+		s[validator] = p
+	}
+	return p
+}
+
+func (s performanceSet) merge(other performanceSet) {
+	for validator, p := range other {
+		s.get(validator).add(p)
+	}
+}
+
+// performanceEntry is the RLP representation of a single validator of a performanceSet.
+type performanceEntry struct {
+	Validator   common.Address
+	Performance ValidatorPerformance
+}
+
+func encodePerformanceSet(s performanceSet) ([]byte, error) {
+	entries := make([]performanceEntry, 0, len(s))
+	for validator, p := range s {
+		entries = append(entries, performanceEntry{Validator: validator, Performance: *p})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].Validator[:], entries[j].Validator[:]) < 0
+	})
+	return rlp.EncodeToBytes(entries)
+}
+
+func decodePerformanceSet(data []byte) (performanceSet, error) {
+	var entries []performanceEntry
+	if err := rlp.DecodeBytes(data, &entries); err != nil {
+		return nil, err
+	}
+	s := make(performanceSet, len(entries))
+	for i := range entries {
+		s[entries[i].Validator] = &entries[i].Performance
+	}
+	return s, nil
+}
+
+// collectPerformance accounts the duties of the given block into the performance set.
+//
+// Attestations are credited to their signers from the finality certificates carried by
+// the header after the Mars hard-fork, so that every node agrees on them.
+func (c *Democracy) collectPerformance(chain consensus.ChainHeaderReader, header *types.Header, perf performanceSet) error {
+	number := header.Number.Uint64()
+	if number == 0 {
+		return nil
+	}
+	snap, err := c.snapshot(chain, number-1, header.ParentHash, nil)
+	if err != nil {
+		return err
+	}
+	inturn, lazy := snap.lazyValidator(number)
+	perf.get(inturn).InturnSlots++
+
+	sealed := perf.get(header.Coinbase)
+	sealed.BlocksSealed++
+	if header.Difficulty.Cmp(diffInTurn) != 0 {
+		sealed.OutOfTurnBlocks++
+		missed := perf.get(inturn)
+		missed.MissedSlots++
+		if lazy {
+			missed.LazyPunishes++
+		}
+	}
+	if err := c.collectAttestations(chain, header, perf); err != nil {
+		return err
+	}
+	reader, ok := chain.(consensus.ChainReader)
+	if !ok {
+		return nil
+	}
+	txs, err := c.executedPunishTxs(reader, header)
+	if err != nil {
+		return err
+	}
+	for _, tx := range txs {
+		if defendant, err := doubleSignDefendant(tx.Data()); err == nil {
+			perf.get(defendant).DoubleSignPunishes++
+		}
+	}
+	return nil
+}
+
+// collectAttestations credits the validators attesting to the targets of the finality
+// certificates carried by the header. Before the Mars hard-fork attestations only live in
+// the local caches of each node and aren't counted, so that every node agrees on them.
+func (c *Democracy) collectAttestations(chain consensus.ChainHeaderReader, header *types.Header, perf performanceSet) error {
+	if !c.chainConfig.IsMars(header.Number) {
+		return nil
+	}
+	certs, err := decodeFinalityCertificates(c.chainConfig, header)
+	if err != nil {
+		return err
+	}
+	for _, cert := range certs {
+		target := cert.TargetRangeEdge
+		signers, err := cert.RecoverSigners()
+		if err != nil {
+			return err
+		}
+		targetSnap, err := c.snapshot(chain, target.Number.Uint64(), target.Hash, nil)
+		if err != nil {
+			return err
+		}
+		credited := make(map[common.Address]struct{})
+		for _, signer := range signers {
+			if validator, ok := targetSnap.validatorOf(signer); ok {
+				credited[validator] = struct{}{}
+			}
+		}
+		for validator := range credited {
+			perf.get(validator).Attestations++
+		}
+	}
+	return nil
+}
+
+// doubleSignDefendant returns the validator punished by the payload of a double sign
+// punishment transaction.
+func doubleSignDefendant(data []byte) (common.Address, error) {
+	if isDoubleSealPunishData(data) {
+		e, err := decodeDoubleSealPunish(data)
+		if err != nil {
+			return common.Address{}, err
+		}
+		if e.HeaderA == nil {
+			return common.Address{}, errInvalidDoubleSealEvidence
+		}
+		return e.HeaderA.Coinbase, nil
+	}
+	if isAggregateVotePunishData(data) {
+		e, err := decodeAggregateVotePunish(data)
+		if err != nil {
+			return common.Address{}, err
+		}
+		return e.Defendant, nil
+	}
+	p := new(types.ViolateCasperFFGPunish)
+	if err := rlp.DecodeBytes(data, p); err != nil {
+		return common.Address{}, err
+	}
+	return p.Defendant, nil
+}
+
+// performanceIndexer implements core.ChainIndexerBackend, aggregating the validator
+// performance of the canonical chain into sections of performanceSectionSize blocks.
+type performanceIndexer struct {
+	db      ethdb.Database              // Database to store the aggregated sections into
+	chain   consensus.ChainHeaderReader // Chain to read blocks and attestations from
+	engine  *Democracy                  // Engine resolving the validators of the blocks
+	section uint64                      // Section being processed currently
+	head    common.Hash                 // Hash of the last header processed
+	perf    performanceSet              // Performance of the section being processed
+}
+
+// NewPerformanceIndexer returns a chain indexer that aggregates the validator performance
+// of the canonical chain, so that GetValidatorPerformance stays cheap over large ranges.
+func NewPerformanceIndexer(db ethdb.Database, chain consensus.ChainHeaderReader, engine *Democracy) *core.ChainIndexer {
+	backend := &performanceIndexer{
+		db:     db,
+		chain:  chain,
+		engine: engine,
+	}
+	table := rawdb.NewTable(db, string(rawdb.ValidatorPerformanceIndexPrefix))
+
+	return core.NewChainIndexer(db, table, backend, performanceSectionSize, performanceConfirms, performanceThrottling, "validatorperf")
+}
+
+// Reset implements core.ChainIndexerBackend, starting a new section.
+func (b *performanceIndexer) Reset(ctx context.Context, section uint64, lastSectionHead common.Hash) error {
+	b.section, b.head, b.perf = section, common.Hash{}, make(performanceSet)
+	return nil
+}
+
+// Process implements core.ChainIndexerBackend, accounting a new header into the section.
+func (b *performanceIndexer) Process(ctx context.Context, header *types.Header) error {
+	b.head = header.Hash()
+	return b.engine.collectPerformance(b.chain, header, b.perf)
+}
+
+// Commit implements core.ChainIndexerBackend, storing the aggregated section.
+func (b *performanceIndexer) Commit() error {
+	data, err := encodePerformanceSet(b.perf)
+	if err != nil {
+		return err
+	}
+	batch := b.db.NewBatch()
+	rawdb.WriteValidatorPerformance(batch, b.section, b.head, data)
+	return batch.Write()
+}
+
+// Prune returns an empty error since we don't support pruning here.
+func (b *performanceIndexer) Prune(threshold uint64) error {
+	return nil
+}
+
+// GetValidatorPerformance returns the performance of every validator active between the
+// given blocks (inclusive). Sections aggregated by the performance indexer are read from
+// the database, the remaining blocks are scanned.
+func (api *API) GetValidatorPerformance(from, to rpc.BlockNumber) (map[common.Address]*ValidatorPerformance, error) {
+	start, end, err := api.blockRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		db      = api.democracy.db
+		perf    = make(performanceSet)
+		scanned = 0
+	)
+	for number := start; number <= end; {
+		// Use the indexed section if the range covers it entirely (block 0 carries no duties)
+		section := number / performanceSectionSize
+		if last := (section+1)*performanceSectionSize - 1; (number%performanceSectionSize == 0 || number == 1) && last <= end {
+			if data := rawdb.ReadValidatorPerformance(db, section, rawdb.ReadCanonicalHash(db, last)); data != nil {
+				indexed, err := decodePerformanceSet(data)
+				if err != nil {
+					return nil, err
+				}
+				perf.merge(indexed)
+				number = last + 1
+				continue
+			}
+		}
+		if scanned++; scanned > performanceScanLimit {
+			return nil, fmt.Errorf("range requires scanning more than %d unindexed blocks", performanceScanLimit)
+		}
+		header := api.chain.GetHeaderByNumber(number)
+		if header == nil {
+			return nil, fmt.Errorf("missing block %d", number)
+		}
+		if err := api.democracy.collectPerformance(api.chain, header, perf); err != nil {
+			return nil, err
+		}
+		number++
+	}
+	return perf, nil
+}
+
+// blockRange resolves the given block numbers to canonical blocks, and checks that they
+// delimit a range of known blocks.
+func (api *API) blockRange(from, to rpc.BlockNumber) (uint64, uint64, error) {
+	first, last := api.headerByNumber(from), api.headerByNumber(to)
+	if first == nil || last == nil {
+		return 0, 0, errUnknownBlock
+	}
+	start, end := first.Number.Uint64(), last.Number.Uint64()
+	if start > end {
+		return 0, 0, errors.New("invalid block range")
+	}
+	return start, end, nil
+}
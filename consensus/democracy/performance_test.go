@@ -0,0 +1,166 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package democracy
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/params"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+	"github.com/QEasyWeb3/QEasyChain/rpc"
+)
+
+// newTestPerformanceChain creates a chain of two validators with the Mars hard-fork at
+// block 5. The first validator seals blocks 1 to 4, taking the in-turn slot of the second
+// one at block 4, which seals the remaining blocks. Block 5 certifies block 3 with the
+// attestations of both validators.
+func newTestPerformanceChain(t *testing.T, length int) (*testFinalityChain, []*ecdsa.PrivateKey, []common.Address) {
+	keys, validators := newTestValidators(t, 2)
+	chain := &testFinalityChain{
+		config:       &params.ChainConfig{MarsBlock: big.NewInt(5), Democracy: &params.DemocracyConfig{Period: 3, Epoch: 200}},
+		status:       make(map[common.Hash]uint8),
+		attestations: make(map[common.Hash][]*types.Attestation),
+	}
+	genesis := &types.Header{Number: big.NewInt(0), Difficulty: diffInTurn,
+		Extra: append(append(make([]byte, extraVanity), append(validators[0].Bytes(), validators[1].Bytes()...)...), make([]byte, extraSeal)...)}
+	chain.headers = append(chain.headers, genesis)
+
+	for i := 1; i < length; i++ {
+		signer, difficulty := 1, diffInTurn
+		if i < 4 {
+			signer = 0
+		} else if i == 4 {
+			signer, difficulty = 0, diffNoTurn
+		}
+		var extra []byte
+		if chain.config.IsMars(big.NewInt(int64(i))) {
+			certs := []*types.FinalityCertificate{}
+			if i == 5 {
+				cert, err := types.NewFinalityCertificate(signTestAttestations(t, keys, genesis, chain.headers[3]))
+				if err != nil {
+					t.Fatalf("failed to create certificate: %v", err)
+				}
+				certs = append(certs, cert)
+			}
+			var err error
+			if extra, err = rlp.EncodeToBytes(certs); err != nil {
+				t.Fatalf("failed to encode certificates: %v", err)
+			}
+		}
+		header := &types.Header{Number: big.NewInt(int64(i)), ParentHash: chain.headers[i-1].Hash(),
+			Coinbase: validators[signer], Difficulty: difficulty, Time: uint64(i) * 3,
+			Extra: append(append(make([]byte, extraVanity), extra...), make([]byte, extraSeal)...)}
+		chain.headers = append(chain.headers, sealTestHeader(t, header, keys[signer]))
+	}
+	return chain, keys, validators
+}
+
+// Tests that the duties of the validators are counted from the chain, crediting only the
+// attestations certified on chain after the Mars hard-fork.
+func TestGetValidatorPerformance(t *testing.T) {
+	chain, keys, validators := newTestPerformanceChain(t, 7)
+	api := &API{chain: chain, democracy: New(chain.config, rawdb.NewMemoryDatabase())}
+
+	// Attestations collected locally before Mars aren't agreed on by every node
+	chain.attestations[chain.headers[2].Hash()] = signTestAttestations(t, keys, chain.headers[1], chain.headers[2])
+
+	perf, err := api.GetValidatorPerformance(rpc.EarliestBlockNumber, rpc.LatestBlockNumber)
+	if err != nil {
+		t.Fatalf("failed to retrieve performance: %v", err)
+	}
+	want := map[common.Address]ValidatorPerformance{
+		validators[0]: {InturnSlots: 3, BlocksSealed: 4, OutOfTurnBlocks: 1, Attestations: 1},
+		validators[1]: {InturnSlots: 3, BlocksSealed: 2, MissedSlots: 1, LazyPunishes: 1, Attestations: 1},
+	}
+	if len(perf) != len(want) {
+		t.Fatalf("validator count mismatch: have %d, want %d", len(perf), len(want))
+	}
+	for validator, p := range want {
+		if have := perf[validator]; have == nil || *have != p {
+			t.Errorf("validator %x performance mismatch: have %+v, want %+v", validator, have, p)
+		}
+	}
+	// Block tags are resolved against the canonical chain
+	chain.finalized = 3
+	if perf, err = api.GetValidatorPerformance(1, rpc.FinalizedBlockNumber); err != nil {
+		t.Fatalf("failed to retrieve finalized performance: %v", err)
+	}
+	if have := perf[validators[0]]; have == nil || have.BlocksSealed != 3 || have.Attestations != 0 {
+		t.Errorf("finalized performance mismatch: have %+v", have)
+	}
+	if _, err := api.GetValidatorPerformance(1, 100); err != errUnknownBlock {
+		t.Errorf("unknown block error mismatch: have %v, want %v", err, errUnknownBlock)
+	}
+	if _, err := api.GetValidatorPerformance(rpc.LatestBlockNumber, 1); err == nil {
+		t.Errorf("inverted range accepted")
+	}
+}
+
+// Tests that the sections aggregated by the performance indexer are the sum of the blocks
+// they cover, and are only served for the canonical section head.
+func TestPerformanceIndexer(t *testing.T) {
+	chain, _, validators := newTestPerformanceChain(t, 7)
+	db := rawdb.NewMemoryDatabase()
+	engine := New(chain.config, db)
+	api := &API{chain: chain, democracy: engine}
+
+	backend := &performanceIndexer{db: db, chain: chain, engine: engine}
+	if err := backend.Reset(context.Background(), 0, common.Hash{}); err != nil {
+		t.Fatalf("failed to reset section: %v", err)
+	}
+	for _, header := range chain.headers[1:] {
+		if err := backend.Process(context.Background(), header); err != nil {
+			t.Fatalf("failed to process block %d: %v", header.Number, err)
+		}
+	}
+	if err := backend.Commit(); err != nil {
+		t.Fatalf("failed to commit section: %v", err)
+	}
+	head := chain.CurrentHeader().Hash()
+	indexed, err := decodePerformanceSet(rawdb.ReadValidatorPerformance(db, 0, head))
+	if err != nil {
+		t.Fatalf("failed to decode section: %v", err)
+	}
+	scanned, err := api.GetValidatorPerformance(1, rpc.LatestBlockNumber)
+	if err != nil {
+		t.Fatalf("failed to scan performance: %v", err)
+	}
+	for _, validator := range validators {
+		if *indexed[validator] != *scanned[validator] {
+			t.Errorf("validator %x section mismatch: have %+v, want %+v", validator, indexed[validator], scanned[validator])
+		}
+	}
+	// Queries covering a whole section read it instead of scanning its blocks
+	// (the unsealed headers appended to the chain couldn't be scanned)
+	extendTestChain(chain, performanceSectionSize-1)
+	rawdb.WriteCanonicalHash(db, head, performanceSectionSize-1)
+	perf, err := api.GetValidatorPerformance(1, performanceSectionSize-1)
+	if err != nil {
+		t.Fatalf("failed to retrieve indexed performance: %v", err)
+	}
+	for _, validator := range validators {
+		if *perf[validator] != *indexed[validator] {
+			t.Errorf("validator %x indexed performance mismatch: have %+v, want %+v", validator, perf[validator], indexed[validator])
+		}
+	}
+}
@@ -0,0 +1,443 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package democracy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/core"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/ethdb"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+	"github.com/QEasyWeb3/QEasyChain/rpc"
+)
+
+const (
+	punishmentSectionSize = 1024                      // Number of blocks whose punishments are indexed into a single section
+	punishmentConfirms    = 64                        // Number of confirmations before a section is indexed
+	punishmentThrottling  = 100 * time.Millisecond    // Time to wait between indexing two consecutive sections
+	punishmentScanLimit   = 4 * punishmentSectionSize // Max number of unindexed blocks scanned by a single query
+)
+
+// Types of the punishments recorded by the punishment history.
+const (
+	LazyPunishment       = "lazy"       // Validator missing its in-turn slot, punished through lazyPunish
+	DoubleSignPunishment = "doubleSign" // Attestations breaking the CasperFFG rules, punished by a system transaction
+	DoubleSealPunishment = "doubleSeal" // Two headers sealed at the same height, punished by a system transaction
+	InactivityPunishment = "inactivity" // Validator not attesting while finality stalls, punished through inactivityPunish
+	EjectionPunishment   = "ejection"   // Validator inactive for too long while finality stalls, ejected through ejectValidator
+)
+
+// Punishment is a punishment of a validator executed by a block, along with its evidence.
+type Punishment struct {
+	Type        string         `json:"type"`
+	Validator   common.Address `json:"validator"`
+	BlockNumber uint64         `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	TxHash      *common.Hash   `json:"txHash,omitempty"` // System transaction executing the punishment, if any
+	Evidence    interface{}    `json:"evidence"`
+}
+
+// PendingPunishment is a violation detected by the local node, waiting to be punished
+// by a block it seals.
+type PendingPunishment struct {
+	Type      string         `json:"type"`
+	Validator common.Address `json:"validator"`
+	Evidence  interface{}    `json:"evidence"`
+}
+
+// LazyPunishEvidence is the evidence of a lazy punishment: the block of the slot was
+// sealed out-of-turn.
+type LazyPunishEvidence struct {
+	Sealer common.Address `json:"sealer"` // Validator sealing the slot instead
+}
+
+// InactivityPunishEvidence is the evidence of an inactivity punishment or ejection: no
+// certificate signed by the validator made it on chain during the epoch, while the
+// chain was leaking.
+type InactivityPunishEvidence struct {
+	Certified      uint64 `json:"certified"`      // Highest block certified on chain
+	InactiveEpochs uint64 `json:"inactiveEpochs"` // Consecutive leaking epochs the validator was inactive in
+}
+
+// DoubleSignPunishEvidence is the evidence of a double sign punishment: two attestations
+// of the validator breaking the CasperFFG rules.
+type DoubleSignPunishEvidence struct {
+	Rule   string             `json:"rule"` // Rule broken, "multiSig" or "inclusive"
+	Before *types.Attestation `json:"before"`
+	After  *types.Attestation `json:"after"`
+}
+
+// AggregateVotePunishEvidence is the evidence of a double sign punishment of BLS votes: two
+// aggregate attestations signed by the validator breaking the CasperFFG rules.
+type AggregateVotePunishEvidence struct {
+	Rule   string                      `json:"rule"` // Rule broken, "multiSig" or "inclusive"
+	Before *types.AggregateAttestation `json:"before"`
+	After  *types.AggregateAttestation `json:"after"`
+}
+
+// DoubleSealPunishEvidence is the evidence of a double seal punishment: two different
+// headers sealed by the validator at the same height.
+type DoubleSealPunishEvidence struct {
+	HeaderA *types.Header `json:"headerA"`
+	HeaderB *types.Header `json:"headerB"`
+}
+
+// punishmentEntry is a punishment as stored by the punishment history index.
+type punishmentEntry struct {
+	Type      string
+	Validator common.Address
+	Number    uint64
+	Hash      common.Hash
+	TxHash    common.Hash // Zero if the punishment isn't executed by a system transaction
+	Evidence  []byte      // RLP encoded evidence, or payload of the punishing system transaction
+}
+
+// punishment decodes the stored punishment along with its evidence.
+func (e *punishmentEntry) punishment() (*Punishment, error) {
+	p := &Punishment{
+		Type:        e.Type,
+		Validator:   e.Validator,
+		BlockNumber: e.Number,
+		BlockHash:   e.Hash,
+	}
+	if e.TxHash != (common.Hash{}) {
+		txHash := e.TxHash
+		p.TxHash = &txHash
+	}
+	var err error
+	switch e.Type {
+	case LazyPunishment:
+		evidence := new(LazyPunishEvidence)
+		err = rlp.DecodeBytes(e.Evidence, evidence)
+		p.Evidence = evidence
+	case InactivityPunishment, EjectionPunishment:
+		evidence := new(InactivityPunishEvidence)
+		err = rlp.DecodeBytes(e.Evidence, evidence)
+		p.Evidence = evidence
+	case DoubleSignPunishment, DoubleSealPunishment:
+		p.Evidence, err = doubleSignEvidence(e.Evidence)
+	default:
+		err = fmt.Errorf("unknown punishment type %q", e.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// doubleSignEvidence decodes the evidence carried by the payload of a double sign
+// punishment transaction.
+func doubleSignEvidence(data []byte) (interface{}, error) {
+	if isDoubleSealPunishData(data) {
+		e, err := decodeDoubleSealPunish(data)
+		if err != nil {
+			return nil, err
+		}
+		return &DoubleSealPunishEvidence{HeaderA: e.HeaderA, HeaderB: e.HeaderB}, nil
+	}
+	if isAggregateVotePunishData(data) {
+		e, err := decodeAggregateVotePunish(data)
+		if err != nil {
+			return nil, err
+		}
+		return aggregateVoteEvidence(e), nil
+	}
+	p := new(types.ViolateCasperFFGPunish)
+	if err := rlp.DecodeBytes(data, p); err != nil {
+		return nil, err
+	}
+	return casperFFGEvidence(p), nil
+}
+
+func casperFFGEvidence(p *types.ViolateCasperFFGPunish) *DoubleSignPunishEvidence {
+	evidence := &DoubleSignPunishEvidence{Before: p.Before, After: p.After}
+	if p.PunishType != nil {
+		switch p.PunishType.Uint64() {
+		case types.PunishMultiSig:
+			evidence.Rule = "multiSig"
+		case types.PunishInclusive:
+			evidence.Rule = "inclusive"
+		}
+	}
+	return evidence
+}
+
+func aggregateVoteEvidence(e *types.AggregateVoteEvidence) *AggregateVotePunishEvidence {
+	evidence := &AggregateVotePunishEvidence{Before: e.Before, After: e.After}
+	if e.Before != nil && e.After != nil && e.Before.TargetRangeEdge != nil && e.After.TargetRangeEdge != nil {
+		if e.Before.TargetRangeEdge.Number.Cmp(e.After.TargetRangeEdge.Number) == 0 {
+			evidence.Rule = "multiSig"
+		} else {
+			evidence.Rule = "inclusive"
+		}
+	}
+	return evidence
+}
+
+// collectPunishments returns the punishments executed by the given block. The lazy and
+// inactivity punishments are contract calls replayed from the snapshot of the parent
+// block, the double sign ones are system transactions of the block.
+func (c *Democracy) collectPunishments(chain consensus.ChainHeaderReader, header *types.Header) ([]*punishmentEntry, error) {
+	number := header.Number.Uint64()
+	if number == 0 {
+		return nil, nil
+	}
+	snap, err := c.snapshot(chain, number-1, header.ParentHash, nil)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		hash    = header.Hash()
+		entries []*punishmentEntry
+	)
+	if header.Difficulty.Cmp(diffInTurn) != 0 {
+		if inturn, lazy := snap.lazyValidator(number); lazy {
+			evidence, err := rlp.EncodeToBytes(&LazyPunishEvidence{Sealer: header.Coinbase})
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, &punishmentEntry{Type: LazyPunishment, Validator: inturn, Number: number, Hash: hash, Evidence: evidence})
+		}
+	}
+	inactive, ejected := snap.inactiveValidators(number)
+	for _, list := range []struct {
+		kind       string
+		validators []common.Address
+	}{{InactivityPunishment, inactive}, {EjectionPunishment, ejected}} {
+		for _, validator := range list.validators {
+			evidence, err := rlp.EncodeToBytes(&InactivityPunishEvidence{Certified: snap.Certified, InactiveEpochs: snap.Inactivity[validator] + 1})
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, &punishmentEntry{Type: list.kind, Validator: validator, Number: number, Hash: hash, Evidence: evidence})
+		}
+	}
+	reader, ok := chain.(consensus.ChainReader)
+	if !ok {
+		return entries, nil
+	}
+	txs, err := c.executedPunishTxs(reader, header)
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range txs {
+		defendant, err := doubleSignDefendant(tx.Data())
+		if err != nil {
+			continue
+		}
+		kind := DoubleSignPunishment
+		if isDoubleSealPunishData(tx.Data()) {
+			kind = DoubleSealPunishment
+		}
+		entries = append(entries, &punishmentEntry{Type: kind, Validator: defendant, Number: number, Hash: hash, TxHash: tx.Hash(), Evidence: tx.Data()})
+	}
+	return entries, nil
+}
+
+// executedPunishTxs returns the double sign punishment transactions of the given block
+// which were executed successfully. The reverted ones, punishing an evidence twice for
+// example, didn't punish anyone.
+func (c *Democracy) executedPunishTxs(chain consensus.ChainReader, header *types.Header) ([]*types.Transaction, error) {
+	number := header.Number.Uint64()
+	block := chain.GetBlock(header.Hash(), number)
+	if block == nil {
+		return nil, fmt.Errorf("missing block %d", number)
+	}
+	var (
+		receipts types.Receipts
+		txs      []*types.Transaction
+	)
+	for i, tx := range block.Transactions() {
+		if to := tx.To(); to == nil || *to != doubleSignIdentity {
+			continue
+		}
+		if receipts == nil {
+			if receipts = rawdb.ReadRawReceipts(c.db, block.Hash(), number); len(receipts) != len(block.Transactions()) {
+				return nil, fmt.Errorf("missing receipts of block %d", number)
+			}
+		}
+		if receipts[i].Status == types.ReceiptStatusSuccessful {
+			txs = append(txs, tx)
+		}
+	}
+	return txs, nil
+}
+
+// punishmentIndexer implements core.ChainIndexerBackend, collecting the punishments
+// executed by the canonical chain into sections of punishmentSectionSize blocks.
+type punishmentIndexer struct {
+	db      ethdb.Database              // Database to store the collected sections into
+	chain   consensus.ChainHeaderReader // Chain to read blocks from
+	engine  *Democracy                  // Engine resolving the punishments of the blocks
+	section uint64                      // Section being processed currently
+	head    common.Hash                 // Hash of the last header processed
+	entries []*punishmentEntry          // Punishments of the section being processed
+}
+
+// NewPunishmentIndexer returns a chain indexer that collects the punishments executed by
+// the canonical chain while blocks are imported, backing GetPunishmentHistory.
+func NewPunishmentIndexer(db ethdb.Database, chain consensus.ChainHeaderReader, engine *Democracy) *core.ChainIndexer {
+	backend := &punishmentIndexer{
+		db:     db,
+		chain:  chain,
+		engine: engine,
+	}
+	table := rawdb.NewTable(db, string(rawdb.PunishmentHistoryIndexPrefix))
+
+	return core.NewChainIndexer(db, table, backend, punishmentSectionSize, punishmentConfirms, punishmentThrottling, "punishments")
+}
+
+// Reset implements core.ChainIndexerBackend, starting a new section.
+func (b *punishmentIndexer) Reset(ctx context.Context, section uint64, lastSectionHead common.Hash) error {
+	b.section, b.head, b.entries = section, common.Hash{}, nil
+	return nil
+}
+
+// Process implements core.ChainIndexerBackend, collecting the punishments of a new header.
+func (b *punishmentIndexer) Process(ctx context.Context, header *types.Header) error {
+	b.head = header.Hash()
+	entries, err := b.engine.collectPunishments(b.chain, header)
+	if err != nil {
+		return err
+	}
+	b.entries = append(b.entries, entries...)
+	return nil
+}
+
+// Commit implements core.ChainIndexerBackend, storing the collected section.
+func (b *punishmentIndexer) Commit() error {
+	data, err := rlp.EncodeToBytes(b.entries)
+	if err != nil {
+		return err
+	}
+	batch := b.db.NewBatch()
+	rawdb.WritePunishmentHistory(batch, b.section, b.head, data)
+	return batch.Write()
+}
+
+// Prune returns an empty error since we don't support pruning here.
+func (b *punishmentIndexer) Prune(threshold uint64) error {
+	return nil
+}
+
+// GetPendingPunishments returns the violations detected by the local node which weren't
+// punished by the chain yet.
+func (api *API) GetPendingPunishments() ([]*PendingPunishment, error) {
+	var (
+		db      = api.democracy.db
+		head    = api.chain.CurrentHeader()
+		pending = make([]*PendingPunishment, 0)
+	)
+	for _, p := range rawdb.ReadAllViolateCasperFFGPunish(db) {
+		signer, err := p.RecoverSigner()
+		if err != nil {
+			continue
+		}
+		validator, err := api.democracy.ValidatorOfKey(api.chain, signer, head.Hash(), head.Number.Uint64())
+		if err != nil {
+			continue
+		}
+		pending = append(pending, &PendingPunishment{
+			Type:      DoubleSignPunishment,
+			Validator: validator,
+			Evidence:  casperFFGEvidence(p),
+		})
+	}
+	for _, e := range rawdb.ReadAllAggregateVoteEvidence(db) {
+		pending = append(pending, &PendingPunishment{
+			Type:      DoubleSignPunishment,
+			Validator: e.Defendant,
+			Evidence:  aggregateVoteEvidence(e),
+		})
+	}
+	for _, e := range rawdb.ReadAllDoubleSealEvidence(db) {
+		if e.HeaderA == nil {
+			continue
+		}
+		pending = append(pending, &PendingPunishment{
+			Type:      DoubleSealPunishment,
+			Validator: e.HeaderA.Coinbase,
+			Evidence:  &DoubleSealPunishEvidence{HeaderA: e.HeaderA, HeaderB: e.HeaderB},
+		})
+	}
+	return pending, nil
+}
+
+// GetPunishmentHistory returns the punishments of the given validator executed by the
+// canonical chain between the given blocks (inclusive). Sections collected by the
+// punishment indexer are read from the database, the remaining blocks are scanned.
+func (api *API) GetPunishmentHistory(validator common.Address, from, to rpc.BlockNumber) ([]*Punishment, error) {
+	start, end, err := api.blockRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		db      = api.democracy.db
+		history = make([]*Punishment, 0)
+		scanned = 0
+	)
+	add := func(entries []*punishmentEntry) error {
+		for _, entry := range entries {
+			if entry.Validator != validator || entry.Number < start || entry.Number > end {
+				continue
+			}
+			p, err := entry.punishment()
+			if err != nil {
+				return err
+			}
+			history = append(history, p)
+		}
+		return nil
+	}
+	for number := start; number <= end; {
+		section := number / punishmentSectionSize
+		last := (section+1)*punishmentSectionSize - 1
+		if data := rawdb.ReadPunishmentHistory(db, section, rawdb.ReadCanonicalHash(db, last)); data != nil {
+			var entries []*punishmentEntry
+			if err := rlp.DecodeBytes(data, &entries); err != nil {
+				return nil, err
+			}
+			if err := add(entries); err != nil {
+				return nil, err
+			}
+			number = last + 1
+			continue
+		}
+		if scanned++; scanned > punishmentScanLimit {
+			return nil, fmt.Errorf("range requires scanning more than %d unindexed blocks", punishmentScanLimit)
+		}
+		header := api.chain.GetHeaderByNumber(number)
+		if header == nil {
+			return nil, fmt.Errorf("missing block %d", number)
+		}
+		entries, err := api.democracy.collectPunishments(api.chain, header)
+		if err != nil {
+			return nil, err
+		}
+		if err := add(entries); err != nil {
+			return nil, err
+		}
+		number++
+	}
+	return history, nil
+}
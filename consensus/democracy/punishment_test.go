@@ -0,0 +1,167 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package democracy
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/ethdb"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+	"github.com/QEasyWeb3/QEasyChain/rpc"
+)
+
+// testBlockChain extends a test chain with the bodies of its blocks.
+type testBlockChain struct {
+	*testFinalityChain
+	blocks map[common.Hash]*types.Block
+}
+
+func (c *testBlockChain) GetBlock(hash common.Hash, number uint64) *types.Block {
+	return c.blocks[hash]
+}
+
+// newTestPunishmentChain creates the chain of newTestPerformanceChain, whose block 4 lazy
+// punishes the second validator, and whose block 6 carries a transfer, a double sign
+// punishment of the first validator, and a reverted one of the second validator. The
+// receipts of the block are stored into the given database.
+func newTestPunishmentChain(t *testing.T, db ethdb.Database) (*testBlockChain, []common.Address, []*types.Transaction) {
+	chain, keys, validators := newTestPerformanceChain(t, 7)
+	bc := &testBlockChain{testFinalityChain: chain, blocks: make(map[common.Hash]*types.Block)}
+
+	punish := func(nonce uint64, index int) *types.Transaction {
+		before := signTestAttestations(t, keys[index:index+1], chain.headers[2], chain.headers[3])[0]
+		after := signTestAttestations(t, keys[index:index+1], chain.headers[1], chain.headers[3])[0]
+		data, err := rlp.EncodeToBytes(&types.ViolateCasperFFGPunish{PunishType: big.NewInt(types.PunishMultiSig), Before: before, After: after,
+			BlockNum: big.NewInt(5), Defendant: validators[index]})
+		if err != nil {
+			t.Fatalf("failed to encode punishment: %v", err)
+		}
+		return types.NewTransaction(nonce, doubleSignIdentity, common.Big0, 100000, common.Big0, data)
+	}
+	txs := []*types.Transaction{
+		types.NewTransaction(0, common.Address{0x1}, common.Big1, 21000, common.Big0, nil),
+		punish(1, 0),
+		punish(2, 1),
+	}
+	receipts := types.Receipts{
+		{Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{}},
+		{Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{}},
+		{Status: types.ReceiptStatusFailed, Logs: []*types.Log{}},
+	}
+	for _, header := range chain.headers {
+		block := types.NewBlockWithHeader(header)
+		if header.Number.Uint64() == 6 {
+			block = block.WithBody(txs, nil)
+			rawdb.WriteReceipts(db, header.Hash(), 6, receipts)
+		}
+		bc.blocks[header.Hash()] = block
+	}
+	return bc, validators, txs
+}
+
+// Tests that the punishments of a block are collected from the lazy validator of its slot
+// and from the punishment transactions it executed successfully.
+func TestCollectPunishments(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	chain, validators, txs := newTestPunishmentChain(t, db)
+	engine := New(chain.config, db)
+
+	entries, err := engine.collectPunishments(chain, chain.headers[4])
+	if err != nil {
+		t.Fatalf("failed to collect punishments: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Type != LazyPunishment || entries[0].Validator != validators[1] {
+		t.Fatalf("lazy punishments mismatch: have %+v", entries)
+	}
+	p, err := entries[0].punishment()
+	if err != nil {
+		t.Fatalf("failed to decode punishment: %v", err)
+	}
+	if evidence, ok := p.Evidence.(*LazyPunishEvidence); !ok || evidence.Sealer != validators[0] {
+		t.Fatalf("lazy evidence mismatch: have %+v", p.Evidence)
+	}
+	// The reverted punishment isn't recorded
+	entries, err = engine.collectPunishments(chain, chain.headers[6])
+	if err != nil {
+		t.Fatalf("failed to collect punishments: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("double sign punishments mismatch: have %d, want 1", len(entries))
+	}
+	if e := entries[0]; e.Type != DoubleSignPunishment || e.Validator != validators[0] || e.TxHash != txs[1].Hash() || e.Number != 6 {
+		t.Fatalf("double sign punishment mismatch: have %+v", e)
+	}
+	// Punishments can't be told apart from reverted ones without the receipts
+	if _, err := New(chain.config, rawdb.NewMemoryDatabase()).collectPunishments(chain, chain.headers[6]); err == nil {
+		t.Fatalf("punishments collected without receipts")
+	}
+}
+
+// Tests that the punishment history is served from the sections collected by the indexer
+// once the chain is indexed, and scanned from the blocks otherwise.
+func TestGetPunishmentHistory(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	chain, validators, txs := newTestPunishmentChain(t, db)
+	engine := New(chain.config, db)
+	api := &API{chain: chain, democracy: engine}
+
+	history, err := api.GetPunishmentHistory(validators[0], rpc.EarliestBlockNumber, rpc.LatestBlockNumber)
+	if err != nil {
+		t.Fatalf("failed to retrieve history: %v", err)
+	}
+	if len(history) != 1 || history[0].Type != DoubleSignPunishment || history[0].TxHash == nil || *history[0].TxHash != txs[1].Hash() {
+		t.Fatalf("first validator history mismatch: have %+v", history)
+	}
+	if history, err = api.GetPunishmentHistory(validators[1], rpc.EarliestBlockNumber, rpc.LatestBlockNumber); err != nil {
+		t.Fatalf("failed to retrieve history: %v", err)
+	}
+	if len(history) != 1 || history[0].Type != LazyPunishment || history[0].BlockNumber != 4 {
+		t.Fatalf("second validator history mismatch: have %+v", history)
+	}
+	// Index the chain as the single section, whose head is canonical
+	backend := &punishmentIndexer{db: db, chain: chain, engine: engine}
+	if err := backend.Reset(context.Background(), 0, common.Hash{}); err != nil {
+		t.Fatalf("failed to reset section: %v", err)
+	}
+	for _, header := range chain.headers[1:] {
+		if err := backend.Process(context.Background(), header); err != nil {
+			t.Fatalf("failed to process block %d: %v", header.Number, err)
+		}
+	}
+	if err := backend.Commit(); err != nil {
+		t.Fatalf("failed to commit section: %v", err)
+	}
+	head := chain.CurrentHeader().Hash()
+	rawdb.WriteCanonicalHash(db, head, punishmentSectionSize-1)
+
+	// The indexed section is served without the blocks
+	chain.blocks = make(map[common.Hash]*types.Block)
+	if history, err = api.GetPunishmentHistory(validators[0], rpc.EarliestBlockNumber, rpc.LatestBlockNumber); err != nil {
+		t.Fatalf("failed to retrieve indexed history: %v", err)
+	}
+	if len(history) != 1 || history[0].Type != DoubleSignPunishment || history[0].BlockNumber != 6 {
+		t.Fatalf("indexed history mismatch: have %+v", history)
+	}
+	if history, err = api.GetPunishmentHistory(validators[0], 1, 5); err != nil || len(history) != 0 {
+		t.Fatalf("indexed history out of range: have %+v (%v)", history, err)
+	}
+}
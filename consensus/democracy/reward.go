@@ -0,0 +1,114 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package democracy
+
+import (
+	"math/big"
+
+	"github.com/QEasyWeb3/QEasyChain/common/hexutil"
+	"github.com/QEasyWeb3/QEasyChain/params"
+	"github.com/QEasyWeb3/QEasyChain/rpc"
+)
+
+var big100 = big.NewInt(100)
+
+// blockReward returns the reward credited to the validators on top of the fees of the
+// given block: the legacy BlockReward, or the one following the reward schedule of the
+// chain config from its activation block on.
+func (c *Democracy) blockReward(number *big.Int) *big.Int {
+	schedule := c.config.RewardSchedule
+	if schedule == nil || schedule.Block == nil || number.Cmp(schedule.Block) < 0 {
+		return new(big.Int).Set(BlockReward)
+	}
+	blocks := new(big.Int).Sub(number, schedule.Block).Uint64() + 1
+
+	reward := emittedRewards(schedule, blocks)
+	return reward.Sub(reward, emittedRewards(schedule, blocks-1))
+}
+
+// emittedRewards returns the total reward emitted by the given number of blocks from
+// the activation of the schedule on.
+func emittedRewards(schedule *params.RewardSchedule, blocks uint64) *big.Int {
+	total, reward := new(big.Int), new(big.Int)
+	if schedule.Initial != nil {
+		reward.Set(schedule.Initial)
+	}
+	if schedule.Interval == 0 || schedule.Decay == 0 {
+		total.Mul(reward, new(big.Int).SetUint64(blocks))
+	} else {
+		decay := schedule.Decay
+		if decay > 100 {
+			decay = 100
+		}
+		// The reward decreases with integer divisions, so it reaches zero eventually
+		keep := new(big.Int).SetUint64(100 - decay)
+		for blocks > 0 && reward.Sign() > 0 {
+			n := schedule.Interval
+			if n > blocks {
+				n = blocks
+			}
+			total.Add(total, new(big.Int).Mul(reward, new(big.Int).SetUint64(n)))
+			blocks -= n
+
+			reward.Mul(reward, keep)
+			reward.Div(reward, big100)
+		}
+	}
+	if schedule.Cap != nil && total.Cmp(schedule.Cap) > 0 {
+		total.Set(schedule.Cap)
+	}
+	return total
+}
+
+// RewardSchedule is the block reward emission at a given block.
+type RewardSchedule struct {
+	Schedule *params.RewardSchedule `json:"schedule"` // Reward schedule of the chain config, nil if the legacy reward is used
+	Number   hexutil.Uint64         `json:"number"`   // Block number the reward is computed for
+	Active   bool                   `json:"active"`   // Whether the reward schedule is active at the block
+	Reward   *hexutil.Big           `json:"reward"`   // Reward of the block in wei
+	Emitted  *hexutil.Big           `json:"emitted"`  // Total reward emitted by the schedule up to the block (included)
+}
+
+// GetRewardSchedule retrieves the block reward schedule, and the reward it emits at the
+// given block.
+func (api *API) GetRewardSchedule(number *rpc.BlockNumber) (*RewardSchedule, error) {
+	// Resolve the tags like the other methods, the reward of future blocks can be computed too
+	tag := rpc.LatestBlockNumber
+	if number != nil {
+		tag = *number
+	}
+	n := big.NewInt(tag.Int64())
+	if tag < 0 {
+		header := api.headerByNumber(tag)
+		if header == nil {
+			return nil, errUnknownBlock
+		}
+		n.Set(header.Number)
+	}
+	schedule := api.democracy.config.RewardSchedule
+	result := &RewardSchedule{
+		Schedule: schedule,
+		Number:   hexutil.Uint64(n.Uint64()),
+		Reward:   (*hexutil.Big)(api.democracy.blockReward(n)),
+		Emitted:  new(hexutil.Big),
+	}
+	if schedule != nil && schedule.Block != nil && n.Cmp(schedule.Block) >= 0 {
+		result.Active = true
+		result.Emitted = (*hexutil.Big)(emittedRewards(schedule, new(big.Int).Sub(n, schedule.Block).Uint64()+1))
+	}
+	return result, nil
+}
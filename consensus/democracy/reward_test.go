@@ -0,0 +1,146 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package democracy
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/params"
+	"github.com/QEasyWeb3/QEasyChain/rpc"
+)
+
+// Tests that the block reward follows the legacy reward before the activation of the
+// schedule, then decays every interval and stops once the cap is emitted.
+func TestBlockReward(t *testing.T) {
+	tests := []struct {
+		schedule *params.RewardSchedule
+		rewards  map[int64]int64
+	}{
+		// Halving every 3 blocks from block 10 on, until the integer divisions reach zero
+		{
+			&params.RewardSchedule{Block: big.NewInt(10), Initial: big.NewInt(1000), Interval: 3, Decay: 50},
+			map[int64]int64{10: 1000, 12: 1000, 13: 500, 16: 250, 22: 62, 37: 1, 40: 0, 1000: 0},
+		},
+		// The block emitting the cap is credited the remainder only
+		{
+			&params.RewardSchedule{Block: big.NewInt(10), Initial: big.NewInt(1000), Interval: 3, Decay: 50, Cap: big.NewInt(3700)},
+			map[int64]int64{12: 1000, 13: 500, 14: 200, 15: 0, 100: 0},
+		},
+		// Without decay the reward is constant up to the cap
+		{
+			&params.RewardSchedule{Block: big.NewInt(0), Initial: big.NewInt(7), Interval: 3, Cap: big.NewInt(20)},
+			map[int64]int64{0: 7, 1: 7, 2: 6, 3: 0},
+		},
+		{
+			&params.RewardSchedule{Block: big.NewInt(0), Initial: big.NewInt(7), Decay: 50},
+			map[int64]int64{0: 7, 1000000: 7},
+		},
+		// A full decay stops the reward after the first interval
+		{
+			&params.RewardSchedule{Block: big.NewInt(0), Initial: big.NewInt(7), Interval: 2, Decay: 100},
+			map[int64]int64{1: 7, 2: 0},
+		},
+	}
+	for i, tt := range tests {
+		engine := New(&params.ChainConfig{Democracy: &params.DemocracyConfig{Epoch: 200, RewardSchedule: tt.schedule}}, rawdb.NewMemoryDatabase())
+		if tt.schedule.Block.Sign() > 0 {
+			if reward := engine.blockReward(new(big.Int).Sub(tt.schedule.Block, big.NewInt(1))); reward.Cmp(BlockReward) != 0 {
+				t.Errorf("test %d: legacy reward mismatch: have %v, want %v", i, reward, BlockReward)
+			}
+		}
+		for number, want := range tt.rewards {
+			if reward := engine.blockReward(big.NewInt(number)); reward.Cmp(big.NewInt(want)) != 0 {
+				t.Errorf("test %d: block %d reward mismatch: have %v, want %d", i, number, reward, want)
+			}
+		}
+	}
+}
+
+// Tests that the total reward emitted by a schedule sums the rewards of its blocks.
+func TestEmittedRewards(t *testing.T) {
+	schedule := &params.RewardSchedule{Block: big.NewInt(0), Initial: big.NewInt(1000), Interval: 3, Decay: 50}
+	tests := []struct {
+		blocks  uint64
+		emitted int64
+	}{
+		{0, 0}, {1, 1000}, {3, 3000}, {4, 3500}, {6, 4500}, {7, 4750},
+		// 3 * (1000 + 500 + 250 + 125 + 62 + 31 + 15 + 7 + 3 + 1)
+		{30, 5982}, {1 << 40, 5982},
+	}
+	for _, tt := range tests {
+		if emitted := emittedRewards(schedule, tt.blocks); emitted.Cmp(big.NewInt(tt.emitted)) != 0 {
+			t.Errorf("%d blocks emission mismatch: have %v, want %d", tt.blocks, emitted, tt.emitted)
+		}
+	}
+	capped := *schedule
+	capped.Cap = big.NewInt(4000)
+	if emitted := emittedRewards(&capped, 1<<40); emitted.Cmp(capped.Cap) != 0 {
+		t.Errorf("capped emission mismatch: have %v, want %v", emitted, capped.Cap)
+	}
+}
+
+// Tests that unusable reward schedules are rejected when the chain config is loaded.
+func TestCheckRewardSchedule(t *testing.T) {
+	tests := []struct {
+		schedule *params.RewardSchedule
+		valid    bool
+	}{
+		{&params.RewardSchedule{Block: big.NewInt(0), Initial: big.NewInt(1), Interval: 1, Decay: 100}, true},
+		{&params.RewardSchedule{Block: big.NewInt(0), Initial: new(big.Int)}, true},
+		{&params.RewardSchedule{Block: big.NewInt(0)}, false},
+		{&params.RewardSchedule{Block: big.NewInt(0), Initial: big.NewInt(-1)}, false},
+		{&params.RewardSchedule{Block: big.NewInt(0), Initial: big.NewInt(1), Interval: 1, Decay: 101}, false},
+		{&params.RewardSchedule{Block: big.NewInt(0), Initial: big.NewInt(1), Cap: big.NewInt(-1)}, false},
+	}
+	for i, tt := range tests {
+		config := &params.ChainConfig{Democracy: &params.DemocracyConfig{Epoch: 200, RewardSchedule: tt.schedule}}
+		if err := config.CheckConfigForkOrder(); (err == nil) != tt.valid {
+			t.Errorf("test %d: validity mismatch: have error %v, want valid %v", i, err, tt.valid)
+		}
+	}
+}
+
+// Tests that the reward schedule resolves the block tags like the other API methods.
+func TestGetRewardSchedule(t *testing.T) {
+	chain := newTestFinalityChain(t, 20, 0, 0, nil)
+	chain.safe, chain.finalized = 12, 10
+
+	api := &API{chain: chain, democracy: New(&params.ChainConfig{Democracy: &params.DemocracyConfig{Epoch: 200}}, rawdb.NewMemoryDatabase())}
+	tests := []struct {
+		number rpc.BlockNumber
+		want   uint64
+	}{
+		{rpc.LatestBlockNumber, 19},
+		{rpc.PendingBlockNumber, 19},
+		{rpc.SafeBlockNumber, 12},
+		{rpc.FinalizedBlockNumber, 10},
+		{rpc.BlockNumber(5), 5},
+		{rpc.BlockNumber(100), 100},
+	}
+	for _, tt := range tests {
+		number := tt.number
+		schedule, err := api.GetRewardSchedule(&number)
+		if err != nil {
+			t.Fatalf("block %d: failed to retrieve the reward schedule: %v", tt.number, err)
+		}
+		if uint64(schedule.Number) != tt.want {
+			t.Errorf("block %d: number mismatch: have %d, want %d", tt.number, schedule.Number, tt.want)
+		}
+	}
+}
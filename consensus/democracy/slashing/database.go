@@ -0,0 +1,370 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package slashing implements the slashing-protection database of the Democracy
+// validators, which refuses to sign anything that could get a validator punished:
+// two different headers at the same height, two different votes for the same
+// target, or a vote surrounding (or surrounded by) a former one.
+package slashing
+
+import (
+	"encoding/binary"
+	"errors"
+	"sync"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/ethdb"
+	"github.com/QEasyWeb3/QEasyChain/log"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+)
+
+const (
+	// pruneDistance is the number of blocks behind the latest signed header or vote
+	// after which the records are pruned, and replaced by the low watermarks.
+	pruneDistance = 4096
+)
+
+var (
+	// ErrDoubleSeal is returned if a header conflicts with a different header signed
+	// at the same height.
+	ErrDoubleSeal = errors.New("slashing protection: header conflicts with a signed header at the same height")
+
+	// ErrDoubleVote is returned if a vote conflicts with a different vote signed for
+	// the same target.
+	ErrDoubleVote = errors.New("slashing protection: vote conflicts with a signed vote for the same target")
+
+	// ErrSurroundVote is returned if a vote surrounds, or is surrounded by, a signed vote.
+	ErrSurroundVote = errors.New("slashing protection: vote surrounds or is surrounded by a signed vote")
+
+	// ErrBelowWatermark is returned if a header or a vote is older than the history
+	// kept by the database, so it can't be proven safe anymore.
+	ErrBelowWatermark = errors.New("slashing protection: signing request below the low watermark")
+)
+
+// vote is a (source,target) vote signed by a validator.
+type vote struct {
+	Source      uint64
+	SigningRoot common.Hash
+}
+
+// watermark is the lowest header and vote a validator may still sign. Records below
+// the watermarks are pruned, or were imported from another machine.
+type watermark struct {
+	Block  uint64 // Headers at or below this height are refused
+	Source uint64 // Votes with a source below this height are refused
+	Target uint64 // Votes with a target at or below this height are refused
+}
+
+// Database is the slashing-protection store of the local validators, keyed by the
+// validator address.
+type Database struct {
+	db   ethdb.KeyValueStore
+	lock sync.Mutex // Serializes the check-and-record operations
+}
+
+// New creates a slashing-protection database on top of a key-value store.
+func New(db ethdb.KeyValueStore) *Database {
+	return &Database{db: db}
+}
+
+// CheckAndRecordBlock checks whether a validator may sign a header of the given height
+// and signing root, and records it if so. Signing the very same header again is allowed.
+func (d *Database) CheckAndRecordBlock(val common.Address, number uint64, signingRoot common.Hash) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if root, ok := d.readBlock(val, number); ok {
+		if root != signingRoot {
+			return ErrDoubleSeal
+		}
+		return nil
+	}
+	if number <= d.readWatermark(val).Block {
+		return ErrBelowWatermark
+	}
+	if err := d.writeBlock(val, number, signingRoot); err != nil {
+		return err
+	}
+	return d.pruneBlocks(val, number)
+}
+
+// CheckAndReplaceBlock checks whether a validator may sign a header of the given height
+// and signing root, and records it if so. Unlike CheckAndRecordBlock, a different header
+// replaces the one recorded at the latest signed height, while nothing below it may be
+// signed anymore, as suits the external signers seeing every sealing attempt of a height,
+// of which the node only releases one.
+func (d *Database) CheckAndReplaceBlock(val common.Address, number uint64, signingRoot common.Hash) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	root, ok := d.readBlock(val, number)
+	if ok && root == signingRoot {
+		return nil
+	}
+	if number <= d.readWatermark(val).Block {
+		return ErrBelowWatermark
+	}
+	it := d.db.NewIterator(validatorKey(rawdb.SlashingBlockPrefix, val), encodeNumber(number+1))
+	defer it.Release()
+
+	if it.Next() {
+		if ok {
+			return ErrDoubleSeal
+		}
+		return ErrBelowWatermark
+	}
+	if err := d.writeBlock(val, number, signingRoot); err != nil {
+		return err
+	}
+	return d.pruneBlocks(val, number)
+}
+
+// CheckAndRecordVote checks whether a validator may sign a (source,target) vote of the
+// given signing root, and records it if so. Signing the very same vote again is allowed.
+func (d *Database) CheckAndRecordVote(val common.Address, source, target uint64, signingRoot common.Hash) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if v, ok := d.readVote(val, target); ok {
+		if v.Source != source || v.SigningRoot != signingRoot {
+			return ErrDoubleVote
+		}
+		return nil
+	}
+	if w := d.readWatermark(val); source < w.Source || target <= w.Target {
+		return ErrBelowWatermark
+	}
+	// Only the votes targeting blocks after the source may surround or be surrounded
+	it := d.db.NewIterator(validatorKey(rawdb.SlashingVotePrefix, val), encodeNumber(source+1))
+	defer it.Release()
+
+	for it.Next() {
+		t, v, ok := decodeVote(val, it.Key(), it.Value())
+		if !ok {
+			continue
+		}
+		if (v.Source < source && t > target) || (source < v.Source && t < target) {
+			return ErrSurroundVote
+		}
+	}
+	if err := d.writeVote(val, target, &vote{Source: source, SigningRoot: signingRoot}); err != nil {
+		return err
+	}
+	return d.pruneVotes(val, target)
+}
+
+// pruneBlocks removes the headers signed more than pruneDistance blocks before the
+// given height, raising the low watermark accordingly.
+func (d *Database) pruneBlocks(val common.Address, number uint64) error {
+	if number <= pruneDistance {
+		return nil
+	}
+	limit := number - pruneDistance
+
+	it := d.db.NewIterator(validatorKey(rawdb.SlashingBlockPrefix, val), nil)
+	defer it.Release()
+
+	var pruned []uint64
+	for it.Next() {
+		n, ok := decodeNumber(rawdb.SlashingBlockPrefix, it.Key())
+		if !ok {
+			continue
+		}
+		if n >= limit {
+			break
+		}
+		pruned = append(pruned, n)
+	}
+	if len(pruned) == 0 {
+		return nil
+	}
+	batch := d.db.NewBatch()
+	for _, n := range pruned {
+		batch.Delete(blockKey(val, n))
+	}
+	w := d.readWatermark(val)
+	if last := pruned[len(pruned)-1]; last > w.Block {
+		w.Block = last
+	}
+	if err := writeWatermark(batch, val, w); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// pruneVotes removes the votes targeting blocks more than pruneDistance blocks before
+// the given target, raising the low watermarks accordingly.
+func (d *Database) pruneVotes(val common.Address, target uint64) error {
+	if target <= pruneDistance {
+		return nil
+	}
+	limit := target - pruneDistance
+
+	it := d.db.NewIterator(validatorKey(rawdb.SlashingVotePrefix, val), nil)
+	defer it.Release()
+
+	var (
+		w      = d.readWatermark(val)
+		batch  = d.db.NewBatch()
+		pruned int
+	)
+	for it.Next() {
+		t, v, ok := decodeVote(val, it.Key(), it.Value())
+		if !ok {
+			continue
+		}
+		if t >= limit {
+			break
+		}
+		batch.Delete(voteKey(val, t))
+		pruned++
+		if v.Source > w.Source {
+			w.Source = v.Source
+		}
+		if t > w.Target {
+			w.Target = t
+		}
+	}
+	if pruned == 0 {
+		return nil
+	}
+	if err := writeWatermark(batch, val, w); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// validators retrieves the addresses of all the validators with any record.
+func (d *Database) validators() []common.Address {
+	var (
+		seen = make(map[common.Address]struct{})
+		vals []common.Address
+	)
+	for _, prefix := range [][]byte{rawdb.SlashingBlockPrefix, rawdb.SlashingVotePrefix, rawdb.SlashingWatermarkPrefix} {
+		it := d.db.NewIterator(prefix, nil)
+		for it.Next() {
+			key := it.Key()
+			if len(key) < len(prefix)+common.AddressLength {
+				continue
+			}
+			val := common.BytesToAddress(key[len(prefix) : len(prefix)+common.AddressLength])
+			if _, ok := seen[val]; !ok {
+				seen[val] = struct{}{}
+				vals = append(vals, val)
+			}
+		}
+		it.Release()
+	}
+	return vals
+}
+
+func (d *Database) readBlock(val common.Address, number uint64) (common.Hash, bool) {
+	blob, err := d.db.Get(blockKey(val, number))
+	if err != nil || len(blob) != common.HashLength {
+		return common.Hash{}, false
+	}
+	return common.BytesToHash(blob), true
+}
+
+func (d *Database) writeBlock(val common.Address, number uint64, signingRoot common.Hash) error {
+	return d.db.Put(blockKey(val, number), signingRoot.Bytes())
+}
+
+func (d *Database) readVote(val common.Address, target uint64) (*vote, bool) {
+	blob, err := d.db.Get(voteKey(val, target))
+	if err != nil || len(blob) == 0 {
+		return nil, false
+	}
+	v := new(vote)
+	if err := rlp.DecodeBytes(blob, v); err != nil {
+		log.Error("Invalid slashing protection vote RLP", "validator", val, "target", target, "err", err)
+		return nil, false
+	}
+	return v, true
+}
+
+func (d *Database) writeVote(val common.Address, target uint64, v *vote) error {
+	blob, err := rlp.EncodeToBytes(v)
+	if err != nil {
+		return err
+	}
+	return d.db.Put(voteKey(val, target), blob)
+}
+
+func (d *Database) readWatermark(val common.Address) *watermark {
+	w := new(watermark)
+	blob, err := d.db.Get(validatorKey(rawdb.SlashingWatermarkPrefix, val))
+	if err != nil || len(blob) == 0 {
+		return w
+	}
+	if err := rlp.DecodeBytes(blob, w); err != nil {
+		log.Error("Invalid slashing protection watermark RLP", "validator", val, "err", err)
+	}
+	return w
+}
+
+func writeWatermark(db ethdb.KeyValueWriter, val common.Address, w *watermark) error {
+	blob, err := rlp.EncodeToBytes(w)
+	if err != nil {
+		return err
+	}
+	return db.Put(validatorKey(rawdb.SlashingWatermarkPrefix, val), blob)
+}
+
+// validatorKey = prefix + address
+func validatorKey(prefix []byte, val common.Address) []byte {
+	return append(append([]byte{}, prefix...), val.Bytes()...)
+}
+
+// blockKey = rawdb.SlashingBlockPrefix + address + num (uint64 big endian)
+func blockKey(val common.Address, number uint64) []byte {
+	return append(validatorKey(rawdb.SlashingBlockPrefix, val), encodeNumber(number)...)
+}
+
+// voteKey = rawdb.SlashingVotePrefix + address + target num (uint64 big endian)
+func voteKey(val common.Address, target uint64) []byte {
+	return append(validatorKey(rawdb.SlashingVotePrefix, val), encodeNumber(target)...)
+}
+
+// encodeNumber encodes a block number as big endian uint64
+func encodeNumber(number uint64) []byte {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, number)
+	return enc
+}
+
+// decodeNumber retrieves the block number of a header or vote key of a validator.
+func decodeNumber(prefix []byte, key []byte) (uint64, bool) {
+	if len(key) != len(prefix)+common.AddressLength+8 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(key[len(prefix)+common.AddressLength:]), true
+}
+
+// decodeVote retrieves the target number and the vote stored under a vote key.
+func decodeVote(val common.Address, key []byte, blob []byte) (uint64, *vote, bool) {
+	target, ok := decodeNumber(rawdb.SlashingVotePrefix, key)
+	if !ok {
+		return 0, nil, false
+	}
+	v := new(vote)
+	if err := rlp.DecodeBytes(blob, v); err != nil {
+		log.Error("Invalid slashing protection vote RLP", "validator", val, "target", target, "err", err)
+		return 0, nil, false
+	}
+	return target, v, true
+}
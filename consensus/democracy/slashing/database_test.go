@@ -0,0 +1,188 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package slashing
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+)
+
+func TestCheckAndRecordBlock(t *testing.T) {
+	var (
+		d     = New(rawdb.NewMemoryDatabase())
+		val   = common.HexToAddress("0x01")
+		other = common.HexToAddress("0x02")
+	)
+	if err := d.CheckAndRecordBlock(val, 10, common.HexToHash("0xa")); err != nil {
+		t.Fatalf("failed to sign first header: %v", err)
+	}
+	if err := d.CheckAndRecordBlock(val, 10, common.HexToHash("0xa")); err != nil {
+		t.Fatalf("failed to sign the same header again: %v", err)
+	}
+	if err := d.CheckAndRecordBlock(val, 10, common.HexToHash("0xb")); err != ErrDoubleSeal {
+		t.Fatalf("double seal error mismatch: have %v, want %v", err, ErrDoubleSeal)
+	}
+	if err := d.CheckAndRecordBlock(other, 10, common.HexToHash("0xb")); err != nil {
+		t.Fatalf("failed to sign header of another validator: %v", err)
+	}
+	// Old headers are pruned into the watermark
+	if err := d.CheckAndRecordBlock(val, 10+pruneDistance+1, common.HexToHash("0xc")); err != nil {
+		t.Fatalf("failed to sign later header: %v", err)
+	}
+	if _, ok := d.readBlock(val, 10); ok {
+		t.Fatalf("old header not pruned")
+	}
+	if err := d.CheckAndRecordBlock(val, 10, common.HexToHash("0xa")); err != ErrBelowWatermark {
+		t.Fatalf("watermark error mismatch: have %v, want %v", err, ErrBelowWatermark)
+	}
+}
+
+func TestCheckAndReplaceBlock(t *testing.T) {
+	var (
+		d   = New(rawdb.NewMemoryDatabase())
+		val = common.HexToAddress("0x01")
+	)
+	// Sealing attempts replace each other at the latest height
+	for _, root := range []common.Hash{common.HexToHash("0xa"), common.HexToHash("0xb")} {
+		if err := d.CheckAndReplaceBlock(val, 10, root); err != nil {
+			t.Fatalf("failed to sign sealing attempt %x: %v", root, err)
+		}
+	}
+	if root, _ := d.readBlock(val, 10); root != common.HexToHash("0xb") {
+		t.Fatalf("recorded header mismatch: have %x, want %x", root, common.HexToHash("0xb"))
+	}
+	// Nothing below a later header may be signed anymore
+	if err := d.CheckAndReplaceBlock(val, 11, common.HexToHash("0xc")); err != nil {
+		t.Fatalf("failed to sign later header: %v", err)
+	}
+	if err := d.CheckAndReplaceBlock(val, 10, common.HexToHash("0xb")); err != nil {
+		t.Fatalf("failed to sign the same header again: %v", err)
+	}
+	if err := d.CheckAndReplaceBlock(val, 10, common.HexToHash("0xa")); err != ErrDoubleSeal {
+		t.Fatalf("double seal error mismatch: have %v, want %v", err, ErrDoubleSeal)
+	}
+	if err := d.CheckAndReplaceBlock(val, 9, common.HexToHash("0xa")); err != ErrBelowWatermark {
+		t.Fatalf("watermark error mismatch: have %v, want %v", err, ErrBelowWatermark)
+	}
+}
+
+func TestCheckAndRecordVote(t *testing.T) {
+	var (
+		d   = New(rawdb.NewMemoryDatabase())
+		val = common.HexToAddress("0x01")
+	)
+	tests := []struct {
+		source, target uint64
+		root           common.Hash
+		err            error
+	}{
+		{10, 20, common.HexToHash("0x1"), nil},
+		{10, 20, common.HexToHash("0x1"), nil},             // same vote again
+		{10, 20, common.HexToHash("0x2"), ErrDoubleVote},   // different target block
+		{11, 20, common.HexToHash("0x1"), ErrDoubleVote},   // different source
+		{20, 21, common.HexToHash("0x3"), nil},             // next vote
+		{5, 25, common.HexToHash("0x4"), ErrSurroundVote},  // surrounding a former vote
+		{15, 19, common.HexToHash("0x5"), ErrSurroundVote}, // surrounded by a former vote
+		{20, 23, common.HexToHash("0x6"), nil},             // votes sharing a source are fine
+	}
+	for i, tt := range tests {
+		if err := d.CheckAndRecordVote(val, tt.source, tt.target, tt.root); err != tt.err {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, tt.err)
+		}
+	}
+}
+
+func TestInterchange(t *testing.T) {
+	var (
+		genesis = common.HexToHash("0xfefe")
+		src     = New(rawdb.NewMemoryDatabase())
+		val     = common.HexToAddress("0x01")
+	)
+	src.CheckAndRecordBlock(val, 7, common.HexToHash("0xa"))
+	src.CheckAndRecordVote(val, 10, 20, common.HexToHash("0x1"))
+	src.CheckAndRecordVote(val, 20, 21, common.HexToHash("0x2"))
+
+	blob, err := json.Marshal(src.Export(genesis))
+	if err != nil {
+		t.Fatalf("failed to encode interchange: %v", err)
+	}
+	ic := new(Interchange)
+	if err := json.Unmarshal(blob, ic); err != nil {
+		t.Fatalf("failed to decode interchange: %v", err)
+	}
+	dst := New(rawdb.NewMemoryDatabase())
+	if err := dst.Import(ic, common.HexToHash("0xdead")); err == nil {
+		t.Fatalf("imported interchange of another chain")
+	}
+	if err := dst.Import(ic, genesis); err != nil {
+		t.Fatalf("failed to import interchange: %v", err)
+	}
+	// Imported records may be signed again, but nothing conflicting or older
+	if err := dst.CheckAndRecordVote(val, 20, 21, common.HexToHash("0x2")); err != nil {
+		t.Fatalf("failed to sign an imported vote again: %v", err)
+	}
+	if err := dst.CheckAndRecordBlock(val, 7, common.HexToHash("0xb")); err != ErrDoubleSeal {
+		t.Fatalf("double seal error mismatch: have %v, want %v", err, ErrDoubleSeal)
+	}
+	if err := dst.CheckAndRecordBlock(val, 6, common.HexToHash("0xb")); err != ErrBelowWatermark {
+		t.Fatalf("watermark error mismatch: have %v, want %v", err, ErrBelowWatermark)
+	}
+	if err := dst.CheckAndRecordVote(val, 20, 22, common.HexToHash("0x3")); err != nil {
+		t.Fatalf("failed to sign a later vote: %v", err)
+	}
+	// Decimal numbers are required by the format
+	var out struct {
+		Data []struct {
+			SignedBlocks []map[string]interface{} `json:"signed_blocks"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(blob, &out); err != nil {
+		t.Fatal(err)
+	}
+	if slot := out.Data[0].SignedBlocks[0]["slot"]; slot != "7" {
+		t.Fatalf("slot encoding mismatch: have %v, want %q", slot, "7")
+	}
+}
+
+// Tests that the watermarks of a validator listed several times in a document are
+// raised to the highest records of all its entries, whatever their order.
+func TestImportRepeatedValidator(t *testing.T) {
+	var (
+		genesis = common.HexToHash("0xfefe")
+		val     = common.HexToAddress("0x01")
+		d       = New(rawdb.NewMemoryDatabase())
+	)
+	ic := &Interchange{
+		Metadata: InterchangeMetadata{InterchangeFormatVersion: InterchangeVersion, GenesisValidatorsRoot: genesis},
+		Data: []InterchangeData{
+			{Pubkey: val, SignedBlocks: []SignedBlock{{Slot: 9}}, SignedAttestations: []SignedAttestation{{SourceEpoch: 10, TargetEpoch: 20}}},
+			{Pubkey: val, SignedBlocks: []SignedBlock{{Slot: 5}}, SignedAttestations: []SignedAttestation{{SourceEpoch: 3, TargetEpoch: 4}}},
+		},
+	}
+	if err := d.Import(ic, genesis); err != nil {
+		t.Fatalf("failed to import interchange: %v", err)
+	}
+	if w := d.readWatermark(val); w.Block != 9 || w.Source != 10 || w.Target != 20 {
+		t.Fatalf("watermark mismatch: have %d/%d/%d, want 9/10/20", w.Block, w.Source, w.Target)
+	}
+	if err := d.CheckAndRecordBlock(val, 8, common.HexToHash("0xb")); err != ErrBelowWatermark {
+		t.Fatalf("watermark error mismatch: have %v, want %v", err, ErrBelowWatermark)
+	}
+}
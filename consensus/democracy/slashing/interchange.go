@@ -0,0 +1,214 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package slashing
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/common/math"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+)
+
+// InterchangeVersion is the version of the EIP-3076 interchange format supported.
+const InterchangeVersion = "5"
+
+// Uint64 marshals an uint64 as a decimal string, as required by EIP-3076.
+type Uint64 uint64
+
+// MarshalText implements encoding.TextMarshaler.
+func (n Uint64) MarshalText() ([]byte, error) {
+	return []byte(strconv.FormatUint(uint64(n), 10)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (n *Uint64) UnmarshalText(input []byte) error {
+	v, ok := math.ParseUint64(string(input))
+	if !ok {
+		return fmt.Errorf("invalid number %q", input)
+	}
+	*n = Uint64(v)
+	return nil
+}
+
+// Interchange is the EIP-3076 slashing-protection interchange document. Being a
+// proof-of-stake-authority chain, the fields are mapped as follows:
+//   - genesis_validators_root: the hash of the genesis block
+//   - pubkey:                  the address of the validator
+//   - slot:                    the number of a sealed header
+//   - source/target_epoch:     the source and target block numbers of a vote
+type Interchange struct {
+	Metadata InterchangeMetadata `json:"metadata"`
+	Data     []InterchangeData   `json:"data"`
+}
+
+// InterchangeMetadata is the metadata of an interchange document.
+type InterchangeMetadata struct {
+	InterchangeFormatVersion string      `json:"interchange_format_version"`
+	GenesisValidatorsRoot    common.Hash `json:"genesis_validators_root"`
+}
+
+// InterchangeData is the signing history of a single validator.
+type InterchangeData struct {
+	Pubkey             common.Address      `json:"pubkey"`
+	SignedBlocks       []SignedBlock       `json:"signed_blocks"`
+	SignedAttestations []SignedAttestation `json:"signed_attestations"`
+}
+
+// SignedBlock is a header sealed by a validator.
+type SignedBlock struct {
+	Slot        Uint64       `json:"slot"`
+	SigningRoot *common.Hash `json:"signing_root,omitempty"`
+}
+
+// SignedAttestation is a (source,target) vote signed by a validator.
+type SignedAttestation struct {
+	SourceEpoch Uint64       `json:"source_epoch"`
+	TargetEpoch Uint64       `json:"target_epoch"`
+	SigningRoot *common.Hash `json:"signing_root,omitempty"`
+}
+
+// Export dumps the signing history of all the validators of the database. Records
+// pruned into the low watermarks are exported as a single entry without signing root.
+func (d *Database) Export(genesis common.Hash) *Interchange {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	ic := &Interchange{
+		Metadata: InterchangeMetadata{
+			InterchangeFormatVersion: InterchangeVersion,
+			GenesisValidatorsRoot:    genesis,
+		},
+		Data: []InterchangeData{},
+	}
+	for _, val := range d.validators() {
+		data := InterchangeData{
+			Pubkey:             val,
+			SignedBlocks:       []SignedBlock{},
+			SignedAttestations: []SignedAttestation{},
+		}
+		// The watermarks are only exported if they don't match a record already
+		w := d.readWatermark(val)
+		if _, ok := d.readBlock(val, w.Block); w.Block > 0 && !ok {
+			data.SignedBlocks = append(data.SignedBlocks, SignedBlock{Slot: Uint64(w.Block)})
+		}
+		if _, ok := d.readVote(val, w.Target); w.Target > 0 && !ok {
+			data.SignedAttestations = append(data.SignedAttestations, SignedAttestation{
+				SourceEpoch: Uint64(w.Source),
+				TargetEpoch: Uint64(w.Target),
+			})
+		}
+		it := d.db.NewIterator(validatorKey(rawdb.SlashingBlockPrefix, val), nil)
+		for it.Next() {
+			number, ok := decodeNumber(rawdb.SlashingBlockPrefix, it.Key())
+			if !ok || len(it.Value()) != common.HashLength {
+				continue
+			}
+			root := common.BytesToHash(it.Value())
+			data.SignedBlocks = append(data.SignedBlocks, SignedBlock{Slot: Uint64(number), SigningRoot: &root})
+		}
+		it.Release()
+
+		it = d.db.NewIterator(validatorKey(rawdb.SlashingVotePrefix, val), nil)
+		for it.Next() {
+			target, v, ok := decodeVote(val, it.Key(), it.Value())
+			if !ok {
+				continue
+			}
+			root := v.SigningRoot
+			data.SignedAttestations = append(data.SignedAttestations, SignedAttestation{
+				SourceEpoch: Uint64(v.Source),
+				TargetEpoch: Uint64(target),
+				SigningRoot: &root,
+			})
+		}
+		it.Release()
+
+		ic.Data = append(ic.Data, data)
+	}
+	return ic
+}
+
+// Import merges the signing history of an interchange document into the database.
+// The imported records are stored, and the low watermarks are raised to the highest
+// imported header and vote, so nothing at or below them can be signed anymore.
+func (d *Database) Import(ic *Interchange, genesis common.Hash) error {
+	if ic.Metadata.InterchangeFormatVersion != InterchangeVersion {
+		return fmt.Errorf("unsupported interchange format version %q, want %q", ic.Metadata.InterchangeFormatVersion, InterchangeVersion)
+	}
+	if ic.Metadata.GenesisValidatorsRoot != genesis {
+		return fmt.Errorf("genesis mismatch: have %x, want %x", ic.Metadata.GenesisValidatorsRoot, genesis)
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	// A validator may be listed several times, so the watermarks are raised in memory
+	// across all its entries, and only written once the whole document is merged
+	var (
+		batch      = d.db.NewBatch()
+		watermarks = make(map[common.Address]*watermark)
+		order      []common.Address
+	)
+	for _, data := range ic.Data {
+		val, w := data.Pubkey, watermarks[data.Pubkey]
+		if w == nil {
+			w = d.readWatermark(val)
+			watermarks[val] = w
+			order = append(order, val)
+		}
+		for _, b := range data.SignedBlocks {
+			number := uint64(b.Slot)
+			if _, ok := d.readBlock(val, number); !ok && b.SigningRoot != nil {
+				if err := batch.Put(blockKey(val, number), b.SigningRoot.Bytes()); err != nil {
+					return err
+				}
+			}
+			if number > w.Block {
+				w.Block = number
+			}
+		}
+		for _, a := range data.SignedAttestations {
+			source, target := uint64(a.SourceEpoch), uint64(a.TargetEpoch)
+			if source > target {
+				return fmt.Errorf("invalid attestation of %x: source %d after target %d", val, source, target)
+			}
+			if _, ok := d.readVote(val, target); !ok && a.SigningRoot != nil {
+				blob, err := rlp.EncodeToBytes(&vote{Source: source, SigningRoot: *a.SigningRoot})
+				if err != nil {
+					return err
+				}
+				if err := batch.Put(voteKey(val, target), blob); err != nil {
+					return err
+				}
+			}
+			if source > w.Source {
+				w.Source = source
+			}
+			if target > w.Target {
+				w.Target = target
+			}
+		}
+	}
+	for _, val := range order {
+		if err := writeWatermark(batch, val, watermarks[val]); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
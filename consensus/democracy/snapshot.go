@@ -38,6 +38,19 @@ type Snapshot struct {
 	Hash       common.Hash                 `json:"hash"`       // Block hash where the snapshot was created
 	Validators map[common.Address]struct{} `json:"validators"` // Set of authorized validators at this moment
 	Recents    map[uint64]common.Address   `json:"recents"`    // Set of recent validators for spam protections
+	Certified  uint64                      `json:"certified"`  // Highest block number whose finality certificate is on chain
+	Epoch      uint64                      `json:"epoch"`      // Number of checkpoints passed since the genesis block
+	EpochKnown bool                        `json:"epochKnown"` // Whether Epoch was counted from the genesis block (else validator set changes aren't indexed)
+
+	Params       *systemcontract.ConsensusParams `json:"params,omitempty"`       // Consensus parameters active at this moment (nil = chain config defaults)
+	ParamsNumber uint64                          `json:"paramsNumber,omitempty"` // Checkpoint block which announced the active consensus parameters
+
+	Keys map[common.Address]common.Address `json:"keys,omitempty"` // Consensus keys of the validators which rotated their key (after Saturn)
+
+	Participants map[common.Address]struct{} `json:"participants,omitempty"` // Validators signing a certificate on chain during the current epoch (inactivity leak)
+	Inactivity   map[common.Address]uint64   `json:"inactivity,omitempty"`   // Consecutive leaking epochs each validator was inactive in (inactivity leak)
+
+	changes []*ValidatorSetChange // Validator set changes of the checkpoints passed by the last apply
 }
 
 // newSnapshot creates a new snapshot with the specified startup parameters. This
@@ -51,6 +64,7 @@ func newSnapshot(config *params.ChainConfig, sigcache *lru.ARCCache, number uint
 		Hash:       hash,
 		Validators: make(map[common.Address]struct{}),
 		Recents:    make(map[uint64]common.Address),
+		EpochKnown: number == 0,
 	}
 	for _, validator := range validators {
 		snap.Validators[validator] = struct{}{}
@@ -70,7 +84,6 @@ func loadSnapshot(config *params.ChainConfig, sigcache *lru.ARCCache, db ethdb.D
 	}
 	snap.config = config
 	snap.sigcache = sigcache
-
 	return snap, nil
 }
 
@@ -92,6 +105,15 @@ func (s *Snapshot) copy() *Snapshot {
 		Hash:       s.Hash,
 		Validators: make(map[common.Address]struct{}),
 		Recents:    make(map[uint64]common.Address),
+		Certified:  s.Certified,
+		Epoch:      s.Epoch,
+		EpochKnown: s.EpochKnown,
+
+		ParamsNumber: s.ParamsNumber,
+	}
+	if s.Params != nil {
+		cp := *s.Params
+		cpy.Params = &cp
 	}
 	for validator := range s.Validators {
 		cpy.Validators[validator] = struct{}{}
@@ -99,13 +121,45 @@ func (s *Snapshot) copy() *Snapshot {
 	for block, validator := range s.Recents {
 		cpy.Recents[block] = validator
 	}
+	if s.Keys != nil {
+		cpy.Keys = make(map[common.Address]common.Address, len(s.Keys))
+		for validator, key := range s.Keys {
+			cpy.Keys[validator] = key
+		}
+	}
+	if s.Participants != nil {
+		cpy.Participants = make(map[common.Address]struct{}, len(s.Participants))
+		for validator := range s.Participants {
+			cpy.Participants[validator] = struct{}{}
+		}
+	}
+	if s.Inactivity != nil {
+		cpy.Inactivity = make(map[common.Address]uint64, len(s.Inactivity))
+		for validator, epochs := range s.Inactivity {
+			cpy.Inactivity[validator] = epochs
+		}
+	}
 
 	return cpy
 }
 
+// consensusParams returns the consensus parameters active at this moment.
+func (s *Snapshot) consensusParams() *systemcontract.ConsensusParams {
+	if s.Params != nil {
+		return s.Params
+	}
+	return defaultConsensusParams(s.config.Democracy)
+}
+
+// isCheckpoint checks whether the given block number is a checkpoint, counting the
+// epochs from the checkpoint which announced the active consensus parameters.
+func (s *Snapshot) isCheckpoint(number uint64) bool {
+	return number >= s.ParamsNumber && (number-s.ParamsNumber)%s.consensusParams().Epoch == 0
+}
+
 // SignedRecently checks whether the validator signed block recently
 func (s *Snapshot) SignedRecently(block uint64, validator common.Address) bool {
-	continuousInturn := params.ContinousInturn
+	continuousInturn := s.consensusParams().ContinuousInturn
 	limit := uint64(len(s.Validators)/2+1) * continuousInturn
 	var count uint64
 	for blockNum, recent := range s.Recents {
@@ -138,16 +192,20 @@ func (s *Snapshot) apply(headers []*types.Header, chain consensus.ChainHeaderRea
 	for i, header := range headers {
 		// Remove any votes on checkpoint blocks
 		number := header.Number.Uint64()
-		continuousInturn := params.ContinousInturn
+		continuousInturn := snap.consensusParams().ContinuousInturn
 		if limit := uint64(len(snap.Validators)/2+1) * continuousInturn; number >= limit {
 			// Delete the oldest validator from the recent list to allow it signing again
 			delete(snap.Recents, number-limit)
 		}
-		// Resolve the authorization key and check against validators
-		validator, err := ecrecover(header, s.sigcache)
+		// Resolve the authorization key and check against the active key of the validator
+		signer, err := ecrecover(header, s.sigcache)
 		if err != nil {
 			return nil, err
 		}
+		validator := header.Coinbase
+		if signer != snap.consensusKey(validator) {
+			return nil, errInvalidCoinbase
+		}
 		if _, ok := snap.Validators[validator]; !ok {
 			return nil, errUnauthorizedValidator
 		}
@@ -156,23 +214,53 @@ func (s *Snapshot) apply(headers []*types.Header, chain consensus.ChainHeaderRea
 		}
 		snap.Recents[number] = validator
 
+		// Close the participation of the epoch ending at a checkpoint, the certificates
+		// of the checkpoint header count for the next epoch
+		leakActive := snap.leakActive(number)
+		if leakActive && number > 0 && snap.isCheckpoint(number) {
+			snap.closeParticipation(number)
+		}
+		// Track the highest block certified by the finality certificates on chain
+		certs, err := decodeFinalityCertificates(s.config, header)
+		if err != nil {
+			return nil, err
+		}
+		for _, cert := range certs {
+			target := cert.TargetRangeEdge.Number.Uint64()
+			if leakActive {
+				signers, err := cert.RecoverSigners()
+				if err != nil {
+					return nil, err
+				}
+				participation := snap.isParticipationCertificate(number, target, len(signers))
+				snap.recordParticipation(signers)
+				if participation {
+					continue
+				}
+			}
+			if target > snap.Certified {
+				snap.Certified = target
+			}
+		}
+
 		// Before the first epoch block after Waterdrop hard-fork: update validators at the first block at epoch;
 		// Starting from the first epoch block after Waterdrop hard-fork: use a look-back validator.
 		// Which means: the blocks in range [1, ((waterdropBlock/EpochPeriod)+1)*EpochPeriod ] are using the latest validators set;
 		// the blocks ≥ ((waterdropBlock/EpochPeriod)+1)*EpochPeriod + 1 are using the look-back validators set.
-		if number > 0 && number%s.config.Democracy.Epoch == 0 {
+		if number > 0 && snap.isCheckpoint(number) {
+			epoch := snap.consensusParams().Epoch
 			var checkpointHeader *types.Header
 			// For a large chain insertion, the previous blocks may not have been written to db,
 			// so we need to find it through both previous `headers` and parents
-			if uint64(i) >= s.config.Democracy.Epoch {
-				checkpointHeader = headers[i-int(s.config.Democracy.Epoch)]
+			if uint64(i) >= epoch {
+				checkpointHeader = headers[i-int(epoch)]
 			} else {
 				// i < epoch ==> epoch -i >= 1
-				idxInParents := len(parents) - (int(s.config.Democracy.Epoch) - i)
+				idxInParents := len(parents) - (int(epoch) - i)
 				if idxInParents >= 0 {
 					checkpointHeader = parents[idxInParents]
 				} else {
-					checkpointHeader = chain.GetHeaderByNumber(number - s.config.Democracy.Epoch)
+					checkpointHeader = chain.GetHeaderByNumber(number - epoch)
 					if checkpointHeader == nil {
 						return nil, consensus.ErrUnknownAncestor
 					}
@@ -180,9 +268,9 @@ func (s *Snapshot) apply(headers []*types.Header, chain consensus.ChainHeaderRea
 			}
 
 			// get validators from headers and use that for new validator set
-			validators := make([]common.Address, (len(checkpointHeader.Extra)-extraVanity-extraSeal)/common.AddressLength)
-			for i := 0; i < len(validators); i++ {
-				copy(validators[i][:], checkpointHeader.Extra[extraVanity+i*common.AddressLength:])
+			validators, err := extraValidators(s.config, checkpointHeader)
+			if err != nil {
+				return nil, err
 			}
 
 			newValidators := make(map[common.Address]struct{})
@@ -197,7 +285,37 @@ func (s *Snapshot) apply(headers []*types.Header, chain consensus.ChainHeaderRea
 				delete(snap.Recents, number-limit-uint64(i))
 			}
 
+			snap.Epoch++
+			if snap.EpochKnown {
+				snap.changes = append(snap.changes, newValidatorSetChange(snap.Epoch, number, header.Hash(), snap.Validators, newValidators))
+			}
 			snap.Validators = newValidators
+
+			// Switch to the consensus keys announced by this checkpoint, they are active
+			// for the validators of the next epoch.
+			if s.config.IsSaturn(header.Number) {
+				if snap.Keys, err = extraConsensusKeys(s.config, header); err != nil {
+					return nil, err
+				}
+			}
+
+			// Switch to the consensus parameters announced by this checkpoint, the next
+			// epochs are counted from here on.
+			cp, err := extraConsensusParams(s.config, header)
+			if err != nil {
+				return nil, err
+			}
+			if cp != nil {
+				snap.Params, snap.ParamsNumber = cp, number
+
+				// drop the recent seen blocks falling out of the window of the new parameters
+				limit := uint64(len(snap.Validators)/2+1) * cp.ContinuousInturn
+				for block := range snap.Recents {
+					if block+limit <= number {
+						delete(snap.Recents, block)
+					}
+				}
+			}
 		}
 	}
 
@@ -223,10 +341,25 @@ func (s *Snapshot) inturn(number uint64, validator common.Address) bool {
 	for offset < len(validators) && validators[offset] != validator {
 		offset++
 	}
-	continousInturn := params.ContinousInturn
+	continousInturn := s.consensusParams().ContinuousInturn
 	return (number%(uint64(len(validators))*continousInturn))/continousInturn == uint64(offset)
 }
 
+// lazyValidator returns the validator in-turn for the given block, and whether it's
+// lazy-punished when the block is sealed out-of-turn. Validators which sealed one of the
+// recent blocks are spared.
+func (s *Snapshot) lazyValidator(number uint64) (common.Address, bool) {
+	validators := s.validators()
+	continuousInturn := s.consensusParams().ContinuousInturn
+	inturn := validators[number%(uint64(len(validators))*continuousInturn)/continuousInturn]
+	for _, recent := range s.Recents {
+		if recent == inturn {
+			return inturn, false
+		}
+	}
+	return inturn, true
+}
+
 func (s *Snapshot) IsAuthorized(addr common.Address) bool {
 	_, exist := s.Validators[addr]
 	return exist
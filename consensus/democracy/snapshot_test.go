@@ -39,7 +39,7 @@ func validatorAddress(index int64) common.Address {
 }
 
 func genFields(waterdropFork *big.Int, block uint64) fields {
-	result := fields{config: &params.ChainConfig{WaterdropBlock: waterdropFork}, Number: block,
+	result := fields{config: &params.ChainConfig{Democracy: &params.DemocracyConfig{Epoch: 200}}, Number: block,
 		Validators: make(map[common.Address]struct{}), Recents: make(map[uint64]common.Address)}
 	continuousInturn := params.ContinousInturn
 	for i := int64(0); i < 21; i++ {
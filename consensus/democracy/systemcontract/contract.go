@@ -23,6 +23,16 @@ func (s AddrAscend) Len() int           { return len(s) }
 func (s AddrAscend) Less(i, j int) bool { return bytes.Compare(s[i][:], s[j][:]) < 0 }
 func (s AddrAscend) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
 
+// ConsensusParams is the set of consensus parameters governed by the OnChainDao contract.
+// A zero field means the parameter is not governed and the active value is kept.
+type ConsensusParams struct {
+	Period           uint64 `json:"period"`
+	Epoch            uint64 `json:"epoch"`
+	AttestationDelay uint64 `json:"attestationDelay"`
+	ContinuousInturn uint64 `json:"continuousInturn"`
+	MaxValidators    uint8  `json:"maxValidators"`
+}
+
 type Proposal struct {
 	Id     *big.Int
 	Action *big.Int
@@ -33,9 +43,9 @@ type Proposal struct {
 }
 
 // GetTopValidators return the result of calling method `getTopValidators` in Staking contract
-func GetTopValidators(ctx *CallContext) ([]common.Address, error) {
+func GetTopValidators(ctx *CallContext, maxValidators uint8) ([]common.Address, error) {
 	const method = "getTopValidators"
-	result, err := contractRead(ctx, system.SysContractName, method, system.MaxValidators)
+	result, err := contractRead(ctx, system.SysContractName, method, maxValidators)
 	if err != nil {
 		log.Error("GetTopValidators contractRead failed", "err", err)
 		return []common.Address{}, err
@@ -48,6 +58,50 @@ func GetTopValidators(ctx *CallContext) ([]common.Address, error) {
 	return validators, nil
 }
 
+// GetValidatorBLSPublicKey return the result of calling method `getValidatorBLSPublicKey` in Staking contract,
+// that is the registered BLS public key of a validator together with its proof of possession.
+// Empty results mean the validator hasn't registered a BLS public key
+func GetValidatorBLSPublicKey(ctx *CallContext, validator common.Address) ([]byte, []byte, error) {
+	const method = "getValidatorBLSPublicKey"
+	result, err := contractReadAll(ctx, system.SysContractName, method, validator)
+	if err != nil {
+		log.Error("GetValidatorBLSPublicKey contractRead failed", "validator", validator, "err", err)
+		return nil, nil, err
+	}
+	if len(result) != 2 {
+		return nil, nil, errors.New("GetValidatorBLSPublicKey: invalid result length")
+	}
+	pubkey, ok := result[0].([]byte)
+	if !ok {
+		return nil, nil, errors.New("GetValidatorBLSPublicKey: invalid public key format")
+	}
+	proof, ok := result[1].([]byte)
+	if !ok {
+		return nil, nil, errors.New("GetValidatorBLSPublicKey: invalid proof format")
+	}
+	return pubkey, proof, nil
+}
+
+// GetConsensusKey return the result of calling method `getConsensusKey` in Staking contract,
+// that is the address of the key a validator seals blocks and signs attestations with.
+// A validator that never rotated its key signs with its own address
+func GetConsensusKey(ctx *CallContext, validator common.Address) (common.Address, error) {
+	const method = "getConsensusKey"
+	result, err := contractRead(ctx, system.SysContractName, method, validator)
+	if err != nil {
+		log.Error("GetConsensusKey contractRead failed", "validator", validator, "err", err)
+		return common.Address{}, err
+	}
+	key, ok := result.(common.Address)
+	if !ok {
+		return common.Address{}, errors.New("GetConsensusKey: invalid key format")
+	}
+	if key == (common.Address{}) {
+		return validator, nil
+	}
+	return key, nil
+}
+
 // UpdateActiveValidatorSet return the result of calling method `updateActiveValidatorSet` in Staking contract
 func UpdateActiveValidatorSet(ctx *CallContext, newValidators []common.Address) error {
 	const method = "updateActiveValidatorSet"
@@ -94,6 +148,26 @@ func LazyPunish(ctx *CallContext, validator common.Address) error {
 	return err
 }
 
+// InactivityPunish return the result of calling method `inactivityPunish` in Staking contract
+func InactivityPunish(ctx *CallContext, validator common.Address) error {
+	const method = "inactivityPunish"
+	err := contractWrite(ctx, system.SysContractName, method, validator)
+	if err != nil {
+		log.Error("InactivityPunish failed", "validator", validator, "err", err)
+	}
+	return err
+}
+
+// EjectValidator return the result of calling method `ejectValidator` in Staking contract
+func EjectValidator(ctx *CallContext, validator common.Address) error {
+	const method = "ejectValidator"
+	err := contractWrite(ctx, system.SysContractName, method, validator)
+	if err != nil {
+		log.Error("EjectValidator failed", "validator", validator, "err", err)
+	}
+	return err
+}
+
 // DoubleSignPunish return the result of calling method `doubleSignPunish` in Staking contract
 func DoubleSignPunish(ctx *CallContext, punishHash common.Hash, validator common.Address) error {
 	const method = "doubleSignPunish"
@@ -249,6 +323,25 @@ func LastRulesUpdatedNumber(state consensus.StateReader, height *big.Int, config
 	return value.Big().Uint64()
 }
 
+// GetConsensusParams returns the consensus parameters governed by the OnChainDao contract
+func GetConsensusParams(ctx *CallContext) (*ConsensusParams, error) {
+	contractName := system.OnChainDaoContractName
+	const method = "getConsensusParams"
+	abi := system.ABI(contractName, ctx.GetContractVersion(contractName))
+	result, err := contractReadBytes(ctx, ctx.GetContractAddress(contractName), &abi, method)
+	if err != nil {
+		log.Error("GetConsensusParams contractReadBytes failed", "err", err)
+		return nil, err
+	}
+	// unpack data
+	cp := &ConsensusParams{}
+	if err = abi.UnpackIntoInterface(cp, method, result); err != nil {
+		log.Error("GetConsensusParams UnpackIntoInterface failed", "err", err)
+		return nil, err
+	}
+	return cp, nil
+}
+
 // GetPassedProposalCount returns passed proposal count
 func GetPassedProposalCount(ctx *CallContext) (uint32, error) {
 	const method = "getPassedProposalCount"
@@ -0,0 +1,71 @@
+pragma solidity ^0.8.0;
+
+/**
+ * @title BLSKeys
+ * @dev Code layer installed on the system contract at the Jupiter hard-fork, assembled by
+ * mklayers.go into SysContractBLSCode. It stores the BLS public keys the validators sign
+ * their attestations with, and delegates the other calls to the former code of the system
+ * contract, moved to SysContractBLSPrevious.
+ *
+ * The proof of possession can't be checked by the EVM, it's checked by every node reading
+ * the key.
+ */
+contract BLSKeys {
+    // BLSPublicKeyRegistered is emitted when a validator registers a new BLS public key.
+    event BLSPublicKeyRegistered(address indexed signer);
+
+    address private constant PREVIOUS = 0x000000000000000000000000000000000000F100;
+
+    // keccak256("QEasyChain.SystemContract.blsPublicKeys")
+    bytes32 private constant BLS_PUBLIC_KEYS_SLOT = 0x63e3ad29c6a4aa3ad82a8637f6035b41515c0bdd7f0a4994eef0579807ac4ede;
+
+    /**
+     * @dev Registers the BLS public key (96 bytes) of the sender and its proof of
+     * possession (192 bytes), replacing the former one.
+     */
+    function registerBLSPublicKey(bytes calldata publicKey, bytes calldata proof) external {
+        require(publicKey.length == 96 && proof.length == 192);
+        bytes32[10] storage entry = blsPublicKeys()[msg.sender];
+        entry[0] = bytes32(uint256(1));
+        for (uint i = 0; i < 3; i++) {
+            entry[1 + i] = bytes32(publicKey[32 * i:32 * (i + 1)]);
+        }
+        for (uint i = 0; i < 6; i++) {
+            entry[4 + i] = bytes32(proof[32 * i:32 * (i + 1)]);
+        }
+        emit BLSPublicKeyRegistered(msg.sender);
+    }
+
+    /**
+     * @dev Returns the BLS public key of a validator and its proof of possession, both
+     * empty if the validator never registered a key.
+     */
+    function getValidatorBLSPublicKey(address signer) external view returns (bytes memory publicKey, bytes memory proof) {
+        bytes32[10] storage entry = blsPublicKeys()[signer];
+        if (entry[0] == 0) {
+            return (publicKey, proof);
+        }
+        publicKey = abi.encodePacked(entry[1], entry[2], entry[3]);
+        proof = abi.encodePacked(entry[4], entry[5], entry[6], entry[7], entry[8], entry[9]);
+    }
+
+    fallback() external payable {
+        address previous = PREVIOUS;
+        assembly {
+            calldatacopy(0, 0, calldatasize())
+            let ok := delegatecall(gas(), previous, 0, calldatasize(), 0, 0)
+            returndatacopy(0, 0, returndatasize())
+            if iszero(ok) {
+                revert(0, returndatasize())
+            }
+            return(0, returndatasize())
+        }
+    }
+
+    function blsPublicKeys() private pure returns (mapping(address => bytes32[10]) storage keys) {
+        bytes32 slot = BLS_PUBLIC_KEYS_SLOT;
+        assembly {
+            keys.slot := slot
+        }
+    }
+}
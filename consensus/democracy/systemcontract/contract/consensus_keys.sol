@@ -0,0 +1,80 @@
+pragma solidity ^0.8.0;
+
+/**
+ * @title ConsensusKeys
+ * @dev Code layer installed on the system contract at the Saturn hard-fork, assembled by
+ * mklayers.go into SysContractKeysCode. It stores the consensus keys the validators seal
+ * their blocks with in place of their own address, and delegates the other calls to the
+ * former code of the system contract, moved to SysContractKeysPrevious.
+ *
+ * The nodes skip the keys colliding with another active validator when announcing them on
+ * the next checkpoint, so the layer refuses to rotate to such a key in the first place.
+ */
+contract ConsensusKeys {
+    // ConsensusKeyRotated is emitted when a validator rotates its consensus key.
+    event ConsensusKeyRotated(address indexed signer, address indexed newKey);
+
+    address private constant PREVIOUS = 0x000000000000000000000000000000000000F101;
+
+    // keccak256("QEasyChain.SystemContract.consensusKeys")
+    bytes32 private constant CONSENSUS_KEYS_SLOT = 0x3322478dc18754369a0778f61e47624d7f569e02e8849194895529f99fa36400;
+
+    /**
+     * @dev Returns the consensus key of a validator, zero if the validator never rotated
+     * its key, it signs with its own address then.
+     */
+    function getConsensusKey(address signer) external view returns (address) {
+        return consensusKeys()[signer];
+    }
+
+    /**
+     * @dev Rotates the consensus key of a validator, only callable by the validator itself.
+     * Rotating back to its own address resets the key. The new key can't be the address or
+     * the current key of another active validator.
+     */
+    function rotateConsensusKey(address signer, address newKey) external {
+        require(msg.sender == signer && newKey != address(0));
+        mapping(address => address) storage keys = consensusKeys();
+        if (newKey == signer) {
+            keys[signer] = address(0);
+        } else {
+            address[] memory validators = activeValidators();
+            for (uint i = 0; i < validators.length; i++) {
+                address validator = validators[i];
+                require(validator != newKey);
+                if (validator != signer) {
+                    require(keys[validator] != newKey);
+                }
+            }
+            keys[signer] = newKey;
+        }
+        emit ConsensusKeyRotated(signer, newKey);
+    }
+
+    fallback() external payable {
+        address previous = PREVIOUS;
+        assembly {
+            calldatacopy(0, 0, calldatasize())
+            let ok := delegatecall(gas(), previous, 0, calldatasize(), 0, 0)
+            returndatacopy(0, 0, returndatasize())
+            if iszero(ok) {
+                revert(0, returndatasize())
+            }
+            return(0, returndatasize())
+        }
+    }
+
+    // activeValidators returns the active validators of the former code.
+    function activeValidators() private returns (address[] memory) {
+        (bool ok, bytes memory out) = PREVIOUS.delegatecall(abi.encodeWithSignature("getActiveValidators()"));
+        require(ok);
+        return abi.decode(out, (address[]));
+    }
+
+    function consensusKeys() private pure returns (mapping(address => address) storage keys) {
+        bytes32 slot = CONSENSUS_KEYS_SLOT;
+        assembly {
+            keys.slot := slot
+        }
+    }
+}
@@ -0,0 +1,64 @@
+pragma solidity ^0.8.0;
+
+/**
+ * @title ConsensusParams
+ * @dev Code layer installed on the OnChainDao contract at the Uranus hard-fork, assembled by
+ * mklayers.go into OnChainDaoParamsCode. It stores the consensus parameters governed by the
+ * proposals, and delegates the other calls to the former code of the OnChainDao contract,
+ * moved to OnChainDaoParamsPrevious.
+ */
+contract ConsensusParams {
+    // ConsensusParamsUpdated is emitted when an executed proposal updates the consensus parameters.
+    event ConsensusParamsUpdated(uint64 period, uint64 epoch, uint64 attestationDelay, uint64 continuousInturn, uint8 maxValidators);
+
+    address private constant PREVIOUS = 0x000000000000000000000000000000000000F110;
+
+    // keccak256("QEasyChain.OnChainDao.consensusParams")
+    bytes32 private constant CONSENSUS_PARAMS_SLOT = 0xb5d0baa0beff0e9c1c2c618823737fae6accb4b3aa8e0cd1d4d4343c0e2b01e7;
+
+    /**
+     * @dev Returns the governed consensus parameters. Zero values are not governed, the
+     * consensus engine keeps the active ones.
+     */
+    function getConsensusParams() external view returns (uint64 period, uint64 epoch,
+        uint64 attestationDelay, uint64 continuousInturn, uint8 maxValidators) {
+        uint256[5] storage params = consensusParams();
+        return (uint64(params[0]), uint64(params[1]), uint64(params[2]), uint64(params[3]), uint8(params[4]));
+    }
+
+    /**
+     * @dev Updates the governed consensus parameters, only callable by the contract itself,
+     * that is by an executed proposal sent from it.
+     */
+    function setConsensusParams(uint64 period, uint64 epoch, uint64 attestationDelay,
+        uint64 continuousInturn, uint8 maxValidators) external {
+        require(msg.sender == address(this));
+        uint256[5] storage params = consensusParams();
+        params[0] = period;
+        params[1] = epoch;
+        params[2] = attestationDelay;
+        params[3] = continuousInturn;
+        params[4] = maxValidators;
+        emit ConsensusParamsUpdated(period, epoch, attestationDelay, continuousInturn, maxValidators);
+    }
+
+    fallback() external payable {
+        address previous = PREVIOUS;
+        assembly {
+            calldatacopy(0, 0, calldatasize())
+            let ok := delegatecall(gas(), previous, 0, calldatasize(), 0, 0)
+            returndatacopy(0, 0, returndatasize())
+            if iszero(ok) {
+                revert(0, returndatasize())
+            }
+            return(0, returndatasize())
+        }
+    }
+
+    function consensusParams() private pure returns (uint256[5] storage params) {
+        bytes32 slot = CONSENSUS_PARAMS_SLOT;
+        assembly {
+            params.slot := slot
+        }
+    }
+}
@@ -0,0 +1,108 @@
+pragma solidity ^0.8.0;
+
+/**
+ * @title Inactivity
+ * @dev Code layer installed on the system contract at the Neptune hard-fork, assembled by
+ * mklayers.go into SysContractInactivityCode. It records the penalties of the validators not
+ * attesting while the finality stalls and ejects the ones inactive for too long, and
+ * delegates the other calls to the former code of the system contract, moved to
+ * SysContractInactivityPrevious.
+ */
+contract Inactivity {
+    // InactivityPunished is emitted when a validator is punished for its inactivity.
+    event InactivityPunished(address indexed signer, uint256 penalties);
+    // ValidatorEjected is emitted when a validator is ejected from the top validators.
+    event ValidatorEjected(address indexed signer);
+    // ValidatorRejoined is emitted when an ejected validator asks to be elected again.
+    event ValidatorRejoined(address indexed signer);
+
+    address private constant PREVIOUS = 0x000000000000000000000000000000000000f102;
+
+    // keccak256("QEasyChain.SystemContract.inactivityPenalties")
+    bytes32 private constant INACTIVITY_PENALTIES_SLOT = 0x00b739adcd78abb79014a8128e76414e7c522b32e5778da6150c876abeedc93c;
+    // keccak256("QEasyChain.SystemContract.ejectedValidators")
+    bytes32 private constant EJECTED_VALIDATORS_SLOT = 0xbfd1cae9f8606063cf209448389ca1aeb0f1282836895543a947cc5e8f39f648;
+
+    /**
+     * @dev Punishes an inactive validator, only callable by the consensus engine when
+     * finalizing a checkpoint while the chain leaks.
+     */
+    function inactivityPunish(address signer) external {
+        require(msg.sender == address(0));
+        uint256 penalties = ++inactivityPenalties()[signer];
+        emit InactivityPunished(signer, penalties);
+    }
+
+    /**
+     * @dev Ejects a validator, only callable by the consensus engine. The ejected validator
+     * is left out of the top validators.
+     */
+    function ejectValidator(address signer) external {
+        require(msg.sender == address(0));
+        ejectedValidators()[signer] = block.number;
+        emit ValidatorEjected(signer);
+    }
+
+    /**
+     * @dev Takes a validator back among the top validators from the next epoch, only
+     * callable by the ejected validator itself.
+     */
+    function rejoinValidator(address signer) external {
+        mapping(address => uint256) storage ejected = ejectedValidators();
+        require(msg.sender == signer && ejected[signer] != 0);
+        ejected[signer] = 0;
+        emit ValidatorRejoined(signer);
+    }
+
+    /**
+     * @dev Returns the top validators of the former code, without the ejected ones. It's
+     * not declared as view because it delegates to the former code, it doesn't modify the
+     * state though.
+     */
+    function getTopValidators(uint8 count) external returns (address[] memory validators) {
+        (bool ok, bytes memory out) = PREVIOUS.delegatecall(abi.encodeWithSignature("getTopValidators(uint8)", count));
+        if (!ok) {
+            assembly {
+                revert(add(out, 0x20), mload(out))
+            }
+        }
+        validators = abi.decode(out, (address[]));
+        mapping(address => uint256) storage ejected = ejectedValidators();
+        uint256 n = 0;
+        for (uint i = 0; i < validators.length; i++) {
+            if (ejected[validators[i]] == 0) {
+                validators[n++] = validators[i];
+            }
+        }
+        assembly {
+            mstore(validators, n)
+        }
+    }
+
+    fallback() external payable {
+        address previous = PREVIOUS;
+        assembly {
+            calldatacopy(0, 0, calldatasize())
+            let ok := delegatecall(gas(), previous, 0, calldatasize(), 0, 0)
+            returndatacopy(0, 0, returndatasize())
+            if iszero(ok) {
+                revert(0, returndatasize())
+            }
+            return(0, returndatasize())
+        }
+    }
+
+    function inactivityPenalties() private pure returns (mapping(address => uint256) storage penalties) {
+        bytes32 slot = INACTIVITY_PENALTIES_SLOT;
+        assembly {
+            penalties.slot := slot
+        }
+    }
+
+    function ejectedValidators() private pure returns (mapping(address => uint256) storage ejected) {
+        bytes32 slot = EJECTED_VALIDATORS_SLOT;
+        assembly {
+            ejected.slot := slot
+        }
+    }
+}
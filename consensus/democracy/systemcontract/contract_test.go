@@ -213,7 +213,7 @@ func TestGetTopValidators(t *testing.T) {
 	ctx, err := initCallContext()
 	assert.NoError(t, err, "Init call context error")
 
-	vals, err := GetTopValidators(ctx)
+	vals, err := GetTopValidators(ctx, system.MaxValidators)
 	if assert.NoError(t, err) {
 		assert.Equal(t, GenesisValidators, vals)
 	}
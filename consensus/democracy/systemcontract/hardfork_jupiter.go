@@ -0,0 +1,36 @@
+package systemcontract
+
+import (
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/contracts/system"
+	"github.com/QEasyWeb3/QEasyChain/core"
+	"github.com/QEasyWeb3/QEasyChain/core/state"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/params"
+)
+
+// SysContractBLSCode is the code layer installed on the system contract at the Jupiter hard-fork,
+// delegating the calls it doesn't handle to the former code moved to SysContractBLSPrevious.
+// It's assembled by mklayers.go from contract/bls_keys.sol.
+const SysContractBLSCode = "0x60003560e01c8063f75816e914610059578063f96da6c61461014757503660006000376000600036600073000000000000000000000000000000000000f1005af43d600060003e61004f573d6000fd5b3d6000f35b600080fd5b5034610054576004356004018035606014156100545780608001361061005457602435600401803560c01415610054578060e001361061005457336000527f63e3ad29c6a4aa3ad82a8637f6035b41515c0bdd7f0a4994eef0579807ac4ede602052604060002060018155826020013581600101558260400135816002015582606001358160030155816020013581600401558160400135816005015581606001358160060155816080013581600701558160a0013581600801558160c001358160090155337fef36b5bbb54cd9a002732fc8ce82c8d1d80f99264aadb50ee7ee1741b4501b9260006000a2005b50346100545760043573ffffffffffffffffffffffffffffffffffffffff166000527f63e3ad29c6a4aa3ad82a8637f6035b41515c0bdd7f0a4994eef0579807ac4ede602052604060002080541561020157604060005260c0602052606060405260c060c05280600101546060528060020154608052806003015460a052806004015460e0528060050154610100528060060154610120528060070154610140528060080154610160528060090154610180526101a06000f35b604060005260606020526000604052600060605260806000f3"
+
+// SysContractBLSPrevious is the address the code of the system contract is moved to at the Jupiter hard-fork
+var SysContractBLSPrevious = common.HexToAddress("0x000000000000000000000000000000000000F100")
+
+func JupiterHardFork() []IUpgradeAction {
+	return []IUpgradeAction{
+		&SysContractBLSHardFork{},
+	}
+}
+
+type SysContractBLSHardFork struct {
+}
+
+func (s *SysContractBLSHardFork) GetName() string {
+	return system.SysContractName
+}
+
+func (s *SysContractBLSHardFork) DoUpdate(state *state.StateDB, header *types.Header, chainContext core.ChainContext, config *params.ChainConfig) (err error) {
+	upgradeContractLayer(state, system.SystemContract, SysContractBLSPrevious, SysContractBLSCode)
+	return
+}
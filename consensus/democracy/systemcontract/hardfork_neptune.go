@@ -0,0 +1,36 @@
+package systemcontract
+
+import (
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/contracts/system"
+	"github.com/QEasyWeb3/QEasyChain/core"
+	"github.com/QEasyWeb3/QEasyChain/core/state"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/params"
+)
+
+// SysContractInactivityCode is the code layer installed on the system contract at the Neptune hard-fork,
+// delegating the calls it doesn't handle to the former code moved to SysContractInactivityPrevious.
+// It's assembled by mklayers.go from contract/inactivity.sol.
+const SysContractInactivityCode = "0x60003560e01c80635b794fc61461006f578063767ba88e146100f3578063d1f407861461016f578063c086559e146101f657503660006000376000600036600073000000000000000000000000000000000000f1025af43d600060003e610065573d6000fd5b3d6000f35b600080fd5b503461006a573361006a5760043573ffffffffffffffffffffffffffffffffffffffff16806000527f00b739adcd78abb79014a8128e76414e7c522b32e5778da6150c876abeedc93c602052604060002080546001018091556000527f768cedaba7d3f9adc6aa32662735d83d24828c295e922b4a06dde834bb62caea60206000a2005b503461006a573361006a5760043573ffffffffffffffffffffffffffffffffffffffff16806000527fbfd1cae9f8606063cf209448389ca1aeb0f1282836895543a947cc5e8f39f64860205260406000204390557f345af3bd200100b026720b0d0c0708b16e7de16b8918b6d25083e9ae97c7773860006000a2005b503461006a5760043573ffffffffffffffffffffffffffffffffffffffff168033141561006a57806000527fbfd1cae9f8606063cf209448389ca1aeb0f1282836895543a947cc5e8f39f648602052604060002080541561006a57600090557f2049b8dc081ded02e8b2b8f2be9ec8411e3be1cb62751ee6bdc87e2f00d8bd8f60006000a2005b503660006000376000600036600073000000000000000000000000000000000000f1025af43d600060003e61022a573d6000fd5b6020516000805b82821015610296578160051b604001518360051b6040018181527fbfd1cae9f8606063cf209448389ca1aeb0f1282836895543a947cc5e8f39f6488160200152604090205461028a578160051b6040015260010161028c565b505b9060010190610231565b8060205260051b6040016000f3"
+
+// SysContractInactivityPrevious is the address the code of the system contract is moved to at the Neptune hard-fork
+var SysContractInactivityPrevious = common.HexToAddress("0x000000000000000000000000000000000000F102")
+
+func NeptuneHardFork() []IUpgradeAction {
+	return []IUpgradeAction{
+		&SysContractInactivityHardFork{},
+	}
+}
+
+type SysContractInactivityHardFork struct {
+}
+
+func (s *SysContractInactivityHardFork) GetName() string {
+	return system.SysContractName
+}
+
+func (s *SysContractInactivityHardFork) DoUpdate(state *state.StateDB, header *types.Header, chainContext core.ChainContext, config *params.ChainConfig) (err error) {
+	upgradeContractLayer(state, system.SystemContract, SysContractInactivityPrevious, SysContractInactivityCode)
+	return
+}
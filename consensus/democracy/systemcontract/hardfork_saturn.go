@@ -0,0 +1,37 @@
+package systemcontract
+
+import (
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/contracts/system"
+	"github.com/QEasyWeb3/QEasyChain/core"
+	"github.com/QEasyWeb3/QEasyChain/core/state"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/params"
+)
+
+// SysContractKeysCode is the code layer installed on the system contract at the Saturn hard-fork,
+// delegating the calls it doesn't handle to the former code moved to SysContractKeysPrevious.
+// The rotated key is announced by the next checkpoint, and signs from the epoch it starts on.
+// It's assembled by mklayers.go from contract/consensus_keys.sol.
+const SysContractKeysCode = "0x60003560e01c8063f356b476146100595780637233ac24146100ae57503660006000376000600036600073000000000000000000000000000000000000f1015af43d600060003e61004f573d6000fd5b3d6000f35b600080fd5b50346100545760043573ffffffffffffffffffffffffffffffffffffffff166000527f3322478dc18754369a0778f61e47624d7f569e02e8849194895529f99fa3640060205260406000205460005260206000f35b50346100545760243573ffffffffffffffffffffffffffffffffffffffff1660043573ffffffffffffffffffffffffffffffffffffffff16803314156100545781156100545781811461019a57639de7025860e01b600052600060006004600073000000000000000000000000000000000000f1015af415610054573d600060403e60605160005b81811015610192578060051b608001518085146100545780841461018957806000527f3322478dc18754369a0778f61e47624d7f569e02e8849194895529f99fa364006020526040600020548514610054575b50600101610136565b50508161019d565b60005b816000527f3322478dc18754369a0778f61e47624d7f569e02e8849194895529f99fa364006020526040600020557f18326c13d1ba3a6fa57b221c5d4376d94a2632c168073e635f3e06acd441099260006000a300"
+
+// SysContractKeysPrevious is the address the code of the system contract is moved to at the Saturn hard-fork
+var SysContractKeysPrevious = common.HexToAddress("0x000000000000000000000000000000000000F101")
+
+func SaturnHardFork() []IUpgradeAction {
+	return []IUpgradeAction{
+		&SysContractKeysHardFork{},
+	}
+}
+
+type SysContractKeysHardFork struct {
+}
+
+func (s *SysContractKeysHardFork) GetName() string {
+	return system.SysContractName
+}
+
+func (s *SysContractKeysHardFork) DoUpdate(state *state.StateDB, header *types.Header, chainContext core.ChainContext, config *params.ChainConfig) (err error) {
+	upgradeContractLayer(state, system.SystemContract, SysContractKeysPrevious, SysContractKeysCode)
+	return
+}
@@ -0,0 +1,36 @@
+package systemcontract
+
+import (
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/contracts/system"
+	"github.com/QEasyWeb3/QEasyChain/core"
+	"github.com/QEasyWeb3/QEasyChain/core/state"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/params"
+)
+
+// OnChainDaoParamsCode is the code layer installed on the OnChainDao contract at the Uranus hard-fork,
+// delegating the calls it doesn't handle to the former code moved to OnChainDaoParamsPrevious.
+// It's assembled by mklayers.go from contract/consensus_params.sol.
+const OnChainDaoParamsCode = "0x60003560e01c8063e9880ea714610059578063963724441461011e57503660006000376000600036600073000000000000000000000000000000000000f1105af43d600060003e61004f573d6000fd5b3d6000f35b600080fd5b5034610054577fb5d0baa0beff0e9c1c2c618823737fae6accb4b3aa8e0cd1d4d4343c0e2b01e7546000527fb5d0baa0beff0e9c1c2c618823737fae6accb4b3aa8e0cd1d4d4343c0e2b01e8546020527fb5d0baa0beff0e9c1c2c618823737fae6accb4b3aa8e0cd1d4d4343c0e2b01e9546040527fb5d0baa0beff0e9c1c2c618823737fae6accb4b3aa8e0cd1d4d4343c0e2b01ea546060527fb5d0baa0beff0e9c1c2c618823737fae6accb4b3aa8e0cd1d4d4343c0e2b01eb5460805260a06000f35b50346100545730331415610054576004358060401c61005457806000527fb5d0baa0beff0e9c1c2c618823737fae6accb4b3aa8e0cd1d4d4343c0e2b01e7556024358060401c61005457806020527fb5d0baa0beff0e9c1c2c618823737fae6accb4b3aa8e0cd1d4d4343c0e2b01e8556044358060401c61005457806040527fb5d0baa0beff0e9c1c2c618823737fae6accb4b3aa8e0cd1d4d4343c0e2b01e9556064358060401c61005457806060527fb5d0baa0beff0e9c1c2c618823737fae6accb4b3aa8e0cd1d4d4343c0e2b01ea556084358060081c61005457806080527fb5d0baa0beff0e9c1c2c618823737fae6accb4b3aa8e0cd1d4d4343c0e2b01eb557fffb9900a67cb946e5afc7e50fed9b2dd7289b65b145f17c1cbfbd0a402a3543260a06000a100"
+
+// OnChainDaoParamsPrevious is the address the code of the OnChainDao contract is moved to at the Uranus hard-fork
+var OnChainDaoParamsPrevious = common.HexToAddress("0x000000000000000000000000000000000000F110")
+
+func UranusHardFork() []IUpgradeAction {
+	return []IUpgradeAction{
+		&OnChainDaoParamsHardFork{},
+	}
+}
+
+type OnChainDaoParamsHardFork struct {
+}
+
+func (s *OnChainDaoParamsHardFork) GetName() string {
+	return system.OnChainDaoContractName
+}
+
+func (s *OnChainDaoParamsHardFork) DoUpdate(state *state.StateDB, header *types.Header, chainContext core.ChainContext, config *params.ChainConfig) (err error) {
+	upgradeContractLayer(state, system.OnChainDaoContract, OnChainDaoParamsPrevious, OnChainDaoParamsCode)
+	return
+}
@@ -0,0 +1,348 @@
+//go:build none
+// +build none
+
+/*
+The mklayers tool assembles the code layers installed on the system contracts by the
+hard-forks, and outputs the hex code of the layer of the given hard-fork. Every layer
+implements one of the Solidity sources of the contract directory, and delegates the calls
+it doesn't handle to the former code of the contract.
+
+	go run mklayers.go jupiter
+*/
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/systemcontract"
+	"github.com/QEasyWeb3/QEasyChain/core/vm"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+)
+
+// item is a single instruction of a layer, a jump destination or a push of its offset.
+type item struct {
+	op    vm.OpCode
+	push  []byte
+	label string
+	ref   string
+}
+
+func op(o vm.OpCode) item { return item{op: o} }
+
+func push(b []byte) item {
+	if len(b) == 0 {
+		b = []byte{0}
+	}
+	return item{op: vm.PUSH1 + vm.OpCode(len(b)-1), push: b}
+}
+
+func pushN(v uint64) item   { return push(new(big.Int).SetUint64(v).Bytes()) }
+func push32(b []byte) item  { return item{op: vm.PUSH32, push: common.LeftPadBytes(b, 32)} }
+func lbl(n string) item     { return item{label: n} }
+func ref(n string) item     { return item{ref: n} }
+func sel(sig string) item   { return item{op: vm.PUSH4, push: crypto.Keccak256([]byte(sig))[:4]} }
+func topic(sig string) item { return push32(crypto.Keccak256([]byte(sig))) }
+func ns(name string) []byte { return crypto.Keccak256([]byte(name)) }
+
+func assemble(prog []item) []byte {
+	labels := map[string]int{}
+	pc := 0
+	for _, it := range prog {
+		switch {
+		case it.label != "":
+			labels[it.label] = pc
+			pc++
+		case it.ref != "":
+			pc += 3
+		default:
+			pc += 1 + len(it.push)
+		}
+	}
+	var out []byte
+	for _, it := range prog {
+		switch {
+		case it.label != "":
+			out = append(out, byte(vm.JUMPDEST))
+		case it.ref != "":
+			p, ok := labels[it.ref]
+			if !ok {
+				panic("unknown label " + it.ref)
+			}
+			out = append(out, byte(vm.PUSH2), byte(p>>8), byte(p))
+		default:
+			out = append(out, byte(it.op))
+			out = append(out, it.push...)
+		}
+	}
+	return out
+}
+
+// mapslot replaces the key on top of the stack by the slot of its value in the mapping
+// stored at the given namespace, using the memory from 0 to 0x40.
+func mapslot(n []byte) []item {
+	return []item{pushN(0), op(vm.MSTORE), push32(n), pushN(0x20), op(vm.MSTORE), pushN(0x40), pushN(0), op(vm.SHA3)}
+}
+
+type handler struct{ sig, label string }
+
+// layer dispatches the selectors of the handlers to their label in body, and delegates
+// the other calls to the former code moved to impl.
+func layer(impl common.Address, handlers []handler, body []item) []byte {
+	prog := []item{pushN(0), op(vm.CALLDATALOAD), pushN(0xe0), op(vm.SHR)}
+	for _, h := range handlers {
+		prog = append(prog, op(vm.DUP1), sel(h.sig), op(vm.EQ), ref(h.label), op(vm.JUMPI))
+	}
+	prog = append(prog, op(vm.POP))
+	prog = append(prog, delegate(impl)...)
+	prog = append(prog, lbl("ok"), op(vm.RETURNDATASIZE), pushN(0), op(vm.RETURN),
+		lbl("revert"), pushN(0), op(vm.DUP1), op(vm.REVERT))
+	return assemble(append(prog, body...))
+}
+
+// delegate forwards the calldata to impl, reverting with its output or jumping to ok with
+// the output in memory.
+func delegate(impl common.Address) []item {
+	return []item{op(vm.CALLDATASIZE), pushN(0), pushN(0), op(vm.CALLDATACOPY),
+		pushN(0), pushN(0), op(vm.CALLDATASIZE), pushN(0), push(impl.Bytes()), op(vm.GAS), op(vm.DELEGATECALL),
+		op(vm.RETURNDATASIZE), pushN(0), pushN(0), op(vm.RETURNDATACOPY), ref("ok"), op(vm.JUMPI),
+		op(vm.RETURNDATASIZE), pushN(0), op(vm.REVERT)}
+}
+
+func nonpayable() []item { return []item{op(vm.CALLVALUE), ref("revert"), op(vm.JUMPI)} }
+
+var mask160 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 160), big.NewInt(1)).Bytes()
+
+// addrArg pushes the address argument at the given calldata offset.
+func addrArg(off uint64) []item {
+	return []item{pushN(off), op(vm.CALLDATALOAD), push(mask160), op(vm.AND)}
+}
+
+// jupiter assembles contract/bls_keys.sol.
+func jupiter(impl common.Address) []byte {
+	blsNS := ns("QEasyChain.SystemContract.blsPublicKeys")
+	var body []item
+	// registerBLSPublicKey(bytes publicKey, bytes proof)
+	body = append(body, lbl("register"), op(vm.POP))
+	body = append(body, nonpayable()...)
+	body = append(body, pushN(4), op(vm.CALLDATALOAD), pushN(4), op(vm.ADD),
+		op(vm.DUP1), op(vm.CALLDATALOAD), pushN(0x60), op(vm.EQ), op(vm.ISZERO), ref("revert"), op(vm.JUMPI),
+		op(vm.DUP1), pushN(0x80), op(vm.ADD), op(vm.CALLDATASIZE), op(vm.LT), ref("revert"), op(vm.JUMPI),
+		pushN(0x24), op(vm.CALLDATALOAD), pushN(4), op(vm.ADD),
+		op(vm.DUP1), op(vm.CALLDATALOAD), pushN(0xc0), op(vm.EQ), op(vm.ISZERO), ref("revert"), op(vm.JUMPI),
+		op(vm.DUP1), pushN(0xe0), op(vm.ADD), op(vm.CALLDATASIZE), op(vm.LT), ref("revert"), op(vm.JUMPI),
+		op(vm.CALLER))
+	body = append(body, mapslot(blsNS)...)
+	// [base, proof, publicKey]
+	body = append(body, pushN(1), op(vm.DUP2), op(vm.SSTORE))
+	for i := uint64(0); i < 3; i++ {
+		body = append(body, op(vm.DUP3), pushN(0x20*(i+1)), op(vm.ADD), op(vm.CALLDATALOAD), op(vm.DUP2), pushN(1+i), op(vm.ADD), op(vm.SSTORE))
+	}
+	for i := uint64(0); i < 6; i++ {
+		body = append(body, op(vm.DUP2), pushN(0x20*(i+1)), op(vm.ADD), op(vm.CALLDATALOAD), op(vm.DUP2), pushN(4+i), op(vm.ADD), op(vm.SSTORE))
+	}
+	body = append(body, op(vm.CALLER), topic("BLSPublicKeyRegistered(address)"), pushN(0), pushN(0), op(vm.LOG2), op(vm.STOP))
+
+	// getValidatorBLSPublicKey(address signer)
+	body = append(body, lbl("get"), op(vm.POP))
+	body = append(body, nonpayable()...)
+	body = append(body, addrArg(4)...)
+	body = append(body, mapslot(blsNS)...)
+	body = append(body, op(vm.DUP1), op(vm.SLOAD), op(vm.ISZERO), ref("empty"), op(vm.JUMPI),
+		pushN(0x40), pushN(0), op(vm.MSTORE), pushN(0xc0), pushN(0x20), op(vm.MSTORE),
+		pushN(0x60), pushN(0x40), op(vm.MSTORE), pushN(0xc0), pushN(0xc0), op(vm.MSTORE))
+	offsets := []uint64{0x60, 0x80, 0xa0, 0xe0, 0x100, 0x120, 0x140, 0x160, 0x180}
+	for i, off := range offsets {
+		body = append(body, op(vm.DUP1), pushN(uint64(i+1)), op(vm.ADD), op(vm.SLOAD), pushN(off), op(vm.MSTORE))
+	}
+	body = append(body, pushN(0x1a0), pushN(0), op(vm.RETURN),
+		lbl("empty"), pushN(0x40), pushN(0), op(vm.MSTORE), pushN(0x60), pushN(0x20), op(vm.MSTORE),
+		pushN(0), pushN(0x40), op(vm.MSTORE), pushN(0), pushN(0x60), op(vm.MSTORE), pushN(0x80), pushN(0), op(vm.RETURN))
+	return layer(impl, []handler{
+		{"registerBLSPublicKey(bytes,bytes)", "register"},
+		{"getValidatorBLSPublicKey(address)", "get"},
+	}, body)
+}
+
+// saturn assembles contract/consensus_keys.sol.
+func saturn(impl common.Address) []byte {
+	keyNS := ns("QEasyChain.SystemContract.consensusKeys")
+	var body []item
+	// getConsensusKey(address signer)
+	body = append(body, lbl("get"), op(vm.POP))
+	body = append(body, nonpayable()...)
+	body = append(body, addrArg(4)...)
+	body = append(body, mapslot(keyNS)...)
+	body = append(body, op(vm.SLOAD), pushN(0), op(vm.MSTORE), pushN(0x20), pushN(0), op(vm.RETURN))
+
+	// rotateConsensusKey(address signer, address newKey)
+	body = append(body, lbl("rotate"), op(vm.POP))
+	body = append(body, nonpayable()...)
+	body = append(body, addrArg(0x24)...)
+	body = append(body, addrArg(4)...)
+	// [signer, newKey]
+	body = append(body, op(vm.DUP1), op(vm.CALLER), op(vm.EQ), op(vm.ISZERO), ref("revert"), op(vm.JUMPI),
+		op(vm.DUP2), op(vm.ISZERO), ref("revert"), op(vm.JUMPI),
+		op(vm.DUP2), op(vm.DUP2), op(vm.EQ), ref("reset"), op(vm.JUMPI))
+	// getActiveValidators() of the former code, its output copied from 0x40 on
+	body = append(body, sel("getActiveValidators()"), pushN(0xe0), op(vm.SHL), pushN(0), op(vm.MSTORE),
+		pushN(0), pushN(0), pushN(4), pushN(0), push(impl.Bytes()), op(vm.GAS), op(vm.DELEGATECALL),
+		op(vm.ISZERO), ref("revert"), op(vm.JUMPI),
+		op(vm.RETURNDATASIZE), pushN(0), pushN(0x40), op(vm.RETURNDATACOPY),
+		pushN(0x60), op(vm.MLOAD), pushN(0),
+		// [i, n, signer, newKey]
+		lbl("loop"), op(vm.DUP2), op(vm.DUP2), op(vm.LT), op(vm.ISZERO), ref("checked"), op(vm.JUMPI),
+		op(vm.DUP1), pushN(5), op(vm.SHL), pushN(0x80), op(vm.ADD), op(vm.MLOAD),
+		// [validator, i, n, signer, newKey]
+		op(vm.DUP1), op(vm.DUP6), op(vm.EQ), ref("revert"), op(vm.JUMPI),
+		op(vm.DUP1), op(vm.DUP5), op(vm.EQ), ref("next"), op(vm.JUMPI),
+		op(vm.DUP1))
+	body = append(body, mapslot(keyNS)...)
+	body = append(body, op(vm.SLOAD), op(vm.DUP6), op(vm.EQ), ref("revert"), op(vm.JUMPI),
+		lbl("next"), op(vm.POP), pushN(1), op(vm.ADD), ref("loop"), op(vm.JUMP),
+		lbl("checked"), op(vm.POP), op(vm.POP),
+		op(vm.DUP2), ref("store"), op(vm.JUMP),
+		lbl("reset"), pushN(0),
+		lbl("store"), op(vm.DUP2))
+	// [signer, value, signer, newKey]
+	body = append(body, mapslot(keyNS)...)
+	body = append(body, op(vm.SSTORE),
+		topic("ConsensusKeyRotated(address,address)"), pushN(0), pushN(0), op(vm.LOG3), op(vm.STOP))
+	return layer(impl, []handler{
+		{"getConsensusKey(address)", "get"},
+		{"rotateConsensusKey(address,address)", "rotate"},
+	}, body)
+}
+
+// uranus assembles contract/consensus_params.sol.
+func uranus(impl common.Address) []byte {
+	paramsSlot := new(big.Int).SetBytes(ns("QEasyChain.OnChainDao.consensusParams"))
+	slot := func(i int64) []byte { return new(big.Int).Add(paramsSlot, big.NewInt(i)).Bytes() }
+	var body []item
+	// getConsensusParams()
+	body = append(body, lbl("get"), op(vm.POP))
+	body = append(body, nonpayable()...)
+	for i := int64(0); i < 5; i++ {
+		body = append(body, push32(slot(i)), op(vm.SLOAD), pushN(uint64(0x20*i)), op(vm.MSTORE))
+	}
+	body = append(body, pushN(0xa0), pushN(0), op(vm.RETURN))
+
+	// setConsensusParams(uint64,uint64,uint64,uint64,uint8)
+	body = append(body, lbl("set"), op(vm.POP))
+	body = append(body, nonpayable()...)
+	body = append(body, op(vm.ADDRESS), op(vm.CALLER), op(vm.EQ), op(vm.ISZERO), ref("revert"), op(vm.JUMPI))
+	for i := int64(0); i < 5; i++ {
+		bits := uint64(64)
+		if i == 4 {
+			bits = 8
+		}
+		body = append(body, pushN(uint64(4+0x20*i)), op(vm.CALLDATALOAD),
+			op(vm.DUP1), pushN(bits), op(vm.SHR), ref("revert"), op(vm.JUMPI),
+			op(vm.DUP1), pushN(uint64(0x20*i)), op(vm.MSTORE),
+			push32(slot(i)), op(vm.SSTORE))
+	}
+	body = append(body, topic("ConsensusParamsUpdated(uint64,uint64,uint64,uint64,uint8)"), pushN(0xa0), pushN(0), op(vm.LOG1), op(vm.STOP))
+	return layer(impl, []handler{
+		{"getConsensusParams()", "get"},
+		{"setConsensusParams(uint64,uint64,uint64,uint64,uint8)", "set"},
+	}, body)
+}
+
+// neptune assembles contract/inactivity.sol.
+func neptune(impl common.Address) []byte {
+	inactivityNS := ns("QEasyChain.SystemContract.inactivityPenalties")
+	ejectedNS := ns("QEasyChain.SystemContract.ejectedValidators")
+	var body []item
+	// inactivityPunish(address signer)
+	body = append(body, lbl("punish"), op(vm.POP))
+	body = append(body, nonpayable()...)
+	body = append(body, op(vm.CALLER), ref("revert"), op(vm.JUMPI))
+	body = append(body, addrArg(4)...)
+	body = append(body, op(vm.DUP1))
+	body = append(body, mapslot(inactivityNS)...)
+	// [slot, validator]
+	body = append(body, op(vm.DUP1), op(vm.SLOAD), pushN(1), op(vm.ADD), op(vm.DUP1), op(vm.SWAP2), op(vm.SSTORE),
+		// [count, validator]
+		pushN(0), op(vm.MSTORE), topic("InactivityPunished(address,uint256)"), pushN(0x20), pushN(0), op(vm.LOG2), op(vm.STOP))
+
+	// ejectValidator(address signer)
+	body = append(body, lbl("eject"), op(vm.POP))
+	body = append(body, nonpayable()...)
+	body = append(body, op(vm.CALLER), ref("revert"), op(vm.JUMPI))
+	body = append(body, addrArg(4)...)
+	body = append(body, op(vm.DUP1))
+	body = append(body, mapslot(ejectedNS)...)
+	body = append(body, op(vm.NUMBER), op(vm.SWAP1), op(vm.SSTORE),
+		topic("ValidatorEjected(address)"), pushN(0), pushN(0), op(vm.LOG2), op(vm.STOP))
+
+	// rejoinValidator(address signer)
+	body = append(body, lbl("rejoin"), op(vm.POP))
+	body = append(body, nonpayable()...)
+	body = append(body, addrArg(4)...)
+	body = append(body, op(vm.DUP1), op(vm.CALLER), op(vm.EQ), op(vm.ISZERO), ref("revert"), op(vm.JUMPI), op(vm.DUP1))
+	body = append(body, mapslot(ejectedNS)...)
+	body = append(body, op(vm.DUP1), op(vm.SLOAD), op(vm.ISZERO), ref("revert"), op(vm.JUMPI),
+		pushN(0), op(vm.SWAP1), op(vm.SSTORE),
+		topic("ValidatorRejoined(address)"), pushN(0), pushN(0), op(vm.LOG2), op(vm.STOP))
+
+	// getTopValidators(uint8 count), filtering out the ejected validators
+	body = append(body, lbl("top"), op(vm.POP))
+	body = append(body, delegate2(impl)...)
+	body = append(body, pushN(0x20), op(vm.MLOAD), pushN(0), op(vm.DUP1),
+		// [j, i, n]
+		lbl("loop"), op(vm.DUP3), op(vm.DUP3), op(vm.LT), op(vm.ISZERO), ref("done"), op(vm.JUMPI),
+		op(vm.DUP2), pushN(5), op(vm.SHL), pushN(0x40), op(vm.ADD), op(vm.MLOAD),
+		// [a, j, i, n]
+		op(vm.DUP4), pushN(5), op(vm.SHL), pushN(0x40), op(vm.ADD),
+		// [s, a, j, i, n]
+		op(vm.DUP2), op(vm.DUP2), op(vm.MSTORE),
+		push32(ejectedNS), op(vm.DUP2), pushN(0x20), op(vm.ADD), op(vm.MSTORE),
+		pushN(0x40), op(vm.SWAP1), op(vm.SHA3), op(vm.SLOAD),
+		// [ejected, a, j, i, n]
+		ref("skip"), op(vm.JUMPI),
+		op(vm.DUP2), pushN(5), op(vm.SHL), pushN(0x40), op(vm.ADD), op(vm.MSTORE),
+		pushN(1), op(vm.ADD), ref("next"), op(vm.JUMP),
+		lbl("skip"), op(vm.POP),
+		lbl("next"), op(vm.SWAP1), pushN(1), op(vm.ADD), op(vm.SWAP1), ref("loop"), op(vm.JUMP),
+		lbl("done"), op(vm.DUP1), pushN(0x20), op(vm.MSTORE),
+		pushN(5), op(vm.SHL), pushN(0x40), op(vm.ADD), pushN(0), op(vm.RETURN))
+	return layer(impl, []handler{
+		{"inactivityPunish(address)", "punish"},
+		{"ejectValidator(address)", "eject"},
+		{"rejoinValidator(address)", "rejoin"},
+		{"getTopValidators(uint8)", "top"},
+	}, body)
+}
+
+// delegate2 is like delegate, but falls through with the output in memory on success.
+func delegate2(impl common.Address) []item {
+	return []item{op(vm.CALLDATASIZE), pushN(0), pushN(0), op(vm.CALLDATACOPY),
+		pushN(0), pushN(0), op(vm.CALLDATASIZE), pushN(0), push(impl.Bytes()), op(vm.GAS), op(vm.DELEGATECALL),
+		op(vm.RETURNDATASIZE), pushN(0), pushN(0), op(vm.RETURNDATACOPY), ref("delegated"), op(vm.JUMPI),
+		op(vm.RETURNDATASIZE), pushN(0), op(vm.REVERT), lbl("delegated")}
+}
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "Usage: mklayers <hard-fork>")
+		os.Exit(1)
+	}
+	var code []byte
+	switch os.Args[1] {
+	case "jupiter":
+		code = jupiter(systemcontract.SysContractBLSPrevious)
+	case "saturn":
+		code = saturn(systemcontract.SysContractKeysPrevious)
+	case "uranus":
+		code = uranus(systemcontract.OnChainDaoParamsPrevious)
+	case "neptune":
+		code = neptune(systemcontract.SysContractInactivityPrevious)
+	default:
+		fmt.Fprintln(os.Stderr, "Unknown hard-fork", os.Args[1])
+		os.Exit(1)
+	}
+	fmt.Println("0x" + hex.EncodeToString(code))
+}
@@ -3,6 +3,7 @@ package systemcontract
 import (
 	"math/big"
 
+	"github.com/QEasyWeb3/QEasyChain/common"
 	"github.com/QEasyWeb3/QEasyChain/core"
 	"github.com/QEasyWeb3/QEasyChain/core/state"
 	"github.com/QEasyWeb3/QEasyChain/core/types"
@@ -11,11 +12,19 @@ import (
 )
 
 const (
-	Earth = "Earth"
+	Earth   = "Earth"
+	Jupiter = "Jupiter"
+	Saturn  = "Saturn"
+	Uranus  = "Uranus"
+	Neptune = "Neptune"
 )
 
 var hardForkContracts map[string][]IUpgradeAction = map[string][]IUpgradeAction{
-	Earth: EarthHardFork(),
+	Earth:   EarthHardFork(),
+	Jupiter: JupiterHardFork(),
+	Saturn:  SaturnHardFork(),
+	Uranus:  UranusHardFork(),
+	Neptune: NeptuneHardFork(),
 }
 
 // IUpgradeAction is the interface for system contracts upgrades
@@ -55,3 +64,13 @@ func ApplySystemContractUpgrade(hardfork string, state *state.StateDB, header *t
 	log.Error("System contract upgrade failed due to unsupported hardfork", "hardfork", hardfork, "height", header.Number)
 	return
 }
+
+// upgradeContractLayer moves the current code of a system contract to the given address, and
+// replaces it with a layer of code which implements some new methods, and delegates all the
+// other calls to the moved code. The storage of the system contract is kept untouched, as the
+// delegated calls run on it.
+func upgradeContractLayer(state *state.StateDB, contract common.Address, previous common.Address, code string) {
+	state.SetCode(previous, state.GetCode(contract))
+	state.SetCode(contract, common.FromHex(code))
+	log.Debug("Upgrade code layer of system contract account", "addr", contract.String(), "previous", previous.String(), "code", code)
+}
@@ -0,0 +1,277 @@
+package democracy
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/systemcontract"
+	"github.com/QEasyWeb3/QEasyChain/contracts/system"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/state"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+	"github.com/QEasyWeb3/QEasyChain/crypto/bls"
+	"github.com/QEasyWeb3/QEasyChain/params"
+)
+
+// legacyTestCode answers any call with the number 42, standing for the code of a system
+// contract before a hard-fork.
+var legacyTestCode = common.FromHex("0x602a60005260206000f3")
+
+// newUpgradeTestContext creates a call context on a fresh state holding the legacy test code
+// on the given system contract, and applies the system contract upgrade of a hard-fork.
+func newUpgradeTestContext(t *testing.T, contract common.Address, hardfork string) *systemcontract.CallContext {
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		t.Fatalf("failed to create state: %v", err)
+	}
+	statedb.SetCode(contract, legacyTestCode)
+
+	config := *params.AllDemocracyProtocolChanges
+	ctx := &systemcontract.CallContext{
+		Statedb:      statedb,
+		Header:       &types.Header{Number: big.NewInt(1), Difficulty: common.Big1, GasLimit: params.GenesisGasLimit},
+		ChainContext: newMinimalChainContext(New(&config, rawdb.NewMemoryDatabase())),
+		ChainConfig:  &config,
+	}
+	if err := systemcontract.ApplySystemContractUpgrade(hardfork, ctx.Statedb, ctx.Header, ctx.ChainContext, ctx.ChainConfig); err != nil {
+		t.Fatalf("failed to upgrade system contracts: %v", err)
+	}
+	return ctx
+}
+
+// callTestContract calls a method of a system contract from the given sender.
+func callTestContract(ctx *systemcontract.CallContext, from common.Address, contractName string, method string, args ...interface{}) ([]byte, error) {
+	data, err := system.ABIPack(contractName, system.ContractV0, method, args...)
+	if err != nil {
+		return nil, err
+	}
+	return systemcontract.CallContractWithValue(ctx, from, ctx.GetContractAddress(contractName), data, common.Big0)
+}
+
+// Tests that the code layer of the Jupiter hard-fork stores the registered BLS public keys,
+// and keeps delegating all the other calls to the former code of the system contract.
+func TestJupiterSystemContractUpgrade(t *testing.T) {
+	ctx := newUpgradeTestContext(t, system.SystemContract, systemcontract.Jupiter)
+
+	ret, err := callTestContract(ctx, common.Address{}, system.SysContractName, "getActiveValidators")
+	if err != nil || new(big.Int).SetBytes(ret).Uint64() != 42 {
+		t.Fatalf("legacy call not delegated: %x, %v", ret, err)
+	}
+	validator := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	pubkey, proof, err := systemcontract.GetValidatorBLSPublicKey(ctx, validator)
+	if err != nil || len(pubkey) != 0 || len(proof) != 0 {
+		t.Fatalf("unregistered key mismatch: %x, %x, %v", pubkey, proof, err)
+	}
+	key, err := bls.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	pub, pop := key.PublicKey().Bytes(), key.ProvePossession().Bytes()
+	if _, err := callTestContract(ctx, validator, system.SysContractName, "registerBLSPublicKey", pub[:len(pub)-1], pop); err == nil {
+		t.Fatalf("truncated public key registered")
+	}
+	if _, err := callTestContract(ctx, validator, system.SysContractName, "registerBLSPublicKey", pub, pop[:len(pop)-1]); err == nil {
+		t.Fatalf("truncated proof registered")
+	}
+	if _, err := callTestContract(ctx, validator, system.SysContractName, "registerBLSPublicKey", pub, pop); err != nil {
+		t.Fatalf("failed to register key: %v", err)
+	}
+	if pubkey, proof, err = systemcontract.GetValidatorBLSPublicKey(ctx, validator); err != nil {
+		t.Fatalf("failed to read key: %v", err)
+	}
+	if !bytes.Equal(pubkey, pub) || !bytes.Equal(proof, pop) {
+		t.Fatalf("registered key mismatch: have %x/%x, want %x/%x", pubkey, proof, pub, pop)
+	}
+	if pubkey, _, _ = systemcontract.GetValidatorBLSPublicKey(ctx, common.Address{0x2}); len(pubkey) != 0 {
+		t.Fatalf("key registered for another validator: %x", pubkey)
+	}
+	if code := ctx.Statedb.GetCode(systemcontract.SysContractBLSPrevious); !bytes.Equal(code, legacyTestCode) {
+		t.Fatalf("former code not moved: %x", code)
+	}
+}
+
+// Tests that the code layer of the Saturn hard-fork lets validators rotate their own consensus
+// key only, and keeps delegating all the other calls to the former code of the system contract.
+func TestSaturnSystemContractUpgrade(t *testing.T) {
+	ctx := newUpgradeTestContext(t, system.SystemContract, systemcontract.Saturn)
+
+	ret, err := callTestContract(ctx, common.Address{}, system.SysContractName, "getActiveValidators")
+	if err != nil || new(big.Int).SetBytes(ret).Uint64() != 42 {
+		t.Fatalf("legacy call not delegated: %x, %v", ret, err)
+	}
+	validator := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	key := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	if have, err := systemcontract.GetConsensusKey(ctx, validator); err != nil || have != validator {
+		t.Fatalf("unrotated key mismatch: have %x (%v), want %x", have, err, validator)
+	}
+	if _, err := callTestContract(ctx, key, system.SysContractName, "rotateConsensusKey", validator, key); err == nil {
+		t.Fatalf("key rotated by another account")
+	}
+	if _, err := callTestContract(ctx, validator, system.SysContractName, "rotateConsensusKey", validator, common.Address{}); err == nil {
+		t.Fatalf("key rotated to the zero address")
+	}
+	if _, err := callTestContract(ctx, validator, system.SysContractName, "rotateConsensusKey", validator, key); err != nil {
+		t.Fatalf("failed to rotate key: %v", err)
+	}
+	if have, err := systemcontract.GetConsensusKey(ctx, validator); err != nil || have != key {
+		t.Fatalf("rotated key mismatch: have %x (%v), want %x", have, err, key)
+	}
+	// Rotating back to the identity of the validator resets the key
+	if _, err := callTestContract(ctx, validator, system.SysContractName, "rotateConsensusKey", validator, validator); err != nil {
+		t.Fatalf("failed to reset key: %v", err)
+	}
+	if have, err := systemcontract.GetConsensusKey(ctx, validator); err != nil || have != validator {
+		t.Fatalf("reset key mismatch: have %x (%v), want %x", have, err, validator)
+	}
+	if code := ctx.Statedb.GetCode(systemcontract.SysContractKeysPrevious); !bytes.Equal(code, legacyTestCode) {
+		t.Fatalf("former code not moved: %x", code)
+	}
+}
+
+// Tests that the code layer of the Saturn hard-fork refuses to rotate a consensus key to the
+// address or the current key of another active validator, as the nodes would skip it.
+func TestSaturnSystemContractKeyCollisions(t *testing.T) {
+	ctx := newUpgradeTestContext(t, system.SystemContract, systemcontract.Saturn)
+
+	validator := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	key := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	// Let the former code answer any call with the active validators
+	code := common.FromHex("0x602060005260026020527f")
+	code = append(code, common.LeftPadBytes(validator.Bytes(), 32)...)
+	code = append(code, common.FromHex("0x6040527f")...)
+	code = append(code, common.LeftPadBytes(other.Bytes(), 32)...)
+	code = append(code, common.FromHex("0x60605260806000f3")...)
+	ctx.Statedb.SetCode(systemcontract.SysContractKeysPrevious, code)
+
+	if _, err := callTestContract(ctx, validator, system.SysContractName, "rotateConsensusKey", validator, other); err == nil {
+		t.Fatalf("key rotated to the address of another validator")
+	}
+	if _, err := callTestContract(ctx, other, system.SysContractName, "rotateConsensusKey", other, key); err != nil {
+		t.Fatalf("failed to rotate key: %v", err)
+	}
+	if _, err := callTestContract(ctx, validator, system.SysContractName, "rotateConsensusKey", validator, key); err == nil {
+		t.Fatalf("key rotated to the key of another validator")
+	}
+	// Rotating to the current key of the validator itself is harmless
+	if _, err := callTestContract(ctx, other, system.SysContractName, "rotateConsensusKey", other, key); err != nil {
+		t.Fatalf("failed to rotate to the current key: %v", err)
+	}
+	// Once the other validator moved away from it, the key is free again
+	if _, err := callTestContract(ctx, other, system.SysContractName, "rotateConsensusKey", other, other); err != nil {
+		t.Fatalf("failed to reset key: %v", err)
+	}
+	if _, err := callTestContract(ctx, validator, system.SysContractName, "rotateConsensusKey", validator, key); err != nil {
+		t.Fatalf("failed to rotate to a released key: %v", err)
+	}
+	if have, err := systemcontract.GetConsensusKey(ctx, validator); err != nil || have != key {
+		t.Fatalf("rotated key mismatch: have %x (%v), want %x", have, err, key)
+	}
+}
+
+// Tests that the code layer of the Uranus hard-fork lets the OnChainDao contract govern the
+// consensus parameters through its own proposals only, and keeps delegating all the other
+// calls to its former code.
+func TestUranusOnChainDaoUpgrade(t *testing.T) {
+	ctx := newUpgradeTestContext(t, system.OnChainDaoContract, systemcontract.Uranus)
+
+	ret, err := callTestContract(ctx, common.Address{}, system.OnChainDaoContractName, "getPassedProposalCount")
+	if err != nil || new(big.Int).SetBytes(ret).Uint64() != 42 {
+		t.Fatalf("legacy call not delegated: %x, %v", ret, err)
+	}
+	cp, err := systemcontract.GetConsensusParams(ctx)
+	if err != nil {
+		t.Fatalf("failed to read consensus params: %v", err)
+	}
+	if *cp != (systemcontract.ConsensusParams{}) {
+		t.Fatalf("ungoverned consensus params mismatch: %+v", cp)
+	}
+	want := systemcontract.ConsensusParams{Period: 2, Epoch: 100, AttestationDelay: 3, ContinuousInturn: 2, MaxValidators: 31}
+	args := []interface{}{want.Period, want.Epoch, want.AttestationDelay, want.ContinuousInturn, want.MaxValidators}
+	if _, err := callTestContract(ctx, common.HexToAddress("0x1"), system.OnChainDaoContractName, "setConsensusParams", args...); err == nil {
+		t.Fatalf("consensus params set by an account")
+	}
+	if _, err := callTestContract(ctx, system.OnChainDaoContract, system.OnChainDaoContractName, "setConsensusParams", args...); err != nil {
+		t.Fatalf("failed to set consensus params: %v", err)
+	}
+	if cp, err = systemcontract.GetConsensusParams(ctx); err != nil {
+		t.Fatalf("failed to read consensus params: %v", err)
+	}
+	if *cp != want {
+		t.Fatalf("governed consensus params mismatch: have %+v, want %+v", cp, want)
+	}
+	if code := ctx.Statedb.GetCode(systemcontract.OnChainDaoParamsPrevious); !bytes.Equal(code, legacyTestCode) {
+		t.Fatalf("former code not moved: %x", code)
+	}
+}
+
+// Tests that the code layer of the Neptune hard-fork lets the consensus engine only punish and
+// eject inactive validators, leaves the ejected validators out of the top validators until they
+// rejoin, and keeps delegating all the other calls to the former code of the system contract.
+func TestNeptuneSystemContractUpgrade(t *testing.T) {
+	ctx := newUpgradeTestContext(t, system.SystemContract, systemcontract.Neptune)
+
+	ret, err := callTestContract(ctx, common.Address{}, system.SysContractName, "getActiveValidators")
+	if err != nil || new(big.Int).SetBytes(ret).Uint64() != 42 {
+		t.Fatalf("legacy call not delegated: %x, %v", ret, err)
+	}
+	if code := ctx.Statedb.GetCode(systemcontract.SysContractInactivityPrevious); !bytes.Equal(code, legacyTestCode) {
+		t.Fatalf("former code not moved: %x", code)
+	}
+	// Let the former code answer the top validators
+	validators := []common.Address{
+		common.HexToAddress("0x1000000000000000000000000000000000000001"),
+		common.HexToAddress("0x2000000000000000000000000000000000000002"),
+	}
+	code := common.FromHex("0x602060005260026020527f")
+	code = append(append(code, common.LeftPadBytes(validators[0].Bytes(), 32)...), common.FromHex("0x6040527f")...)
+	code = append(append(code, common.LeftPadBytes(validators[1].Bytes(), 32)...), common.FromHex("0x60605260806000f3")...)
+	ctx.Statedb.SetCode(systemcontract.SysContractInactivityPrevious, code)
+
+	if top, err := systemcontract.GetTopValidators(ctx, 21); err != nil || len(top) != 2 {
+		t.Fatalf("top validators mismatch: have %x (%v), want %x", top, err, validators)
+	}
+	penalties := func(validator common.Address) uint64 {
+		slot := crypto.Keccak256Hash(common.LeftPadBytes(validator.Bytes(), 32), crypto.Keccak256([]byte("QEasyChain.SystemContract.inactivityPenalties")))
+		return ctx.Statedb.GetState(system.SystemContract, slot).Big().Uint64()
+	}
+	validator := validators[0]
+	if err := systemcontract.InactivityPunish(ctx, validator); err != nil {
+		t.Fatalf("failed to punish validator: %v", err)
+	}
+	if err := systemcontract.InactivityPunish(ctx, validator); err != nil {
+		t.Fatalf("failed to punish validator: %v", err)
+	}
+	if have := penalties(validator); have != 2 {
+		t.Fatalf("inactivity penalties mismatch: have %d, want 2", have)
+	}
+	if _, err := callTestContract(ctx, validator, system.SysContractName, "inactivityPunish", validators[1]); err == nil {
+		t.Fatalf("validator punished by an account")
+	}
+	if _, err := callTestContract(ctx, validator, system.SysContractName, "ejectValidator", validators[1]); err == nil {
+		t.Fatalf("validator ejected by an account")
+	}
+	// Ejected validators are left out of the top validators until they rejoin by themselves
+	if _, err := callTestContract(ctx, validator, system.SysContractName, "rejoinValidator", validator); err == nil {
+		t.Fatalf("active validator rejoined")
+	}
+	if err := systemcontract.EjectValidator(ctx, validator); err != nil {
+		t.Fatalf("failed to eject validator: %v", err)
+	}
+	if top, err := systemcontract.GetTopValidators(ctx, 21); err != nil || len(top) != 1 || top[0] != validators[1] {
+		t.Fatalf("top validators mismatch: have %x (%v), want [%x]", top, err, validators[1])
+	}
+	if _, err := callTestContract(ctx, validators[1], system.SysContractName, "rejoinValidator", validator); err == nil {
+		t.Fatalf("validator rejoined by another account")
+	}
+	if _, err := callTestContract(ctx, validator, system.SysContractName, "rejoinValidator", validator); err != nil {
+		t.Fatalf("failed to rejoin: %v", err)
+	}
+	if top, err := systemcontract.GetTopValidators(ctx, 21); err != nil || len(top) != 2 {
+		t.Fatalf("top validators mismatch: have %x (%v), want %x", top, err, validators)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package democracy
+
+import (
+	"errors"
+
+	"github.com/QEasyWeb3/QEasyChain/accounts"
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+)
+
+var (
+	// errUnauthorizedRecord is returned if the local node is asked to sign a validator
+	// record without being authorized as a validator.
+	errUnauthorizedRecord = errors.New("validator record requires an authorized validator")
+
+	// errInvalidValidatorRecord is returned if a validator record isn't signed by the
+	// consensus key of the validator it advertises.
+	errInvalidValidatorRecord = errors.New("invalid validator record signature")
+)
+
+// SignValidatorRecord signs with the consensus key of the local validator the record
+// advertising the node with the given id as run by the validator.
+func (c *Democracy) SignValidatorRecord(node common.Hash) (common.Address, []byte, error) {
+	c.lock.RLock()
+	val, key, signFn := c.validator, c.signingKey, c.signFn
+	c.lock.RUnlock()
+
+	if signFn == nil {
+		return common.Address{}, nil, errUnauthorizedRecord
+	}
+	sig, err := signFn(accounts.Account{Address: key}, accounts.MimetypeDemocracyRecord, types.ValidatorRecordData(val, node))
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	return val, sig, nil
+}
+
+// VerifyValidatorRecord checks that the record advertising the node with the given id
+// as run by the validator is signed by the consensus key of the validator at the given
+// block. The identity of a validator which rotated its key can't sign records anymore.
+func (c *Democracy) VerifyValidatorRecord(chain consensus.ChainHeaderReader, hash common.Hash, number uint64,
+	validator common.Address, node common.Hash, sig []byte) error {
+	if len(sig) != crypto.SignatureLength {
+		return errInvalidValidatorRecord
+	}
+	pubkey, err := crypto.SigToPub(types.ValidatorRecordSignHash(validator, node).Bytes(), sig)
+	if err != nil {
+		return errInvalidValidatorRecord
+	}
+	if chain.GetHeader(hash, number) == nil {
+		return errUnknownBlock
+	}
+	snap, err := c.snapshot(chain, number, hash, nil)
+	if err != nil {
+		return err
+	}
+	if signer, ok := snap.validatorOf(crypto.PubkeyToAddress(*pubkey)); !ok || signer != validator {
+		return errInvalidValidatorRecord
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package democracy
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/accounts"
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+)
+
+// Tests that the validator records are only accepted when signed by the consensus key
+// active at the given block, the rotated identity of a validator included.
+func TestVerifyValidatorRecord(t *testing.T) {
+	identity, _ := crypto.GenerateKey()
+	key, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+	validator, signer := crypto.PubkeyToAddress(identity.PublicKey), crypto.PubkeyToAddress(key.PublicKey)
+
+	chain := newTestKeysChain(t, 7, identity, key)
+	engine := New(chain.config, rawdb.NewMemoryDatabase())
+	node := common.Hash{0x1}
+
+	sign := func(validator common.Address, key *ecdsa.PrivateKey) []byte {
+		sig, err := crypto.Sign(types.ValidatorRecordSignHash(validator, node).Bytes(), key)
+		if err != nil {
+			t.Fatalf("failed to sign record: %v", err)
+		}
+		return sig
+	}
+	tests := []struct {
+		number uint64
+		sig    []byte
+		err    error
+	}{
+		{3, sign(validator, identity), nil},                            // Identity before the rotation
+		{3, sign(validator, key), errInvalidValidatorRecord},           // Key before the rotation
+		{6, sign(validator, key), nil},                                 // Key after the rotation
+		{6, sign(validator, identity), errInvalidValidatorRecord},      // Rotated identity
+		{6, sign(validator, other), errInvalidValidatorRecord},         // Not a validator
+		{6, sign(validator, identity)[:10], errInvalidValidatorRecord}, // Malformed
+	}
+	for i, tt := range tests {
+		header := chain.headers[tt.number]
+		if err := engine.VerifyValidatorRecord(chain, header.Hash(), tt.number, validator, node, tt.sig); err != tt.err {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, tt.err)
+		}
+	}
+	if err := engine.VerifyValidatorRecord(chain, common.Hash{0x1}, 6, validator, node, sign(validator, key)); err != errUnknownBlock {
+		t.Fatalf("unknown block error mismatch: have %v, want %v", err, errUnknownBlock)
+	}
+	// The local validator signs its records with its consensus key
+	engine.Authorize(validator, func(account accounts.Account, mimeType string, message []byte) ([]byte, error) {
+		if account.Address != signer {
+			t.Fatalf("record signed by %x, want %x", account.Address, signer)
+		}
+		return crypto.Sign(crypto.Keccak256(message), key)
+	}, nil)
+	engine.AuthorizeConsensusKey(signer)
+
+	have, sig, err := engine.SignValidatorRecord(node)
+	if err != nil || have != validator {
+		t.Fatalf("failed to sign record: %x (%v)", have, err)
+	}
+	head := chain.CurrentHeader()
+	if err := engine.VerifyValidatorRecord(chain, head.Hash(), head.Number.Uint64(), validator, node, sig); err != nil {
+		t.Fatalf("signed record rejected: %v", err)
+	}
+}
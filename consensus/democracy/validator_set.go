@@ -0,0 +1,370 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package democracy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/systemcontract"
+	"github.com/QEasyWeb3/QEasyChain/core"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/ethdb"
+	"github.com/QEasyWeb3/QEasyChain/event"
+	"github.com/QEasyWeb3/QEasyChain/log"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+	"github.com/QEasyWeb3/QEasyChain/rpc"
+)
+
+// maxValidatorSetHistory is the max number of epochs retrieved by a single history query.
+const maxValidatorSetHistory = 1024
+
+// errValidatorSetHistoryUnavailable is returned if the epochs of a chain segment aren't
+// known, so its validator set changes can't be indexed.
+var errValidatorSetHistoryUnavailable = errors.New("validator set history unavailable")
+
+// ValidatorSetChange is the switch to a new validator set at a checkpoint block.
+type ValidatorSetChange struct {
+	Epoch      uint64           `json:"epoch"`      // Epoch started by the checkpoint
+	Number     uint64           `json:"number"`     // Number of the checkpoint block
+	Hash       common.Hash      `json:"hash"`       // Hash of the checkpoint block
+	Validators []common.Address `json:"validators"` // Validators authorized from the checkpoint on
+	Added      []common.Address `json:"added"`      // Validators joining the set at the checkpoint
+	Removed    []common.Address `json:"removed"`    // Validators leaving the set at the checkpoint
+}
+
+// newValidatorSetChange creates the change from the old validator set to the new one.
+func newValidatorSetChange(epoch uint64, number uint64, hash common.Hash, old, new map[common.Address]struct{}) *ValidatorSetChange {
+	change := &ValidatorSetChange{
+		Epoch:      epoch,
+		Number:     number,
+		Hash:       hash,
+		Validators: make([]common.Address, 0, len(new)),
+		Added:      make([]common.Address, 0),
+		Removed:    make([]common.Address, 0),
+	}
+	for validator := range new {
+		change.Validators = append(change.Validators, validator)
+		if _, ok := old[validator]; !ok {
+			change.Added = append(change.Added, validator)
+		}
+	}
+	for validator := range old {
+		if _, ok := new[validator]; !ok {
+			change.Removed = append(change.Removed, validator)
+		}
+	}
+	sort.Sort(systemcontract.AddrAscend(change.Validators))
+	sort.Sort(systemcontract.AddrAscend(change.Added))
+	sort.Sort(systemcontract.AddrAscend(change.Removed))
+	return change
+}
+
+// writeValidatorSetChanges persists the validator set changes into the history index.
+func writeValidatorSetChanges(db ethdb.Database, changes []*ValidatorSetChange) error {
+	batch := db.NewBatch()
+	for _, change := range changes {
+		data, err := rlp.EncodeToBytes(change)
+		if err != nil {
+			return err
+		}
+		rawdb.WriteValidatorSetChange(batch, change.Epoch, change.Hash, data)
+	}
+	return batch.Write()
+}
+
+// readValidatorSetChanges retrieves the validator set changes starting the given epoch,
+// both canonical and reorged forks included.
+func readValidatorSetChanges(db ethdb.Database, epoch uint64) []*ValidatorSetChange {
+	var changes []*ValidatorSetChange
+	for _, data := range rawdb.ReadValidatorSetChanges(db, epoch) {
+		change := new(ValidatorSetChange)
+		if err := rlp.DecodeBytes(data, change); err != nil {
+			log.Error("Invalid validator set change RLP", "epoch", epoch, "err", err)
+			continue
+		}
+		if change.Added == nil {
+			change.Added = make([]common.Address, 0)
+		}
+		if change.Removed == nil {
+			change.Removed = make([]common.Address, 0)
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// nextCheckpoint returns the checkpoint of the canonical chain following the given one,
+// and the epoch length active from it on. The given length is the one active before the
+// checkpoint, after the Uranus hard-fork the checkpoint may announce a new one.
+func (c *Democracy) nextCheckpoint(chain consensus.ChainHeaderReader, number uint64, length uint64) (uint64, uint64, error) {
+	if number > 0 && c.chainConfig.IsUranus(new(big.Int).SetUint64(number)) {
+		header := chain.GetHeaderByNumber(number)
+		if header == nil {
+			return 0, 0, consensus.ErrUnknownAncestor
+		}
+		cp, err := extraConsensusParams(c.chainConfig, header)
+		if err != nil {
+			return 0, 0, err
+		}
+		if cp != nil {
+			length = cp.Epoch
+		}
+	}
+	return number + length, length, nil
+}
+
+// canonicalCheckpoint walks the checkpoints of the canonical chain from the genesis block
+// on, and returns the index, number and epoch length of the last one whose index doesn't
+// exceed maxEpoch and whose number doesn't exceed maxNumber. The checkpoints before the
+// Uranus hard-fork are counted from the configured epoch length, the next ones from the
+// epoch lengths announced by their predecessors.
+func (c *Democracy) canonicalCheckpoint(chain consensus.ChainHeaderReader, maxEpoch, maxNumber uint64) (uint64, uint64, uint64, error) {
+	length := defaultConsensusParams(c.config).Epoch
+	epoch := maxNumber / length
+	if epoch > maxEpoch {
+		epoch = maxEpoch
+	}
+	uranus := c.chainConfig.UranusBlock
+	if uranus == nil || epoch*length < uranus.Uint64() {
+		return epoch, epoch * length, length, nil
+	}
+	// Start from the last checkpoint before the hard-fork, and follow the announcements
+	epoch = 0
+	if uranus.Sign() > 0 {
+		epoch = (uranus.Uint64() - 1) / length
+	}
+	number := epoch * length
+	for epoch < maxEpoch {
+		next, nextLength, err := c.nextCheckpoint(chain, number, length)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		if next > maxNumber {
+			break
+		}
+		epoch, number, length = epoch+1, next, nextLength
+	}
+	return epoch, number, length, nil
+}
+
+// deriveEpoch counts the checkpoints passed by a snapshot since the genesis block along
+// the canonical chain, so that the validator set changes it passes are indexed under the
+// right epochs. Snapshots whose past checkpoints aren't known, such as the ones created
+// from a light client checkpoint, are left out of the history index.
+func (c *Democracy) deriveEpoch(chain consensus.ChainHeaderReader, snap *Snapshot) {
+	if snap.EpochKnown {
+		return
+	}
+	epoch, _, _, err := c.canonicalCheckpoint(chain, math.MaxUint64, snap.Number)
+	if err != nil {
+		log.Debug("Validator set history unavailable", "number", snap.Number, "hash", snap.Hash, "err", err)
+		return
+	}
+	snap.Epoch, snap.EpochKnown = epoch, true
+}
+
+// validatorSetChange retrieves the validator set change at the given canonical checkpoint
+// starting the given epoch. Changes missing from the history index, such as the ones of the
+// checkpoints passed before it was introduced, are computed from the snapshots and indexed.
+func (c *Democracy) validatorSetChange(chain consensus.ChainHeaderReader, epoch uint64, number uint64) (*ValidatorSetChange, error) {
+	header := chain.GetHeaderByNumber(number)
+	if header == nil {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	hash := header.Hash()
+	for _, change := range readValidatorSetChanges(c.db, epoch) {
+		if change.Hash == hash {
+			return change, nil
+		}
+	}
+	parent, err := c.snapshot(chain, number-1, header.ParentHash, nil)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := c.snapshot(chain, number, hash, nil)
+	if err != nil {
+		return nil, err
+	}
+	change := newValidatorSetChange(epoch, number, hash, parent.Validators, snap.Validators)
+	if err := writeValidatorSetChanges(c.db, []*ValidatorSetChange{change}); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// validatorSetChangesBetween retrieves the validator set changes of the canonical chain
+// after the block last up to the block head. If last isn't canonical anymore, the changes
+// are retrieved from the common ancestor on.
+func (c *Democracy) validatorSetChangesBetween(chain consensus.ChainHeaderReader, last, head *types.Header) ([]*ValidatorSetChange, error) {
+	number := last.Number.Uint64()
+	if number > head.Number.Uint64() {
+		number = head.Number.Uint64()
+	}
+	from := ancestor(chain, last, number, nil)
+	for from != nil && from.Number.Sign() > 0 {
+		if canonical := chain.GetHeaderByNumber(from.Number.Uint64()); canonical != nil && canonical.Hash() == from.Hash() {
+			break
+		}
+		from = chain.GetHeader(from.ParentHash, from.Number.Uint64()-1)
+	}
+	if from == nil {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	snap, err := c.snapshot(chain, from.Number.Uint64(), from.Hash(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if !snap.EpochKnown {
+		return nil, errValidatorSetHistoryUnavailable
+	}
+	var (
+		epoch   = snap.Epoch
+		changes []*ValidatorSetChange
+	)
+	for n := from.Number.Uint64() + 1; n <= head.Number.Uint64(); n++ {
+		header := chain.GetHeaderByNumber(n)
+		if header == nil {
+			return nil, consensus.ErrUnknownAncestor
+		}
+		// Building the snapshot of a checkpoint stores its change into the history index
+		snap, err := c.snapshot(chain, n, header.Hash(), nil)
+		if err != nil {
+			return nil, err
+		}
+		if snap.Epoch == epoch {
+			continue
+		}
+		epoch = snap.Epoch
+		for _, change := range readValidatorSetChanges(c.db, epoch) {
+			if change.Hash == header.Hash() {
+				changes = append(changes, change)
+			}
+		}
+	}
+	return changes, nil
+}
+
+// chainHeadSubscriber is the chain notifying the new canonical heads.
+type chainHeadSubscriber interface {
+	SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription
+}
+
+// ValidatorSetChanges creates a subscription notified of every switch of the validator
+// set on the canonical chain. Changes replaced by a reorg are notified again for the
+// checkpoints of the new canonical chain.
+func (api *API) ValidatorSetChanges(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	chain, ok := api.chain.(chainHeadSubscriber)
+	if !ok {
+		return nil, errors.New("chain head events not available")
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		heads := make(chan core.ChainHeadEvent, 16)
+		sub := chain.SubscribeChainHeadEvent(heads)
+		defer sub.Unsubscribe()
+
+		last := api.chain.CurrentHeader()
+		for {
+			select {
+			case ev := <-heads:
+				head := ev.Block.Header()
+				changes, err := api.democracy.validatorSetChangesBetween(api.chain, last, head)
+				if err != nil {
+					log.Debug("Failed to retrieve validator set changes", "number", head.Number, "hash", head.Hash(), "err", err)
+					continue
+				}
+				for _, change := range changes {
+					notifier.Notify(rpcSub.ID, change)
+				}
+				last = head
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// GetValidatorSetHistory retrieves the validator set changes of the canonical chain
+// between the given epochs (inclusive). The changes of the checkpoints passed before the
+// history index was introduced are computed on demand. If the past checkpoints aren't
+// known, such as on a light client, only the indexed changes are retrieved.
+func (api *API) GetValidatorSetHistory(fromEpoch, toEpoch uint64) ([]*ValidatorSetChange, error) {
+	if fromEpoch > toEpoch {
+		return nil, errors.New("invalid epoch range")
+	}
+	if toEpoch-fromEpoch >= maxValidatorSetHistory {
+		return nil, fmt.Errorf("epoch range exceeds %d epochs", maxValidatorSetHistory)
+	}
+	var (
+		history = make([]*ValidatorSetChange, 0)
+		head    = api.chain.CurrentHeader().Number.Uint64()
+	)
+	epoch, number, length, err := api.democracy.canonicalCheckpoint(api.chain, fromEpoch, head)
+	if err != nil {
+		log.Debug("Validator set history not derivable", "err", err)
+		return api.indexedValidatorSetHistory(fromEpoch, toEpoch), nil
+	}
+	if epoch < fromEpoch {
+		return history, nil
+	}
+	for ; epoch <= toEpoch && number <= head; epoch++ {
+		// The genesis block starts the first epoch without any change
+		if epoch > 0 {
+			change, err := api.democracy.validatorSetChange(api.chain, epoch, number)
+			if err != nil {
+				return nil, err
+			}
+			history = append(history, change)
+		}
+		if number, length, err = api.democracy.nextCheckpoint(api.chain, number, length); err != nil {
+			return nil, err
+		}
+	}
+	return history, nil
+}
+
+// indexedValidatorSetHistory retrieves the canonical validator set changes of the history
+// index between the given epochs (inclusive).
+func (api *API) indexedValidatorSetHistory(fromEpoch, toEpoch uint64) []*ValidatorSetChange {
+	history := make([]*ValidatorSetChange, 0)
+	for i := uint64(0); i <= toEpoch-fromEpoch; i++ {
+		for _, change := range readValidatorSetChanges(api.democracy.db, fromEpoch+i) {
+			if rawdb.ReadCanonicalHash(api.democracy.db, change.Number) == change.Hash {
+				history = append(history, change)
+				break
+			}
+		}
+	}
+	return history
+}
@@ -0,0 +1,239 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package democracy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/systemcontract"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+	"github.com/QEasyWeb3/QEasyChain/params"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+)
+
+// newTestHistoryChain creates a chain whose checkpoint at block 4 shortens the epochs to 3
+// blocks, so that the checkpoints are 4, 7, 10 and 13.
+func newTestHistoryChain(t *testing.T) *testFinalityChain {
+	key, _ := crypto.GenerateKey()
+	chain, _ := newTestParamsChain(t, 15, key, func(number uint64) *systemcontract.ConsensusParams {
+		if number == 4 {
+			return &systemcontract.ConsensusParams{Period: 3, Epoch: 3, AttestationDelay: 2, ContinuousInturn: 1, MaxValidators: 21}
+		}
+		return nil
+	})
+	return chain
+}
+
+// Tests that the epochs are counted along the checkpoints announced on chain, and not
+// guessed from the configured epoch length.
+func TestCanonicalCheckpoint(t *testing.T) {
+	chain := newTestHistoryChain(t)
+	engine := New(chain.config, rawdb.NewMemoryDatabase())
+
+	tests := []struct {
+		maxEpoch, maxNumber uint64
+		epoch, number       uint64
+	}{
+		{100, 3, 0, 0},
+		{100, 4, 1, 4},
+		{100, 9, 2, 7},
+		{100, 14, 4, 13},
+		{2, 14, 2, 7},
+		{0, 14, 0, 0},
+	}
+	for i, tt := range tests {
+		epoch, number, _, err := engine.canonicalCheckpoint(chain, tt.maxEpoch, tt.maxNumber)
+		if err != nil {
+			t.Fatalf("test %d: failed to walk checkpoints: %v", i, err)
+		}
+		if epoch != tt.epoch || number != tt.number {
+			t.Errorf("test %d: checkpoint mismatch: have %d at %d, want %d at %d", i, epoch, number, tt.epoch, tt.number)
+		}
+	}
+	// A snapshot anchored to a trusted checkpoint counts the epochs before it
+	checkpoint := chain.headers[10]
+	engine.SetTrustedCheckpoint(&params.SyncCheckpoint{Number: 10, Hash: checkpoint.Hash()})
+	snap, err := engine.snapshot(chain, 10, checkpoint.Hash(), nil)
+	if err != nil {
+		t.Fatalf("failed to create trusted snapshot: %v", err)
+	}
+	if !snap.EpochKnown || snap.Epoch != 3 {
+		t.Fatalf("trusted snapshot epoch mismatch: have %d (known %v), want 3", snap.Epoch, snap.EpochKnown)
+	}
+	// Unknown past checkpoints leave the epochs unknown instead of guessing them
+	light := &testFinalityChain{config: chain.config, headers: chain.headers[:7]}
+	trusted := newSnapshot(chain.config, engine.signatures, 10, checkpoint.Hash(), nil)
+	engine.deriveEpoch(light, trusted)
+	if trusted.EpochKnown {
+		t.Fatalf("epoch derived without the past checkpoints: %d", trusted.Epoch)
+	}
+}
+
+// Tests that the validator set history is backfilled for the checkpoints passed before it
+// was indexed, and that the changes passed afterwards are indexed under the right epochs.
+func TestGetValidatorSetHistory(t *testing.T) {
+	chain := newTestHistoryChain(t)
+	engine := New(chain.config, rawdb.NewMemoryDatabase())
+	api := &API{chain: chain, democracy: engine}
+
+	history, err := api.GetValidatorSetHistory(0, 10)
+	if err != nil {
+		t.Fatalf("failed to retrieve history: %v", err)
+	}
+	want := []uint64{4, 7, 10, 13}
+	if len(history) != len(want) {
+		t.Fatalf("history length mismatch: have %d, want %d", len(history), len(want))
+	}
+	for i, change := range history {
+		if change.Epoch != uint64(i+1) || change.Number != want[i] || change.Hash != chain.headers[want[i]].Hash() {
+			t.Errorf("change %d mismatch: have epoch %d at %d, want epoch %d at %d", i, change.Epoch, change.Number, i+1, want[i])
+		}
+		if len(change.Validators) != 1 || len(change.Added) != 0 || len(change.Removed) != 0 {
+			t.Errorf("change %d validators mismatch: %+v", i, change)
+		}
+		if indexed := readValidatorSetChanges(engine.db, change.Epoch); len(indexed) != 1 || indexed[0].Number != change.Number {
+			t.Errorf("change %d not indexed", i)
+		}
+	}
+	if history, err := api.GetValidatorSetHistory(2, 3); err != nil || len(history) != 2 || history[0].Number != 7 {
+		t.Fatalf("partial history mismatch: %v (%v)", history, err)
+	}
+	if history, err := api.GetValidatorSetHistory(5, 6); err != nil || len(history) != 0 {
+		t.Fatalf("future history mismatch: %v (%v)", history, err)
+	}
+	// Snapshots applying the checkpoints index their changes
+	fresh := New(chain.config, rawdb.NewMemoryDatabase())
+	if _, err := fresh.snapshot(chain, 14, chain.headers[14].Hash(), nil); err != nil {
+		t.Fatalf("failed to apply chain: %v", err)
+	}
+	for epoch, number := range want {
+		if indexed := readValidatorSetChanges(fresh.db, uint64(epoch+1)); len(indexed) != 1 || indexed[0].Number != number {
+			t.Errorf("epoch %d not indexed at checkpoint %d: %v", epoch+1, number, indexed)
+		}
+	}
+}
+
+// newTestCheckpointChain creates a chain of 17 blocks with the inactivity leak active from
+// the genesis block, and epochs of 4 blocks. Three validators seal the blocks in turn, until
+// the checkpoint at block 12 switches to the first two announced at block 8. If certify is
+// set, blocks 6, 14 and 16 carry a finality certificate of their parent.
+func newTestCheckpointChain(t *testing.T, certify bool) *testFinalityChain {
+	keys, validators := newTestValidators(t, 3)
+	chain := &testFinalityChain{
+		config: &params.ChainConfig{MarsBlock: big.NewInt(0), NeptuneBlock: big.NewInt(0),
+			Democracy: &params.DemocracyConfig{Period: 3, Epoch: 4,
+				InactivityLeak: &params.InactivityLeak{Block: big.NewInt(0), Epochs: 1}}},
+		status: make(map[common.Hash]uint8),
+	}
+	announce := func(validators []common.Address) []byte {
+		var extra []byte
+		for _, validator := range validators {
+			extra = append(extra, validator.Bytes()...)
+		}
+		return extra
+	}
+	genesis := &types.Header{Number: big.NewInt(0), Difficulty: diffInTurn,
+		Extra: append(append(make([]byte, extraVanity), announce(validators)...), make([]byte, extraSeal)...)}
+	chain.headers = append(chain.headers, genesis)
+
+	for i := 1; i <= 16; i++ {
+		active := len(validators)
+		if i > 12 {
+			active = 2
+		}
+		signer := i % (active * int(params.ContinousInturn)) / int(params.ContinousInturn)
+
+		certs := []*types.FinalityCertificate{}
+		if certify && (i == 6 || i == 14 || i == 16) {
+			cert, err := types.NewFinalityCertificate(signTestAttestations(t, keys[:active], chain.headers[i-2], chain.headers[i-1]))
+			if err != nil {
+				t.Fatalf("failed to create certificate: %v", err)
+			}
+			certs = append(certs, cert)
+		}
+		extra, err := rlp.EncodeToBytes(certs)
+		if err != nil {
+			t.Fatalf("failed to encode certificates: %v", err)
+		}
+		if i%4 == 0 {
+			if i == 8 {
+				extra = append(extra, announce(validators[:2])...)
+			} else {
+				extra = append(extra, announce(validators)...)
+			}
+		}
+		header := &types.Header{Number: big.NewInt(int64(i)), ParentHash: chain.headers[i-1].Hash(),
+			Coinbase: validators[signer], Difficulty: diffInTurn, Time: uint64(i) * 3,
+			Extra: append(append(make([]byte, extraVanity), extra...), make([]byte, extraSeal)...)}
+		chain.headers = append(chain.headers, sealTestHeader(t, header, keys[signer]))
+	}
+	return chain
+}
+
+// Tests that the snapshot of a trusted checkpoint is derived from the epochs leading to it
+// as if the chain was applied from the genesis block, and that the checkpoints whose
+// snapshot can't be derived that way are refused.
+func TestTrustedSnapshot(t *testing.T) {
+	chain := newTestCheckpointChain(t, true)
+	checkpoint := chain.headers[16]
+
+	want, err := New(chain.config, rawdb.NewMemoryDatabase()).snapshot(chain, 16, checkpoint.Hash(), nil)
+	if err != nil {
+		t.Fatalf("failed to apply chain: %v", err)
+	}
+	if len(want.Recents) == 0 || len(want.Participants) == 0 || want.Certified != 15 {
+		t.Fatalf("applied snapshot too trivial: %+v", want)
+	}
+	// The headers before the epochs covering the recent validators aren't needed
+	pruned := &testFinalityChain{config: chain.config, headers: append([]*types.Header{}, chain.headers...)}
+	for i := 1; i < 4; i++ {
+		pruned.headers[i] = nil
+	}
+	engine := New(chain.config, rawdb.NewMemoryDatabase())
+	engine.SetTrustedCheckpoint(&params.SyncCheckpoint{Number: 16, Hash: checkpoint.Hash()})
+	have, err := engine.snapshot(pruned, 16, checkpoint.Hash(), nil)
+	if err != nil {
+		t.Fatalf("failed to create trusted snapshot: %v", err)
+	}
+	haveJSON, _ := json.Marshal(have)
+	wantJSON, _ := json.Marshal(want)
+	if !bytes.Equal(haveJSON, wantJSON) {
+		t.Fatalf("trusted snapshot mismatch:\nhave %s\nwant %s", haveJSON, wantJSON)
+	}
+	if changes := readValidatorSetChanges(engine.db, 4); len(changes) != 1 || changes[0].Number != 16 || len(changes[0].Added) != 1 {
+		t.Errorf("checkpoint validator set change not indexed: %v", changes)
+	}
+	// Blocks which aren't checkpoints can't be trusted
+	engine.SetTrustedCheckpoint(&params.SyncCheckpoint{Number: 15, Hash: chain.headers[15].Hash()})
+	if _, err := engine.snapshot(chain, 15, chain.headers[15].Hash(), nil); !errors.Is(err, errUnderivableCheckpoint) {
+		t.Errorf("non-checkpoint error mismatch: have %v, want %v", err, errUnderivableCheckpoint)
+	}
+	// Without a finality certificate the inactivity leak can't be derived
+	uncertified := newTestCheckpointChain(t, false)
+	engine = New(uncertified.config, rawdb.NewMemoryDatabase())
+	engine.SetTrustedCheckpoint(&params.SyncCheckpoint{Number: 16, Hash: uncertified.headers[16].Hash()})
+	if _, err := engine.snapshot(uncertified, 16, uncertified.headers[16].Hash(), nil); !errors.Is(err, errUnderivableCheckpoint) {
+		t.Errorf("uncertified checkpoint error mismatch: have %v, want %v", err, errUnderivableCheckpoint)
+	}
+}
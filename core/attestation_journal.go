@@ -0,0 +1,159 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"io"
+	"os"
+
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/log"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+)
+
+// attestationJournal is a rotating log of attestations with the aim of storing the
+// pending and recently processed ones, so that the attestation pool and the data
+// needed to check the CasperFFG rules survive node restarts.
+type attestationJournal struct {
+	path   string         // Filesystem path to store the attestations at
+	writer io.WriteCloser // Output stream to write new attestations into
+}
+
+// newAttestationJournal creates a new attestation journal to
+func newAttestationJournal(path string) *attestationJournal {
+	return &attestationJournal{
+		path: path,
+	}
+}
+
+// load parses an attestation journal dump from disk, loading its contents into
+// the specified pool.
+func (journal *attestationJournal) load(add func([]*types.Attestation) []error) error {
+	// Skip the parsing if the journal file doesn't exist at all
+	if _, err := os.Stat(journal.path); os.IsNotExist(err) {
+		return nil
+	}
+	// Open the journal for loading any past attestations
+	input, err := os.Open(journal.path)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	// Temporarily discard any journal additions (don't double add on load)
+	journal.writer = new(devNull)
+	defer func() { journal.writer = nil }()
+
+	// Inject all attestations from the journal into the pool
+	stream := rlp.NewStream(input, 0)
+	total, dropped := 0, 0
+
+	loadBatch := func(as []*types.Attestation) {
+		for _, err := range add(as) {
+			if err != nil {
+				log.Debug("Failed to add journaled attestation", "err", err)
+				dropped++
+			}
+		}
+	}
+	var (
+		failure error
+		batch   []*types.Attestation
+	)
+	for {
+		// Parse the next attestation and terminate on error
+		a := new(types.Attestation)
+		if err = stream.Decode(a); err != nil {
+			if err != io.EOF {
+				failure = err
+			}
+			if len(batch) > 0 {
+				loadBatch(batch)
+			}
+			break
+		}
+		// New attestation parsed, queue up for later, import if threshold is reached
+		total++
+
+		if batch = append(batch, a); len(batch) > 1024 {
+			loadBatch(batch)
+			batch = batch[:0]
+		}
+	}
+	log.Info("Loaded attestation journal", "attestations", total, "dropped", dropped)
+
+	return failure
+}
+
+// insert adds the specified attestation to the local disk journal.
+func (journal *attestationJournal) insert(a *types.Attestation) error {
+	if journal.writer == nil {
+		return errNoActiveJournal
+	}
+	if err := rlp.Encode(journal.writer, a); err != nil {
+		return err
+	}
+	return nil
+}
+
+// rotate regenerates the attestation journal based on the current contents of
+// the attestation pool.
+func (journal *attestationJournal) rotate(all []*types.Attestation) error {
+	// Close the current journal (if any is open)
+	if journal.writer != nil {
+		if err := journal.writer.Close(); err != nil {
+			return err
+		}
+		journal.writer = nil
+	}
+	// Generate a new journal with the contents of the current pool
+	replacement, err := os.OpenFile(journal.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	for _, a := range all {
+		if err = rlp.Encode(replacement, a); err != nil {
+			replacement.Close()
+			return err
+		}
+	}
+	replacement.Close()
+
+	// Replace the live journal with the newly generated one
+	if err = os.Rename(journal.path+".new", journal.path); err != nil {
+		return err
+	}
+	sink, err := os.OpenFile(journal.path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	journal.writer = sink
+	log.Debug("Regenerated attestation journal", "attestations", len(all))
+
+	return nil
+}
+
+// close flushes the attestation journal contents to disk and closes the file.
+func (journal *attestationJournal) close() error {
+	var err error
+
+	if journal.writer != nil {
+		err = journal.writer.Close()
+		journal.writer = nil
+	}
+	return err
+}
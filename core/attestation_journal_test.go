@@ -0,0 +1,88 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+)
+
+// Tests that the attestations of a rotated journal and the ones inserted afterwards
+// are all loaded back, in order, and that rotating drops the stale ones.
+func TestAttestationJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "attestations.rlp")
+
+	var loaded []*types.Attestation
+	add := func(as []*types.Attestation) []error {
+		loaded = append(loaded, as...)
+		return make([]error, len(as))
+	}
+	// A missing journal is an empty one
+	journal := newAttestationJournal(path)
+	if err := journal.load(add); err != nil || len(loaded) != 0 {
+		t.Fatalf("missing journal loaded: %d attestations, %v", len(loaded), err)
+	}
+	if err := journal.insert(&types.Attestation{}); err != errNoActiveJournal {
+		t.Fatalf("insert into inactive journal error mismatch: have %v, want %v", err, errNoActiveJournal)
+	}
+	source := &types.RangeEdge{Hash: common.Hash{0x1}, Number: big.NewInt(1)}
+	as := make([]*types.Attestation, 4)
+	for i := range as {
+		target := &types.RangeEdge{Hash: common.Hash{byte(i + 2)}, Number: big.NewInt(int64(i + 2))}
+		as[i] = signTestAttestation(t, source, target)
+	}
+	if err := journal.rotate(as[:2]); err != nil {
+		t.Fatalf("failed to rotate journal: %v", err)
+	}
+	for _, a := range as[2:] {
+		if err := journal.insert(a); err != nil {
+			t.Fatalf("failed to insert attestation: %v", err)
+		}
+	}
+	if err := journal.close(); err != nil {
+		t.Fatalf("failed to close journal: %v", err)
+	}
+	journal = newAttestationJournal(path)
+	if err := journal.load(add); err != nil {
+		t.Fatalf("failed to load journal: %v", err)
+	}
+	if len(loaded) != len(as) {
+		t.Fatalf("loaded attestations mismatch: have %d, want %d", len(loaded), len(as))
+	}
+	for i, a := range loaded {
+		if a.Hash() != as[i].Hash() {
+			t.Errorf("attestation %d mismatch: have %x, want %x", i, a.Hash(), as[i].Hash())
+		}
+	}
+	// Rotating regenerates the journal with the given attestations only
+	if err := journal.rotate(as[3:]); err != nil {
+		t.Fatalf("failed to rotate journal: %v", err)
+	}
+	journal.close()
+
+	loaded = nil
+	if err := newAttestationJournal(path).load(add); err != nil {
+		t.Fatalf("failed to load journal: %v", err)
+	}
+	if len(loaded) != 1 || loaded[0].Hash() != as[3].Hash() {
+		t.Fatalf("rotated journal mismatch: have %d attestations", len(loaded))
+	}
+}
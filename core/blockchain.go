@@ -131,6 +131,9 @@ type CacheConfig struct {
 	SnapshotLimit       int           // Memory allowance (MB) to use for caching snapshot entries in memory
 	Preimages           bool          // Whether to store preimage of trie key to the disk
 
+	AttestationJournal   string        // Disk journal for saving the attestation pool across restarts
+	AttestationRejournal time.Duration // Time interval to regenerate the attestation journal
+
 	SnapshotWait bool // Wait for snapshot construction on startup. TODO(karalabe): This is a dirty hack for testing, nuke it
 }
 
@@ -183,6 +186,7 @@ type BlockChain struct {
 	blockProcFeed                    event.Feed
 	newAttestationFeed               event.Feed
 	newJustifiedOrFinalizedBlockFeed event.Feed
+	newAggregateAttestationFeed      event.Feed
 	scope                            event.SubscriptionScope
 	genesisBlock                     *types.Block
 
@@ -229,11 +233,19 @@ type BlockChain struct {
 	currentEpochCheckBps atomic.Value // types.EpochCheckBps
 	lock                 sync.RWMutex
 
+	attestationJournal   *attestationJournal        // Journal of the attestation pool to survive node restarts
+	ownAttestations      types.CasperFFGHistoryList // Votes of the local validator, mirrored from the database
+	ownAttestationsOwner common.Address             // Validator whose votes are mirrored in ownAttestations
+
 	lockAddOneAttestationToRecentCache sync.RWMutex
 	lockHistoryAttessCache             sync.RWMutex
 	lockFutureAttessCache              sync.RWMutex
 	lockRecentAttessCache              sync.RWMutex
 	lockCasperFFGHistoryCache          sync.RWMutex
+	lockAggregateAttestations          sync.Mutex
+	lockAttestationJournal             sync.Mutex
+	lockOwnAttestations                sync.Mutex
+	lockVoteIndex                      sync.Mutex
 }
 
 // NewBlockChain returns a fully initialised block chain using information
@@ -430,8 +442,20 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 	bc.wg.Add(1)
 	go bc.futureBlocksLoop()
 
-	// Start attestation processor
+	// Start attestation processor, restoring the attestation pool of the last run first
 	if bc.isDemocracy {
+		if bc.cacheConfig.AttestationJournal != "" {
+			if bc.cacheConfig.AttestationRejournal < time.Second {
+				log.Warn("Sanitizing invalid attestation journal time", "provided", bc.cacheConfig.AttestationRejournal, "updated", time.Second)
+				bc.cacheConfig.AttestationRejournal = time.Second
+			}
+			bc.attestationJournal = newAttestationJournal(bc.cacheConfig.AttestationJournal)
+
+			if err := bc.attestationJournal.load(bc.addJournaledAttestations); err != nil {
+				log.Warn("Failed to load attestation journal", "err", err)
+			}
+			bc.rotateAttestationJournal()
+		}
 		bc.wg.Add(1)
 		go bc.attestationHandleLoop()
 	}
@@ -852,6 +876,12 @@ func (bc *BlockChain) Stop() {
 	// waiting async commit to finish
 	bc.stateCache.TrieDB().WaitAsyncCommit()
 
+	// Regenerate the attestation journal with the final contents of the pool.
+	if bc.attestationJournal != nil {
+		bc.rotateAttestationJournal()
+		bc.attestationJournal.close()
+	}
+
 	// Ensure that the entirety of the state snapshot is journalled to disk.
 	var snapBase common.Hash
 	if bc.snaps != nil {
@@ -1415,6 +1445,9 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 	// Set new head.
 	if status == CanonStatTy {
 		bc.writeHeadBlock(block)
+		if bc.isDemocracy {
+			bc.applyFinalityCertificates(block.Header())
+		}
 	}
 	bc.futureBlocks.Remove(block.Hash())
 
@@ -2070,6 +2103,9 @@ func (bc *BlockChain) reorg(oldBlock, newBlock *types.Block) error {
 	for i := len(newChain) - 1; i >= 1; i-- {
 		// Insert the block in the canonical way, re-writing history
 		bc.writeHeadBlock(newChain[i])
+		if bc.isDemocracy {
+			bc.applyFinalityCertificates(newChain[i].Header())
+		}
 
 		// Collect reborn logs due to chain reorg
 		collectLogs(newChain[i].Hash(), false)
@@ -2302,3 +2338,20 @@ func (bc *BlockChain) InsertHeaderChain(chain []*types.Header, checkFreq int) (i
 	_, err := bc.hc.InsertHeaderChain(chain, start)
 	return 0, err
 }
+
+// InsertTrustedHeaderChain is like InsertHeaderChain, but doesn't verify the headers
+// against the consensus rules. It's meant for headers authenticated by other means,
+// e.g. by linking their hash chain to a trusted checkpoint.
+func (bc *BlockChain) InsertTrustedHeaderChain(chain []*types.Header) (int, error) {
+	start := time.Now()
+	if i, err := bc.hc.checkHeaderChain(chain); err != nil {
+		return i, err
+	}
+
+	if !bc.chainmu.TryLock() {
+		return 0, errChainStopped
+	}
+	defer bc.chainmu.Unlock()
+	_, err := bc.hc.InsertHeaderChain(chain, start)
+	return 0, err
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"github.com/QEasyWeb3/QEasyChain/params"
 	"math/big"
 	"runtime"
 	"sort"
@@ -12,6 +11,7 @@ import (
 	"time"
 
 	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
 	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
 	"github.com/QEasyWeb3/QEasyChain/core/types"
 	"github.com/QEasyWeb3/QEasyChain/log"
@@ -37,20 +37,48 @@ const (
 	unableSureBlockStateInterval = 100
 )
 
+var (
+	// ErrInvalidAttestation is returned if an attestation is malformed or carries an invalid signature.
+	ErrInvalidAttestation = errors.New("invalid attestation")
+
+	// ErrUnauthorizedAttester is returned if an attestation isn't signed by a validator of its epoch.
+	ErrUnauthorizedAttester = errors.New("the signer of the current attestation is not a valid verifier in the current epoch")
+
+	// ErrAttestationBlockMismatch is returned if the blocks of an attestation don't match the local chain.
+	ErrAttestationBlockMismatch = errors.New("the block information in the current proof does not match the local data")
+
+	// ErrUnverifiableAttestation is returned if the signers of an attestation can't be checked against the
+	// validators known locally, as its target is unknown or their keys were rotated or registered since.
+	ErrUnverifiableAttestation = errors.New("the signers of the current attestation can't be verified locally")
+)
+
 // HandleAttestation The attestations received from other P2P nodes are processed through a series of security checks.
 // The certificates that meet the inspection will be stored according to the height of the current chain plot.
 // If they are higher than the local height, they will be stored in the future cache.
 func (bc *BlockChain) HandleAttestation(a *types.Attestation) error {
+	return bc.handleAttestation(a, bc.VerifyValidLimit)
+}
+
+// HandleBackfillAttestation processes an attestation retrieved while backfilling the justification of a
+// range of blocks. Unlike the gossiped ones, attestations of blocks older than the gossip window are
+// accepted as long as the chain still tries to settle the state of their target.
+func (bc *BlockChain) HandleBackfillAttestation(a *types.Attestation) error {
+	return bc.handleAttestation(a, bc.VerifyBackfillLimit)
+}
+
+// handleAttestation runs the security checks of HandleAttestation, dropping the attestations whose
+// target is outside the accepted limit.
+func (bc *BlockChain) handleAttestation(a *types.Attestation, inLimit func(num uint64, currentNum uint64) bool) error {
 	currentBlockNumber := bc.CurrentBlock().NumberU64()
 	if err := a.SanityCheck(); err != nil {
-		return err
+		return fmt.Errorf("%w: %v", ErrInvalidAttestation, err)
 	}
 	sourceNumber := a.SourceRangeEdge.Number.Uint64()
 	targetNumber := a.TargetRangeEdge.Number.Uint64()
 	if targetNumber-sourceNumber > unableSureBlockStateInterval {
-		return errors.New("inspection interval not conforming to attestation")
+		return fmt.Errorf("%w: inspection interval not conforming to attestation", ErrInvalidAttestation)
 	}
-	if !bc.VerifyValidLimit(targetNumber, currentBlockNumber) {
+	if !inLimit(targetNumber, currentBlockNumber) {
 		return nil
 	}
 
@@ -64,16 +92,17 @@ func (bc *BlockChain) HandleAttestation(a *types.Attestation) error {
 			return nil
 		}
 	}
-	signer, err := a.RecoverSigner()
+	signer, err := bc.attestationValidator(a)
 	if err != nil {
 		log.Warn("RecoverSigner error:", "err", err.Error())
 		return err
 	}
+	bc.observeAttestation(signer, a.TargetRangeEdge)
 	if !bc.VerifyLocalDataCheck(a, currentBlockNumber) {
-		return errors.New("the block information in the current proof does not match the local data")
+		return ErrAttestationBlockMismatch
 	}
 	if !bc.VerifySignerInEpochValidBP(targetNumber, signer) {
-		return errors.New("the signer of the current attestation is not a valid verifier in the current epoch")
+		return ErrUnauthorizedAttester
 	}
 	if targetNumber <= currentBlockNumber {
 		return bc.AddOneAttestationToRecentCache(a, signer, false)
@@ -87,10 +116,20 @@ func (bc *BlockChain) attestationHandleLoop() {
 	chainHeadCh := make(chan ChainHeadEvent)
 	sub := bc.SubscribeChainHeadEvent(chainHeadCh)
 	defer sub.Unsubscribe()
+
+	// Periodically regenerate the attestation journal, if one is configured
+	var journal <-chan time.Time
+	if bc.attestationJournal != nil {
+		ticker := time.NewTicker(bc.cacheConfig.AttestationRejournal)
+		defer ticker.Stop()
+		journal = ticker.C
+	}
 	for {
 		select {
 		case ev := <-chainHeadCh:
 			bc.processAttestationOnHead(ev.Block.Header())
+		case <-journal:
+			bc.rotateAttestationJournal()
 		case <-bc.quit:
 			return
 		}
@@ -115,18 +154,18 @@ func (bc *BlockChain) attestationHandleLoop() {
 // NewAttestation = {source: block height to be processed before - 100, target: block height to be processed before}
 // When enough new block certificates are not received, the node continues to create the above certificates until the
 // qualified or finalized block state of the new block is received, and then the network returns to normal
-func (bc *BlockChain) bestAttestationToProcessed(headNum *big.Int) (*types.Attestation, error) {
-	currentNeedHandleHeight, err := bc.Democracy.CurrentNeedHandleHeight(headNum.Uint64())
+func (bc *BlockChain) bestAttestationRange(head *types.Header) (*types.RangeEdge, *types.RangeEdge, error) {
+	currentNeedHandleHeight, err := bc.Democracy.CurrentNeedHandleHeight(bc, head)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	latestAttestedNum := bc.currentAttestedNumber.Load().(*big.Int).Uint64()
 	if currentNeedHandleHeight <= latestAttestedNum { // Prevent multiple signups due to block rollback
-		return nil, errors.New("the current block height does not reach the range")
+		return nil, nil, errors.New("the current block height does not reach the range")
 	}
 	re, err := bc.LastValidJustifiedOrFinalized()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	block := bc.GetBlockByNumber(currentNeedHandleHeight)
 	target := &types.RangeEdge{
@@ -140,7 +179,7 @@ func (bc *BlockChain) bestAttestationToProcessed(headNum *big.Int) (*types.Attes
 		if re.Number.Uint64() <= diffNumber {
 			b := bc.GetBlockByNumber(diffNumber)
 			source := &types.RangeEdge{Number: new(big.Int).Set(b.Number()), Hash: b.Hash()}
-			return bc.Democracy.Attest(bc, new(big.Int).SetUint64(currentNeedHandleHeight), source, target)
+			return source, target, nil
 		}
 	}
 	// Fast update
@@ -150,11 +189,11 @@ func (bc *BlockChain) bestAttestationToProcessed(headNum *big.Int) (*types.Attes
 		if status == types.BasJustified || status == types.BasFinalized {
 			b := bc.GetBlockByNumber(latestAttestedNum)
 			source := &types.RangeEdge{Number: new(big.Int).Set(b.Number()), Hash: b.Hash()}
-			return bc.Democracy.Attest(bc, new(big.Int).SetUint64(currentNeedHandleHeight), source, target)
+			return source, target, nil
 		}
-		return nil, errors.New("the current block height does not reach the range")
+		return nil, nil, errors.New("the current block height does not reach the range")
 	}
-	return bc.Democracy.Attest(bc, new(big.Int).SetUint64(currentNeedHandleHeight), re, target)
+	return re, target, nil
 }
 
 // Subscribe to the ChainHeadEvent message. After obtaining the new block event, first check whether it meets
@@ -165,9 +204,9 @@ func (bc *BlockChain) processAttestationOnHead(head *types.Header) {
 	if bc.Democracy.AttestationStatus() == types.AttestationPending {
 		// Give priority to judge whether it has caught up
 		firstCatchup := bc.firstCatchUpNumber.Load().(*big.Int)
-		attestationDelay := bc.Democracy.AttestationDelay()
+		attestationDelay := bc.Democracy.AttestationDelay(bc, head)
 		// Prevent false triggering during node initialization
-		if firstCatchup.Uint64() == 0 && head.Number.Uint64() > params.ContinousInturn*catchUpSafetyMultiple &&
+		if firstCatchup.Uint64() == 0 && head.Number.Uint64() > bc.Democracy.ContinuousInturn(bc, head)*catchUpSafetyMultiple &&
 			uint64(time.Now().Unix()) <= head.Time+catchUpDiffTime {
 			num := head.Number.Uint64() + catchUpDiffBlocks
 			bc.firstCatchUpNumber.Store(new(big.Int).SetUint64(num))
@@ -176,8 +215,10 @@ func (bc *BlockChain) processAttestationOnHead(head *types.Header) {
 		firstCatchup = bc.firstCatchUpNumber.Load().(*big.Int)
 		if firstCatchup.Uint64() > 0 && head.Number.Uint64() > firstCatchup.Uint64()+attestationDelay &&
 			(head.Number.Uint64()-firstCatchup.Uint64()-attestationDelay >= unableSureBlockStateInterval || !bc.Democracy.IsReady()) {
-			bc.Democracy.StartAttestation()
-			log.Info("✨StartAttestation", "firstCatchup", firstCatchup.Uint64(), "currentHeight", head.Number.Uint64())
+			// The attestation may stay pending, e.g. during doppelganger detection
+			if bc.Democracy.StartAttestation(); bc.Democracy.AttestationStatus() == types.AttestationStart {
+				log.Info("✨StartAttestation", "firstCatchup", firstCatchup.Uint64(), "currentHeight", head.Number.Uint64())
+			}
 		}
 	}
 
@@ -189,31 +230,20 @@ func (bc *BlockChain) processAttestationOnHead(head *types.Header) {
 	if bc.Democracy.IsReadyAttest() {
 		// From the perspective of the current node itself, all it can do is create
 		// attestation in turn, and it cannot initiate across heights
-		a, err := bc.bestAttestationToProcessed(head.Number)
+		source, target, err := bc.bestAttestationRange(head)
 		if err != nil {
 			log.Warn(err.Error())
 			return
 		}
-		if a == nil {
-			return
-		}
-		isExist := bc.IsExistsRecentCache(a)
-		if isExist {
-			return
-		}
-		log.Debug("Create a attestation", "SourceNum", a.SourceRangeEdge.Number.Uint64(),
-			"TargetNum", a.TargetRangeEdge.Number.Uint64())
-		threshold, err := bc.Democracy.AttestationThreshold(bc, a.TargetRangeEdge.Hash, a.TargetRangeEdge.Number.Uint64())
-		if err != nil {
-			log.Error(err.Error())
-			return
-		}
-		err = bc.AddOneValidAttestationToRecentCache(a, threshold, bc.Democracy.CurrentValidator())
-		if err != nil {
-			log.Error(err.Error())
+		if bc.chainConfig.IsJupiter(target.Number) {
+			if err := bc.attestAggregate(source, target); err != nil {
+				log.Warn(err.Error())
+				return
+			}
+		} else if err := bc.attest(source, target); err != nil {
+			log.Warn(err.Error())
 			return
 		}
-		bc.StoreLastAttested(a.TargetRangeEdge.Number)
 	}
 	err = bc.MoveAttestsCacheFutureToRecent(head.Number)
 	if err != nil {
@@ -221,6 +251,35 @@ func (bc *BlockChain) processAttestationOnHead(head *types.Header) {
 	}
 }
 
+// attest creates a secp256k1 attestation of the local validator, and adds it to the recent cache
+func (bc *BlockChain) attest(source, target *types.RangeEdge) error {
+	validator := bc.Democracy.CurrentValidator()
+	if err := bc.verifyOwnAttestation(validator, source, target); err != nil {
+		return err
+	}
+	a, err := bc.Democracy.Attest(bc, target.Number, source, target)
+	if err != nil || a == nil {
+		return err
+	}
+	bc.storeOwnAttestation(validator, source, target, a.Hash())
+	isExist := bc.IsExistsRecentCache(a)
+	if isExist {
+		return nil
+	}
+	log.Debug("Create a attestation", "SourceNum", a.SourceRangeEdge.Number.Uint64(),
+		"TargetNum", a.TargetRangeEdge.Number.Uint64())
+	threshold, err := bc.Democracy.AttestationThreshold(bc, a.TargetRangeEdge.Hash, a.TargetRangeEdge.Number.Uint64())
+	if err != nil {
+		return err
+	}
+	err = bc.AddOneValidAttestationToRecentCache(a, threshold, validator)
+	if err != nil {
+		return err
+	}
+	bc.StoreLastAttested(a.TargetRangeEdge.Number)
+	return nil
+}
+
 // LastValidJustifiedOrFinalized Get the last valid block status information after the specified block
 func (bc *BlockChain) LastValidJustifiedOrFinalized() (*types.RangeEdge, error) {
 	last := bc.currentBlockStatusNumber.Load().(*big.Int)
@@ -249,6 +308,12 @@ func (bc *BlockChain) StoreLastAttested(num *big.Int) {
 
 // AddOneAttestationToRecentCache Trying to add a attestation to the RecentCache store requires a series of checks
 func (bc *BlockChain) AddOneAttestationToRecentCache(a *types.Attestation, signer common.Address, isTest bool) error {
+	return bc.addOneAttestationToRecentCache(a, signer, isTest, true)
+}
+
+// addOneAttestationToRecentCache Check an attestation and add it to the RecentCache store, broadcasting it to
+// other nodes only if requested
+func (bc *BlockChain) addOneAttestationToRecentCache(a *types.Attestation, signer common.Address, isTest bool, broadcast bool) error {
 	bc.lockAddOneAttestationToRecentCache.Lock()
 	defer bc.lockAddOneAttestationToRecentCache.Unlock()
 	// check again whether it's already exists or not
@@ -271,7 +336,11 @@ func (bc *BlockChain) AddOneAttestationToRecentCache(a *types.Attestation, signe
 	if err != nil {
 		return err
 	}
-	return bc.AddOneValidAttestationToRecentCache(a, threshold, signer)
+	err = bc.VerifyCasperFFGVoteIndex(a, signer)
+	if err != nil {
+		return err
+	}
+	return bc.addOneValidAttestationToRecentCache(a, threshold, signer, broadcast)
 }
 
 // Get the goroutine ID of the current execution, which is used to locate problems among multiple goroutines
@@ -287,6 +356,12 @@ func (bc *BlockChain) goID() uint64 {
 // AddOneValidAttestationToRecentCache Add a valid attestation to RecentCache storage, broadcast the corresponding
 // data to other nodes, and store the corresponding data for historical data and CasperFFG rule verification
 func (bc *BlockChain) AddOneValidAttestationToRecentCache(a *types.Attestation, threshold int, signer common.Address) error {
+	return bc.addOneValidAttestationToRecentCache(a, threshold, signer, true)
+}
+
+// addOneValidAttestationToRecentCache Add a valid attestation to RecentCache storage, broadcasting it and the blocks
+// it justifies to other nodes only if requested
+func (bc *BlockChain) addOneValidAttestationToRecentCache(a *types.Attestation, threshold int, signer common.Address, broadcast bool) error {
 	bc.lockRecentAttessCache.Lock()
 	defer bc.lockRecentAttessCache.Unlock()
 
@@ -322,7 +397,7 @@ func (bc *BlockChain) AddOneValidAttestationToRecentCache(a *types.Attestation,
 			if err != nil {
 				log.Error(err.Error())
 			}
-			if status == types.BasJustified || status == types.BasFinalized {
+			if broadcast && (status == types.BasJustified || status == types.BasFinalized) {
 				bc.BroadcastNewJustifiedOrFinalizedBlockToOtherNodes(
 					&types.BlockStatus{BlockNumber: treNumber, Hash: treHash,
 						Status: status})
@@ -331,8 +406,12 @@ func (bc *BlockChain) AddOneValidAttestationToRecentCache(a *types.Attestation,
 	}
 	log.Debug("🙋 Received a valid attestation", "number", treNumberUint64, "totalCount", totalCount,
 		"threshold", threshold, "GoId", bc.goID())
-	bc.BroadcastNewAttestationToOtherNodes(a)
+	if broadcast {
+		bc.BroadcastNewAttestationToOtherNodes(a)
+	}
 	bc.addOneValidAttestationToHistoryCache(a)
+	bc.journalAttestation(a)
+	bc.indexVote(signer, a)
 	return bc.addOneValidAttestationForCasperFFG(signer, a)
 }
 
@@ -354,6 +433,7 @@ func (bc *BlockChain) AddOneAttestationToFutureCache(a *types.Attestation) error
 	}
 
 	bc.FutureAttessCache.Add(a.TargetRangeEdge.Number.Uint64(), cAs)
+	bc.journalAttestation(a)
 	return nil
 }
 
@@ -393,6 +473,29 @@ func (bc *BlockChain) AddBlockBasJustified(num *big.Int, hash common.Hash) (uint
 	return currentBlockStatus, bc.UpdateBlockStatus(num, hash, currentBlockStatus)
 }
 
+// applyFinalityCertificates Update the local block status with the finality certificates embedded in the header.
+// The certificates have been verified together with the header, so a node that missed the attestations
+// gossip can still learn the justified blocks from the chain itself. As the block status is tracked
+// by number, only the certificates of canonical blocks are applied. They are persisted as well, to keep
+// serving the attestations of their targets once evicted from the HistoryAttessCache
+func (bc *BlockChain) applyFinalityCertificates(header *types.Header) {
+	certs, err := bc.Democracy.FinalityCertificates(header)
+	if err != nil {
+		log.Warn("Failed to read finality certificates", "number", header.Number, "hash", header.Hash(), "err", err)
+		return
+	}
+	for _, cert := range certs {
+		target := cert.TargetRangeEdge
+		rawdb.WriteFinalityCertificate(bc.db, cert)
+		if status, _ := bc.GetBlockStatusByNum(target.Number.Uint64()); status != types.BasUnknown {
+			continue
+		}
+		if _, err := bc.AddBlockBasJustified(target.Number, target.Hash); err != nil {
+			log.Error("Failed to apply finality certificate", "number", target.Number, "hash", target.Hash, "err", err)
+		}
+	}
+}
+
 // addOneValidAttestationForCasperFFG Store corresponding data for casperffg rule judgment.
 // The data here is stored in the cache. For punishment, only try your best
 func (bc *BlockChain) addOneValidAttestationForCasperFFG(signer common.Address, a *types.Attestation) error {
@@ -436,7 +539,7 @@ func (bc *BlockChain) MoveAttestsCacheFutureToRecent(num *big.Int) error {
 			if isExist {
 				continue
 			}
-			signer, err := a.RecoverSigner()
+			signer, err := bc.attestationValidator(a)
 			if err != nil {
 				continue
 			}
@@ -508,6 +611,12 @@ func (bc *BlockChain) VerifyValidLimit(num uint64, currentNum uint64) bool {
 	return bc.VerifyLowerLimit(num, currentNum) && bc.VerifyUpperLimit(num, currentNum)
 }
 
+// VerifyBackfillLimit checks whether a backfilled attestation targets a block of the local chain
+// whose state can still be settled.
+func (bc *BlockChain) VerifyBackfillLimit(num uint64, currentNum uint64) bool {
+	return num <= currentNum && num+unableSureBlockStateInterval >= currentNum
+}
+
 func (bc *BlockChain) IsExistsRecentCache(a *types.Attestation) bool {
 	bc.lockRecentAttessCache.Lock()
 	defer bc.lockRecentAttessCache.Unlock()
@@ -556,8 +665,21 @@ func (bc *BlockChain) BroadcastNewJustifiedOrFinalizedBlockToOtherNodes(bs *type
 	bc.newJustifiedOrFinalizedBlockFeed.Send(NewJustifiedOrFinalizedBlockEvent{bs})
 }
 
+// CalculateCurrentEpochIndex returns the index of the epoch a block belongs to. The epoch length
+// is governed on chain, so the canonical blocks are looked up, and the future ones are counted
+// from the epoch of the chain head.
 func (bc *BlockChain) CalculateCurrentEpochIndex(number uint64) uint64 {
-	return number / bc.chainConfig.Democracy.Epoch
+	if header := bc.GetHeaderByNumber(number); header != nil {
+		if index, _, _, err := bc.Democracy.EpochAt(bc, header.Hash(), number); err == nil {
+			return index
+		}
+	}
+	head := bc.CurrentHeader()
+	index, checkpoint, length, err := bc.Democracy.EpochAt(bc, head.Hash(), head.Number.Uint64())
+	if err != nil || number < checkpoint {
+		return index
+	}
+	return index + (number-checkpoint)/length
 }
 
 // UpdateCurrentEpochBPList Continuously update the BP list within two epoch cycles for verification when receiving the
@@ -568,7 +690,10 @@ func (bc *BlockChain) UpdateCurrentEpochBPList(hash common.Hash, number uint64)
 	if value != nil {
 		last = value.(*types.EpochCheckBps)
 	}
-	newCurrentEpochIndex := bc.CalculateCurrentEpochIndex(number)
+	newCurrentEpochIndex, checkpoint, _, err := bc.Democracy.EpochAt(bc, hash, number)
+	if err != nil {
+		return err
+	}
 	if last == nil || last.CurrentEpochIndex.Uint64() < newCurrentEpochIndex {
 		bps, err := bc.Democracy.Validators(bc, hash, number)
 		if err != nil {
@@ -579,11 +704,13 @@ func (bc *BlockChain) UpdateCurrentEpochBPList(hash common.Hash, number uint64)
 		if last != nil {
 			lastEpochBps = last.CurrentEpochBps
 			lastEpochIndex = new(big.Int).Set(last.CurrentEpochIndex)
-		} else { //Update previous cycle
-			epoch := bc.chainConfig.Democracy.Epoch
-			if number > epoch {
-				block := bc.GetBlockByNumber(number - epoch)
-				lastBps, err := bc.Democracy.Validators(bc, block.Hash(), block.NumberU64())
+		} else { //Update previous cycle, the block before the checkpoint is the last one of the previous epoch
+			if checkpoint > 0 {
+				header := bc.GetHeaderByNumber(checkpoint - 1)
+				if header == nil {
+					return consensus.ErrUnknownAncestor
+				}
+				lastBps, err := bc.Democracy.Validators(bc, header.Hash(), checkpoint-1)
 				if err != nil {
 					return err
 				}
@@ -671,6 +798,25 @@ func (bc *BlockChain) GetHistoryAttestations(num *big.Int, hash common.Hash) ([]
 	return nil, errors.New("not found")
 }
 
+// GetBlockAttestations Provide access interface for the attestations of a block that are still known, either
+// collected from the network or kept by the finality certificate embedded in the chain once they are evicted,
+// together with the stored aggregate attestations targeting it
+func (bc *BlockChain) GetBlockAttestations(num *big.Int, hash common.Hash) ([]*types.Attestation, []*types.AggregateAttestation) {
+	attestations, err := bc.GetHistoryAttestations(num, hash)
+	if err != nil {
+		if cert := rawdb.ReadFinalityCertificate(bc.db, num.Uint64(), hash); cert != nil {
+			attestations = cert.Attestations()
+		}
+	}
+	var aggregates []*types.AggregateAttestation
+	for _, a := range bc.GetAggregateAttestations(num.Uint64()) {
+		if a.TargetRangeEdge.Hash == hash {
+			aggregates = append(aggregates, a)
+		}
+	}
+	return attestations, aggregates
+}
+
 // GetHistoryOneAttestation Gets the certificate specified in the history
 func (bc *BlockChain) GetHistoryOneAttestation(num *big.Int, hash common.Hash, aHash common.Hash) (*types.Attestation, error) {
 	aList, err := bc.GetHistoryAttestations(num, hash)
@@ -790,3 +936,34 @@ func (bc *BlockChain) IsNeedReorgByCasperFFG(oldBlock, newBlock *types.Block) (u
 func (bc *BlockChain) MaxValidators() uint8 {
 	return bc.Democracy.MaxValidators()
 }
+
+// observeAttestation hands the signer of an attestation seen on the network to the
+// doppelganger detection, timed by its target block
+func (bc *BlockChain) observeAttestation(signer common.Address, target *types.RangeEdge) {
+	// Attestations of unknown blocks can't be timed, and could be replayed from any time
+	header := bc.GetHeader(target.Hash, target.Number.Uint64())
+	if header == nil {
+		return
+	}
+	bc.Democracy.ObserveSignature(signer, target.Number.Uint64(), header.Time)
+}
+
+// attestationValidator recovers the key signing an attestation and resolves the validator
+// it belongs to at the target block, as the consensus key of a validator may be rotated
+func (bc *BlockChain) attestationValidator(a *types.Attestation) (common.Address, error) {
+	key, err := a.RecoverSigner()
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %v", ErrInvalidAttestation, err)
+	}
+	// The keys signing future blocks are resolved with the ones active at the head, then again
+	// once the target is imported
+	hash, number := a.TargetRangeEdge.Hash, a.TargetRangeEdge.Number.Uint64()
+	if head := bc.CurrentBlock(); number > head.NumberU64() {
+		hash, number = head.Hash(), head.NumberU64()
+	}
+	validator, err := bc.Democracy.ValidatorOfKey(bc, key, hash, number)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %v", ErrUnverifiableAttestation, err)
+	}
+	return validator, nil
+}
@@ -0,0 +1,176 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/log"
+)
+
+// HandleAggregateAttestation The aggregate attestations received from other P2P nodes are processed through
+// the same security checks as the single attestations. Valid aggregates are merged into the one stored
+// locally for their (source,target) pair, so that a single signature and a signer bitfield is kept per pair.
+func (bc *BlockChain) HandleAggregateAttestation(a *types.AggregateAttestation) error {
+	return bc.handleAggregateAttestation(a, bc.VerifyValidLimit)
+}
+
+// HandleBackfillAggregateAttestation processes an aggregate attestation retrieved while backfilling the
+// justification of a range of blocks, see HandleBackfillAttestation.
+func (bc *BlockChain) HandleBackfillAggregateAttestation(a *types.AggregateAttestation) error {
+	return bc.handleAggregateAttestation(a, bc.VerifyBackfillLimit)
+}
+
+// handleAggregateAttestation runs the checks of HandleAggregateAttestation, dropping the aggregates
+// whose target is outside the accepted limit.
+func (bc *BlockChain) handleAggregateAttestation(a *types.AggregateAttestation, inLimit func(num uint64, currentNum uint64) bool) error {
+	currentBlockNumber := bc.CurrentBlock().NumberU64()
+	if err := a.SanityCheck(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidAttestation, err)
+	}
+	if !bc.chainConfig.IsJupiter(a.TargetRangeEdge.Number) {
+		return fmt.Errorf("%w: aggregate attestation before the Jupiter fork", ErrInvalidAttestation)
+	}
+	sourceNumber := a.SourceRangeEdge.Number.Uint64()
+	targetNumber := a.TargetRangeEdge.Number.Uint64()
+	if targetNumber-sourceNumber > unableSureBlockStateInterval {
+		return fmt.Errorf("%w: inspection interval not conforming to attestation", ErrInvalidAttestation)
+	}
+	// Aggregates keep being gossiped while they grow, so the ones of future blocks
+	// are simply dropped instead of being cached
+	if !inLimit(targetNumber, currentBlockNumber) || targetNumber > currentBlockNumber {
+		return nil
+	}
+	if bc.isKnownAggregateAttestation(a) {
+		return nil
+	}
+	if (sourceNumber != 0 && !bc.HasBlock(a.SourceRangeEdge.Hash, sourceNumber)) ||
+		!bc.HasBlock(a.TargetRangeEdge.Hash, targetNumber) {
+		return ErrAttestationBlockMismatch
+	}
+	branch, err := bc.IsFiliation(a.SourceRangeEdge, a.TargetRangeEdge)
+	if err != nil || !branch {
+		return errors.New("it is currently proved that the two blocks are not in the same branch")
+	}
+	// The signers of an aggregate are checked against the locally known BLS keys of the validators
+	signers, threshold, err := bc.Democracy.VerifyAggregateAttestation(bc, a)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnverifiableAttestation, err)
+	}
+	for _, signer := range signers {
+		bc.observeAttestation(signer, a.TargetRangeEdge)
+	}
+	// The votes folded into the aggregate are held to the CasperFFG rules like the single ones
+	if err := bc.verifyAggregateVoteIndex(a, signers); err != nil {
+		return err
+	}
+	bc.indexAggregateVote(signers, a)
+	return bc.addValidAggregateAttestation(a, threshold)
+}
+
+// attestAggregate creates a BLS attestation of the local validator, and merges it into the stored aggregate
+func (bc *BlockChain) attestAggregate(source, target *types.RangeEdge) error {
+	validator := bc.Democracy.CurrentValidator()
+	if err := bc.verifyOwnAttestation(validator, source, target); err != nil {
+		return err
+	}
+	a, err := bc.Democracy.AttestAggregate(bc, source, target)
+	if err != nil {
+		return err
+	}
+	bc.storeOwnAttestation(validator, source, target, a.Hash())
+	bc.indexAggregateVote([]common.Address{validator}, a)
+	log.Debug("Create an aggregate attestation", "SourceNum", source.Number.Uint64(), "TargetNum", target.Number.Uint64())
+	threshold, err := bc.Democracy.AttestationThreshold(bc, target.Hash, target.Number.Uint64())
+	if err != nil {
+		return err
+	}
+	if err := bc.addValidAggregateAttestation(a, threshold); err != nil {
+		return err
+	}
+	bc.StoreLastAttested(target.Number)
+	return nil
+}
+
+// isKnownAggregateAttestation checks whether all the signers of an aggregate are already
+// included in the aggregate stored for the same (source,target) pair
+func (bc *BlockChain) isKnownAggregateAttestation(a *types.AggregateAttestation) bool {
+	stored := rawdb.ReadAggregateAttestation(bc.db, a.TargetRangeEdge.Number.Uint64(), a.SignHash())
+	if stored == nil {
+		return false
+	}
+	for _, index := range a.SignerIndexes() {
+		if !stored.HasSigner(index) {
+			return false
+		}
+	}
+	return true
+}
+
+// addValidAggregateAttestation Merge a valid aggregate attestation into the stored one, justify the target
+// block once enough validators signed it, and broadcast the grown aggregate to other nodes
+func (bc *BlockChain) addValidAggregateAttestation(a *types.AggregateAttestation, threshold int) error {
+	bc.lockAggregateAttestations.Lock()
+	defer bc.lockAggregateAttestations.Unlock()
+
+	treNumber := a.TargetRangeEdge.Number
+	treHash := a.TargetRangeEdge.Hash
+
+	merged := a
+	if stored := rawdb.ReadAggregateAttestation(bc.db, treNumber.Uint64(), a.SignHash()); stored != nil {
+		m, err := stored.Merge(a)
+		if err != nil {
+			// Overlapping signers, keep whichever aggregate holds more signatures
+			if stored.Count() >= a.Count() {
+				return nil
+			}
+		} else {
+			merged = m
+		}
+	}
+	rawdb.WriteAggregateAttestation(bc.db, merged)
+
+	totalCount := merged.Count()
+	if totalCount >= threshold {
+		status, _ := bc.GetBlockStatusByNum(treNumber.Uint64())
+		if status == types.BasUnknown { // not found
+			status, err := bc.AddBlockBasJustified(treNumber, treHash)
+			if err != nil {
+				log.Error(err.Error())
+			}
+			if status == types.BasJustified || status == types.BasFinalized {
+				bc.BroadcastNewJustifiedOrFinalizedBlockToOtherNodes(
+					&types.BlockStatus{BlockNumber: treNumber, Hash: treHash,
+						Status: status})
+			}
+		}
+	}
+	log.Debug("🙋 Received a valid aggregate attestation", "number", treNumber.Uint64(), "totalCount", totalCount,
+		"threshold", threshold)
+	bc.BroadcastNewAggregateAttestationToOtherNodes(merged)
+	return nil
+}
+
+// pruneAggregateAttestations Remove the stored aggregate attestations targeting blocks below the last finalized
+// one. The justification of those blocks can't change anymore, and is kept by the certificates of the chain
+func (bc *BlockChain) pruneAggregateAttestations(finalized uint64) {
+	if !bc.chainConfig.IsJupiter(new(big.Int).SetUint64(finalized)) {
+		return
+	}
+	bc.lockAggregateAttestations.Lock()
+	defer bc.lockAggregateAttestations.Unlock()
+
+	rawdb.DeleteAggregateAttestations(bc.db, finalized)
+}
+
+// GetAggregateAttestations Provide access interface for the stored aggregate attestations of a block
+func (bc *BlockChain) GetAggregateAttestations(number uint64) []*types.AggregateAttestation {
+	return rawdb.ReadAggregateAttestations(bc.db, number)
+}
+
+func (bc *BlockChain) BroadcastNewAggregateAttestationToOtherNodes(a *types.AggregateAttestation) {
+	bc.newAggregateAttestationFeed.Send(NewAggregateAttestationEvent{a})
+}
@@ -0,0 +1,184 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/log"
+)
+
+const (
+	// ownAttestationsLimit is the number of the latest votes of a local validator kept on disk.
+	ownAttestationsLimit = 1024
+)
+
+var (
+	// errStaleJournaledAttestation is returned if a journaled attestation is too far
+	// from the current head to be of any use for the attestation pool anymore.
+	errStaleJournaledAttestation = errors.New("journaled attestation out of range")
+
+	// errOwnCasperFFGViolation is returned if the local validator is about to give a vote
+	// that would break the CasperFFG rules together with one of its former votes.
+	errOwnCasperFFGViolation = errors.New("attestation would violate the CasperFFG rules with a former vote")
+)
+
+// addJournaledAttestations Restore the attestations of the journal into the attestation pool. The ones
+// targeting past blocks are re-verified like the ones received from other nodes, so that the history
+// used to check the CasperFFG rules is restored too, while the future ones wait for the head to reach them.
+// The restored attestations were relayed before the restart already, they aren't broadcast again
+func (bc *BlockChain) addJournaledAttestations(as []*types.Attestation) []error {
+	head := bc.CurrentBlock().NumberU64()
+	errs := make([]error, len(as))
+	for i, a := range as {
+		if errs[i] = a.SanityCheck(); errs[i] != nil {
+			continue
+		}
+		target := a.TargetRangeEdge.Number.Uint64()
+		switch {
+		case target+unableSureBlockStateInterval < head || !bc.VerifyUpperLimit(target, head):
+			errs[i] = errStaleJournaledAttestation
+		case target > head:
+			if !bc.IsExistsFutureCache(a) {
+				errs[i] = bc.AddOneAttestationToFutureCache(a)
+			}
+		default:
+			signer, err := bc.attestationValidator(a)
+			if err != nil {
+				errs[i] = err
+				continue
+			}
+			errs[i] = bc.addOneAttestationToRecentCache(a, signer, false, false)
+		}
+	}
+	return errs
+}
+
+// journaledAttestations Collect the attestations of the pool worth surviving a restart, namely the
+// future ones and the history of the recent blocks, ordered by their target number
+func (bc *BlockChain) journaledAttestations() []*types.Attestation {
+	head := bc.CurrentBlock().NumberU64()
+
+	var all []*types.Attestation
+	bc.lockHistoryAttessCache.Lock()
+	for _, key := range bc.HistoryAttessCache.Keys() {
+		if number := key.(uint64); number+unableSureBlockStateInterval < head {
+			continue
+		}
+		if as, found := bc.HistoryAttessCache.Peek(key); found {
+			for _, list := range as.(*types.HistoryAttestations).Attestations {
+				all = append(all, list...)
+			}
+		}
+	}
+	bc.lockHistoryAttessCache.Unlock()
+
+	bc.lockFutureAttessCache.Lock()
+	for _, key := range bc.FutureAttessCache.Keys() {
+		if as, found := bc.FutureAttessCache.Peek(key); found {
+			for _, a := range as.(*types.FutureAttestations).Attestations {
+				all = append(all, a)
+			}
+		}
+	}
+	bc.lockFutureAttessCache.Unlock()
+
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].TargetRangeEdge.Number.Cmp(all[j].TargetRangeEdge.Number) < 0
+	})
+	return all
+}
+
+// journalAttestation Add an attestation accepted into the pool to the local disk journal, if any
+func (bc *BlockChain) journalAttestation(a *types.Attestation) {
+	if bc.attestationJournal == nil {
+		return
+	}
+	bc.lockAttestationJournal.Lock()
+	defer bc.lockAttestationJournal.Unlock()
+
+	if err := bc.attestationJournal.insert(a); err != nil {
+		log.Warn("Failed to journal attestation", "err", err)
+	}
+}
+
+// rotateAttestationJournal Regenerate the attestation journal from the current contents of the pool,
+// dropping the attestations that aren't needed anymore
+func (bc *BlockChain) rotateAttestationJournal() {
+	all := bc.journaledAttestations()
+
+	bc.lockAttestationJournal.Lock()
+	defer bc.lockAttestationJournal.Unlock()
+
+	if err := bc.attestationJournal.rotate(all); err != nil {
+		log.Warn("Failed to rotate attestation journal", "err", err)
+	}
+}
+
+// ownAttestationHistory Get the latest votes of a local validator, loading them from the database
+// the first time they are needed. The caller must hold lockOwnAttestations
+func (bc *BlockChain) ownAttestationHistory(val common.Address) types.CasperFFGHistoryList {
+	if bc.ownAttestations == nil || bc.ownAttestationsOwner != val {
+		bc.ownAttestations = rawdb.ReadOwnAttestations(bc.db, val)
+		bc.ownAttestationsOwner = val
+	}
+	return bc.ownAttestations
+}
+
+// verifyOwnAttestation Verify that a new vote of a local validator doesn't break the CasperFFG rules together
+// with any of its former votes, including the ones given before the node was restarted
+func (bc *BlockChain) verifyOwnAttestation(val common.Address, source, target *types.RangeEdge) error {
+	bc.lockOwnAttestations.Lock()
+	defer bc.lockOwnAttestations.Unlock()
+
+	for _, h := range bc.ownAttestationHistory(val) {
+		ruleType := bc.Democracy.VerifyCasperFFGRule(h.SourceNum.Uint64(), h.TargetNum.Uint64(),
+			source.Number.Uint64(), target.Number.Uint64())
+		if ruleType != types.PunishNone {
+			log.Warn("Refused to attest against a former vote", "source", source.Number, "target", target.Number,
+				"formerSource", h.SourceNum, "formerTarget", h.TargetNum)
+			return errOwnCasperFFGViolation
+		}
+	}
+	return nil
+}
+
+// storeOwnAttestation Persist a vote given by a local validator, keeping only the latest ownAttestationsLimit ones
+func (bc *BlockChain) storeOwnAttestation(val common.Address, source, target *types.RangeEdge, hash common.Hash) {
+	bc.lockOwnAttestations.Lock()
+	defer bc.lockOwnAttestations.Unlock()
+
+	history := append(bc.ownAttestationHistory(val), &types.CasperFFGHistory{
+		TargetNum:       new(big.Int).Set(target.Number),
+		SourceNum:       new(big.Int).Set(source.Number),
+		TargetHash:      target.Hash,
+		AttestationHash: hash,
+	})
+	rawdb.WriteOwnAttestation(bc.db, val, history[len(history)-1])
+
+	sort.Sort(history)
+	for len(history) > ownAttestationsLimit {
+		rawdb.DeleteOwnAttestation(bc.db, val, history[0].TargetNum.Uint64())
+		history = history[1:]
+	}
+	bc.ownAttestations = history
+}
@@ -0,0 +1,261 @@
+package core
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/consensus/ethash"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+	"github.com/QEasyWeb3/QEasyChain/crypto/bls"
+	"github.com/QEasyWeb3/QEasyChain/ethdb"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// testDemocracy is a democracy engine accepting every attestation signed by a key as a
+// vote of the validator with the same address.
+type testDemocracy struct {
+	consensus.Democracy
+	db       ethdb.Database   // Database the CasperFFG violations are stored into
+	observed []common.Address // Signers handed to the doppelganger detection
+}
+
+func (d *testDemocracy) GetDb() ethdb.Database {
+	return d.db
+}
+
+func (d *testDemocracy) ObserveSignature(signer common.Address, number uint64, timestamp uint64) {
+	d.observed = append(d.observed, signer)
+}
+
+func (d *testDemocracy) ValidatorOfKey(chain consensus.ChainHeaderReader, key common.Address, hash common.Hash, number uint64) (common.Address, error) {
+	if chain.GetHeader(hash, number) == nil {
+		return common.Address{}, errors.New("unknown block")
+	}
+	return key, nil
+}
+
+func (d *testDemocracy) VerifyAttestation(chain consensus.ChainHeaderReader, a *types.Attestation) (common.Address, int, error) {
+	signer, err := a.RecoverSigner()
+	return signer, 3, err
+}
+
+func (d *testDemocracy) VerifyCasperFFGRule(beforeSourceNum uint64, beforeTargetNum uint64, afterSourceNum uint64, afterTargetNum uint64) int {
+	if beforeTargetNum == afterTargetNum {
+		return types.PunishMultiSig
+	}
+	if (beforeSourceNum < afterSourceNum && beforeTargetNum > afterTargetNum) ||
+		(afterSourceNum < beforeSourceNum && afterTargetNum > beforeTargetNum) {
+		return types.PunishInclusive
+	}
+	return types.PunishNone
+}
+
+// newTestAttestationChain creates a block chain of n blocks with the attestation caches of
+// the democracy engine.
+func newTestAttestationChain(t *testing.T, n int) *BlockChain {
+	_, bc, err := newCanonical(ethash.NewFaker(), n, true)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	bc.Democracy = &testDemocracy{db: bc.db}
+	bc.FutureAttessCache, _ = lru.New(maxFutureAttestations)
+	bc.RecentAttessCache, _ = lru.New(attestationsCacheLimit)
+	bc.HistoryAttessCache, _ = lru.New(historyAttessCacheLimit)
+	bc.CasperFFGHistoryCache, _ = lru.New(casperFFGHistoryCacheLimit)
+	return bc
+}
+
+// signTestAttestation signs an attestation of the (source,target) pair with a new key.
+func signTestAttestation(t *testing.T, source, target *types.RangeEdge) *types.Attestation {
+	key, _ := crypto.GenerateKey()
+	sig, err := crypto.Sign(types.AttestationSignHash(source, target).Bytes(), key)
+	if err != nil {
+		t.Fatalf("failed to sign attestation: %v", err)
+	}
+	return types.NewAttestation(source, target, sig)
+}
+
+// Tests that the attestations of a block are served from the cache while they are collected,
+// and from the certificate embedded in the chain once evicted, together with the aggregates.
+func TestGetBlockAttestations(t *testing.T) {
+	bc := newTestAttestationChain(t, 0)
+	defer bc.Stop()
+
+	source := &types.RangeEdge{Hash: common.Hash{0x1}, Number: big.NewInt(1)}
+	target := &types.RangeEdge{Hash: common.Hash{0x2}, Number: big.NewInt(2)}
+	other := &types.RangeEdge{Hash: common.Hash{0x3}, Number: big.NewInt(2)}
+
+	// Blocks without any attestation are answered with empty lists
+	if attestations, aggregates := bc.GetBlockAttestations(target.Number, target.Hash); len(attestations) != 0 || len(aggregates) != 0 {
+		t.Fatalf("unknown block attestations: %d single, %d aggregates", len(attestations), len(aggregates))
+	}
+	// Certified attestations are served once evicted from the cache
+	certified := []*types.Attestation{signTestAttestation(t, source, target), signTestAttestation(t, source, target)}
+	cert, err := types.NewFinalityCertificate(certified)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	rawdb.WriteFinalityCertificate(bc.db, cert)
+
+	attestations, _ := bc.GetBlockAttestations(target.Number, target.Hash)
+	if len(attestations) != len(certified) {
+		t.Fatalf("certified attestations mismatch: have %d, want %d", len(attestations), len(certified))
+	}
+	for i, a := range attestations {
+		if a.Hash() != certified[i].Hash() {
+			t.Errorf("certified attestation %d mismatch", i)
+		}
+	}
+	// Collected attestations are preferred while known
+	collected := signTestAttestation(t, source, target)
+	bc.addOneValidAttestationToHistoryCache(collected)
+	if attestations, _ := bc.GetBlockAttestations(target.Number, target.Hash); len(attestations) != 1 || attestations[0].Hash() != collected.Hash() {
+		t.Fatalf("collected attestations mismatch: have %d", len(attestations))
+	}
+	// Only the aggregates of the requested block are served
+	sk, _ := bls.GenerateKey()
+	for _, edge := range []*types.RangeEdge{target, other} {
+		a, err := types.NewAggregateAttestation(source, edge, 0, 4, sk.Sign(types.AttestationData(source, edge)).Bytes())
+		if err != nil {
+			t.Fatalf("failed to create aggregate: %v", err)
+		}
+		rawdb.WriteAggregateAttestation(bc.db, a)
+	}
+	_, aggregates := bc.GetBlockAttestations(target.Number, target.Hash)
+	if len(aggregates) != 1 || aggregates[0].TargetRangeEdge.Hash != target.Hash {
+		t.Fatalf("aggregates mismatch: have %d", len(aggregates))
+	}
+}
+
+// Tests that the attestations restored from the journal are verified into the pool without
+// being broadcast again, while the future ones wait for their target.
+func TestAddJournaledAttestations(t *testing.T) {
+	bc := newTestAttestationChain(t, 4)
+	defer bc.Stop()
+
+	events := make(chan NewAttestationEvent, 10)
+	sub := bc.SubscribeNewAttestationEvent(events)
+	defer sub.Unsubscribe()
+
+	genesis := &types.RangeEdge{Hash: bc.Genesis().Hash(), Number: big.NewInt(0)}
+	edge := func(number uint64) *types.RangeEdge {
+		header := bc.GetHeaderByNumber(number)
+		return &types.RangeEdge{Hash: header.Hash(), Number: header.Number}
+	}
+	past := signTestAttestation(t, genesis, edge(3))
+	future := signTestAttestation(t, edge(3), &types.RangeEdge{Hash: common.Hash{0x1}, Number: big.NewInt(6)})
+
+	for i, err := range bc.addJournaledAttestations([]*types.Attestation{past, future}) {
+		if err != nil {
+			t.Fatalf("attestation %d rejected: %v", i, err)
+		}
+	}
+	if !bc.IsExistsRecentCache(past) || !bc.IsExistsFutureCache(future) {
+		t.Fatalf("journaled attestations not restored")
+	}
+	if _, err := bc.GetHistoryOneAttestation(past.TargetRangeEdge.Number, past.TargetRangeEdge.Hash, past.Hash()); err != nil {
+		t.Fatalf("journaled attestation missing from history: %v", err)
+	}
+	select {
+	case ev := <-events:
+		t.Fatalf("journaled attestation broadcast: %x", ev.A.Hash())
+	default:
+	}
+	// Attestations received from the network are still broadcast
+	if err := bc.AddOneAttestationToRecentCache(signTestAttestation(t, genesis, edge(4)), common.Address{}, false); err != nil {
+		t.Fatalf("failed to add attestation: %v", err)
+	}
+	select {
+	case <-events:
+	default:
+		t.Fatalf("received attestation not broadcast")
+	}
+}
+
+// Tests that only the attestations of known blocks are handed to the doppelganger detection,
+// the other ones can't be timed.
+func TestObserveAttestation(t *testing.T) {
+	bc := newTestAttestationChain(t, 2)
+	defer bc.Stop()
+
+	head := bc.CurrentBlock()
+	known := &types.RangeEdge{Hash: head.Hash(), Number: head.Number()}
+	unknown := &types.RangeEdge{Hash: common.Hash{0x1}, Number: big.NewInt(3)}
+
+	bc.observeAttestation(common.Address{0x1}, unknown)
+	bc.observeAttestation(common.Address{0x2}, known)
+
+	observed := bc.Democracy.(*testDemocracy).observed
+	if len(observed) != 1 || observed[0] != (common.Address{0x2}) {
+		t.Fatalf("observed signers mismatch: have %v, want [%x]", observed, common.Address{0x2})
+	}
+}
+
+// Tests that the signers of attestations are resolved with the keys known at their target, or
+// at the head for future targets, and that only the malformed signatures are invalid.
+func TestAttestationValidator(t *testing.T) {
+	bc := newTestAttestationChain(t, 2)
+	defer bc.Stop()
+
+	head := bc.CurrentBlock()
+	source := &types.RangeEdge{Hash: head.Hash(), Number: head.Number()}
+
+	future := signTestAttestation(t, source, &types.RangeEdge{Hash: common.Hash{0x1}, Number: big.NewInt(4)})
+	signer, _ := future.RecoverSigner()
+	if validator, err := bc.attestationValidator(future); err != nil || validator != signer {
+		t.Fatalf("future attestation validator mismatch: have %x (%v), want %x", validator, err, signer)
+	}
+	unknown := signTestAttestation(t, &types.RangeEdge{Hash: bc.Genesis().Hash(), Number: big.NewInt(0)}, &types.RangeEdge{Hash: common.Hash{0x1}, Number: big.NewInt(1)})
+	if _, err := bc.attestationValidator(unknown); !errors.Is(err, ErrUnverifiableAttestation) {
+		t.Fatalf("unknown target error mismatch: have %v, want %v", err, ErrUnverifiableAttestation)
+	}
+	malformed := types.NewAttestation(source, future.TargetRangeEdge, make([]byte, 65))
+	if _, err := bc.attestationValidator(malformed); !errors.Is(err, ErrInvalidAttestation) {
+		t.Fatalf("malformed signature error mismatch: have %v, want %v", err, ErrInvalidAttestation)
+	}
+}
+
+// Tests that a local validator refuses to give a vote breaking the CasperFFG rules together
+// with a former one, including the votes given before a restart.
+func TestOwnAttestationGuard(t *testing.T) {
+	bc := newTestAttestationChain(t, 0)
+	defer bc.Stop()
+
+	val := common.Address{0x1}
+	edge := func(number int64) *types.RangeEdge {
+		return &types.RangeEdge{Hash: common.Hash{byte(number)}, Number: big.NewInt(number)}
+	}
+	bc.storeOwnAttestation(val, edge(1), edge(5), common.Hash{0xa})
+
+	tests := []struct {
+		source, target int64
+		err            error
+	}{
+		{5, 6, nil},                      // Next vote
+		{2, 5, errOwnCasperFFGViolation}, // Double vote
+		{0, 6, errOwnCasperFFGViolation}, // Surrounding vote
+		{2, 4, errOwnCasperFFGViolation}, // Surrounded vote
+	}
+	check := func() {
+		for i, tt := range tests {
+			if err := bc.verifyOwnAttestation(val, edge(tt.source), edge(tt.target)); err != tt.err {
+				t.Errorf("test %d: error mismatch: have %v, want %v", i, err, tt.err)
+			}
+		}
+	}
+	check()
+
+	// The votes are reloaded from the database after a restart
+	bc.ownAttestations = nil
+	check()
+
+	// Other validators aren't restricted by the votes
+	if err := bc.verifyOwnAttestation(common.Address{0x2}, edge(2), edge(4)); err != nil {
+		t.Fatalf("vote of another validator refused: %v", err)
+	}
+}
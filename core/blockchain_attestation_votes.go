@@ -0,0 +1,192 @@
+package core
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/log"
+)
+
+// VerifyCasperFFGVoteIndex Verify a new vote against the whole vote history of its validator kept on disk, so that
+// double votes and surround votes are caught even after the conflicting vote was evicted from the CasperFFGHistoryCache.
+// The violations are stored for punishment like the ones found in the cache, as long as the former vote is still kept
+// by the attestations of its target block
+func (bc *BlockChain) VerifyCasperFFGVoteIndex(a *types.Attestation, signer common.Address) error {
+	before, ruleType := bc.conflictingVote(signer, a.SourceRangeEdge.Number.Uint64(), a.TargetRangeEdge.Number.Uint64(), a.SignHash())
+	if ruleType == types.PunishNone {
+		return nil
+	}
+	// A single attestation conflicting with a vote folded into an aggregate can only happen
+	// around the Jupiter fork, it's rejected but can't be punished
+	if before != nil && !before.Aggregate {
+		key, err := a.RecoverSigner()
+		if err != nil {
+			return err
+		}
+		if p := bc.indexedAttestation(before, key); p != nil {
+			if err := bc.ViolationCasperFFGExecutePunish(p, a, ruleType, bc.CurrentBlock().Number()); err != nil {
+				return err
+			}
+		}
+	}
+	log.Debug("CasperFFG violation against the vote index", "validator", signer, "type", ruleType,
+		"2TNumer", a.TargetRangeEdge.Number.Uint64(), "2SNumer", a.SourceRangeEdge.Number.Uint64())
+	return voteIndexViolation(ruleType)
+}
+
+// verifyAggregateVoteIndex Verify every signer of a new aggregate vote against its vote history kept on disk. The
+// violations against a former aggregate are stored for punishment, as the aggregates of the same (source,target)
+// pair are merged and persisted until their target is finalized
+func (bc *BlockChain) verifyAggregateVoteIndex(a *types.AggregateAttestation, signers []common.Address) error {
+	source, target, signHash := a.SourceRangeEdge.Number.Uint64(), a.TargetRangeEdge.Number.Uint64(), a.SignHash()
+	for _, signer := range signers {
+		before, ruleType := bc.conflictingVote(signer, source, target, signHash)
+		if ruleType == types.PunishNone {
+			continue
+		}
+		if before != nil && before.Aggregate {
+			if stored := rawdb.ReadAggregateAttestation(bc.db, before.Target, before.SignHash); stored != nil {
+				evidence := types.NewAggregateVoteEvidence(signer, stored, a)
+				if _, err := bc.Democracy.VerifyAggregateVoteEvidence(bc, evidence); err != nil {
+					log.Debug("Discarded aggregate vote evidence", "validator", signer, "err", err)
+				} else if err := rawdb.WriteAggregateVoteEvidence(bc.db, evidence); err == nil {
+					log.Warn("Detected conflicting aggregate votes", "validator", signer, "type", ruleType,
+						"1TNumer", before.Target, "1SNumer", before.Source, "2TNumer", target, "2SNumer", source)
+				}
+			}
+		}
+		return voteIndexViolation(ruleType)
+	}
+	return nil
+}
+
+func voteIndexViolation(ruleType int) error {
+	if ruleType == types.PunishMultiSig {
+		return errors.New("multi-signature with indexed attestation")
+	}
+	return errors.New("inclusive relationship with indexed attestation")
+}
+
+// conflictingVote Find a former vote of a validator breaking the CasperFFG rules together with a new vote. The former
+// vote is nil if it was pruned from the vote index, a surround vote is still detected thanks to the span of the pruned
+// votes, but can't be punished anymore
+func (bc *BlockChain) conflictingVote(val common.Address, source, target uint64, signHash common.Hash) (*types.VoteRecord, int) {
+	bc.lockVoteIndex.Lock()
+	defer bc.lockVoteIndex.Unlock()
+
+	// Votes targeting the finalized blocks whose votes were pruned can't justify anything anymore
+	span := rawdb.ReadVoteSpan(bc.db, val)
+	if span != nil && target <= span.MaxTarget {
+		return nil, types.PunishNone
+	}
+	// Double vote: a different vote for the same target
+	if old := rawdb.ReadAttestationVote(bc.db, val, target); old != nil {
+		if old.SignHash != signHash {
+			return old, types.PunishMultiSig
+		}
+		return nil, types.PunishNone
+	}
+	// Surrounding vote of a pruned vote, all of them have a lower target
+	if span != nil && source < span.MaxSource {
+		return nil, types.PunishInclusive
+	}
+	// Surrounded or surrounding vote of a vote for a block not finalized yet
+	var from uint64
+	if span != nil {
+		from = span.MaxTarget + 1
+	}
+	for _, old := range rawdb.ReadAttestationVotes(bc.db, val, from) {
+		if (old.Source < source && old.Target > target) || (old.Source > source && old.Target < target) {
+			return old, types.PunishInclusive
+		}
+	}
+	return nil, types.PunishNone
+}
+
+// indexedAttestation Find the single attestation of an indexed vote signed by the given key, among the attestations
+// kept for its target block
+func (bc *BlockChain) indexedAttestation(vote *types.VoteRecord, key common.Address) *types.Attestation {
+	attestations, _ := bc.GetBlockAttestations(new(big.Int).SetUint64(vote.Target), vote.TargetHash)
+	for _, a := range attestations {
+		if a.SignHash() != vote.SignHash {
+			continue
+		}
+		if signer, err := a.RecoverSigner(); err == nil && signer == key {
+			return a
+		}
+	}
+	return nil
+}
+
+// indexVote Record a valid single attestation of a validator into the vote index
+func (bc *BlockChain) indexVote(val common.Address, a *types.Attestation) {
+	bc.indexVoteRecord(val, &types.VoteRecord{
+		Source:     a.SourceRangeEdge.Number.Uint64(),
+		Target:     a.TargetRangeEdge.Number.Uint64(),
+		TargetHash: a.TargetRangeEdge.Hash,
+		SignHash:   a.SignHash(),
+	})
+}
+
+// indexAggregateVote Record the votes of the signers of a valid aggregate attestation into the vote index
+func (bc *BlockChain) indexAggregateVote(signers []common.Address, a *types.AggregateAttestation) {
+	for _, signer := range signers {
+		bc.indexVoteRecord(signer, &types.VoteRecord{
+			Source:     a.SourceRangeEdge.Number.Uint64(),
+			Target:     a.TargetRangeEdge.Number.Uint64(),
+			TargetHash: a.TargetRangeEdge.Hash,
+			SignHash:   a.SignHash(),
+			Aggregate:  true,
+		})
+	}
+}
+
+// indexVoteRecord Record the first vote of a validator for a target into the vote index, unless the votes of the
+// target were already pruned
+func (bc *BlockChain) indexVoteRecord(val common.Address, vote *types.VoteRecord) {
+	bc.lockVoteIndex.Lock()
+	defer bc.lockVoteIndex.Unlock()
+
+	if span := rawdb.ReadVoteSpan(bc.db, val); span != nil && vote.Target <= span.MaxTarget {
+		return
+	}
+	if rawdb.ReadAttestationVote(bc.db, val, vote.Target) != nil {
+		return
+	}
+	rawdb.WriteAttestationVote(bc.db, val, vote)
+}
+
+// pruneVoteIndex Fold the votes targeting blocks below the last finalized one into the vote spans of their validators,
+// so that the vote index only keeps a record per validator for the blocks not finalized yet
+func (bc *BlockChain) pruneVoteIndex(finalized uint64) {
+	bc.lockVoteIndex.Lock()
+	defer bc.lockVoteIndex.Unlock()
+
+	votes := rawdb.ReadAttestationVotesBelow(bc.db, finalized)
+	if len(votes) == 0 {
+		return
+	}
+	batch := bc.db.NewBatch()
+	for val, records := range votes {
+		span := rawdb.ReadVoteSpan(bc.db, val)
+		if span == nil {
+			span = new(types.VoteSpan)
+		}
+		for _, vote := range records {
+			if vote.Source > span.MaxSource {
+				span.MaxSource = vote.Source
+			}
+			if vote.Target > span.MaxTarget {
+				span.MaxTarget = vote.Target
+			}
+			rawdb.DeleteAttestationVote(batch, val, vote.Target)
+		}
+		rawdb.WriteVoteSpan(batch, val, span)
+	}
+	if err := batch.Write(); err != nil {
+		log.Crit("Failed to prune vote index", "err", err)
+	}
+}
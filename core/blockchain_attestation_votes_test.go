@@ -0,0 +1,146 @@
+package core
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+)
+
+// testVote returns the vote of a (source,target) pair of numbers, the hashes of the blocks
+// being derived from their numbers.
+func testVote(source, target uint64) (*types.RangeEdge, *types.RangeEdge) {
+	return &types.RangeEdge{Hash: common.BigToHash(new(big.Int).SetUint64(source)), Number: new(big.Int).SetUint64(source)},
+		&types.RangeEdge{Hash: common.BigToHash(new(big.Int).SetUint64(target)), Number: new(big.Int).SetUint64(target)}
+}
+
+// signTestVote signs the attestation of a (source,target) pair of numbers with the given key.
+func signTestVote(t *testing.T, key *ecdsa.PrivateKey, source, target uint64) *types.Attestation {
+	s, tg := testVote(source, target)
+	sig, err := crypto.Sign(types.AttestationSignHash(s, tg).Bytes(), key)
+	if err != nil {
+		t.Fatalf("failed to sign attestation: %v", err)
+	}
+	return types.NewAttestation(s, tg, sig)
+}
+
+// Tests that the vote index catches the double votes and the surround votes in both directions,
+// against the votes of blocks not finalized yet as well as against the pruned ones.
+func TestConflictingVote(t *testing.T) {
+	bc := newTestAttestationChain(t, 0)
+	defer bc.Stop()
+
+	val, other := common.Address{0x1}, common.Address{0x2}
+	key, _ := crypto.GenerateKey()
+	bc.indexVote(val, signTestVote(t, key, 2, 5))
+
+	signHash := func(source, target uint64) common.Hash {
+		return types.AttestationSignHash(testVote(source, target))
+	}
+	tests := []struct {
+		val            common.Address
+		source, target uint64
+		rule           int
+		before         bool
+	}{
+		{val, 2, 5, types.PunishNone, false},       // Same vote again
+		{val, 3, 5, types.PunishMultiSig, true},    // Double vote
+		{val, 3, 4, types.PunishInclusive, true},   // Surrounded by the former vote
+		{val, 1, 6, types.PunishInclusive, true},   // Surrounding the former vote
+		{val, 5, 6, types.PunishNone, false},       // Next vote
+		{val, 1, 5, types.PunishMultiSig, true},    // Double vote with a lower source
+		{other, 3, 5, types.PunishNone, false},     // Vote of another validator
+		{other, 1, 6, types.PunishNone, false},     // Vote of another validator
+		{val, 2, 4, types.PunishNone, false},       // Same source, lower target
+		{val, 0, 5, types.PunishMultiSig, true},    // Double vote from the genesis
+		{val, 3, 100, types.PunishNone, false},     // Same direction, higher source and target
+		{val, 0, 100, types.PunishInclusive, true}, // Surrounding from far away
+	}
+	for i, tt := range tests {
+		before, rule := bc.conflictingVote(tt.val, tt.source, tt.target, signHash(tt.source, tt.target))
+		if rule != tt.rule || (before != nil) != tt.before {
+			t.Errorf("test %d: conflict mismatch: have %d (%v), want %d (%v)", i, rule, before != nil, tt.rule, tt.before)
+		}
+		if before != nil && (before.Source != 2 || before.Target != 5) {
+			t.Errorf("test %d: former vote mismatch: have %d->%d, want 2->5", i, before.Source, before.Target)
+		}
+	}
+	// Once finalized, the votes are folded into the span of their validator
+	bc.indexVote(val, signTestVote(t, key, 5, 7))
+	bc.pruneVoteIndex(6)
+
+	if vote := rawdb.ReadAttestationVote(bc.db, val, 5); vote != nil {
+		t.Fatalf("finalized vote not pruned")
+	}
+	if vote := rawdb.ReadAttestationVote(bc.db, val, 7); vote == nil {
+		t.Fatalf("pending vote pruned")
+	}
+	if span := rawdb.ReadVoteSpan(bc.db, val); span == nil || span.MaxSource != 2 || span.MaxTarget != 5 {
+		t.Fatalf("vote span mismatch: have %+v, want {2 5}", span)
+	}
+	tests = []struct {
+		val            common.Address
+		source, target uint64
+		rule           int
+		before         bool
+	}{
+		{val, 3, 5, types.PunishNone, false},      // Vote for a pruned target
+		{val, 1, 8, types.PunishInclusive, false}, // Surrounding the pruned vote
+		{val, 4, 7, types.PunishMultiSig, true},   // Double vote against the pending vote
+		{val, 6, 7, types.PunishMultiSig, true},   // Double vote against the pending vote
+		{val, 6, 8, types.PunishNone, false},      // Next vote
+		{val, 6, 6, types.PunishInclusive, true},  // Surrounded by the pending vote
+	}
+	for i, tt := range tests {
+		before, rule := bc.conflictingVote(tt.val, tt.source, tt.target, signHash(tt.source, tt.target))
+		if rule != tt.rule || (before != nil) != tt.before {
+			t.Errorf("pruned test %d: conflict mismatch: have %d (%v), want %d (%v)", i, rule, before != nil, tt.rule, tt.before)
+		}
+	}
+	// The pruned targets aren't indexed again
+	bc.indexVote(val, signTestVote(t, key, 3, 4))
+	if vote := rawdb.ReadAttestationVote(bc.db, val, 4); vote != nil {
+		t.Fatalf("vote for a pruned target indexed")
+	}
+}
+
+// Tests that the violations against the vote index are stored for punishment together with
+// the former attestation, as long as it's still kept for its target block.
+func TestVerifyCasperFFGVoteIndex(t *testing.T) {
+	bc := newTestAttestationChain(t, 0)
+	defer bc.Stop()
+
+	key, _ := crypto.GenerateKey()
+	signer := crypto.PubkeyToAddress(key.PublicKey)
+
+	before := signTestVote(t, key, 2, 5)
+	bc.addOneValidAttestationToHistoryCache(before)
+	bc.indexVote(signer, before)
+
+	if err := bc.VerifyCasperFFGVoteIndex(signTestVote(t, key, 5, 6), signer); err != nil {
+		t.Fatalf("valid vote rejected: %v", err)
+	}
+	after := signTestVote(t, key, 1, 6)
+	if err := bc.VerifyCasperFFGVoteIndex(after, signer); err == nil {
+		t.Fatalf("surrounding vote accepted")
+	}
+	punishments := rawdb.ReadAllViolateCasperFFGPunish(bc.db)
+	if len(punishments) != 1 {
+		t.Fatalf("punishments mismatch: have %d, want 1", len(punishments))
+	}
+	if p := punishments[0]; p.Before.Hash() != before.Hash() || p.After.Hash() != after.Hash() || p.PunishType.Int64() != types.PunishInclusive {
+		t.Fatalf("punishment mismatch: have %x/%x (%d), want %x/%x (%d)", p.Before.Hash(), p.After.Hash(), p.PunishType, before.Hash(), after.Hash(), types.PunishInclusive)
+	}
+	// A vote conflicting with a pruned one is rejected, but can't be punished
+	bc.pruneVoteIndex(6)
+	if err := bc.VerifyCasperFFGVoteIndex(signTestVote(t, key, 0, 7), signer); err == nil {
+		t.Fatalf("vote surrounding a pruned vote accepted")
+	}
+	if punishments := rawdb.ReadAllViolateCasperFFGPunish(bc.db); len(punishments) != 1 {
+		t.Fatalf("punishments mismatch: have %d, want 1", len(punishments))
+	}
+}
@@ -49,6 +49,41 @@ func (bc *BlockChain) CurrentFastBlock() *types.Block {
 	return bc.currentFastBlock.Load().(*types.Block)
 }
 
+// CurrentSafeBlock retrieves the last justified or finalized block of the canonical
+// chain. Nil is returned if the consensus engine doesn't track block status, or if no
+// block was justified yet.
+func (bc *BlockChain) CurrentSafeBlock() *types.Block {
+	if !bc.isDemocracy {
+		return nil
+	}
+	number := bc.currentBlockStatusNumber.Load().(*big.Int).Uint64()
+	if finalized := bc.lastFinalizedBlockNumber.Load().(*big.Int).Uint64(); finalized > number {
+		number = finalized
+	}
+	if number == 0 {
+		return nil
+	}
+	if head := bc.CurrentBlock().NumberU64(); number > head {
+		number = head
+	}
+	return bc.GetBlockByNumber(number)
+}
+
+// CurrentFinalizedBlock retrieves the last block of the canonical chain finalized by
+// Casper-FFG. Unlike GetLastFinalizedBlockNumber, it never assumes the blocks deep
+// enough below the head to be final. Nil is returned if the consensus engine doesn't
+// track block status, or if no block was finalized yet.
+func (bc *BlockChain) CurrentFinalizedBlock() *types.Block {
+	if !bc.isDemocracy {
+		return nil
+	}
+	number := bc.lastFinalizedBlockNumber.Load().(*big.Int).Uint64()
+	if number == 0 {
+		return nil
+	}
+	return bc.GetBlockByNumber(number)
+}
+
 // HasHeader checks if a block header is present in the database or not, caching
 // it if present.
 func (bc *BlockChain) HasHeader(hash common.Hash, number uint64) bool {
@@ -418,6 +453,10 @@ func (bc *BlockChain) SubscribeNewAttestationEvent(ch chan<- NewAttestationEvent
 	return bc.scope.Track(bc.newAttestationFeed.Subscribe(ch))
 }
 
+func (bc *BlockChain) SubscribeNewAggregateAttestationEvent(ch chan<- NewAggregateAttestationEvent) event.Subscription {
+	return bc.scope.Track(bc.newAggregateAttestationFeed.Subscribe(ch))
+}
+
 func (bc *BlockChain) SubscribeNewJustifiedOrFinalizedBlockEvent(ch chan<- NewJustifiedOrFinalizedBlockEvent) event.Subscription {
 	return bc.scope.Track(bc.newJustifiedOrFinalizedBlockFeed.Subscribe(ch))
 }
@@ -35,13 +35,16 @@ func (bc *BlockChain) UpdateBlockStatus(num *big.Int, hash common.Hash, status u
 	if num.Cmp(last) > 0 && status == types.BasFinalized {
 		rawdb.WriteLastFinalizedBlockNumber(bc.db, num)
 		bc.lastFinalizedBlockNumber.Store(new(big.Int).Set(num))
+		bc.pruneAggregateAttestations(num.Uint64())
+		bc.pruneVoteIndex(num.Uint64())
 	}
 
 	if bc.Democracy.AttestationStatus() == types.AttestationPending {
 		firstCatchup := bc.firstCatchUpNumber.Load().(*big.Int)
 		if firstCatchup.Uint64() > 0 && num.Uint64() > firstCatchup.Uint64() {
-			bc.Democracy.StartAttestation()
-			log.Info("StartAttestation", "firstCatchup", firstCatchup.Uint64(), "latestJustifiedNumber", num.Uint64())
+			if bc.Democracy.StartAttestation(); bc.Democracy.AttestationStatus() == types.AttestationStart {
+				log.Info("StartAttestation", "firstCatchup", firstCatchup.Uint64(), "latestJustifiedNumber", num.Uint64())
+			}
 		}
 	}
 	return nil
@@ -47,6 +47,8 @@ type ChainHeadEvent struct{ Block *types.Block }
 
 type NewAttestationEvent struct{ A *types.Attestation }
 
+type NewAggregateAttestationEvent struct{ A *types.AggregateAttestation }
+
 type NewJustifiedOrFinalizedBlockEvent struct {
 	JF *types.BlockStatus
 }
@@ -472,7 +472,13 @@ func DefaultRopstenGenesisBlock() *Genesis {
 func BasicDemocracyGenesisBlock(config *params.ChainConfig, initialValidators []common.Address, faucet common.Address) *Genesis {
 	extraVanity := 32
 	extraData := make([]byte, extraVanity+65)
-	alloc := decodePrealloc(basicAllocForDemocracy)
+	// Start from the system contracts of the main network, without its funded accounts
+	alloc := make(GenesisAlloc)
+	for addr, account := range decodePrealloc(mainnetAllocData) {
+		if len(account.Code) > 0 {
+			alloc[addr] = account
+		}
+	}
 	if (faucet != common.Address{}) {
 		// 100M
 		b, _ := new(big.Int).SetString("100000000000000000000000000", 10)
@@ -532,7 +538,7 @@ func decodePrealloc(data string) GenesisAlloc {
 		Addr    *big.Int
 		Balance *big.Int
 		Code    []byte
-		Init    *initArgs
+		Init    *initArgs `rlp:"optional"`
 	}
 
 	if err := rlp.NewStream(strings.NewReader(data), 0).Decode(&p); err != nil {
@@ -27,6 +27,3 @@ const mainnetAllocData = "\xf9r\xfb\xf9;\x1f\x82\xf0\x00\x80\xb9;\x02`\x80`@R`\x
 const testnetAllocData = "\xf9h\x84\xf9Ic\x82\xf0\x00\x80\xb9I\\`\x80`@R`\x046\x10a\x01\xe2W`\x005`\xe0\x1c\x80c\x8a\x11\xd7\xc9\x11a\x01\x02W\x80c\xb6\u0205\x19\x11a\x00\x95W\x80c\xd6\xc0\xed\xad\x11a\x00dW\x80c\xd6\xc0\xed\xad\x14a\x0e\x90W\x80c\xdbx\xdd(\x14a\x0e\x98W\x80c\xef\xd8\xd8\xe2\x14a\x0e\xcaW\x80c\U0008837b\x14a\x0e\xdfWa\x01\xe2V[\x80c\xb6\u0205\x19\x14a\v\\W\x80c\xbedV\x92\x14a\x0e!W\x80c\xc2S\u00c4\x14a\x0e6W\x80c\xc9g\xf9\x0f\x14a\x0edWa\x01\xe2V[\x80c\xa2$\xce\xe7\x11a\x00\xd1W\x80c\xa2$\xce\xe7\x14a\x06\xbeW\x80c\xa4\x06\xfc\xb7\x14a\a9W\x80c\xa45i\xb3\x14a\t\x04W\x80c\xaf\xee\xa1\x15\x14a\vGWa\x01\xe2V[\x80c\x8a\x11\xd7\xc9\x14a\x05IW\x80c\x8b\x0e\x9f?\x14a\x06\x11W\x80c\x98\xe3\xb6&\x14a\x06&W\x80c\x9d\xe7\x02X\x14a\x06YWa\x01\xe2V[\x80c@\xa1A\xff\x11a\x01zW\x80chF\x99*\x11a\x01IW\x80chF\x99*\x14a\x03\xe3W\x80cii\xa2\\\x14a\x04\x93W\x80c\u007fO\x95\xfa\x14a\x04\xbdW\x80c\x82\xbd=\x92\x14a\x05\x16Wa\x01\xe2V[\x80c@\xa1A\xff\x14a\x03<W\x80cK=P\v\x14a\x03qW\x80c]\u0415\x90\x14a\x03\x9bW\x80cb3\xbe]\x14a\x03\xceWa\x01\xe2V[\x80c\"-;\x05\x11a\x01\xb6W\x80c\"-;\x05\x14a\x02\x9bW\x80c&Gb\x04\x14a\x02\xceW\x80c:\x06\x1b\xd3\x14a\x02\xf4W\x80c@U\n\x1c\x14a\x03\tWa\x01\xe2V[\x80b6*w\x14a\x01\xe7W\x80c\x13\x03\xf7\xcf\x14a\x02.W\x80c\x15\x8e\xf9>\x14a\x02UW\x80c\x1b^5\x8c\x14a\x02jW[`\x00\x80\xfd[4\x80\x15a\x01\xf3W`\x00\x80\xfd[Pa\x02\x1a`\x04\x806\x03` \x81\x10\x15a\x02\nW`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x0f\x12V[`@\x80Q\x91\x15\x15\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[4\x80\x15a\x02:W`\x00\x80\xfd[Pa\x02Ca\x11zV[`@\x80Q\x91\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[4\x80\x15a\x02aW`\x00\x80\xfd[Pa\x02\x1aa\x11\x80V[4\x80\x15a\x02vW`\x00\x80\xfd[Pa\x02\u007fa\x11\x89V[`@\x80Q`\x01`\x01`\xa0\x1b\x03\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[4\x80\x15a\x02\xa7W`\x00\x80\xfd[Pa\x02\x1a`\x04\x806\x03` \x81\x10\x15a\x02\xbeW`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x11\x8fV[a\x02\x1a`\x04\x806\x03` \x81\x10\x15a\x02\xe4W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x13\xb4V[4\x80\x15a\x03\x00W`\x00\x80\xfd[Pa\x02\u007fa\x17\xdfV[4\x80\x15a\x03\x15W`\x00\x80\xfd[Pa\x02\x1a`\x04\x806\x03` \x81\x10\x15a\x03,W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x17\xe5V[4\x80\x15a\x03HW`\x00\x80\xfd[Pa\x03o`\x04\x806\x03` \x81\x10\x15a\x03_W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x18@V[\x00[4\x80\x15a\x03}W`\x00\x80\xfd[Pa\x02\u007f`\x04\x806\x03` \x81\x10\x15a\x03\x94W`\x00\x80\xfd[P5a\x19\x88V[4\x80\x15a\x03\xa7W`\x00\x80\xfd[Pa\x03o`\x04\x806\x03` \x81\x10\x15a\x03\xbeW`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x19\xafV[4\x80\x15a\x03\xdaW`\x00\x80\xfd[Pa\x02\u007fa\x1a\bV[4\x80\x15a\x03\xefW`\x00\x80\xfd[Pa\x03o`\x04\x806\x03`@\x81\x10\x15a\x04\x06W`\x00\x80\xfd[\x81\x01\x90` \x81\x01\x815`\x01` \x1b\x81\x11\x15a\x04 W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\x042W`\x00\x80\xfd[\x805\x90` \x01\x91\x84` \x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\x04SW`\x00\x80\xfd[\x91\x90\x80\x80` \x02` \x01`@Q\x90\x81\x01`@R\x80\x93\x92\x91\x90\x81\x81R` \x01\x83\x83` \x02\x80\x82\x847`\x00\x92\x01\x91\x90\x91RP\x92\x95PP\x915\x92Pa\x1a\x0e\x91PPV[4\x80\x15a\x04\x9fW`\x00\x80\xfd[Pa\x02\u007f`\x04\x806\x03` \x81\x10\x15a\x04\xb6W`\x00\x80\xfd[P5a\x1cUV[4\x80\x15a\x04\xc9W`\x00\x80\xfd[Pa\x04\xf8`\x04\x806\x03`@\x81\x10\x15a\x04\xe0W`\x00\x80\xfd[P`\x01`\x01`\xa0\x1b\x03\x815\x81\x16\x91` \x015\x16a\x1cbV[`@\x80Q\x93\x84R` \x84\x01\x92\x90\x92R\x82\x82\x01RQ\x90\x81\x90\x03``\x01\x90\xf3[4\x80\x15a\x05\"W`\x00\x80\xfd[Pa\x02\x1a`\x04\x806\x03` \x81\x10\x15a\x059W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x1c\x9fV[4\x80\x15a\x05UW`\x00\x80\xfd[Pa\x05|`\x04\x806\x03` \x81\x10\x15a\x05lW`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x1f\x03V[`@Q`\x01`\x01`\xa0\x1b\x03\x88\x16\x81R` \x81\x01\x87`\x04\x81\x11\x15a\x05\x9bW\xfe[`\xff\x16\x81R` \x01\x86\x81R` \x01\x85\x81R` \x01\x84\x81R` \x01\x83\x81R` \x01\x80` \x01\x82\x81\x03\x82R\x83\x81\x81Q\x81R` \x01\x91P\x80Q\x90` \x01\x90` \x02\x80\x83\x83`\x00[\x83\x81\x10\x15a\x05\xf7W\x81\x81\x01Q\x83\x82\x01R` \x01a\x05\xdfV[PPPP\x90P\x01\x98PPPPPPPPP`@Q\x80\x91\x03\x90\xf3[4\x80\x15a\x06\x1dW`\x00\x80\xfd[Pa\x02Ca#LV[4\x80\x15a\x062W`\x00\x80\xfd[Pa\x02\x1a`\x04\x806\x03` \x81\x10\x15a\x06IW`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a#RV[4\x80\x15a\x06eW`\x00\x80\xfd[Pa\x06na#\xa4V[`@\x80Q` \x80\x82R\x83Q\x81\x83\x01R\x83Q\x91\x92\x83\x92\x90\x83\x01\x91\x85\x81\x01\x91\x02\x80\x83\x83`\x00[\x83\x81\x10\x15a\x06\xaaW\x81\x81\x01Q\x83\x82\x01R` \x01a\x06\x92V[PPPP\x90P\x01\x92PPP`@Q\x80\x91\x03\x90\xf3[4\x80\x15a\x06\xcaW`\x00\x80\xfd[Pa\x03o`\x04\x806\x03` \x81\x10\x15a\x06\xe1W`\x00\x80\xfd[\x81\x01\x90` \x81\x01\x815`\x01` \x1b\x81\x11\x15a\x06\xfbW`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\a\rW`\x00\x80\xfd[\x805\x90` \x01\x91\x84` \x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\a.W`\x00\x80\xfd[P\x90\x92P\x90Pa$\aV[4\x80\x15a\aEW`\x00\x80\xfd[Pa\x02\x1a`\x04\x806\x03`\xc0\x81\x10\x15a\a\\W`\x00\x80\xfd[`\x01`\x01`\xa0\x1b\x03\x825\x16\x91\x90\x81\x01\x90`@\x81\x01` \x82\x015`\x01` \x1b\x81\x11\x15a\a\x86W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\a\x98W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\a\xb9W`\x00\x80\xfd[\x91\x93\x90\x92\x90\x91` \x81\x01\x905`\x01` \x1b\x81\x11\x15a\a\xd6W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\a\xe8W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\b\tW`\x00\x80\xfd[\x91\x93\x90\x92\x90\x91` \x81\x01\x905`\x01` \x1b\x81\x11\x15a\b&W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\b8W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\bYW`\x00\x80\xfd[\x91\x93\x90\x92\x90\x91` \x81\x01\x905`\x01` \x1b\x81\x11\x15a\bvW`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\b\x88W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\b\xa9W`\x00\x80\xfd[\x91\x93\x90\x92\x90\x91` \x81\x01\x905`\x01` \x1b\x81\x11\x15a\b\xc6W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\b\xd8W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\b\xf9W`\x00\x80\xfd[P\x90\x92P\x90Pa'hV[4\x80\x15a\t\x10W`\x00\x80\xfd[Pa\t7`\x04\x806\x03` \x81\x10\x15a\t'W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a-tV[`@Q\x80\x80` \x01\x80` \x01\x80` \x01\x80` \x01\x80` \x01\x86\x81\x03\x86R\x8b\x81\x81Q\x81R` \x01\x91P\x80Q\x90` \x01\x90\x80\x83\x83`\x00[\x83\x81\x10\x15a\t\x84W\x81\x81\x01Q\x83\x82\x01R` \x01a\tlV[PPPP\x90P\x90\x81\x01\x90`\x1f\x16\x80\x15a\t\xb1W\x80\x82\x03\x80Q`\x01\x83` \x03a\x01\x00\n\x03\x19\x16\x81R` \x01\x91P[P\x86\x81\x03\x85R\x8aQ\x81R\x8aQ` \x91\x82\x01\x91\x8c\x01\x90\x80\x83\x83`\x00[\x83\x81\x10\x15a\t\xe4W\x81\x81\x01Q\x83\x82\x01R` \x01a\t\xccV[PPPP\x90P\x90\x81\x01\x90`\x1f\x16\x80\x15a\n\x11W\x80\x82\x03\x80Q`\x01\x83` \x03a\x01\x00\n\x03\x19\x16\x81R` \x01\x91P[P\x86\x81\x03\x84R\x89Q\x81R\x89Q` \x91\x82\x01\x91\x8b\x01\x90\x80\x83\x83`\x00[\x83\x81\x10\x15a\nDW\x81\x81\x01Q\x83\x82\x01R` \x01a\n,V[PPPP\x90P\x90\x81\x01\x90`\x1f\x16\x80\x15a\nqW\x80\x82\x03\x80Q`\x01\x83` \x03a\x01\x00\n\x03\x19\x16\x81R` \x01\x91P[P\x86\x81\x03\x83R\x88Q\x81R\x88Q` \x91\x82\x01\x91\x8a\x01\x90\x80\x83\x83`\x00[\x83\x81\x10\x15a\n\xa4W\x81\x81\x01Q\x83\x82\x01R` \x01a\n\x8cV[PPPP\x90P\x90\x81\x01\x90`\x1f\x16\x80\x15a\n\xd1W\x80\x82\x03\x80Q`\x01\x83` \x03a\x01\x00\n\x03\x19\x16\x81R` \x01\x91P[P\x86\x81\x03\x82R\x87Q\x81R\x87Q` \x91\x82\x01\x91\x89\x01\x90\x80\x83\x83`\x00[\x83\x81\x10\x15a\v\x04W\x81\x81\x01Q\x83\x82\x01R` \x01a\n\xecV[PPPP\x90P\x90\x81\x01\x90`\x1f\x16\x80\x15a\v1W\x80\x82\x03\x80Q`\x01\x83` \x03a\x01\x00\n\x03\x19\x16\x81R` \x01\x91P[P\x9aPPPPPPPPPPP`@Q\x80\x91\x03\x90\xf3[4\x80\x15a\vSW`\x00\x80\xfd[Pa\x06na1\xa8V[4\x80\x15a\vhW`\x00\x80\xfd[Pa\x02\x1a`\x04\x806\x03`\xa0\x81\x10\x15a\v\u007fW`\x00\x80\xfd[\x81\x01\x90` \x81\x01\x815`\x01` \x1b\x81\x11\x15a\v\x99W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\v\xabW`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\v\xccW`\x00\x80\xfd[\x91\x90\x80\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x93\x92\x91\x90\x81\x81R` \x01\x83\x83\x80\x82\x847`\x00\x92\x01\x91\x90\x91RP\x92\x95\x94\x93` \x81\x01\x93P5\x91PP`\x01` \x1b\x81\x11\x15a\f\x1eW`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\f0W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\fQW`\x00\x80\xfd[\x91\x90\x80\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x93\x92\x91\x90\x81\x81R` \x01\x83\x83\x80\x82\x847`\x00\x92\x01\x91\x90\x91RP\x92\x95\x94\x93` \x81\x01\x93P5\x91PP`\x01` \x1b\x81\x11\x15a\f\xa3W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\f\xb5W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\f\xd6W`\x00\x80\xfd[\x91\x90\x80\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x93\x92\x91\x90\x81\x81R` \x01\x83\x83\x80\x82\x847`\x00\x92\x01\x91\x90\x91RP\x92\x95\x94\x93` \x81\x01\x93P5\x91PP`\x01` \x1b\x81\x11\x15a\r(W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\r:W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\r[W`\x00\x80\xfd[\x91\x90\x80\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x93\x92\x91\x90\x81\x81R` \x01\x83\x83\x80\x82\x847`\x00\x92\x01\x91\x90\x91RP\x92\x95\x94\x93` \x81\x01\x93P5\x91PP`\x01` \x1b\x81\x11\x15a\r\xadW`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\r\xbfW`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\r\xe0W`\x00\x80\xfd[\x91\x90\x80\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x93\x92\x91\x90\x81\x81R` \x01\x83\x83\x80\x82\x847`\x00\x92\x01\x91\x90\x91RP\x92\x95Pa2\b\x94PPPPPV[4\x80\x15a\x0e-W`\x00\x80\xfd[Pa\x02Ca3\xadV[4\x80\x15a\x0eBW`\x00\x80\xfd[Pa\x0eKa3\xbaV[`@\x80Q\x92\x83R` \x83\x01\x91\x90\x91R\x80Q\x91\x82\x90\x03\x01\x90\xf3[4\x80\x15a\x0epW`\x00\x80\xfd[Pa\x0eya3\xcfV[`@\x80Qa\xff\xff\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x03oa3\xd4V[4\x80\x15a\x0e\xa4W`\x00\x80\xfd[Pa\x0e\xada5tV[`@\x80Qg\xff\xff\xff\xff\xff\xff\xff\xff\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[4\x80\x15a\x0e\xd6W`\x00\x80\xfd[Pa\x0e\xada5{V[4\x80\x15a\x0e\xebW`\x00\x80\xfd[Pa\x02\x1a`\x04\x806\x03` \x81\x10\x15a\x0f\x02W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a5\x81V[`\x003\x81`\x01`\x01`\xa0\x1b\x03\x84\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x0fDW\xfe[\x14\x15a\x0f\x8dW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x13`$\x82\x01Rr\x15\x98[\x1aY\x18]\x1b\u0708\x1b\x9b\xdd\b\x19^\x1a\\\xdd`j\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x83\x81\x16`\x00\x90\x81R`\x01` R`@\x90 T\x81\x16\x90\x82\x16\x14a\x0f\xe8W`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`.\x81R` \x01\x80aH\xf9`.\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 `\t\x01TCap\x80\x90\x91\x01\x11\x15a\x10HW`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`\\\x81R` \x01\x80aGl`\\\x919``\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01T\x80a\x10\xb6W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x1a`$\x82\x01R\u007fYou don't have any profits\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x84\x16`\x00\x90\x81R`\x01` R`@\x81 `\a\x81\x01\x91\x90\x91UC`\t\x90\x91\x01U\x80\x15a\x11\x1bW`@Q`\x01`\x01`\xa0\x1b\x03\x83\x16\x90\x82\x15a\b\xfc\x02\x90\x83\x90`\x00\x81\x81\x81\x85\x88\x88\xf1\x93PPPP\x15\x80\x15a\x11\x19W=`\x00\x80>=`\x00\xfd[P[\x81`\x01`\x01`\xa0\x1b\x03\x16\x84`\x01`\x01`\xa0\x1b\x03\x16\u007fQ\xa6\x9bE\x02\xf6`wL\x939\x82\\{Z\xdb\xf0\xb8b\"\x89\x13FG\xe2\x97(\xec]\x9b;\xb9\x83B`@Q\x80\x83\x81R` \x01\x82\x81R` \x01\x92PPP`@Q\x80\x91\x03\x90\xa3`\x01\x92PPP[\x91\x90PV[`\x06T\x81V[`\x00T`\xff\x16\x81V[a\xf0\x01\x81V[3`\x00\x81\x81R`\x02` \x90\x81R`@\x80\x83 `\x01`\x01`\xa0\x1b\x03\x86\x16\x84R\x82R\x80\x83 `\x01\x90\x92R\x82 T\x91\x92\x91\x83\x90`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x11\xd4W\xfe[\x14\x15a\x12\x1dW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x13`$\x82\x01Rr\x1d\x98[\x1aY\x18]\x1b\u0708\x1b\x9b\xdd\b\x19^\x1a\\\xdd`j\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01\x81\x01Ta\x12sW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x19`$\x82\x01R\u007fYou have to unstake first\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[Cb\x01Q\x80g\xff\xff\xff\xff\xff\xff\xff\xff\x16\x82`\x01\x01T\x01\x11\x15a\x12\xc5W`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`!\x81R` \x01\x80aG\xc8`!\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[\x80Ta\x13\x13W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x18`$\x82\x01RwYou don't have any stake`@\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[\x80T`\x00\x80\x83U`\x01\x83\x01\x81\x90U`@Q`\x01`\x01`\xa0\x1b\x03\x85\x16\x91\x83\x15a\b\xfc\x02\x91\x84\x91\x81\x81\x81\x85\x88\x88\xf1\x93PPPP\x15\x80\x15a\x13UW=`\x00\x80>=`\x00\xfd[P\x84`\x01`\x01`\xa0\x1b\x03\x16\x83`\x01`\x01`\xa0\x1b\x03\x16\u007f\xa7\f\xd9@p\u0345#9\xa7k2\xcf-\x95\xa3\xc8\xf2\xa3\"&\x91c\xd2v\a\x1c\x1c\x14\x95V\x19\x83B`@Q\x80\x83\x81R` \x01\x82\x81R` \x01\x92PPP`@Q\x80\x91\x03\x90\xa3P`\x01\x94\x93PPPPV[`\x00\x80T`\xff\x16a\x13\xfbW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[34`\x01`\x01`\x01`\xa0\x1b\x03\x85\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x14-W\xfe[\x14\x80a\x14fWP`\x02`\x01`\x01`\xa0\x1b\x03\x85\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x14dW\xfe[\x14[a\x14\xa1W`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`-\x81R` \x01\x80aHP`-\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\aT`@\x80QcAbY\xd9`\xe1\x1b\x81R`\x01`\x01`\xa0\x1b\x03\x87\x81\x16`\x04\x83\x01R\x91Q\x91\x90\x92\x16\x91c\x82\u0133\xb2\x91`$\x80\x83\x01\x92` \x92\x91\x90\x82\x90\x03\x01\x81\x86\x80;\x15\x80\x15a\x14\xeeW`\x00\x80\xfd[PZ\xfa\x15\x80\x15a\x15\x02W=`\x00\x80>=`\x00\xfd[PPPP`@Q=` \x81\x10\x15a\x15\x18W`\x00\x80\xfd[PQa\x15UW`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`8\x81R` \x01\x80aH\x9e`8\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x80\x83\x16`\x00\x90\x81R`\x02` \x90\x81R`@\x80\x83 \x93\x88\x16\x83R\x92\x90R `\x01\x01T\x15a\x15\xbaW`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`\"\x81R` \x01\x80aH.`\"\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x84\x16`\x00\x90\x81R`\x01` \x81\x90R`@\x90\x91 \x90\x81\x01Th\x01\xbc\x16\xd6t\xec\x80\x00\x00\x90a\x15\xf4\x90\x84c\xff\xff\xff\xffa9\xd9\x16V[\x10\x15a\x16GW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x18`$\x82\x01R\u007fStaking coins not enough\x00\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x80\x84\x16`\x00\x90\x81R`\x02` \x90\x81R`@\x80\x83 \x93\x89\x16\x83R\x92\x90R Ta\x16\xc0W`\n\x81\x01\x80T`\x01`\x01`\xa0\x1b\x03\x80\x86\x16`\x00\x81\x81R`\x02` \x81\x81R`@\x80\x84 \x95\x8d\x16\x84R\x94\x81R\x93\x82 \x01\x84\x90U`\x01\x84\x01\x85U\x93\x84R\x92 \x01\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16\x90\x91\x17\x90U[`\x01\x81\x01Ta\x16\u0550\x83c\xff\xff\xff\xffa9\xd9\x16V[`\x01\x82\x01U`\x02\x81T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x16\xf3W\xfe[\x14a\x17\nW\x80T`\xff`\xa0\x1b\x19\x16`\x01`\xa1\x1b\x17\x81U[a\x17\x18\x85\x82`\x01\x01Ta:<V[`\x01`\x01`\xa0\x1b\x03\x80\x84\x16`\x00\x90\x81R`\x02` \x90\x81R`@\x80\x83 \x93\x89\x16\x83R\x92\x90R Ta\x17N\x90\x83c\xff\xff\xff\xffa9\xd9\x16V[`\x01`\x01`\xa0\x1b\x03\x80\x85\x16`\x00\x90\x81R`\x02` \x90\x81R`@\x80\x83 \x93\x8a\x16\x83R\x92\x90R U`\x05Ta\x17\x87\x90\x83c\xff\xff\xff\xffa9\xd9\x16V[`\x05U`@\x80Q\x83\x81RB` \x82\x01R\x81Q`\x01`\x01`\xa0\x1b\x03\x80\x89\x16\x93\x90\x87\x16\x92\u007f\xb9\xbarY4S#\x16\xcf\xfe\x10\x97]\xa6\xeb%\xadI\xc2\xd1\u0094\u0642\xc4l\x9f\x8dhN\xe0u\x92\x90\x81\x90\x03\x90\x91\x01\x90\xa3P`\x01\x94\x93PPPPV[a\xf0\x00\x81V[`\x00\x80[`\x03T\x81\x10\x15a\x187W\x82`\x01`\x01`\xa0\x1b\x03\x16`\x03\x82\x81T\x81\x10a\x18\nW\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x16\x14\x15a\x18/W`\x01\x91PPa\x11uV[`\x01\x01a\x17\xe9V[P`\x00\x92\x91PPV[3a\xf0\x01\x14a\x18\x8dW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x14`$\x82\x01RsPunish contract only``\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x81\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01Ta\x18\xb2\x82a<\xd6V[`\x04T`\x01\x10\x15a\x19\x84Wa\x18\u0182a=\xfaV[`\aT`@\x80Qc\x15\xea'\x81`\xe0\x1b\x81R`\x01`\x01`\xa0\x1b\x03\x85\x81\x16`\x04\x83\x01R\x91Q\x91\x90\x92\x16\x91c\x15\xea'\x81\x91`$\x80\x83\x01\x92` \x92\x91\x90\x82\x90\x03\x01\x81`\x00\x87\x80;\x15\x80\x15a\x19\x15W`\x00\x80\xfd[PZ\xf1\x15\x80\x15a\x19)W=`\x00\x80>=`\x00\xfd[PPPP`@Q=` \x81\x10\x15a\x19?W`\x00\x80\xfd[PP`@\x80Q\x82\x81RB` \x82\x01R\x81Q`\x01`\x01`\xa0\x1b\x03\x85\x16\x92\u007f\xa2m\xe7\xab2N\xac\b\u0156T\x9fB\x1e\\\x87A!=#}.\x9a,\x9c\x0e\xbd\u09e8I\xfe\x92\x82\x90\x03\x01\x90\xa2[PPV[`\x04\x81\x81T\x81\x10a\x19\x95W\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x16\x90P\x81V[3a\xf0\x01\x14a\x19\xfcW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x14`$\x82\x01RsPunish contract only``\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[a\x1a\x05\x81a<\xd6V[PV[a\xf0\x02\x81V[3A\x14a\x1aOW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\n`$\x82\x01RiMiner only`\xb0\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[C`\x00\x90\x81R`\t` \x90\x81R`@\x80\x83 `\x01\x84R\x90\x91R\x90 T`\xff\x16\x15a\x1a\xc0W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x1a`$\x82\x01R\u007fValidators already updated\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x00T`\xff\x16a\x1b\x06W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[\x80\x80C\x81a\x1b\x10W\xfe[\x06\x15a\x1bVW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x10`$\x82\x01RoBlock epoch only`\x80\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[C`\x00\x90\x81R`\t` \x90\x81R`@\x80\x83 `\x01\x80\x85R\x92R\x90\x91 \x80T`\xff\x19\x16\x90\x91\x17\x90U\x82Qa\x1b\xc7W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x14`$\x82\x01RsValidator set empty!``\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[\x82Qa\x1b\u0690`\x03\x90` \x86\x01\x90aE\xd3V[P\u007f\xea\u03a8\xf3\xc2/\x06\xc0\xb1\x83\x06\xbd\xb0M\n\x96rU\x12\x9e\x8c\xe0\tM\ubc20\xff\x89\xd0\x06\xb5\x83`@Q\x80\x80` \x01\x82\x81\x03\x82R\x83\x81\x81Q\x81R` \x01\x91P\x80Q\x90` \x01\x90` \x02\x80\x83\x83`\x00[\x83\x81\x10\x15a\x1c=W\x81\x81\x01Q\x83\x82\x01R` \x01a\x1c%V[PPPP\x90P\x01\x92PPP`@Q\x80\x91\x03\x90\xa1PPPV[`\x03\x81\x81T\x81\x10a\x19\x95W\xfe[`\x01`\x01`\xa0\x1b\x03\x91\x82\x16`\x00\x90\x81R`\x02` \x81\x81R`@\x80\x84 \x94\x90\x95\x16\x83R\x92\x90\x92R\x91\x90\x91 \x80T`\x01\x82\x01T\x91\x90\x92\x01T\x91\x92\x90\x91\x90V[`\x003a\xf0\x02\x14a\x1c\xf0W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x16`$\x82\x01RuProposal contract only`P\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x00T`\xff\x16a\x1d6W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x03`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x1dfW\xfe[\x14\x15\x80\x15a\x1d\xa2WP`\x04`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x1d\x9fW\xfe[\x14\x15[\x15a\x1d\xafWP`\x01a\x11uV[`\x04`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x1d\xdfW\xfe[\x14\x15a\x1e\xa0W`\bT`@\x80Qcc\xe1\xd4Q`\xe0\x1b\x81R`\x01`\x01`\xa0\x1b\x03\x85\x81\x16`\x04\x83\x01R\x91Q\x91\x90\x92\x16\x91cc\xe1\xd4Q\x91`$\x80\x83\x01\x92` \x92\x91\x90\x82\x90\x03\x01\x81`\x00\x87\x80;\x15\x80\x15a\x1e4W`\x00\x80\xfd[PZ\xf1\x15\x80\x15a\x1eHW=`\x00\x80>=`\x00\xfd[PPPP`@Q=` \x81\x10\x15a\x1e^W`\x00\x80\xfd[PQa\x1e\xa0W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x18\xdb\x19X[\x88\x19\x98Z[\x19Y`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x81\x81R`\x01` \x90\x81R`@\x91\x82\x90 \x80T`\xff`\xa0\x1b\x19\x16`\x01`\xa0\x1b\x17\x90U\x81QB\x81R\x91Q\u007f\u0632\xc4&\xec\x1b\u6727X=&\xb1\u84d4n2'C\r>\xbc;\xd6M\x9e\x1cF\x9c\xb4\x00\x92\x81\x90\x03\x90\x91\x01\x90\xa2\x91\x90PV[`\x00\x80`\x00\x80`\x00\x80``a\x1f\x16aF8V[`\x01`\x01`\xa0\x1b\x03\x89\x81\x16`\x00\x90\x81R`\x01` \x90\x81R`@\x91\x82\x90 \x82Qa\x01\x00\x81\x01\x90\x93R\x80T\x93\x84\x16\x83R\x91\x92\x90\x83\x01\x90`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x1f_W\xfe[`\x04\x81\x11\x15a\x1fjW\xfe[\x81R` \x01`\x01\x82\x01T\x81R` \x01`\x02\x82\x01`@Q\x80`\xa0\x01`@R\x90\x81`\x00\x82\x01\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x92\x91\x90\x81\x81R` \x01\x82\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x80\x15a !W\x80`\x1f\x10a\x1f\xf6Wa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a !V[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a \x04W\x82\x90\x03`\x1f\x16\x82\x01\x91[PPPPP\x81R` \x01`\x01\x82\x01\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x92\x91\x90\x81\x81R` \x01\x82\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x80\x15a \xc3W\x80`\x1f\x10a \x98Wa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a \xc3V[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a \xa6W\x82\x90\x03`\x1f\x16\x82\x01\x91[PPP\x91\x83RPP`\x02\x82\x81\x01\x80T`@\x80Q` `\x01\x84\x16\x15a\x01\x00\x02`\x00\x19\x01\x90\x93\x16\x94\x90\x94\x04`\x1f\x81\x01\x83\x90\x04\x83\x02\x85\x01\x83\x01\x90\x91R\x80\x84R\x93\x81\x01\x93\x90\x83\x01\x82\x82\x80\x15a!UW\x80`\x1f\x10a!*Wa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a!UV[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a!8W\x82\x90\x03`\x1f\x16\x82\x01\x91[PPP\x91\x83RPP`\x03\x82\x01\x80T`@\x80Q` `\x02`\x01\x85\x16\x15a\x01\x00\x02`\x00\x19\x01\x90\x94\x16\x93\x90\x93\x04`\x1f\x81\x01\x84\x90\x04\x84\x02\x82\x01\x84\x01\x90\x92R\x81\x81R\x93\x82\x01\x93\x92\x91\x83\x01\x82\x82\x80\x15a!\xe9W\x80`\x1f\x10a!\xbeWa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a!\xe9V[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a!\xccW\x82\x90\x03`\x1f\x16\x82\x01\x91[PPP\x91\x83RPP`\x04\x82\x01\x80T`@\x80Q` `\x02`\x01\x85\x16\x15a\x01\x00\x02`\x00\x19\x01\x90\x94\x16\x93\x90\x93\x04`\x1f\x81\x01\x84\x90\x04\x84\x02\x82\x01\x84\x01\x90\x92R\x81\x81R\x93\x82\x01\x93\x92\x91\x83\x01\x82\x82\x80\x15a\"}W\x80`\x1f\x10a\"RWa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a\"}V[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a\"`W\x82\x90\x03`\x1f\x16\x82\x01\x91[PPPPP\x81RPP\x81R` \x01`\a\x82\x01T\x81R` \x01`\b\x82\x01T\x81R` \x01`\t\x82\x01T\x81R` \x01`\n\x82\x01\x80T\x80` \x02` \x01`@Q\x90\x81\x01`@R\x80\x92\x91\x90\x81\x81R` \x01\x82\x80T\x80\x15a#\x01W` \x02\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T`\x01`\x01`\xa0\x1b\x03\x16\x81R`\x01\x90\x91\x01\x90` \x01\x80\x83\x11a\"\xe3W[PPPPP\x81RPP\x90P\x80`\x00\x01Q\x81` \x01Q\x82`@\x01Q\x83`\x80\x01Q\x84`\xa0\x01Q\x85`\xc0\x01Q\x86`\xe0\x01Q\x80\x90P\x97P\x97P\x97P\x97P\x97P\x97P\x97PP\x91\x93\x95\x97\x90\x92\x94\x96PV[`\x05T\x81V[`\x00\x80[`\x04T\x81\x10\x15a\x187W\x82`\x01`\x01`\xa0\x1b\x03\x16`\x04\x82\x81T\x81\x10a#wW\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x16\x14\x15a#\x9cW`\x01\x91PPa\x11uV[`\x01\x01a#VV[```\x03\x80T\x80` \x02` \x01`@Q\x90\x81\x01`@R\x80\x92\x91\x90\x81\x81R` \x01\x82\x80T\x80\x15a#\xfcW` \x02\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T`\x01`\x01`\xa0\x1b\x03\x16\x81R`\x01\x90\x91\x01\x90` \x01\x80\x83\x11a#\xdeW[PPPPP\x90P[\x90V[`\x00T`\xff\x16\x15a$UW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x13`$\x82\x01Rr\x10[\x1c\x99XY\x1eH\x1a[\x9a]\x1aX[\x1a^\x99Y`j\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\a\x80T`\x01`\x01`\xa0\x1b\x03\x19\x90\x81\x16a\xf0\x02\x17\x90\x91U`\b\x80T\x90\x91\x16a\xf0\x01\x17\x90U`\x00[\x81\x81\x10\x15a'VW`\x00\x83\x83\x83\x81\x81\x10a$\x92W\xfe[\x90P` \x02\x015`\x01`\x01`\xa0\x1b\x03\x16`\x01`\x01`\xa0\x1b\x03\x16\x14\x15a$\xfeW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x19`$\x82\x01R\u007fInvalid validator address\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[a%\"\x83\x83\x83\x81\x81\x10a%\rW\xfe[\x90P` \x02\x015`\x01`\x01`\xa0\x1b\x03\x16a\x17\xe5V[a%qW`\x03\x83\x83\x83\x81\x81\x10a%4W\xfe[\x83T`\x01\x81\x01\x85U`\x00\x94\x85R` \x94\x85\x90 \x01\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16`\x01`\x01`\xa0\x1b\x03\x95\x90\x92\x02\x93\x90\x93\x015\x93\x90\x93\x16\x92\x90\x92\x17\x90UP[a%\x95\x83\x83\x83\x81\x81\x10a%\x80W\xfe[\x90P` \x02\x015`\x01`\x01`\xa0\x1b\x03\x16a#RV[a%\xe4W`\x04\x83\x83\x83\x81\x81\x10a%\xa7W\xfe[\x83T`\x01\x81\x01\x85U`\x00\x94\x85R` \x94\x85\x90 \x01\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16`\x01`\x01`\xa0\x1b\x03\x95\x90\x92\x02\x93\x90\x93\x015\x93\x90\x93\x16\x92\x90\x92\x17\x90UP[`\x00`\x01\x81\x85\x85\x85\x81\x81\x10a%\xf5W\xfe[`\x01`\x01`\xa0\x1b\x03` \x91\x82\x02\x93\x90\x93\x015\x83\x16\x84R\x83\x01\x93\x90\x93R`@\x90\x91\x01`\x00 T\x16\x91\x90\x91\x14\x15\x90Pa&\xaaW\x82\x82\x82\x81\x81\x10a&2W\xfe[\x90P` \x02\x015`\x01`\x01`\xa0\x1b\x03\x16`\x01`\x00\x85\x85\x85\x81\x81\x10a&RW\xfe[\x90P` \x02\x015`\x01`\x01`\xa0\x1b\x03\x16`\x01`\x01`\xa0\x1b\x03\x16`\x01`\x01`\xa0\x1b\x03\x16\x81R` \x01\x90\x81R` \x01`\x00 `\x00\x01`\x00a\x01\x00\n\x81T\x81`\x01`\x01`\xa0\x1b\x03\x02\x19\x16\x90\x83`\x01`\x01`\xa0\x1b\x03\x16\x02\x17\x90UP[`\x00`\x01`\x00\x85\x85\x85\x81\x81\x10a&\xbcW\xfe[` \x90\x81\x02\x92\x90\x92\x015`\x01`\x01`\xa0\x1b\x03\x16\x83RP\x81\x01\x91\x90\x91R`@\x01`\x00 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a&\xf4W\xfe[\x14\x15a'NW`\x02`\x01`\x00\x85\x85\x85\x81\x81\x10a'\fW\xfe[` \x90\x81\x02\x92\x90\x92\x015`\x01`\x01`\xa0\x1b\x03\x16\x83RP\x81\x01\x91\x90\x91R`@\x01`\x00 \x80T`\xff`\xa0\x1b\x19\x16`\x01`\xa0\x1b\x83`\x04\x81\x11\x15a'HW\xfe[\x02\x17\x90UP[`\x01\x01a$|V[PP`\x00\x80T`\xff\x19\x16`\x01\x17\x90UPV[`\x00\x80T`\xff\x16a'\xafW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x8c\x16a(\x00W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x13`$\x82\x01RrInvalid fee address`h\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[a)\x0f\x8b\x8b\x80\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x93\x92\x91\x90\x81\x81R` \x01\x83\x83\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPP`@\x80Q` `\x1f\x8f\x01\x81\x90\x04\x81\x02\x82\x01\x81\x01\x90\x92R\x8d\x81R\x92P\x8d\x91P\x8c\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPP`@\x80Q` `\x1f\x8e\x01\x81\x90\x04\x81\x02\x82\x01\x81\x01\x90\x92R\x8c\x81R\x92P\x8c\x91P\x8b\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPP`@\x80Q` `\x1f\x8d\x01\x81\x90\x04\x81\x02\x82\x01\x81\x01\x90\x92R\x8b\x81R\x92P\x8b\x91P\x8a\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPP`@\x80Q` `\x1f\x8c\x01\x81\x90\x04\x81\x02\x82\x01\x81\x01\x90\x92R\x8a\x81R\x92P\x8a\x91P\x89\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPa2\b\x92PPPV[a)VW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x13`$\x82\x01Rr$\xb7;0\xb64\xb2\x1022\xb9\xb1\xb94\xb8:4\xb7\xb7`i\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[3`\x00\x81\x81R`\x01` R`@\x81 T\x81\x90`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a)}W\xfe[\x14\x15a*{W`\aT`@\x80QcAbY\xd9`\xe1\x1b\x81R`\x01`\x01`\xa0\x1b\x03\x85\x81\x16`\x04\x83\x01R\x91Q\x91\x90\x92\x16\x91c\x82\u0133\xb2\x91`$\x80\x83\x01\x92` \x92\x91\x90\x82\x90\x03\x01\x81\x86\x80;\x15\x80\x15a)\xd0W`\x00\x80\xfd[PZ\xfa\x15\x80\x15a)\xe4W=`\x00\x80>=`\x00\xfd[PPPP`@Q=` \x81\x10\x15a)\xfaW`\x00\x80\xfd[PQa*MW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x1c`$\x82\x01R\u007fYou must be authorized first\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[P`\x01`\x01`\xa0\x1b\x03\x81\x16`\x00\x90\x81R`\x01` \x81\x90R`@\x90\x91 \x80T`\xff`\xa0\x1b\x19\x16`\x01`\xa0\x1b\x17\x90U[`\x01`\x01`\xa0\x1b\x03\x82\x81\x16`\x00\x90\x81R`\x01` R`@\x90 T\x81\x16\x90\x8f\x16\x14a*\xedW\x8d`\x01`\x00\x84`\x01`\x01`\xa0\x1b\x03\x16`\x01`\x01`\xa0\x1b\x03\x16\x81R` \x01\x90\x81R` \x01`\x00 `\x00\x01`\x00a\x01\x00\n\x81T\x81`\x01`\x01`\xa0\x1b\x03\x02\x19\x16\x90\x83`\x01`\x01`\xa0\x1b\x03\x16\x02\x17\x90UP[`@Q\x80`\xa0\x01`@R\x80\x8e\x8e\x80\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x93\x92\x91\x90\x81\x81R` \x01\x83\x83\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPPP\x90\x82RP`@\x80Q` `\x1f\x8f\x01\x81\x90\x04\x81\x02\x82\x01\x81\x01\x90\x92R\x8d\x81R\x91\x81\x01\x91\x90\x8e\x90\x8e\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPPP\x90\x82RP`@\x80Q` `\x1f\x8d\x01\x81\x90\x04\x81\x02\x82\x01\x81\x01\x90\x92R\x8b\x81R\x91\x81\x01\x91\x90\x8c\x90\x8c\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPPP\x90\x82RP`@\x80Q` `\x1f\x8b\x01\x81\x90\x04\x81\x02\x82\x01\x81\x01\x90\x92R\x89\x81R\x91\x81\x01\x91\x90\x8a\x90\x8a\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPPP\x90\x82RP`@\x80Q` `\x1f\x89\x01\x81\x90\x04\x81\x02\x82\x01\x81\x01\x90\x92R\x87\x81R\x91\x81\x01\x91\x90\x88\x90\x88\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x82\x90RP\x93\x90\x94RPP`\x01`\x01`\xa0\x1b\x03\x85\x16\x81R`\x01` \x90\x81R`@\x90\x91 \x83Q\x80Q`\x02\x90\x92\x01\x93Pa,M\x92\x84\x92\x91\x01\x90aF\x84V[P` \x82\x81\x01Q\x80Qa,f\x92`\x01\x85\x01\x92\x01\x90aF\x84V[P`@\x82\x01Q\x80Qa,\x82\x91`\x02\x84\x01\x91` \x90\x91\x01\x90aF\x84V[P``\x82\x01Q\x80Qa,\x9e\x91`\x03\x84\x01\x91` \x90\x91\x01\x90aF\x84V[P`\x80\x82\x01Q\x80Qa,\xba\x91`\x04\x84\x01\x91` \x90\x91\x01\x90aF\x84V[P\x90PP\x80\x15a-\x14W\x8d`\x01`\x01`\xa0\x1b\x03\x16\x82`\x01`\x01`\xa0\x1b\x03\x16\u007f\x88~\xec\x9du{rG\u074eQ\x19\x8f\x9d\x1b\x8f'\x97\x9b\u03b3K\xdc\xc1\xbf\xfdN\xc5\xecsl\"B`@Q\x80\x82\x81R` \x01\x91PP`@Q\x80\x91\x03\x90\xa3a-`V[\x8d`\x01`\x01`\xa0\x1b\x03\x16\x82`\x01`\x01`\xa0\x1b\x03\x16\u007f\xb8B\x1feP\x13q\xf5MX\xde\x197\xff\x1e\x1c\u0377d#\xefo\x84\xac\xea\x18\x14\xa0\xf66,\xa0B`@Q\x80\x82\x81R` \x01\x91PP`@Q\x80\x91\x03\x90\xa3[P`\x01\x9d\x9cPPPPPPPPPPPPPV[``\x80``\x80``a-\x84aF8V[`\x01`\x01`\xa0\x1b\x03\x87\x81\x16`\x00\x90\x81R`\x01` \x90\x81R`@\x91\x82\x90 \x82Qa\x01\x00\x81\x01\x90\x93R\x80T\x93\x84\x16\x83R\x91\x92\x90\x83\x01\x90`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a-\xcdW\xfe[`\x04\x81\x11\x15a-\xd8W\xfe[\x81R` \x01`\x01\x82\x01T\x81R` \x01`\x02\x82\x01`@Q\x80`\xa0\x01`@R\x90\x81`\x00\x82\x01\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x92\x91\x90\x81\x81R` \x01\x82\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x80\x15a.\x8fW\x80`\x1f\x10a.dWa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a.\x8fV[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a.rW\x82\x90\x03`\x1f\x16\x82\x01\x91[PPPPP\x81R` \x01`\x01\x82\x01\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x92\x91\x90\x81\x81R` \x01\x82\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x80\x15a/1W\x80`\x1f\x10a/\x06Wa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a/1V[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a/\x14W\x82\x90\x03`\x1f\x16\x82\x01\x91[PPP\x91\x83RPP`\x02\x82\x81\x01\x80T`@\x80Q` `\x01\x84\x16\x15a\x01\x00\x02`\x00\x19\x01\x90\x93\x16\x94\x90\x94\x04`\x1f\x81\x01\x83\x90\x04\x83\x02\x85\x01\x83\x01\x90\x91R\x80\x84R\x93\x81\x01\x93\x90\x83\x01\x82\x82\x80\x15a/\xc3W\x80`\x1f\x10a/\x98Wa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a/\xc3V[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a/\xa6W\x82\x90\x03`\x1f\x16\x82\x01\x91[PPP\x91\x83RPP`\x03\x82\x01\x80T`@\x80Q` `\x02`\x01\x85\x16\x15a\x01\x00\x02`\x00\x19\x01\x90\x94\x16\x93\x90\x93\x04`\x1f\x81\x01\x84\x90\x04\x84\x02\x82\x01\x84\x01\x90\x92R\x81\x81R\x93\x82\x01\x93\x92\x91\x83\x01\x82\x82\x80\x15a0WW\x80`\x1f\x10a0,Wa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a0WV[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a0:W\x82\x90\x03`\x1f\x16\x82\x01\x91[PPP\x91\x83RPP`\x04\x82\x01\x80T`@\x80Q` `\x02`\x01\x85\x16\x15a\x01\x00\x02`\x00\x19\x01\x90\x94\x16\x93\x90\x93\x04`\x1f\x81\x01\x84\x90\x04\x84\x02\x82\x01\x84\x01\x90\x92R\x81\x81R\x93\x82\x01\x93\x92\x91\x83\x01\x82\x82\x80\x15a0\xebW\x80`\x1f\x10a0\xc0Wa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a0\xebV[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a0\xceW\x82\x90\x03`\x1f\x16\x82\x01\x91[PPPPP\x81RPP\x81R` \x01`\a\x82\x01T\x81R` \x01`\b\x82\x01T\x81R` \x01`\t\x82\x01T\x81R` \x01`\n\x82\x01\x80T\x80` \x02` \x01`@Q\x90\x81\x01`@R\x80\x92\x91\x90\x81\x81R` \x01\x82\x80T\x80\x15a1oW` \x02\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T`\x01`\x01`\xa0\x1b\x03\x16\x81R`\x01\x90\x91\x01\x90` \x01\x80\x83\x11a1QW[PPP\x91\x90\x92RPPP``\x90\x81\x01Q\x80Q` \x82\x01Q`@\x83\x01Q\x93\x83\x01Q`\x80\x90\x93\x01Q\x91\x9b\x90\x9aP\x92\x98P\x90\x96P\x94P\x92PPPV[```\x04\x80T\x80` \x02` \x01`@Q\x90\x81\x01`@R\x80\x92\x91\x90\x81\x81R` \x01\x82\x80T\x80\x15a#\xfcW` \x02\x82\x01\x91\x90`\x00R` `\x00 \x90\x81T`\x01`\x01`\xa0\x1b\x03\x16\x81R`\x01\x90\x91\x01\x90` \x01\x80\x83\x11a#\xdeWPPPPP\x90P\x90V[`\x00`F\x86Q\x11\x15a2ZW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x16`$\x82\x01Ru\t-\xce\xcc-\x8d,\x84\r\xad\xed\xcd-l\xaeD\r\x8c\xad\xcc\xee\x8d`S\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[a\v\xb8\x85Q\x11\x15a2\xb2W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x17`$\x82\x01R\u007fInvalid identity length\x00\x00\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x8c\x84Q\x11\x15a3\x02W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x16`$\x82\x01Ru\t-\xce\xcc-\x8d,\x84\x0e\xec\xacNm.\x8c\xa4\r\x8c\xad\xcc\xee\x8d`S\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x8c\x83Q\x11\x15a3PW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x14`$\x82\x01Rs\t-\xce\xcc-\x8d,\x84\f\xad\xac--\x84\r\x8c\xad\xcc\xee\x8d`c\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[a\x01\x18\x82Q\x11\x15a3\xa1W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x16`$\x82\x01Ru\t-\xce\xcc-\x8d,\x84\f\x8c\xae\x8c--\x8ed\r\x8c\xad\xcc\xee\x8d`S\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[P`\x01\x95\x94PPPPPV[h\x01\xbc\x16\xd6t\xec\x80\x00\x00\x81V[`\x00\x80a3\xc7`\x00a>gV[\x91P\x91P\x90\x91V[`\x15\x81V[3A\x14a4\x15W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\n`$\x82\x01RiMiner only`\xb0\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[C`\x00\x90\x81R`\t` \x90\x81R`@\x80\x83 \x83\x80R\x90\x91R\x90 T`\xff\x16\x15a4\x85W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x19`$\x82\x01R\u007fBlock is already rewarded\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x00T`\xff\x16a4\xcbW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[C`\x00\x90\x81R`\t` \x90\x81R`@\x80\x83 \x83\x80R\x82R\x80\x83 \x80T`\xff\x19\x16`\x01\x90\x81\x17\x90\x91U3\x80\x85R\x92R\x82 T\x90\x914\x91`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a5\x15W\xfe[\x14\x15a5\"WPPa5rV[a5-\x81`\x00a?[V[`@\x80Q\x82\x81RB` \x82\x01R\x81Q`\x01`\x01`\xa0\x1b\x03\x85\x16\x92\u007f}\xc4\xe5\xdfYQ7\b\u0723U\xb8pbs\xa5\xdf{\x81\nL\xec\x80\x19\U000a4e7b\x16j\x1a\x04\x92\x82\x90\x03\x01\x90\xa2PP[V[b\x01Q\x80\x81V[ap\x80\x81V[`\x00\x80T`\xff\x16a5\xc8W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[3`\x00`\x01`\x01`\xa0\x1b\x03\x84\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a5\xf9W\xfe[\x14\x15a6BW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x13`$\x82\x01Rr\x15\x98[\x1aY\x18]\x1b\u0708\x1b\x9b\xdd\b\x19^\x1a\\\xdd`j\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x80\x82\x16`\x00\x90\x81R`\x02` \x90\x81R`@\x80\x83 \x93\x87\x16\x83R\x92\x81R\x82\x82 `\x01\x91\x82\x90R\x92\x90\x91 \x82T\x91\x83\x01T\x90\x91\x90\x15a6\xb8W`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`#\x81R` \x01\x80aH\xd6`#\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\x00\x81\x11a7\bW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x18`$\x82\x01RwYou don't have any stake`@\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x04T`\x01\x14\x80\x15a7\x1eWPa7\x1e\x86a#RV[\x80\x15a7GWP`\x01\x82\x01Th\x01\xbc\x16\xd6t\xec\x80\x00\x00\x90a7E\x90\x83c\xff\xff\xff\xffaB\xc4\x16V[\x10[\x15a7\x83W`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`E\x81R` \x01\x80aG\xe9`E\x919``\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\n\x82\x01T`\x02\x84\x01T`\x00\x19\x90\x91\x01\x14a8`W`\n\x82\x01\x80T`\x00\x19\x81\x01\x90\x81\x10a7\xacW\xfe[\x90`\x00R` `\x00 \x01`\x00\x90T\x90a\x01\x00\n\x90\x04`\x01`\x01`\xa0\x1b\x03\x16\x82`\n\x01\x84`\x02\x01T\x81T\x81\x10a7\xddW\xfe[\x90`\x00R` `\x00 \x01`\x00a\x01\x00\n\x81T\x81`\x01`\x01`\xa0\x1b\x03\x02\x19\x16\x90\x83`\x01`\x01`\xa0\x1b\x03\x16\x02\x17\x90UP\x82`\x02\x01T`\x02`\x00\x84`\n\x01\x86`\x02\x01T\x81T\x81\x10a8'W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x90\x81\x16\x84R\x83\x82\x01\x94\x90\x94R`@\x92\x83\x01\x82 \x93\x8b\x16\x82R\x92\x90\x92R\x90 `\x02\x01U[\x81`\n\x01\x80T\x80a8mW\xfe[`\x00\x82\x81R` \x90 \x81\x01`\x00\x19\x90\x81\x01\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16\x90U\x01\x90U`\x01\x82\x01Ta8\xa4\x90\x82c\xff\xff\xff\xffaB\xc4\x16V[`\x01\x80\x84\x01\x91\x90\x91UC\x90\x84\x01U`\x00`\x02\x84\x01U`\x05Ta8\u0310\x82c\xff\xff\xff\xffaB\xc4\x16V[`\x05U`\x01\x82\x01Th\x01\xbc\x16\xd6t\xec\x80\x00\x00\x11\x15a9zW\x81T`\xff`\xa0\x1b\x19\x16`\x03`\xa0\x1b\x17\x82Ua8\xfe\x86aC\x06V[`\aT`@\x80Qc\x15\xea'\x81`\xe0\x1b\x81R`\x01`\x01`\xa0\x1b\x03\x89\x81\x16`\x04\x83\x01R\x91Q\x91\x90\x92\x16\x91c\x15\xea'\x81\x91`$\x80\x83\x01\x92` \x92\x91\x90\x82\x90\x03\x01\x81`\x00\x87\x80;\x15\x80\x15a9MW`\x00\x80\xfd[PZ\xf1\x15\x80\x15a9aW=`\x00\x80>=`\x00\xfd[PPPP`@Q=` \x81\x10\x15a9wW`\x00\x80\xfd[PP[\x85`\x01`\x01`\xa0\x1b\x03\x16\x84`\x01`\x01`\xa0\x1b\x03\x16\u007fD\x90\x02\xae\x18\xe7H\u059aU\xf3\x85\x14@\rd\xf9fI.Y>2\xd6\u9e32M\xb9\x8a\v\xc1\x83B`@Q\x80\x83\x81R` \x01\x82\x81R` \x01\x92PPP`@Q\x80\x91\x03\x90\xa3P`\x01\x95\x94PPPPPV[`\x00\x82\x82\x01\x83\x81\x10\x15a:3W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x1b`$\x82\x01R\u007fSafeMath: addition overflow\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[\x90P[\x92\x91PPV[`\x00[`\x04T\x81\x10\x15a:\x89W\x82`\x01`\x01`\xa0\x1b\x03\x16`\x04\x82\x81T\x81\x10a:`W\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x16\x14\x15a:\x81WPa\x19\x84V[`\x01\x01a:?V[P`\x04T`\x15\x11\x15a;\x1cW`\x04\x80T`\x01\x81\x01\x82U`\x00\x91\x90\x91R\u007f\x8a5\xac\xfb\xc1_\xf8\x1a9\xae}4O\xd7\t\xf2\x8e\x86\x00\xb4\xaa\x8ce\u01b6K\xfe\u007f\xe3k\u045b\x01\x80T`\x01`\x01`\xa0\x1b\x03\x84\x16`\x01`\x01`\xa0\x1b\x03\x19\x90\x91\x16\x81\x17\x90\x91U`@\x80QB\x81R\x90Q\u007f\x1e3\x10\xadh\x91\xb3\x0e\x03\x87N\xc3\xd1B*c\x86\xc5\xdac\xd9\xfa\xf5\x95\xf5\u065e\xea\xf4C\xb9\x9a\x91\x81\x90\x03` \x01\x90\xa2a\x19\x84V[`\x00`\x01`\x00`\x04`\x00\x81T\x81\x10a;0W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x81 `\x01\x90\x81\x01T\x92P[`\x04T\x81\x10\x15a;\xeeW\x82`\x01`\x00`\x04\x84\x81T\x81\x10a;yW\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x90 `\x01\x01T\x10\x15a;\xe6W`\x01`\x00`\x04\x83\x81T\x81\x10a;\xb8W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x90 `\x01\x01T\x92P\x90P\x80[`\x01\x01a;]V[P\x81\x83\x11a;\xfdWPPa\x19\x84V[`@\x80QB\x81R\x90Q`\x01`\x01`\xa0\x1b\x03\x86\x16\x91\u007f\x1e3\x10\xadh\x91\xb3\x0e\x03\x87N\xc3\xd1B*c\x86\xc5\xdac\xd9\xfa\xf5\x95\xf5\u065e\xea\xf4C\xb9\x9a\x91\x90\x81\x90\x03` \x01\x90\xa2`\x04\x81\x81T\x81\x10a<IW\xfe[`\x00\x91\x82R` \x91\x82\x90 \x01T`@\x80QB\x81R\x90Q`\x01`\x01`\xa0\x1b\x03\x90\x92\x16\x92\u007fu!\xe4EY\xc8p\xc3\x16\xe8N`\xbcG\x85\xd9\xc04\xa8\xab\x1dj\xcd\u03814\xac\x03\xf9F\xc6\ud491\x82\x90\x03\x01\x90\xa2\x83`\x04\x82\x81T\x81\x10a<\xa2W\xfe[\x90`\x00R` `\x00 \x01`\x00a\x01\x00\n\x81T\x81`\x01`\x01`\xa0\x1b\x03\x02\x19\x16\x90\x83`\x01`\x01`\xa0\x1b\x03\x16\x02\x17\x90UPPPPPV[`\x00`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a=\x06W\xfe[\x14\x80a=\x15WP`\x03T`\x01\x10\x15[\x15a=\x1fWa\x1a\x05V[`\x01`\x01`\xa0\x1b\x03\x81\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01T\x80\x15a=\xb4Wa=K\x81\x83a?[V[`\x06Ta=^\x90\x82c\xff\xff\xff\xffa9\xd9\x16V[`\x06U`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x01` R`@\x90 `\b\x01Ta=\x8d\x90\x82c\xff\xff\xff\xffa9\xd9\x16V[`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x81 `\b\x81\x01\x92\x90\x92U`\a\x90\x91\x01U[`@\x80Q\x82\x81RB` \x82\x01R\x81Q`\x01`\x01`\xa0\x1b\x03\x85\x16\x92\u007f\xe2\x94\xe9\xd7?\x8e\xee#\xe2\x1b.\x15g\x96\x06%\xa6\xb5\xd39\xcb\x12{U\xd0\u0414s\xa9\x95\x125\x92\x82\x90\x03\x01\x90\xa2PPV[`\x00`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a>*W\xfe[\x14\x15a>5Wa\x1a\x05V[`\x01`\x01`\xa0\x1b\x03\x81\x16`\x00\x90\x81R`\x01` R`@\x90 \x80T`\xff`\xa0\x1b\x19\x16`\x01`\xa2\x1b\x17\x90Ua\x1a\x05\x81aC\x06V[`\x00\x80\x80[`\x03T\x81\x10\x15a?UW`\x04`\x01`\x00`\x03\x84\x81T\x81\x10a>\x89W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x90 T`\xff`\x01`\xa0\x1b\x90\x91\x04\x16`\x04\x81\x11\x15a>\xc4W\xfe[\x14\x15\x80\x15a>\xf6WP`\x03\x81\x81T\x81\x10a>\xdaW\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x85\x81\x16\x91\x16\x14\x15[\x15a?MWa?D`\x01`\x00`\x03\x84\x81T\x81\x10a?\x0fW\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x90 `\x01\x01T\x84\x90c\xff\xff\xff\xffa9\xd9\x16V[\x92P`\x01\x90\x91\x01\x90[`\x01\x01a>lV[P\x91P\x91V[\x81a?eWa\x19\x84V[`\x00\x80a?q\x83a>gV[\x90\x92P\x90P\x80a?\x82WPPa\x19\x84V[`\x00\x80\x83aA\x0eW`\x00a?\x9c\x87\x85c\xff\xff\xff\xffaD<\x16V[\x90Pa?\xbea?\xb1\x82\x86c\xff\xff\xff\xffaD~\x16V[\x88\x90c\xff\xff\xff\xffaB\xc4\x16V[\x92P`\x00[`\x03T\x81\x10\x15a@\x9dW`\x00`\x03\x82\x81T\x81\x10a?\xdcW\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x16\x90P`\x04`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a@#W\xfe[\x14\x15\x80\x15a@CWP\x87`\x01`\x01`\xa0\x1b\x03\x16\x81`\x01`\x01`\xa0\x1b\x03\x16\x14\x15[\x15a@\x94W`\x01`\x01`\xa0\x1b\x03\x81\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01Ta@t\x90\x84c\xff\xff\xff\xffa9\xd9\x16V[`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01U\x92P\x82[P`\x01\x01a?\xc3V[P`\x00\x83\x11\x80\x15a@\xb6WP`\x01`\x01`\xa0\x1b\x03\x82\x16\x15\x15[\x15aA\x04W`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01Ta@\u7404c\xff\xff\xff\xffa9\xd9\x16V[`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01U[PPPPPa\x19\x84V[`\x00\x80[`\x03T\x81\x10\x15aBBW`\x00`\x03\x82\x81T\x81\x10aA+W\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x16\x90P`\x04`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15aArW\xfe[\x14\x15\x80\x15aA\x92WP\x87`\x01`\x01`\xa0\x1b\x03\x16\x81`\x01`\x01`\xa0\x1b\x03\x16\x14\x15[\x15aB9W`\x01`\x01`\xa0\x1b\x03\x81\x16`\x00\x90\x81R`\x01` \x81\x90R`@\x82 \x01TaA\u0590\x89\x90aA\u0290\x8d\x90c\xff\xff\xff\xffaD~\x16V[\x90c\xff\xff\xff\xffaD<\x16V[\x90PaA\u8102c\xff\xff\xff\xffa9\xd9\x16V[`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01T\x92\x95P\x93P\x84\x91aB\x1b\x90\x82c\xff\xff\xff\xffa9\xd9\x16V[`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01UP[P`\x01\x01aA\x12V[PaBS\x87\x82c\xff\xff\xff\xffaB\xc4\x16V[\x92P`\x00\x83\x11\x80\x15aBmWP`\x01`\x01`\xa0\x1b\x03\x82\x16\x15\x15[\x15aB\xbbW`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01TaB\x9e\x90\x84c\xff\xff\xff\xffa9\xd9\x16V[`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01U[PPPPPPPV[`\x00a:3\x83\x83`@Q\x80`@\x01`@R\x80`\x1e\x81R` \x01\u007fSafeMath: subtraction overflow\x00\x00\x81RPaD\xd7V[`\x00[`\x04T\x81\x10\x80\x15aC\x1cWP`\x04T`\x01\x10[\x15a\x19\x84W`\x04\x81\x81T\x81\x10aC.W\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x83\x81\x16\x91\x16\x14\x15aD4W`\x04T`\x00\x19\x01\x81\x14aC\xc1W`\x04\x80T`\x00\x19\x81\x01\x90\x81\x10aClW\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x04\x80T`\x01`\x01`\xa0\x1b\x03\x90\x92\x16\x91\x83\x90\x81\x10aC\x92W\xfe[\x90`\x00R` `\x00 \x01`\x00a\x01\x00\n\x81T\x81`\x01`\x01`\xa0\x1b\x03\x02\x19\x16\x90\x83`\x01`\x01`\xa0\x1b\x03\x16\x02\x17\x90UP[`\x04\x80T\x80aC\xccW\xfe[`\x00\x82\x81R` \x90\x81\x90 \x82\x01`\x00\x19\x90\x81\x01\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16\x90U\x90\x91\x01\x90\x91U`@\x80QB\x81R\x90Q`\x01`\x01`\xa0\x1b\x03\x85\x16\x92\u007fu!\xe4EY\xc8p\xc3\x16\xe8N`\xbcG\x85\xd9\xc04\xa8\xab\x1dj\xcd\u03814\xac\x03\xf9F\xc6\ud482\x90\x03\x01\x90\xa2a\x19\x84V[`\x01\x01aC\tV[`\x00a:3\x83\x83`@Q\x80`@\x01`@R\x80`\x1a\x81R` \x01\u007fSafeMath: division by zero\x00\x00\x00\x00\x00\x00\x81RPaEnV[`\x00\x82aD\x8dWP`\x00a:6V[\x82\x82\x02\x82\x84\x82\x81aD\x9aW\xfe[\x04\x14a:3W`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`!\x81R` \x01\x80aH}`!\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\x00\x81\x84\x84\x11\x15aEfW`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R\x83\x81\x81Q\x81R` \x01\x91P\x80Q\x90` \x01\x90\x80\x83\x83`\x00[\x83\x81\x10\x15aE+W\x81\x81\x01Q\x83\x82\x01R` \x01aE\x13V[PPPP\x90P\x90\x81\x01\x90`\x1f\x16\x80\x15aEXW\x80\x82\x03\x80Q`\x01\x83` \x03a\x01\x00\n\x03\x19\x16\x81R` \x01\x91P[P\x92PPP`@Q\x80\x91\x03\x90\xfd[PPP\x90\x03\x90V[`\x00\x81\x83aE\xbdW`@QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01\x81\x81R\x83Q`$\x84\x01R\x83Q\x90\x92\x83\x92`D\x90\x91\x01\x91\x90\x85\x01\x90\x80\x83\x83`\x00\x83\x15aE+W\x81\x81\x01Q\x83\x82\x01R` \x01aE\x13V[P`\x00\x83\x85\x81aE\xc9W\xfe[\x04\x95\x94PPPPPV[\x82\x80T\x82\x82U\x90`\x00R` `\x00 \x90\x81\x01\x92\x82\x15aF(W\x91` \x02\x82\x01[\x82\x81\x11\x15aF(W\x82Q\x82T`\x01`\x01`\xa0\x1b\x03\x19\x16`\x01`\x01`\xa0\x1b\x03\x90\x91\x16\x17\x82U` \x90\x92\x01\x91`\x01\x90\x91\x01\x90aE\xf3V[PaF4\x92\x91PaF\xfeV[P\x90V[`@\x80Qa\x01\x00\x81\x01\x90\x91R`\x00\x80\x82R` \x82\x01\x90\x81R` \x01`\x00\x81R` \x01aFbaG\"V[\x81R` \x01`\x00\x81R` \x01`\x00\x81R` \x01`\x00\x81R` \x01``\x81RP\x90V[\x82\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x90`\x00R` `\x00 \x90`\x1f\x01` \x90\x04\x81\x01\x92\x82`\x1f\x10aF\xc5W\x80Q`\xff\x19\x16\x83\x80\x01\x17\x85UaF\xf2V[\x82\x80\x01`\x01\x01\x85U\x82\x15aF\xf2W\x91\x82\x01[\x82\x81\x11\x15aF\xf2W\x82Q\x82U\x91` \x01\x91\x90`\x01\x01\x90aF\xd7V[PaF4\x92\x91PaGQV[a$\x04\x91\x90[\x80\x82\x11\x15aF4W\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16\x81U`\x01\x01aG\x04V[`@Q\x80`\xa0\x01`@R\x80``\x81R` \x01``\x81R` \x01``\x81R` \x01``\x81R` \x01``\x81RP\x90V[a$\x04\x91\x90[\x80\x82\x11\x15aF4W`\x00\x81U`\x01\x01aGWV\xfeYou must wait enough blocks to withdraw your profits after latest withdraw of this validatorYour staking haven't unlocked yetYou can't unstake, validator list will be empty after this operation!Can't stake when you are unstakingCan't stake to a validator in abnormal statusSafeMath: multiplication overflowThe validator you want to stake must be authorized firstYou are already in unstaking statusYou are not the fee receiver of this validator\xa2dipfsX\"\x12 \x8a\xa6\u050a-\xe0jg\x02$\xf3\x03\xbd\xa2\xfa\u00f3\x06\xe53\xf0[\xcb\xf1b\xf9\xf4\x1f\x02E{ dsolcC\x00\x06\x01\x003\xf9\v\xe0\x82\xf0\x01\x80\xb9\v\xd9`\x80`@R4\x80\x15a\x00\x10W`\x00\x80\xfd[P`\x046\x10a\x01\x16W`\x005`\xe0\x1c\x80c\xbedV\x92\x11a\x00\xa2W\x80c\xdbx\xdd(\x11a\x00qW\x80c\xdbx\xdd(\x14a\x02/W\x80c\xe0\xd8\xeaS\x14a\x02TW\x80c\xear!\xa1\x14a\x02\\W\x80c\xef\xd8\xd8\xe2\x14a\x02\x82W\x80c\xf6*\xf2l\x14a\x02\x8aWa\x01\x16V[\x80c\xbedV\x92\x14a\x01\xe3W\x80c\xc9g\xf9\x0f\x14a\x01\xebW\x80c\xcb\x1e\xa7%\x14a\x02\nW\x80c\xd9=,\xb9\x14a\x02\x12Wa\x01\x16V[\x80c:\x06\x1b\xd3\x11a\x00\xe9W\x80c:\x06\x1b\xd3\x14a\x01\x9bW\x80cD\xc1\xaa\x99\x14a\x01\xa3W\x80cb3\xbe]\x14a\x01\xabW\x80cc\xe1\xd4Q\x14a\x01\xb3W\x80c\x81)\xfc\x1c\x14a\x01\xd9Wa\x01\x16V[\x80c\x15\x8e\xf9>\x14a\x01\x1bW\x80c\x1b^5\x8c\x14a\x017W\x80c(\x97\x18=\x14a\x01[W\x80c2\xf3\xc1\u007f\x14a\x01uW[`\x00\x80\xfd[a\x01#a\x02\xa7V[`@\x80Q\x91\x15\x15\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x01?a\x02\xb0V[`@\x80Q`\x01`\x01`\xa0\x1b\x03\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x01ca\x02\xb6V[`@\x80Q\x91\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x01c`\x04\x806\x03` \x81\x10\x15a\x01\x8bW`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x02\xbcV[a\x01?a\x02\xd7V[a\x01ca\x02\xddV[a\x01?a\x02\xe3V[a\x01#`\x04\x806\x03` \x81\x10\x15a\x01\xc9W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x02\xe9V[a\x01\xe1a\x05\x15V[\x00[a\x01ca\x05\x98V[a\x01\xf3a\x05\xa5V[`@\x80Qa\xff\xff\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x01ca\x05\xaaV[a\x01\xe1`\x04\x806\x03` \x81\x10\x15a\x02(W`\x00\x80\xfd[P5a\x05\xb0V[a\x027a\bSV[`@\x80Qg\xff\xff\xff\xff\xff\xff\xff\xff\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x01ca\bZV[a\x01\xe1`\x04\x806\x03` \x81\x10\x15a\x02rW`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\b`V[a\x027a\vvV[a\x01?`\x04\x806\x03` \x81\x10\x15a\x02\xa0W`\x00\x80\xfd[P5a\v|V[`\x00T`\xff\x16\x81V[a\xf0\x01\x81V[`\x03T\x81V[`\x01`\x01`\xa0\x1b\x03\x16`\x00\x90\x81R`\x05` R`@\x90 T\x90V[a\xf0\x00\x81V[`\x02T\x81V[a\xf0\x02\x81V[`\x00\x80T`\xff\x16a\x030W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[3a\xf0\x00\x14a\x03\x86W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x18`$\x82\x01R\u007fValidators contract only\x00\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x05` R`@\x90 T\x15a\x03\xbeW`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x05` R`@\x81 U[`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x05` R`@\x90 `\x02\x01T`\xff\x16\x80\x15a\x03\xeaWP`\x06T\x15\x15[\x15a\x05\rW`\x06T`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x05` R`@\x90 `\x01\x01T`\x00\x19\x90\x91\x01\x14a\x04\xb4W`\x06\x80T`\x00\x91\x90`\x00\x19\x81\x01\x90\x81\x10a\x04/W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x86\x81\x16\x84R`\x05\x90\x92R`@\x90\x92 `\x01\x01T`\x06\x80T\x92\x90\x93\x16\x93P\x83\x92\x91\x81\x10a\x04mW\xfe[`\x00\x91\x82R` \x80\x83 \x91\x90\x91\x01\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16`\x01`\x01`\xa0\x1b\x03\x94\x85\x16\x17\x90U\x85\x83\x16\x82R`\x05\x90R`@\x80\x82 `\x01\x90\x81\x01T\x94\x90\x93\x16\x82R\x90 \x01U[`\x06\x80T\x80a\x04\xbfW\xfe[`\x00\x82\x81R` \x80\x82 \x83\x01`\x00\x19\x90\x81\x01\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16\x90U\x90\x92\x01\x90\x92U`\x01`\x01`\xa0\x1b\x03\x84\x16\x82R`\x05\x90R`@\x81 `\x01\x81\x01\x91\x90\x91U`\x02\x01\x80T`\xff\x19\x16\x90U[P`\x01\x91\x90PV[`\x00T`\xff\x16\x15a\x05cW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x13`$\x82\x01Rr\x10[\x1c\x99XY\x1eH\x1a[\x9a]\x1aX[\x1a^\x99Y`j\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x04\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16a\xf0\x00\x17\x90U`\x18`\x01\x81\x81U`0`\x02U`\x03\x91\x90\x91U`\x00\x80T`\xff\x19\x16\x90\x91\x17\x90UV[h\x01\xbc\x16\xd6t\xec\x80\x00\x00\x81V[`\x15\x81V[`\x01T\x81V[3A\x14a\x05\xf1W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\n`$\x82\x01RiMiner only`\xb0\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[C`\x00\x90\x81R`\b` R`@\x90 T`\xff\x16\x15a\x06JW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x11`$\x82\x01Rp\x10[\x1c\x99XY\x1eH\x19\x19X\u0719X\\\xd9Y`z\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x00T`\xff\x16a\x06\x90W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[\x80\x80C\x81a\x06\x9aW\xfe[\x06\x15a\x06\xe0W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x10`$\x82\x01RoBlock epoch only`\x80\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[C`\x00\x90\x81R`\b` R`@\x90 \x80T`\xff\x19\x16`\x01\x17\x90U`\x06Ta\a\x06Wa\bOV[`\x00[`\x06T\x81\x10\x15a\b$W`\x03T`\x02T\x81a\a W\xfe[\x04`\x05`\x00`\x06\x84\x81T\x81\x10a\a2W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x90 T\x11\x15a\a\xe3W`\x03T`\x02T\x81a\ajW\xfe[\x04`\x05`\x00`\x06\x84\x81T\x81\x10a\a|W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x81 T`\x06\x80T\x93\x90\x91\x03\x92`\x05\x92\x91\x90\x85\x90\x81\x10a\a\xb9W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x90 Ua\b\x1cV[`\x00`\x05`\x00`\x06\x84\x81T\x81\x10a\a\xf6W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x90 U[`\x01\x01a\a\tV[P`@Q\u007f\x18\x1dQ\xbeT\xe8\xe8\xea\xcan\xae\x0e\xab2\xd4\x16 \x99#k\xd5\x19\xe7#\x8d\x01]\bp\xdbFA\x90`\x00\x90\xa1[PPV[b\x01Q\x80\x81V[`\x06T\x90V[3A\x14a\b\xa1W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\n`$\x82\x01RiMiner only`\xb0\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x00T`\xff\x16a\b\xe7W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[C`\x00\x90\x81R`\a` R`@\x90 T`\xff\x16\x15a\t?W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x10`$\x82\x01Ro\x10[\x1c\x99XY\x1eH\x1c\x1d[\x9a\\\xda\x19Y`\x82\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[C`\x00\x90\x81R`\a` \x90\x81R`@\x80\x83 \x80T`\xff\x19\x16`\x01\x17\x90U`\x01`\x01`\xa0\x1b\x03\x84\x16\x83R`\x05\x90\x91R\x90 `\x02\x01T`\xff\x16a\t\xe8W`\x06\x80T`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x81\x81R`\x05` R`@\x81 `\x01\x80\x82\x01\x85\x90U\x80\x85\x01\x90\x95U\u007f\xf6R\"#\x13\xe2\x84YR\x8d\x92\ve\x11\\\x16\xc0O>\xfc\x82\xaa\xed\xc9{\xe5\x9f?7|\r?\x90\x93\x01\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16\x83\x17\x90UR`\x02\x01\x80T`\xff\x19\x16\x90\x91\x17\x90U[`\x01`\x01`\xa0\x1b\x03\x81\x16`\x00\x90\x81R`\x05` R`@\x90 \x80T`\x01\x01\x90\x81\x90U`\x02T\x90\x81a\n\x14W\xfe[\x06a\n\xa1W`\x04\x80T`@\x80Qc@\xa1A\xff`\xe0\x1b\x81R`\x01`\x01`\xa0\x1b\x03\x85\x81\x16\x94\x82\x01\x94\x90\x94R\x90Q\x92\x90\x91\x16\x91c@\xa1A\xff\x91`$\x80\x82\x01\x92`\x00\x92\x90\x91\x90\x82\x90\x03\x01\x81\x83\x87\x80;\x15\x80\x15a\nkW`\x00\x80\xfd[PZ\xf1\x15\x80\x15a\n\u007fW=`\x00\x80>=`\x00\xfd[PPP`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x05` R`@\x81 UPa\v4V[`\x01T`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x05` R`@\x90 T\x81a\n\xc4W\xfe[\x06a\v4W`\x04\x80T`@\x80Qc\x05\xdd\tY`\xe4\x1b\x81R`\x01`\x01`\xa0\x1b\x03\x85\x81\x16\x94\x82\x01\x94\x90\x94R\x90Q\x92\x90\x91\x16\x91c]\u0415\x90\x91`$\x80\x82\x01\x92`\x00\x92\x90\x91\x90\x82\x90\x03\x01\x81\x83\x87\x80;\x15\x80\x15a\v\x1bW`\x00\x80\xfd[PZ\xf1\x15\x80\x15a\v/W=`\x00\x80>=`\x00\xfd[PPPP[`@\x80QB\x81R\x90Q`\x01`\x01`\xa0\x1b\x03\x83\x16\x91\u007fw\x0e\f\xcaB\xc3]\x00$\t\x86\u038d>\xd48\xbe\x04f<\x91\xda\xc6WkyS}|\x18\x0f\x1e\x91\x90\x81\x90\x03` \x01\x90\xa2PV[ap\x80\x81V[`\x06\x81\x81T\x81\x10a\v\x89W\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x16\x90P\x81V\xfe\xa2dipfsX\"\x12 `\xd8#\xe4\xe7t\x19\xf5\x13\x98\x11\uee0f\x06\xb7y\xeb\xe3N\xd1\u0126?-5N#\x05\x17\xfc\\dsolcC\x00\x06\x01\x003\xf9\x13\x15\x82\xf0\x02\x80\xb9\x13\x0e`\x80`@R4\x80\x15a\x00\x10W`\x00\x80\xfd[P`\x046\x10a\x01\x00W`\x005`\xe0\x1c\x80c\x82\u0133\xb2\x11a\x00\x97W\x80c\xc9g\xf9\x0f\x11a\x00fW\x80c\xc9g\xf9\x0f\x14a\x03\xfcW\x80c\xdbx\xdd(\x14a\x04\x1bW\x80c\xe8#\xc8\x14\x14a\x04@W\x80c\xef\xd8\xd8\xe2\x14a\x04HWa\x01\x00V[\x80c\x82\u0133\xb2\x14a\x03'W\x80c\xa2$\xce\xe7\x14a\x03MW\x80c\xa4\xc4\xd9\"\x14a\x03\xbdW\x80c\xbedV\x92\x14a\x03\xe2Wa\x01\x00V[\x80c\x1fO})\x11a\x00\xd3W\x80c\x1fO})\x14a\x01\xc1W\x80c2\xed[\x12\x14a\x02?W\x80c:\x06\x1b\xd3\x14a\x03\x17W\x80cb3\xbe]\x14a\x03\x1fWa\x01\x00V[\x80c\x15\x8e\xf9>\x14a\x01\x05W\x80c\x15\xea'\x81\x14a\x01!W\x80c\x1b^5\x8c\x14a\x01GW\x80c\x1d\xb5\xad\xe8\x14a\x01kW[`\x00\x80\xfd[a\x01\ra\x04PV[`@\x80Q\x91\x15\x15\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x01\r`\x04\x806\x03` \x81\x10\x15a\x017W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x04YV[a\x01Oa\x05\x0eV[`@\x80Q`\x01`\x01`\xa0\x1b\x03\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x01\x97`\x04\x806\x03`@\x81\x10\x15a\x01\x81W`\x00\x80\xfd[P`\x01`\x01`\xa0\x1b\x03\x815\x16\x90` \x015a\x05\x14V[`@\x80Q`\x01`\x01`\xa0\x1b\x03\x90\x94\x16\x84R` \x84\x01\x92\x90\x92R\x15\x15\x82\x82\x01RQ\x90\x81\x90\x03``\x01\x90\xf3[a\x01\r`\x04\x806\x03`@\x81\x10\x15a\x01\xd7W`\x00\x80\xfd[`\x01`\x01`\xa0\x1b\x03\x825\x16\x91\x90\x81\x01\x90`@\x81\x01` \x82\x015`\x01` \x1b\x81\x11\x15a\x02\x01W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\x02\x13W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\x024W`\x00\x80\xfd[P\x90\x92P\x90Pa\x05MV[a\x02\\`\x04\x806\x03` \x81\x10\x15a\x02UW`\x00\x80\xfd[P5a\b'V[`@\x80Q`\x01`\x01`\xa0\x1b\x03\x80\x8a\x16\x82R\x88\x16` \x80\x83\x01\x91\x90\x91R``\x82\x01\x87\x90Ra\xff\xff\x80\x87\x16`\x80\x84\x01R\x85\x16`\xa0\x83\x01R\x83\x15\x15`\xc0\x83\x01R`\xe0\x92\x82\x01\x83\x81R\x88Q\x93\x83\x01\x93\x90\x93R\x87Q\x91\x92\x91a\x01\x00\x84\x01\x91\x89\x01\x90\x80\x83\x83`\x00[\x83\x81\x10\x15a\x02\xd6W\x81\x81\x01Q\x83\x82\x01R` \x01a\x02\xbeV[PPPP\x90P\x90\x81\x01\x90`\x1f\x16\x80\x15a\x03\x03W\x80\x82\x03\x80Q`\x01\x83` \x03a\x01\x00\n\x03\x19\x16\x81R` \x01\x91P[P\x98PPPPPPPPP`@Q\x80\x91\x03\x90\xf3[a\x01Oa\t\x06V[a\x01Oa\t\fV[a\x01\r`\x04\x806\x03` \x81\x10\x15a\x03=W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\t\x12V[a\x03\xbb`\x04\x806\x03` \x81\x10\x15a\x03cW`\x00\x80\xfd[\x81\x01\x90` \x81\x01\x815`\x01` \x1b\x81\x11\x15a\x03}W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\x03\x8fW`\x00\x80\xfd[\x805\x90` \x01\x91\x84` \x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\x03\xb0W`\x00\x80\xfd[P\x90\x92P\x90Pa\t'V[\x00[a\x01\r`\x04\x806\x03`@\x81\x10\x15a\x03\xd3W`\x00\x80\xfd[P\x805\x90` \x015\x15\x15a\nrV[a\x03\xeaa\x11\x8fV[`@\x80Q\x91\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x04\x04a\x11\x9cV[`@\x80Qa\xff\xff\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x04#a\x11\xa1V[`@\x80Qg\xff\xff\xff\xff\xff\xff\xff\xff\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x03\xeaa\x11\xa8V[a\x04#a\x11\xaeV[`\x00T`\xff\x16\x81V[`\x003a\xf0\x00\x14a\x04\xb1W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x18`$\x82\x01R\u007fValidators contract only\x00\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x81\x81R`\x02` \x90\x81R`@\x91\x82\x90 \x80T`\xff\x19\x16\x90U\x81QB\x81R\x91Q\u007fN\v\x19\x1f\u007f\\2\xb1\xb5\xe3pKh\x87K\x1a9\x80\x14|\xae\x00\xbe\x8e\xce'\x1b\xfb[\x92\xc0z\x92\x81\x90\x03\x90\x91\x01\x90\xa2P`\x01\x91\x90PV[a\xf0\x01\x81V[`\x04` \x90\x81R`\x00\x92\x83R`@\x80\x84 \x90\x91R\x90\x82R\x90 \x80T`\x01\x82\x01T`\x02\x90\x92\x01T`\x01`\x01`\xa0\x1b\x03\x90\x91\x16\x91\x90`\xff\x16\x83V[`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x02` R`@\x81 T`\xff\x16\x15a\x05\xa5W`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`)\x81R` \x01\x80a\x12\x8d`)\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\x003\x85\x85\x85B`@Q` \x01\x80\x86`\x01`\x01`\xa0\x1b\x03\x16`\x01`\x01`\xa0\x1b\x03\x16``\x1b\x81R`\x14\x01\x85`\x01`\x01`\xa0\x1b\x03\x16`\x01`\x01`\xa0\x1b\x03\x16``\x1b\x81R`\x14\x01\x84\x84\x80\x82\x847\x91\x90\x91\x01\x92\x83RPP`@\x80Q\x80\x83\x03\x81R` \x92\x83\x01\x90\x91R\x80Q\x91\x01 \x94PPPa\v\xb8\x85\x11\x15\x91Pa\x06`\x90PW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x10`$\x82\x01RoDetails too long`\x80\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x00\x81\x81R`\x03` \x81\x90R`@\x90\x91 \x01T\x15a\x06\xc5W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x17`$\x82\x01R\u007fProposal already exists\x00\x00\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[a\x06\xcda\x11\xb4V[3\x81R`\x01`\x01`\xa0\x1b\x03\x86\x16` \x80\x83\x01\x91\x90\x91R`@\x80Q`\x1f\x87\x01\x83\x90\x04\x83\x02\x81\x01\x83\x01\x90\x91R\x85\x81R\x90\x86\x90\x86\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x82\x90RP`@\x80\x87\x01\x95\x86RB``\x88\x01R\x87\x82R`\x03` \x90\x81R\x91 \x86Q\x81T`\x01`\x01`\xa0\x1b\x03\x91\x82\x16`\x01`\x01`\xa0\x1b\x03\x19\x91\x82\x16\x17\x83U\x83\x89\x01Q`\x01\x84\x01\x80T\x91\x90\x93\x16\x91\x16\x17\x90U\x94Q\x80Q\x87\x96\x95Pa\aw\x94P`\x02\x86\x01\x93P\x91\x01\x90a\x11\xf1V[P``\x82\x01Q`\x03\x82\x01U`\x80\x82\x01Q`\x04\x90\x91\x01\x80T`\xa0\x84\x01Q`\xc0\x90\x94\x01Qa\xff\xff\x19\x90\x91\x16a\xff\xff\x93\x84\x16\x17c\xff\xff\x00\x00\x19\x16b\x01\x00\x00\x93\x90\x94\x16\x92\x90\x92\x02\x92\x90\x92\x17d\xff\x00\x00\x00\x00\x19\x16`\x01` \x1b\x91\x15\x15\x91\x90\x91\x02\x17\x90U`@\x80QB\x81R\x90Q`\x01`\x01`\xa0\x1b\x03\x88\x16\x913\x91\x85\x91\u007f\xc1\x0f/MS\xa0\xe3BSlj\xf3\xcc\xe9\xc6\xee%\xc3-\xbb25!\xce\x0e\x1dD\x94\xa3\xe3b\xe8\x91` \x91\x81\x90\x03\x91\x90\x91\x01\x90\xa4P`\x01\x95\x94PPPPPV[`\x03` \x90\x81R`\x00\x91\x82R`@\x91\x82\x90 \x80T`\x01\x80\x83\x01T`\x02\x80\x85\x01\x80T\x88Qa\x01\x00\x95\x82\x16\x15\x95\x90\x95\x02`\x00\x19\x01\x16\x91\x90\x91\x04`\x1f\x81\x01\x87\x90\x04\x87\x02\x84\x01\x87\x01\x90\x97R\x86\x83R`\x01`\x01`\xa0\x1b\x03\x93\x84\x16\x96\x93\x90\x91\x16\x94\x91\x92\x90\x91\x83\x01\x82\x82\x80\x15a\b\xd7W\x80`\x1f\x10a\b\xacWa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a\b\xd7V[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a\b\xbaW\x82\x90\x03`\x1f\x16\x82\x01\x91[PPPP`\x03\x83\x01T`\x04\x90\x93\x01T\x91\x92\x91a\xff\xff\x80\x82\x16\x92Pb\x01\x00\x00\x82\x04\x16\x90`\x01` \x1b\x90\x04`\xff\x16\x87V[a\xf0\x00\x81V[a\xf0\x02\x81V[`\x02` R`\x00\x90\x81R`@\x90 T`\xff\x16\x81V[`\x00T`\xff\x16\x15a\tuW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x13`$\x82\x01Rr\x10[\x1c\x99XY\x1eH\x1a[\x9a]\x1aX[\x1a^\x99Y`j\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[b\t:\x80`\x01U`\x05\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16a\xf0\x00\x17\x90U`\x00[\x81\x81\x10\x15a\n`W`\x00\x83\x83\x83\x81\x81\x10a\t\xa9W\xfe[\x90P` \x02\x015`\x01`\x01`\xa0\x1b\x03\x16`\x01`\x01`\xa0\x1b\x03\x16\x14\x15a\n\x15W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x19`$\x82\x01R\u007fInvalid validator address\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x02`\x00\x85\x85\x85\x81\x81\x10a\n'W\xfe[` \x90\x81\x02\x92\x90\x92\x015`\x01`\x01`\xa0\x1b\x03\x16\x83RP\x81\x01\x91\x90\x91R`@\x01`\x00 \x80T`\xff\x19\x16\x91\x15\x15\x91\x90\x91\x17\x90U`\x01\x01a\t\x93V[PP`\x00\x80T`\xff\x19\x16`\x01\x17\x90UPV[`\x05T`@\x80Qc\x10\x15B\x87`\xe2\x1b\x81R3`\x04\x82\x01R\x90Q`\x00\x92`\x01`\x01`\xa0\x1b\x03\x16\x91c@U\n\x1c\x91`$\x80\x83\x01\x92` \x92\x91\x90\x82\x90\x03\x01\x81\x86\x80;\x15\x80\x15a\n\xbdW`\x00\x80\xfd[PZ\xfa\x15\x80\x15a\n\xd1W=`\x00\x80>=`\x00\xfd[PPPP`@Q=` \x81\x10\x15a\n\xe7W`\x00\x80\xfd[PQa\v+W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x0e`$\x82\x01RmValidator only`\x90\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x00\x83\x81R`\x03` \x81\x90R`@\x90\x91 \x01Ta\v\x84W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x12`$\x82\x01Rq\x14\x1c\x9b\xdc\x1b\xdc\xd8[\b\x1b\x9b\xdd\b\x19^\x1a\\\xdd`r\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[3`\x00\x90\x81R`\x04` \x90\x81R`@\x80\x83 \x86\x84R\x90\x91R\x90 `\x01\x01T\x15a\v\xdeW`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`#\x81R` \x01\x80a\x12\xb6`#\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\x01T`\x00\x84\x81R`\x03` \x81\x90R`@\x90\x91 \x01T\x01B\x10a\f;W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x10`$\x82\x01Ro\x14\x1c\x9b\xdc\x1b\xdc\xd8[\b\x19^\x1c\x1a\\\x99Y`\x82\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[3`\x00\x81\x81R`\x04` \x90\x81R`@\x80\x83 \x87\x84R\x82R\x91\x82\x90 B`\x01\x82\x01\x81\x90U\x81T`\x01`\x01`\xa0\x1b\x03\x19\x16\x85\x17\x82U`\x02\x90\x91\x01\x80T`\xff\x19\x16\x87\x15\x15\x90\x81\x17\x90\x91U\x83Q\x90\x81R\x91\x82\x01R\x81Q\x86\x92\u007flY\xbd\xa6\x8c\xac1\x87\x17\xc6\f|\x965\xa7\x8a\x0f\x06\x13\xf9\x88|\xc1\x8aqW\xf5tZ\x86\xd1N\x92\x82\x90\x03\x01\x90\xa3\x81\x15a\f\xe9W`\x00\x83\x81R`\x03` R`@\x90 `\x04\x01\x80Ta\xff\xff\x80\x82\x16`\x01\x01\x16a\xff\xff\x19\x90\x91\x16\x17\x90Ua\r\x1dV[`\x00\x83\x81R`\x03` R`@\x90 `\x04\x01\x80T`\x01a\xff\xffb\x01\x00\x00\x80\x84\x04\x82\x16\x92\x90\x92\x01\x16\x02c\xff\xff\x00\x00\x19\x90\x91\x16\x17\x90U[`\x00\x83\x81R`\x03` \x90\x81R`@\x80\x83 `\x01\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R`\x02\x90\x91R\x90 T`\xff\x16\x80a\rkWP`\x00\x83\x81R`\x03` R`@\x90 `\x04\x01T`\x01` \x1b\x90\x04`\xff\x16[\x15a\rxWP`\x01a\x11\x89V[`\x05T`@\x80Qc\x13\xbc\xe0K`\xe3\x1b\x81R\x90Q`\x02\x92`\x01`\x01`\xa0\x1b\x03\x16\x91c\x9d\xe7\x02X\x91`\x04\x80\x83\x01\x92`\x00\x92\x91\x90\x82\x90\x03\x01\x81\x86\x80;\x15\x80\x15a\r\xbdW`\x00\x80\xfd[PZ\xfa\x15\x80\x15a\r\xd1W=`\x00\x80>=`\x00\xfd[PPPP`@Q=`\x00\x82>`\x1f=\x90\x81\x01`\x1f\x19\x16\x82\x01`@R` \x81\x10\x15a\r\xfaW`\x00\x80\xfd[\x81\x01\x90\x80\x80Q`@Q\x93\x92\x91\x90\x84`\x01` \x1b\x82\x11\x15a\x0e\x19W`\x00\x80\xfd[\x90\x83\x01\x90` \x82\x01\x85\x81\x11\x15a\x0e.W`\x00\x80\xfd[\x82Q\x86` \x82\x02\x83\x01\x11`\x01` \x1b\x82\x11\x17\x15a\x0eJW`\x00\x80\xfd[\x82RP\x81Q` \x91\x82\x01\x92\x82\x01\x91\x02\x80\x83\x83`\x00[\x83\x81\x10\x15a\x0ewW\x81\x81\x01Q\x83\x82\x01R` \x01a\x0e_V[PPPP\x90P\x01`@RPPPQ\x81a\x0e\x8cW\xfe[`\x00\x85\x81R`\x03` R`@\x90 `\x04\x01T\x91\x90\x04`\x01\x01a\xff\xff\x90\x91\x16\x10a\x0f\xd4W`\x00\x83\x81R`\x03` \x81\x81R`@\x80\x84 `\x01\x81\x81\x01\x80T`\x01`\x01`\xa0\x1b\x03\x90\x81\x16\x88R`\x02\x86R\x84\x88 \x80T`\xff\x19\x16\x90\x93\x17\x90\x92U\x89\x87R\x94\x84R`\x04\x91\x82\x01\x80Td\xff\x00\x00\x00\x00\x19\x16`\x01` \x1b\x17\x90U`\x05T\x94T\x83QcA^\x9e\xc9`\xe1\x1b\x81R\x90\x82\x16\x92\x81\x01\x92\x90\x92R\x91Q\x93\x90\x91\x16\x93c\x82\xbd=\x92\x93`$\x80\x84\x01\x94\x93\x91\x92\x91\x83\x90\x03\x01\x90\x82\x90\x87\x80;\x15\x80\x15a\x0fLW`\x00\x80\xfd[PZ\xf1\x15\x80\x15a\x0f`W=`\x00\x80>=`\x00\xfd[PPPP`@Q=` \x81\x10\x15a\x0fvW`\x00\x80\xfd[PP`\x00\x83\x81R`\x03` \x90\x81R`@\x91\x82\x90 `\x01\x01T\x82QB\x81R\x92Q`\x01`\x01`\xa0\x1b\x03\x90\x91\x16\x92\x86\x92\u007f\xc9\xd9ma\xebb\x03\x18e\xc5#\xae\x10\u007f<\"\xf5\xedDZ\xf27ck\u0348\xbe\xa1p\\p\u0552\x91\x82\x90\x03\x01\x90\xa3P`\x01a\x11\x89V[`\x05T`@\x80Qc\x13\xbc\xe0K`\xe3\x1b\x81R\x90Q`\x02\x92`\x01`\x01`\xa0\x1b\x03\x16\x91c\x9d\xe7\x02X\x91`\x04\x80\x83\x01\x92`\x00\x92\x91\x90\x82\x90\x03\x01\x81\x86\x80;\x15\x80\x15a\x10\x19W`\x00\x80\xfd[PZ\xfa\x15\x80\x15a\x10-W=`\x00\x80>=`\x00\xfd[PPPP`@Q=`\x00\x82>`\x1f=\x90\x81\x01`\x1f\x19\x16\x82\x01`@R` \x81\x10\x15a\x10VW`\x00\x80\xfd[\x81\x01\x90\x80\x80Q`@Q\x93\x92\x91\x90\x84`\x01` \x1b\x82\x11\x15a\x10uW`\x00\x80\xfd[\x90\x83\x01\x90` \x82\x01\x85\x81\x11\x15a\x10\x8aW`\x00\x80\xfd[\x82Q\x86` \x82\x02\x83\x01\x11`\x01` \x1b\x82\x11\x17\x15a\x10\xa6W`\x00\x80\xfd[\x82RP\x81Q` \x91\x82\x01\x92\x82\x01\x91\x02\x80\x83\x83`\x00[\x83\x81\x10\x15a\x10\xd3W\x81\x81\x01Q\x83\x82\x01R` \x01a\x10\xbbV[PPPP\x90P\x01`@RPPPQ\x81a\x10\xe8W\xfe[\x04`\x01\x01`\x03`\x00\x85\x81R` \x01\x90\x81R` \x01`\x00 `\x04\x01`\x02\x90T\x90a\x01\x00\n\x90\x04a\xff\xff\x16a\xff\xff\x16\x10a\x11\x85W`\x00\x83\x81R`\x03` \x90\x81R`@\x91\x82\x90 `\x04\x81\x01\x80Td\xff\x00\x00\x00\x00\x19\x16`\x01` \x1b\x17\x90U`\x01\x01T\x82QB\x81R\x92Q`\x01`\x01`\xa0\x1b\x03\x90\x91\x16\x92\x86\x92\u007f\xec\x95]w\xe6\xe7\xd7N\x18\xb1\xc9\x19w\xef\x0fo\u0566\xd0*(\u0457\x96\x863\x9f\u64d9x%\x92\x91\x82\x90\x03\x01\x90\xa3[P`\x01[\x92\x91PPV[h\x01\xbc\x16\xd6t\xec\x80\x00\x00\x81V[`\x15\x81V[b\x01Q\x80\x81V[`\x01T\x81V[ap\x80\x81V[`@\x80Q`\xe0\x81\x01\x82R`\x00\x80\x82R` \x82\x01\x81\x90R``\x92\x82\x01\x83\x90R\x91\x81\x01\x82\x90R`\x80\x81\x01\x82\x90R`\xa0\x81\x01\x82\x90R`\xc0\x81\x01\x91\x90\x91R\x90V[\x82\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x90`\x00R` `\x00 \x90`\x1f\x01` \x90\x04\x81\x01\x92\x82`\x1f\x10a\x122W\x80Q`\xff\x19\x16\x83\x80\x01\x17\x85Ua\x12_V[\x82\x80\x01`\x01\x01\x85U\x82\x15a\x12_W\x91\x82\x01[\x82\x81\x11\x15a\x12_W\x82Q\x82U\x91` \x01\x91\x90`\x01\x01\x90a\x12DV[Pa\x12k\x92\x91Pa\x12oV[P\x90V[a\x12\x89\x91\x90[\x80\x82\x11\x15a\x12kW`\x00\x81U`\x01\x01a\x12uV[\x90V\xfeDst already passed, You can start stakingYou can't vote for a proposal twice\xa2dipfsX\"\x12 \xb5\xd3\xe9 @\xa6>\x98v\x84\xb1\xa2\x8eX\xe2p\x16\xbed\x89\x1c\x03h\xeb\xe3Y\xa5\xee$;\xa7\xdbdsolcC\x00\x06\x01\x003\xe2\x94c\x01\xcd\xf0\x18\xe8g\x80g\u03cf\x14\xab\x99\xf6\xf2\xa9\x06\xdbD\x8b\u251a\xbe\x87Z\xba\xe1`\x00\x00\x80"
 
 const ropstenAllocData = "\xf9\x03\xa4\u0080\x01\xc2\x01\x01\xc2\x02\x01\xc2\x03\x01\xc2\x04\x01\xc2\x05\x01\xc2\x06\x01\xc2\a\x01\xc2\b\x01\xc2\t\x01\xc2\n\x80\xc2\v\x80\xc2\f\x80\xc2\r\x80\xc2\x0e\x80\xc2\x0f\x80\xc2\x10\x80\xc2\x11\x80\xc2\x12\x80\xc2\x13\x80\xc2\x14\x80\xc2\x15\x80\xc2\x16\x80\xc2\x17\x80\xc2\x18\x80\xc2\x19\x80\xc2\x1a\x80\xc2\x1b\x80\xc2\x1c\x80\xc2\x1d\x80\xc2\x1e\x80\xc2\x1f\x80\xc2 \x80\xc2!\x80\xc2\"\x80\xc2#\x80\xc2$\x80\xc2%\x80\xc2&\x80\xc2'\x80\xc2(\x80\xc2)\x80\xc2*\x80\xc2+\x80\xc2,\x80\xc2-\x80\xc2.\x80\xc2/\x80\xc20\x80\xc21\x80\xc22\x80\xc23\x80\xc24\x80\xc25\x80\xc26\x80\xc27\x80\xc28\x80\xc29\x80\xc2:\x80\xc2;\x80\xc2<\x80\xc2=\x80\xc2>\x80\xc2?\x80\xc2@\x80\xc2A\x80\xc2B\x80\xc2C\x80\xc2D\x80\xc2E\x80\xc2F\x80\xc2G\x80\xc2H\x80\xc2I\x80\xc2J\x80\xc2K\x80\xc2L\x80\xc2M\x80\xc2N\x80\xc2O\x80\xc2P\x80\xc2Q\x80\xc2R\x80\xc2S\x80\xc2T\x80\xc2U\x80\xc2V\x80\xc2W\x80\xc2X\x80\xc2Y\x80\xc2Z\x80\xc2[\x80\xc2\\\x80\xc2]\x80\xc2^\x80\xc2_\x80\xc2`\x80\xc2a\x80\xc2b\x80\xc2c\x80\xc2d\x80\xc2e\x80\xc2f\x80\xc2g\x80\xc2h\x80\xc2i\x80\xc2j\x80\xc2k\x80\xc2l\x80\xc2m\x80\xc2n\x80\xc2o\x80\xc2p\x80\xc2q\x80\xc2r\x80\xc2s\x80\xc2t\x80\xc2u\x80\xc2v\x80\xc2w\x80\xc2x\x80\xc2y\x80\xc2z\x80\xc2{\x80\xc2|\x80\xc2}\x80\xc2~\x80\xc2\u007f\x80\u00c1\x80\x80\u00c1\x81\x80\u00c1\x82\x80\u00c1\x83\x80\u00c1\x84\x80\u00c1\x85\x80\u00c1\x86\x80\u00c1\x87\x80\u00c1\x88\x80\u00c1\x89\x80\u00c1\x8a\x80\u00c1\x8b\x80\u00c1\x8c\x80\u00c1\x8d\x80\u00c1\x8e\x80\u00c1\x8f\x80\u00c1\x90\x80\u00c1\x91\x80\u00c1\x92\x80\u00c1\x93\x80\u00c1\x94\x80\u00c1\x95\x80\u00c1\x96\x80\u00c1\x97\x80\u00c1\x98\x80\u00c1\x99\x80\u00c1\x9a\x80\u00c1\x9b\x80\u00c1\x9c\x80\u00c1\x9d\x80\u00c1\x9e\x80\u00c1\x9f\x80\u00c1\xa0\x80\u00c1\xa1\x80\u00c1\xa2\x80\u00c1\xa3\x80\u00c1\xa4\x80\u00c1\xa5\x80\u00c1\xa6\x80\u00c1\xa7\x80\u00c1\xa8\x80\u00c1\xa9\x80\u00c1\xaa\x80\u00c1\xab\x80\u00c1\xac\x80\u00c1\xad\x80\u00c1\xae\x80\u00c1\xaf\x80\u00c1\xb0\x80\u00c1\xb1\x80\u00c1\xb2\x80\u00c1\xb3\x80\u00c1\xb4\x80\u00c1\xb5\x80\u00c1\xb6\x80\u00c1\xb7\x80\u00c1\xb8\x80\u00c1\xb9\x80\u00c1\xba\x80\u00c1\xbb\x80\u00c1\xbc\x80\u00c1\xbd\x80\u00c1\xbe\x80\u00c1\xbf\x80\u00c1\xc0\x80\u00c1\xc1\x80\u00c1\u0080\u00c1\u00c0\u00c1\u0100\u00c1\u0140\u00c1\u0180\u00c1\u01c0\u00c1\u0200\u00c1\u0240\u00c1\u0280\u00c1\u02c0\u00c1\u0300\u00c1\u0340\u00c1\u0380\u00c1\u03c0\u00c1\u0400\u00c1\u0440\u00c1\u0480\u00c1\u04c0\u00c1\u0500\u00c1\u0540\u00c1\u0580\u00c1\u05c0\u00c1\u0600\u00c1\u0640\u00c1\u0680\u00c1\u06c0\u00c1\u0700\u00c1\u0740\u00c1\u0780\u00c1\u07c0\u00c1\xe0\x80\u00c1\xe1\x80\u00c1\xe2\x80\u00c1\xe3\x80\u00c1\xe4\x80\u00c1\xe5\x80\u00c1\xe6\x80\u00c1\xe7\x80\u00c1\xe8\x80\u00c1\xe9\x80\u00c1\xea\x80\u00c1\xeb\x80\u00c1\xec\x80\u00c1\xed\x80\u00c1\xee\x80\u00c1\xef\x80\u00c1\xf0\x80\u00c1\xf1\x80\u00c1\xf2\x80\u00c1\xf3\x80\u00c1\xf4\x80\u00c1\xf5\x80\u00c1\xf6\x80\u00c1\xf7\x80\u00c1\xf8\x80\u00c1\xf9\x80\u00c1\xfa\x80\u00c1\xfb\x80\u00c1\xfc\x80\u00c1\xfd\x80\u00c1\xfe\x80\u00c1\xff\x80\u3507KT\xa8\xbd\x15)f\xd6?pk\xae\x1f\xfe\xb0A\x19!\xe5\x8d\f\x9f,\x9c\xd0Ft\xed\xea@\x00\x00\x00"
-
-// basicAllocForDemocracy contains three basic system contracts for democracy engine
-const basicAllocForDemocracy = "\xf9ha\xf9Ic\x82\xf0\x00\x80\xb9I\\`\x80`@R`\x046\x10a\x01\xe2W`\x005`\xe0\x1c\x80c\x8a\x11\xd7\xc9\x11a\x01\x02W\x80c\xb6\u0205\x19\x11a\x00\x95W\x80c\xd6\xc0\xed\xad\x11a\x00dW\x80c\xd6\xc0\xed\xad\x14a\x0e\x90W\x80c\xdbx\xdd(\x14a\x0e\x98W\x80c\xef\xd8\xd8\xe2\x14a\x0e\xcaW\x80c\U0008837b\x14a\x0e\xdfWa\x01\xe2V[\x80c\xb6\u0205\x19\x14a\v\\W\x80c\xbedV\x92\x14a\x0e!W\x80c\xc2S\u00c4\x14a\x0e6W\x80c\xc9g\xf9\x0f\x14a\x0edWa\x01\xe2V[\x80c\xa2$\xce\xe7\x11a\x00\xd1W\x80c\xa2$\xce\xe7\x14a\x06\xbeW\x80c\xa4\x06\xfc\xb7\x14a\a9W\x80c\xa45i\xb3\x14a\t\x04W\x80c\xaf\xee\xa1\x15\x14a\vGWa\x01\xe2V[\x80c\x8a\x11\xd7\xc9\x14a\x05IW\x80c\x8b\x0e\x9f?\x14a\x06\x11W\x80c\x98\xe3\xb6&\x14a\x06&W\x80c\x9d\xe7\x02X\x14a\x06YWa\x01\xe2V[\x80c@\xa1A\xff\x11a\x01zW\x80chF\x99*\x11a\x01IW\x80chF\x99*\x14a\x03\xe3W\x80cii\xa2\\\x14a\x04\x93W\x80c\u007fO\x95\xfa\x14a\x04\xbdW\x80c\x82\xbd=\x92\x14a\x05\x16Wa\x01\xe2V[\x80c@\xa1A\xff\x14a\x03<W\x80cK=P\v\x14a\x03qW\x80c]\u0415\x90\x14a\x03\x9bW\x80cb3\xbe]\x14a\x03\xceWa\x01\xe2V[\x80c\"-;\x05\x11a\x01\xb6W\x80c\"-;\x05\x14a\x02\x9bW\x80c&Gb\x04\x14a\x02\xceW\x80c:\x06\x1b\xd3\x14a\x02\xf4W\x80c@U\n\x1c\x14a\x03\tWa\x01\xe2V[\x80b6*w\x14a\x01\xe7W\x80c\x13\x03\xf7\xcf\x14a\x02.W\x80c\x15\x8e\xf9>\x14a\x02UW\x80c\x1b^5\x8c\x14a\x02jW[`\x00\x80\xfd[4\x80\x15a\x01\xf3W`\x00\x80\xfd[Pa\x02\x1a`\x04\x806\x03` \x81\x10\x15a\x02\nW`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x0f\x12V[`@\x80Q\x91\x15\x15\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[4\x80\x15a\x02:W`\x00\x80\xfd[Pa\x02Ca\x11zV[`@\x80Q\x91\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[4\x80\x15a\x02aW`\x00\x80\xfd[Pa\x02\x1aa\x11\x80V[4\x80\x15a\x02vW`\x00\x80\xfd[Pa\x02\u007fa\x11\x89V[`@\x80Q`\x01`\x01`\xa0\x1b\x03\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[4\x80\x15a\x02\xa7W`\x00\x80\xfd[Pa\x02\x1a`\x04\x806\x03` \x81\x10\x15a\x02\xbeW`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x11\x8fV[a\x02\x1a`\x04\x806\x03` \x81\x10\x15a\x02\xe4W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x13\xb4V[4\x80\x15a\x03\x00W`\x00\x80\xfd[Pa\x02\u007fa\x17\xdfV[4\x80\x15a\x03\x15W`\x00\x80\xfd[Pa\x02\x1a`\x04\x806\x03` \x81\x10\x15a\x03,W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x17\xe5V[4\x80\x15a\x03HW`\x00\x80\xfd[Pa\x03o`\x04\x806\x03` \x81\x10\x15a\x03_W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x18@V[\x00[4\x80\x15a\x03}W`\x00\x80\xfd[Pa\x02\u007f`\x04\x806\x03` \x81\x10\x15a\x03\x94W`\x00\x80\xfd[P5a\x19\x88V[4\x80\x15a\x03\xa7W`\x00\x80\xfd[Pa\x03o`\x04\x806\x03` \x81\x10\x15a\x03\xbeW`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x19\xafV[4\x80\x15a\x03\xdaW`\x00\x80\xfd[Pa\x02\u007fa\x1a\bV[4\x80\x15a\x03\xefW`\x00\x80\xfd[Pa\x03o`\x04\x806\x03`@\x81\x10\x15a\x04\x06W`\x00\x80\xfd[\x81\x01\x90` \x81\x01\x815`\x01` \x1b\x81\x11\x15a\x04 W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\x042W`\x00\x80\xfd[\x805\x90` \x01\x91\x84` \x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\x04SW`\x00\x80\xfd[\x91\x90\x80\x80` \x02` \x01`@Q\x90\x81\x01`@R\x80\x93\x92\x91\x90\x81\x81R` \x01\x83\x83` \x02\x80\x82\x847`\x00\x92\x01\x91\x90\x91RP\x92\x95PP\x915\x92Pa\x1a\x0e\x91PPV[4\x80\x15a\x04\x9fW`\x00\x80\xfd[Pa\x02\u007f`\x04\x806\x03` \x81\x10\x15a\x04\xb6W`\x00\x80\xfd[P5a\x1cUV[4\x80\x15a\x04\xc9W`\x00\x80\xfd[Pa\x04\xf8`\x04\x806\x03`@\x81\x10\x15a\x04\xe0W`\x00\x80\xfd[P`\x01`\x01`\xa0\x1b\x03\x815\x81\x16\x91` \x015\x16a\x1cbV[`@\x80Q\x93\x84R` \x84\x01\x92\x90\x92R\x82\x82\x01RQ\x90\x81\x90\x03``\x01\x90\xf3[4\x80\x15a\x05\"W`\x00\x80\xfd[Pa\x02\x1a`\x04\x806\x03` \x81\x10\x15a\x059W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x1c\x9fV[4\x80\x15a\x05UW`\x00\x80\xfd[Pa\x05|`\x04\x806\x03` \x81\x10\x15a\x05lW`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x1f\x03V[`@Q`\x01`\x01`\xa0\x1b\x03\x88\x16\x81R` \x81\x01\x87`\x04\x81\x11\x15a\x05\x9bW\xfe[`\xff\x16\x81R` \x01\x86\x81R` \x01\x85\x81R` \x01\x84\x81R` \x01\x83\x81R` \x01\x80` \x01\x82\x81\x03\x82R\x83\x81\x81Q\x81R` \x01\x91P\x80Q\x90` \x01\x90` \x02\x80\x83\x83`\x00[\x83\x81\x10\x15a\x05\xf7W\x81\x81\x01Q\x83\x82\x01R` \x01a\x05\xdfV[PPPP\x90P\x01\x98PPPPPPPPP`@Q\x80\x91\x03\x90\xf3[4\x80\x15a\x06\x1dW`\x00\x80\xfd[Pa\x02Ca#LV[4\x80\x15a\x062W`\x00\x80\xfd[Pa\x02\x1a`\x04\x806\x03` \x81\x10\x15a\x06IW`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a#RV[4\x80\x15a\x06eW`\x00\x80\xfd[Pa\x06na#\xa4V[`@\x80Q` \x80\x82R\x83Q\x81\x83\x01R\x83Q\x91\x92\x83\x92\x90\x83\x01\x91\x85\x81\x01\x91\x02\x80\x83\x83`\x00[\x83\x81\x10\x15a\x06\xaaW\x81\x81\x01Q\x83\x82\x01R` \x01a\x06\x92V[PPPP\x90P\x01\x92PPP`@Q\x80\x91\x03\x90\xf3[4\x80\x15a\x06\xcaW`\x00\x80\xfd[Pa\x03o`\x04\x806\x03` \x81\x10\x15a\x06\xe1W`\x00\x80\xfd[\x81\x01\x90` \x81\x01\x815`\x01` \x1b\x81\x11\x15a\x06\xfbW`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\a\rW`\x00\x80\xfd[\x805\x90` \x01\x91\x84` \x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\a.W`\x00\x80\xfd[P\x90\x92P\x90Pa$\aV[4\x80\x15a\aEW`\x00\x80\xfd[Pa\x02\x1a`\x04\x806\x03`\xc0\x81\x10\x15a\a\\W`\x00\x80\xfd[`\x01`\x01`\xa0\x1b\x03\x825\x16\x91\x90\x81\x01\x90`@\x81\x01` \x82\x015`\x01` \x1b\x81\x11\x15a\a\x86W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\a\x98W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\a\xb9W`\x00\x80\xfd[\x91\x93\x90\x92\x90\x91` \x81\x01\x905`\x01` \x1b\x81\x11\x15a\a\xd6W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\a\xe8W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\b\tW`\x00\x80\xfd[\x91\x93\x90\x92\x90\x91` \x81\x01\x905`\x01` \x1b\x81\x11\x15a\b&W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\b8W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\bYW`\x00\x80\xfd[\x91\x93\x90\x92\x90\x91` \x81\x01\x905`\x01` \x1b\x81\x11\x15a\bvW`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\b\x88W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\b\xa9W`\x00\x80\xfd[\x91\x93\x90\x92\x90\x91` \x81\x01\x905`\x01` \x1b\x81\x11\x15a\b\xc6W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\b\xd8W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\b\xf9W`\x00\x80\xfd[P\x90\x92P\x90Pa'hV[4\x80\x15a\t\x10W`\x00\x80\xfd[Pa\t7`\x04\x806\x03` \x81\x10\x15a\t'W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a-tV[`@Q\x80\x80` \x01\x80` \x01\x80` \x01\x80` \x01\x80` \x01\x86\x81\x03\x86R\x8b\x81\x81Q\x81R` \x01\x91P\x80Q\x90` \x01\x90\x80\x83\x83`\x00[\x83\x81\x10\x15a\t\x84W\x81\x81\x01Q\x83\x82\x01R` \x01a\tlV[PPPP\x90P\x90\x81\x01\x90`\x1f\x16\x80\x15a\t\xb1W\x80\x82\x03\x80Q`\x01\x83` \x03a\x01\x00\n\x03\x19\x16\x81R` \x01\x91P[P\x86\x81\x03\x85R\x8aQ\x81R\x8aQ` \x91\x82\x01\x91\x8c\x01\x90\x80\x83\x83`\x00[\x83\x81\x10\x15a\t\xe4W\x81\x81\x01Q\x83\x82\x01R` \x01a\t\xccV[PPPP\x90P\x90\x81\x01\x90`\x1f\x16\x80\x15a\n\x11W\x80\x82\x03\x80Q`\x01\x83` \x03a\x01\x00\n\x03\x19\x16\x81R` \x01\x91P[P\x86\x81\x03\x84R\x89Q\x81R\x89Q` \x91\x82\x01\x91\x8b\x01\x90\x80\x83\x83`\x00[\x83\x81\x10\x15a\nDW\x81\x81\x01Q\x83\x82\x01R` \x01a\n,V[PPPP\x90P\x90\x81\x01\x90`\x1f\x16\x80\x15a\nqW\x80\x82\x03\x80Q`\x01\x83` \x03a\x01\x00\n\x03\x19\x16\x81R` \x01\x91P[P\x86\x81\x03\x83R\x88Q\x81R\x88Q` \x91\x82\x01\x91\x8a\x01\x90\x80\x83\x83`\x00[\x83\x81\x10\x15a\n\xa4W\x81\x81\x01Q\x83\x82\x01R` \x01a\n\x8cV[PPPP\x90P\x90\x81\x01\x90`\x1f\x16\x80\x15a\n\xd1W\x80\x82\x03\x80Q`\x01\x83` \x03a\x01\x00\n\x03\x19\x16\x81R` \x01\x91P[P\x86\x81\x03\x82R\x87Q\x81R\x87Q` \x91\x82\x01\x91\x89\x01\x90\x80\x83\x83`\x00[\x83\x81\x10\x15a\v\x04W\x81\x81\x01Q\x83\x82\x01R` \x01a\n\xecV[PPPP\x90P\x90\x81\x01\x90`\x1f\x16\x80\x15a\v1W\x80\x82\x03\x80Q`\x01\x83` \x03a\x01\x00\n\x03\x19\x16\x81R` \x01\x91P[P\x9aPPPPPPPPPPP`@Q\x80\x91\x03\x90\xf3[4\x80\x15a\vSW`\x00\x80\xfd[Pa\x06na1\xa8V[4\x80\x15a\vhW`\x00\x80\xfd[Pa\x02\x1a`\x04\x806\x03`\xa0\x81\x10\x15a\v\u007fW`\x00\x80\xfd[\x81\x01\x90` \x81\x01\x815`\x01` \x1b\x81\x11\x15a\v\x99W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\v\xabW`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\v\xccW`\x00\x80\xfd[\x91\x90\x80\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x93\x92\x91\x90\x81\x81R` \x01\x83\x83\x80\x82\x847`\x00\x92\x01\x91\x90\x91RP\x92\x95\x94\x93` \x81\x01\x93P5\x91PP`\x01` \x1b\x81\x11\x15a\f\x1eW`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\f0W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\fQW`\x00\x80\xfd[\x91\x90\x80\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x93\x92\x91\x90\x81\x81R` \x01\x83\x83\x80\x82\x847`\x00\x92\x01\x91\x90\x91RP\x92\x95\x94\x93` \x81\x01\x93P5\x91PP`\x01` \x1b\x81\x11\x15a\f\xa3W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\f\xb5W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\f\xd6W`\x00\x80\xfd[\x91\x90\x80\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x93\x92\x91\x90\x81\x81R` \x01\x83\x83\x80\x82\x847`\x00\x92\x01\x91\x90\x91RP\x92\x95\x94\x93` \x81\x01\x93P5\x91PP`\x01` \x1b\x81\x11\x15a\r(W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\r:W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\r[W`\x00\x80\xfd[\x91\x90\x80\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x93\x92\x91\x90\x81\x81R` \x01\x83\x83\x80\x82\x847`\x00\x92\x01\x91\x90\x91RP\x92\x95\x94\x93` \x81\x01\x93P5\x91PP`\x01` \x1b\x81\x11\x15a\r\xadW`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\r\xbfW`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\r\xe0W`\x00\x80\xfd[\x91\x90\x80\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x93\x92\x91\x90\x81\x81R` \x01\x83\x83\x80\x82\x847`\x00\x92\x01\x91\x90\x91RP\x92\x95Pa2\b\x94PPPPPV[4\x80\x15a\x0e-W`\x00\x80\xfd[Pa\x02Ca3\xadV[4\x80\x15a\x0eBW`\x00\x80\xfd[Pa\x0eKa3\xbaV[`@\x80Q\x92\x83R` \x83\x01\x91\x90\x91R\x80Q\x91\x82\x90\x03\x01\x90\xf3[4\x80\x15a\x0epW`\x00\x80\xfd[Pa\x0eya3\xcfV[`@\x80Qa\xff\xff\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x03oa3\xd4V[4\x80\x15a\x0e\xa4W`\x00\x80\xfd[Pa\x0e\xada5tV[`@\x80Qg\xff\xff\xff\xff\xff\xff\xff\xff\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[4\x80\x15a\x0e\xd6W`\x00\x80\xfd[Pa\x0e\xada5{V[4\x80\x15a\x0e\xebW`\x00\x80\xfd[Pa\x02\x1a`\x04\x806\x03` \x81\x10\x15a\x0f\x02W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a5\x81V[`\x003\x81`\x01`\x01`\xa0\x1b\x03\x84\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x0fDW\xfe[\x14\x15a\x0f\x8dW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x13`$\x82\x01Rr\x15\x98[\x1aY\x18]\x1b\u0708\x1b\x9b\xdd\b\x19^\x1a\\\xdd`j\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x83\x81\x16`\x00\x90\x81R`\x01` R`@\x90 T\x81\x16\x90\x82\x16\x14a\x0f\xe8W`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`.\x81R` \x01\x80aH\xf9`.\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 `\t\x01TCap\x80\x90\x91\x01\x11\x15a\x10HW`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`\\\x81R` \x01\x80aGl`\\\x919``\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01T\x80a\x10\xb6W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x1a`$\x82\x01R\u007fYou don't have any profits\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x84\x16`\x00\x90\x81R`\x01` R`@\x81 `\a\x81\x01\x91\x90\x91UC`\t\x90\x91\x01U\x80\x15a\x11\x1bW`@Q`\x01`\x01`\xa0\x1b\x03\x83\x16\x90\x82\x15a\b\xfc\x02\x90\x83\x90`\x00\x81\x81\x81\x85\x88\x88\xf1\x93PPPP\x15\x80\x15a\x11\x19W=`\x00\x80>=`\x00\xfd[P[\x81`\x01`\x01`\xa0\x1b\x03\x16\x84`\x01`\x01`\xa0\x1b\x03\x16\u007fQ\xa6\x9bE\x02\xf6`wL\x939\x82\\{Z\xdb\xf0\xb8b\"\x89\x13FG\xe2\x97(\xec]\x9b;\xb9\x83B`@Q\x80\x83\x81R` \x01\x82\x81R` \x01\x92PPP`@Q\x80\x91\x03\x90\xa3`\x01\x92PPP[\x91\x90PV[`\x06T\x81V[`\x00T`\xff\x16\x81V[a\xf0\x01\x81V[3`\x00\x81\x81R`\x02` \x90\x81R`@\x80\x83 `\x01`\x01`\xa0\x1b\x03\x86\x16\x84R\x82R\x80\x83 `\x01\x90\x92R\x82 T\x91\x92\x91\x83\x90`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x11\xd4W\xfe[\x14\x15a\x12\x1dW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x13`$\x82\x01Rr\x1d\x98[\x1aY\x18]\x1b\u0708\x1b\x9b\xdd\b\x19^\x1a\\\xdd`j\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01\x81\x01Ta\x12sW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x19`$\x82\x01R\u007fYou have to unstake first\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[Cb\x01Q\x80g\xff\xff\xff\xff\xff\xff\xff\xff\x16\x82`\x01\x01T\x01\x11\x15a\x12\xc5W`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`!\x81R` \x01\x80aG\xc8`!\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[\x80Ta\x13\x13W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x18`$\x82\x01RwYou don't have any stake`@\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[\x80T`\x00\x80\x83U`\x01\x83\x01\x81\x90U`@Q`\x01`\x01`\xa0\x1b\x03\x85\x16\x91\x83\x15a\b\xfc\x02\x91\x84\x91\x81\x81\x81\x85\x88\x88\xf1\x93PPPP\x15\x80\x15a\x13UW=`\x00\x80>=`\x00\xfd[P\x84`\x01`\x01`\xa0\x1b\x03\x16\x83`\x01`\x01`\xa0\x1b\x03\x16\u007f\xa7\f\xd9@p\u0345#9\xa7k2\xcf-\x95\xa3\xc8\xf2\xa3\"&\x91c\xd2v\a\x1c\x1c\x14\x95V\x19\x83B`@Q\x80\x83\x81R` \x01\x82\x81R` \x01\x92PPP`@Q\x80\x91\x03\x90\xa3P`\x01\x94\x93PPPPV[`\x00\x80T`\xff\x16a\x13\xfbW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[34`\x01`\x01`\x01`\xa0\x1b\x03\x85\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x14-W\xfe[\x14\x80a\x14fWP`\x02`\x01`\x01`\xa0\x1b\x03\x85\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x14dW\xfe[\x14[a\x14\xa1W`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`-\x81R` \x01\x80aHP`-\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\aT`@\x80QcAbY\xd9`\xe1\x1b\x81R`\x01`\x01`\xa0\x1b\x03\x87\x81\x16`\x04\x83\x01R\x91Q\x91\x90\x92\x16\x91c\x82\u0133\xb2\x91`$\x80\x83\x01\x92` \x92\x91\x90\x82\x90\x03\x01\x81\x86\x80;\x15\x80\x15a\x14\xeeW`\x00\x80\xfd[PZ\xfa\x15\x80\x15a\x15\x02W=`\x00\x80>=`\x00\xfd[PPPP`@Q=` \x81\x10\x15a\x15\x18W`\x00\x80\xfd[PQa\x15UW`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`8\x81R` \x01\x80aH\x9e`8\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x80\x83\x16`\x00\x90\x81R`\x02` \x90\x81R`@\x80\x83 \x93\x88\x16\x83R\x92\x90R `\x01\x01T\x15a\x15\xbaW`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`\"\x81R` \x01\x80aH.`\"\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x84\x16`\x00\x90\x81R`\x01` \x81\x90R`@\x90\x91 \x90\x81\x01Th\x01\xbc\x16\xd6t\xec\x80\x00\x00\x90a\x15\xf4\x90\x84c\xff\xff\xff\xffa9\xd9\x16V[\x10\x15a\x16GW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x18`$\x82\x01R\u007fStaking coins not enough\x00\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x80\x84\x16`\x00\x90\x81R`\x02` \x90\x81R`@\x80\x83 \x93\x89\x16\x83R\x92\x90R Ta\x16\xc0W`\n\x81\x01\x80T`\x01`\x01`\xa0\x1b\x03\x80\x86\x16`\x00\x81\x81R`\x02` \x81\x81R`@\x80\x84 \x95\x8d\x16\x84R\x94\x81R\x93\x82 \x01\x84\x90U`\x01\x84\x01\x85U\x93\x84R\x92 \x01\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16\x90\x91\x17\x90U[`\x01\x81\x01Ta\x16\u0550\x83c\xff\xff\xff\xffa9\xd9\x16V[`\x01\x82\x01U`\x02\x81T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x16\xf3W\xfe[\x14a\x17\nW\x80T`\xff`\xa0\x1b\x19\x16`\x01`\xa1\x1b\x17\x81U[a\x17\x18\x85\x82`\x01\x01Ta:<V[`\x01`\x01`\xa0\x1b\x03\x80\x84\x16`\x00\x90\x81R`\x02` \x90\x81R`@\x80\x83 \x93\x89\x16\x83R\x92\x90R Ta\x17N\x90\x83c\xff\xff\xff\xffa9\xd9\x16V[`\x01`\x01`\xa0\x1b\x03\x80\x85\x16`\x00\x90\x81R`\x02` \x90\x81R`@\x80\x83 \x93\x8a\x16\x83R\x92\x90R U`\x05Ta\x17\x87\x90\x83c\xff\xff\xff\xffa9\xd9\x16V[`\x05U`@\x80Q\x83\x81RB` \x82\x01R\x81Q`\x01`\x01`\xa0\x1b\x03\x80\x89\x16\x93\x90\x87\x16\x92\u007f\xb9\xbarY4S#\x16\xcf\xfe\x10\x97]\xa6\xeb%\xadI\xc2\xd1\u0094\u0642\xc4l\x9f\x8dhN\xe0u\x92\x90\x81\x90\x03\x90\x91\x01\x90\xa3P`\x01\x94\x93PPPPV[a\xf0\x00\x81V[`\x00\x80[`\x03T\x81\x10\x15a\x187W\x82`\x01`\x01`\xa0\x1b\x03\x16`\x03\x82\x81T\x81\x10a\x18\nW\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x16\x14\x15a\x18/W`\x01\x91PPa\x11uV[`\x01\x01a\x17\xe9V[P`\x00\x92\x91PPV[3a\xf0\x01\x14a\x18\x8dW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x14`$\x82\x01RsPunish contract only``\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x81\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01Ta\x18\xb2\x82a<\xd6V[`\x04T`\x01\x10\x15a\x19\x84Wa\x18\u0182a=\xfaV[`\aT`@\x80Qc\x15\xea'\x81`\xe0\x1b\x81R`\x01`\x01`\xa0\x1b\x03\x85\x81\x16`\x04\x83\x01R\x91Q\x91\x90\x92\x16\x91c\x15\xea'\x81\x91`$\x80\x83\x01\x92` \x92\x91\x90\x82\x90\x03\x01\x81`\x00\x87\x80;\x15\x80\x15a\x19\x15W`\x00\x80\xfd[PZ\xf1\x15\x80\x15a\x19)W=`\x00\x80>=`\x00\xfd[PPPP`@Q=` \x81\x10\x15a\x19?W`\x00\x80\xfd[PP`@\x80Q\x82\x81RB` \x82\x01R\x81Q`\x01`\x01`\xa0\x1b\x03\x85\x16\x92\u007f\xa2m\xe7\xab2N\xac\b\u0156T\x9fB\x1e\\\x87A!=#}.\x9a,\x9c\x0e\xbd\u09e8I\xfe\x92\x82\x90\x03\x01\x90\xa2[PPV[`\x04\x81\x81T\x81\x10a\x19\x95W\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x16\x90P\x81V[3a\xf0\x01\x14a\x19\xfcW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x14`$\x82\x01RsPunish contract only``\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[a\x1a\x05\x81a<\xd6V[PV[a\xf0\x02\x81V[3A\x14a\x1aOW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\n`$\x82\x01RiMiner only`\xb0\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[C`\x00\x90\x81R`\t` \x90\x81R`@\x80\x83 `\x01\x84R\x90\x91R\x90 T`\xff\x16\x15a\x1a\xc0W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x1a`$\x82\x01R\u007fValidators already updated\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x00T`\xff\x16a\x1b\x06W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[\x80\x80C\x81a\x1b\x10W\xfe[\x06\x15a\x1bVW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x10`$\x82\x01RoBlock epoch only`\x80\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[C`\x00\x90\x81R`\t` \x90\x81R`@\x80\x83 `\x01\x80\x85R\x92R\x90\x91 \x80T`\xff\x19\x16\x90\x91\x17\x90U\x82Qa\x1b\xc7W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x14`$\x82\x01RsValidator set empty!``\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[\x82Qa\x1b\u0690`\x03\x90` \x86\x01\x90aE\xd3V[P\u007f\xea\u03a8\xf3\xc2/\x06\xc0\xb1\x83\x06\xbd\xb0M\n\x96rU\x12\x9e\x8c\xe0\tM\ubc20\xff\x89\xd0\x06\xb5\x83`@Q\x80\x80` \x01\x82\x81\x03\x82R\x83\x81\x81Q\x81R` \x01\x91P\x80Q\x90` \x01\x90` \x02\x80\x83\x83`\x00[\x83\x81\x10\x15a\x1c=W\x81\x81\x01Q\x83\x82\x01R` \x01a\x1c%V[PPPP\x90P\x01\x92PPP`@Q\x80\x91\x03\x90\xa1PPPV[`\x03\x81\x81T\x81\x10a\x19\x95W\xfe[`\x01`\x01`\xa0\x1b\x03\x91\x82\x16`\x00\x90\x81R`\x02` \x81\x81R`@\x80\x84 \x94\x90\x95\x16\x83R\x92\x90\x92R\x91\x90\x91 \x80T`\x01\x82\x01T\x91\x90\x92\x01T\x91\x92\x90\x91\x90V[`\x003a\xf0\x02\x14a\x1c\xf0W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x16`$\x82\x01RuProposal contract only`P\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x00T`\xff\x16a\x1d6W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x03`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x1dfW\xfe[\x14\x15\x80\x15a\x1d\xa2WP`\x04`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x1d\x9fW\xfe[\x14\x15[\x15a\x1d\xafWP`\x01a\x11uV[`\x04`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x1d\xdfW\xfe[\x14\x15a\x1e\xa0W`\bT`@\x80Qcc\xe1\xd4Q`\xe0\x1b\x81R`\x01`\x01`\xa0\x1b\x03\x85\x81\x16`\x04\x83\x01R\x91Q\x91\x90\x92\x16\x91cc\xe1\xd4Q\x91`$\x80\x83\x01\x92` \x92\x91\x90\x82\x90\x03\x01\x81`\x00\x87\x80;\x15\x80\x15a\x1e4W`\x00\x80\xfd[PZ\xf1\x15\x80\x15a\x1eHW=`\x00\x80>=`\x00\xfd[PPPP`@Q=` \x81\x10\x15a\x1e^W`\x00\x80\xfd[PQa\x1e\xa0W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x18\xdb\x19X[\x88\x19\x98Z[\x19Y`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x81\x81R`\x01` \x90\x81R`@\x91\x82\x90 \x80T`\xff`\xa0\x1b\x19\x16`\x01`\xa0\x1b\x17\x90U\x81QB\x81R\x91Q\u007f\u0632\xc4&\xec\x1b\u6727X=&\xb1\u84d4n2'C\r>\xbc;\xd6M\x9e\x1cF\x9c\xb4\x00\x92\x81\x90\x03\x90\x91\x01\x90\xa2\x91\x90PV[`\x00\x80`\x00\x80`\x00\x80``a\x1f\x16aF8V[`\x01`\x01`\xa0\x1b\x03\x89\x81\x16`\x00\x90\x81R`\x01` \x90\x81R`@\x91\x82\x90 \x82Qa\x01\x00\x81\x01\x90\x93R\x80T\x93\x84\x16\x83R\x91\x92\x90\x83\x01\x90`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a\x1f_W\xfe[`\x04\x81\x11\x15a\x1fjW\xfe[\x81R` \x01`\x01\x82\x01T\x81R` \x01`\x02\x82\x01`@Q\x80`\xa0\x01`@R\x90\x81`\x00\x82\x01\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x92\x91\x90\x81\x81R` \x01\x82\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x80\x15a !W\x80`\x1f\x10a\x1f\xf6Wa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a !V[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a \x04W\x82\x90\x03`\x1f\x16\x82\x01\x91[PPPPP\x81R` \x01`\x01\x82\x01\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x92\x91\x90\x81\x81R` \x01\x82\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x80\x15a \xc3W\x80`\x1f\x10a \x98Wa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a \xc3V[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a \xa6W\x82\x90\x03`\x1f\x16\x82\x01\x91[PPP\x91\x83RPP`\x02\x82\x81\x01\x80T`@\x80Q` `\x01\x84\x16\x15a\x01\x00\x02`\x00\x19\x01\x90\x93\x16\x94\x90\x94\x04`\x1f\x81\x01\x83\x90\x04\x83\x02\x85\x01\x83\x01\x90\x91R\x80\x84R\x93\x81\x01\x93\x90\x83\x01\x82\x82\x80\x15a!UW\x80`\x1f\x10a!*Wa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a!UV[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a!8W\x82\x90\x03`\x1f\x16\x82\x01\x91[PPP\x91\x83RPP`\x03\x82\x01\x80T`@\x80Q` `\x02`\x01\x85\x16\x15a\x01\x00\x02`\x00\x19\x01\x90\x94\x16\x93\x90\x93\x04`\x1f\x81\x01\x84\x90\x04\x84\x02\x82\x01\x84\x01\x90\x92R\x81\x81R\x93\x82\x01\x93\x92\x91\x83\x01\x82\x82\x80\x15a!\xe9W\x80`\x1f\x10a!\xbeWa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a!\xe9V[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a!\xccW\x82\x90\x03`\x1f\x16\x82\x01\x91[PPP\x91\x83RPP`\x04\x82\x01\x80T`@\x80Q` `\x02`\x01\x85\x16\x15a\x01\x00\x02`\x00\x19\x01\x90\x94\x16\x93\x90\x93\x04`\x1f\x81\x01\x84\x90\x04\x84\x02\x82\x01\x84\x01\x90\x92R\x81\x81R\x93\x82\x01\x93\x92\x91\x83\x01\x82\x82\x80\x15a\"}W\x80`\x1f\x10a\"RWa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a\"}V[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a\"`W\x82\x90\x03`\x1f\x16\x82\x01\x91[PPPPP\x81RPP\x81R` \x01`\a\x82\x01T\x81R` \x01`\b\x82\x01T\x81R` \x01`\t\x82\x01T\x81R` \x01`\n\x82\x01\x80T\x80` \x02` \x01`@Q\x90\x81\x01`@R\x80\x92\x91\x90\x81\x81R` \x01\x82\x80T\x80\x15a#\x01W` \x02\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T`\x01`\x01`\xa0\x1b\x03\x16\x81R`\x01\x90\x91\x01\x90` \x01\x80\x83\x11a\"\xe3W[PPPPP\x81RPP\x90P\x80`\x00\x01Q\x81` \x01Q\x82`@\x01Q\x83`\x80\x01Q\x84`\xa0\x01Q\x85`\xc0\x01Q\x86`\xe0\x01Q\x80\x90P\x97P\x97P\x97P\x97P\x97P\x97P\x97PP\x91\x93\x95\x97\x90\x92\x94\x96PV[`\x05T\x81V[`\x00\x80[`\x04T\x81\x10\x15a\x187W\x82`\x01`\x01`\xa0\x1b\x03\x16`\x04\x82\x81T\x81\x10a#wW\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x16\x14\x15a#\x9cW`\x01\x91PPa\x11uV[`\x01\x01a#VV[```\x03\x80T\x80` \x02` \x01`@Q\x90\x81\x01`@R\x80\x92\x91\x90\x81\x81R` \x01\x82\x80T\x80\x15a#\xfcW` \x02\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T`\x01`\x01`\xa0\x1b\x03\x16\x81R`\x01\x90\x91\x01\x90` \x01\x80\x83\x11a#\xdeW[PPPPP\x90P[\x90V[`\x00T`\xff\x16\x15a$UW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x13`$\x82\x01Rr\x10[\x1c\x99XY\x1eH\x1a[\x9a]\x1aX[\x1a^\x99Y`j\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\a\x80T`\x01`\x01`\xa0\x1b\x03\x19\x90\x81\x16a\xf0\x02\x17\x90\x91U`\b\x80T\x90\x91\x16a\xf0\x01\x17\x90U`\x00[\x81\x81\x10\x15a'VW`\x00\x83\x83\x83\x81\x81\x10a$\x92W\xfe[\x90P` \x02\x015`\x01`\x01`\xa0\x1b\x03\x16`\x01`\x01`\xa0\x1b\x03\x16\x14\x15a$\xfeW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x19`$\x82\x01R\u007fInvalid validator address\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[a%\"\x83\x83\x83\x81\x81\x10a%\rW\xfe[\x90P` \x02\x015`\x01`\x01`\xa0\x1b\x03\x16a\x17\xe5V[a%qW`\x03\x83\x83\x83\x81\x81\x10a%4W\xfe[\x83T`\x01\x81\x01\x85U`\x00\x94\x85R` \x94\x85\x90 \x01\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16`\x01`\x01`\xa0\x1b\x03\x95\x90\x92\x02\x93\x90\x93\x015\x93\x90\x93\x16\x92\x90\x92\x17\x90UP[a%\x95\x83\x83\x83\x81\x81\x10a%\x80W\xfe[\x90P` \x02\x015`\x01`\x01`\xa0\x1b\x03\x16a#RV[a%\xe4W`\x04\x83\x83\x83\x81\x81\x10a%\xa7W\xfe[\x83T`\x01\x81\x01\x85U`\x00\x94\x85R` \x94\x85\x90 \x01\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16`\x01`\x01`\xa0\x1b\x03\x95\x90\x92\x02\x93\x90\x93\x015\x93\x90\x93\x16\x92\x90\x92\x17\x90UP[`\x00`\x01\x81\x85\x85\x85\x81\x81\x10a%\xf5W\xfe[`\x01`\x01`\xa0\x1b\x03` \x91\x82\x02\x93\x90\x93\x015\x83\x16\x84R\x83\x01\x93\x90\x93R`@\x90\x91\x01`\x00 T\x16\x91\x90\x91\x14\x15\x90Pa&\xaaW\x82\x82\x82\x81\x81\x10a&2W\xfe[\x90P` \x02\x015`\x01`\x01`\xa0\x1b\x03\x16`\x01`\x00\x85\x85\x85\x81\x81\x10a&RW\xfe[\x90P` \x02\x015`\x01`\x01`\xa0\x1b\x03\x16`\x01`\x01`\xa0\x1b\x03\x16`\x01`\x01`\xa0\x1b\x03\x16\x81R` \x01\x90\x81R` \x01`\x00 `\x00\x01`\x00a\x01\x00\n\x81T\x81`\x01`\x01`\xa0\x1b\x03\x02\x19\x16\x90\x83`\x01`\x01`\xa0\x1b\x03\x16\x02\x17\x90UP[`\x00`\x01`\x00\x85\x85\x85\x81\x81\x10a&\xbcW\xfe[` \x90\x81\x02\x92\x90\x92\x015`\x01`\x01`\xa0\x1b\x03\x16\x83RP\x81\x01\x91\x90\x91R`@\x01`\x00 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a&\xf4W\xfe[\x14\x15a'NW`\x02`\x01`\x00\x85\x85\x85\x81\x81\x10a'\fW\xfe[` \x90\x81\x02\x92\x90\x92\x015`\x01`\x01`\xa0\x1b\x03\x16\x83RP\x81\x01\x91\x90\x91R`@\x01`\x00 \x80T`\xff`\xa0\x1b\x19\x16`\x01`\xa0\x1b\x83`\x04\x81\x11\x15a'HW\xfe[\x02\x17\x90UP[`\x01\x01a$|V[PP`\x00\x80T`\xff\x19\x16`\x01\x17\x90UPV[`\x00\x80T`\xff\x16a'\xafW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x8c\x16a(\x00W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x13`$\x82\x01RrInvalid fee address`h\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[a)\x0f\x8b\x8b\x80\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x93\x92\x91\x90\x81\x81R` \x01\x83\x83\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPP`@\x80Q` `\x1f\x8f\x01\x81\x90\x04\x81\x02\x82\x01\x81\x01\x90\x92R\x8d\x81R\x92P\x8d\x91P\x8c\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPP`@\x80Q` `\x1f\x8e\x01\x81\x90\x04\x81\x02\x82\x01\x81\x01\x90\x92R\x8c\x81R\x92P\x8c\x91P\x8b\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPP`@\x80Q` `\x1f\x8d\x01\x81\x90\x04\x81\x02\x82\x01\x81\x01\x90\x92R\x8b\x81R\x92P\x8b\x91P\x8a\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPP`@\x80Q` `\x1f\x8c\x01\x81\x90\x04\x81\x02\x82\x01\x81\x01\x90\x92R\x8a\x81R\x92P\x8a\x91P\x89\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPa2\b\x92PPPV[a)VW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x13`$\x82\x01Rr$\xb7;0\xb64\xb2\x1022\xb9\xb1\xb94\xb8:4\xb7\xb7`i\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[3`\x00\x81\x81R`\x01` R`@\x81 T\x81\x90`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a)}W\xfe[\x14\x15a*{W`\aT`@\x80QcAbY\xd9`\xe1\x1b\x81R`\x01`\x01`\xa0\x1b\x03\x85\x81\x16`\x04\x83\x01R\x91Q\x91\x90\x92\x16\x91c\x82\u0133\xb2\x91`$\x80\x83\x01\x92` \x92\x91\x90\x82\x90\x03\x01\x81\x86\x80;\x15\x80\x15a)\xd0W`\x00\x80\xfd[PZ\xfa\x15\x80\x15a)\xe4W=`\x00\x80>=`\x00\xfd[PPPP`@Q=` \x81\x10\x15a)\xfaW`\x00\x80\xfd[PQa*MW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x1c`$\x82\x01R\u007fYou must be authorized first\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[P`\x01`\x01`\xa0\x1b\x03\x81\x16`\x00\x90\x81R`\x01` \x81\x90R`@\x90\x91 \x80T`\xff`\xa0\x1b\x19\x16`\x01`\xa0\x1b\x17\x90U[`\x01`\x01`\xa0\x1b\x03\x82\x81\x16`\x00\x90\x81R`\x01` R`@\x90 T\x81\x16\x90\x8f\x16\x14a*\xedW\x8d`\x01`\x00\x84`\x01`\x01`\xa0\x1b\x03\x16`\x01`\x01`\xa0\x1b\x03\x16\x81R` \x01\x90\x81R` \x01`\x00 `\x00\x01`\x00a\x01\x00\n\x81T\x81`\x01`\x01`\xa0\x1b\x03\x02\x19\x16\x90\x83`\x01`\x01`\xa0\x1b\x03\x16\x02\x17\x90UP[`@Q\x80`\xa0\x01`@R\x80\x8e\x8e\x80\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x93\x92\x91\x90\x81\x81R` \x01\x83\x83\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPPP\x90\x82RP`@\x80Q` `\x1f\x8f\x01\x81\x90\x04\x81\x02\x82\x01\x81\x01\x90\x92R\x8d\x81R\x91\x81\x01\x91\x90\x8e\x90\x8e\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPPP\x90\x82RP`@\x80Q` `\x1f\x8d\x01\x81\x90\x04\x81\x02\x82\x01\x81\x01\x90\x92R\x8b\x81R\x91\x81\x01\x91\x90\x8c\x90\x8c\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPPP\x90\x82RP`@\x80Q` `\x1f\x8b\x01\x81\x90\x04\x81\x02\x82\x01\x81\x01\x90\x92R\x89\x81R\x91\x81\x01\x91\x90\x8a\x90\x8a\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x91\x90\x91RPPP\x90\x82RP`@\x80Q` `\x1f\x89\x01\x81\x90\x04\x81\x02\x82\x01\x81\x01\x90\x92R\x87\x81R\x91\x81\x01\x91\x90\x88\x90\x88\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x82\x90RP\x93\x90\x94RPP`\x01`\x01`\xa0\x1b\x03\x85\x16\x81R`\x01` \x90\x81R`@\x90\x91 \x83Q\x80Q`\x02\x90\x92\x01\x93Pa,M\x92\x84\x92\x91\x01\x90aF\x84V[P` \x82\x81\x01Q\x80Qa,f\x92`\x01\x85\x01\x92\x01\x90aF\x84V[P`@\x82\x01Q\x80Qa,\x82\x91`\x02\x84\x01\x91` \x90\x91\x01\x90aF\x84V[P``\x82\x01Q\x80Qa,\x9e\x91`\x03\x84\x01\x91` \x90\x91\x01\x90aF\x84V[P`\x80\x82\x01Q\x80Qa,\xba\x91`\x04\x84\x01\x91` \x90\x91\x01\x90aF\x84V[P\x90PP\x80\x15a-\x14W\x8d`\x01`\x01`\xa0\x1b\x03\x16\x82`\x01`\x01`\xa0\x1b\x03\x16\u007f\x88~\xec\x9du{rG\u074eQ\x19\x8f\x9d\x1b\x8f'\x97\x9b\u03b3K\xdc\xc1\xbf\xfdN\xc5\xecsl\"B`@Q\x80\x82\x81R` \x01\x91PP`@Q\x80\x91\x03\x90\xa3a-`V[\x8d`\x01`\x01`\xa0\x1b\x03\x16\x82`\x01`\x01`\xa0\x1b\x03\x16\u007f\xb8B\x1feP\x13q\xf5MX\xde\x197\xff\x1e\x1c\u0377d#\xefo\x84\xac\xea\x18\x14\xa0\xf66,\xa0B`@Q\x80\x82\x81R` \x01\x91PP`@Q\x80\x91\x03\x90\xa3[P`\x01\x9d\x9cPPPPPPPPPPPPPV[``\x80``\x80``a-\x84aF8V[`\x01`\x01`\xa0\x1b\x03\x87\x81\x16`\x00\x90\x81R`\x01` \x90\x81R`@\x91\x82\x90 \x82Qa\x01\x00\x81\x01\x90\x93R\x80T\x93\x84\x16\x83R\x91\x92\x90\x83\x01\x90`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a-\xcdW\xfe[`\x04\x81\x11\x15a-\xd8W\xfe[\x81R` \x01`\x01\x82\x01T\x81R` \x01`\x02\x82\x01`@Q\x80`\xa0\x01`@R\x90\x81`\x00\x82\x01\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x92\x91\x90\x81\x81R` \x01\x82\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x80\x15a.\x8fW\x80`\x1f\x10a.dWa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a.\x8fV[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a.rW\x82\x90\x03`\x1f\x16\x82\x01\x91[PPPPP\x81R` \x01`\x01\x82\x01\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x80`\x1f\x01` \x80\x91\x04\x02` \x01`@Q\x90\x81\x01`@R\x80\x92\x91\x90\x81\x81R` \x01\x82\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x80\x15a/1W\x80`\x1f\x10a/\x06Wa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a/1V[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a/\x14W\x82\x90\x03`\x1f\x16\x82\x01\x91[PPP\x91\x83RPP`\x02\x82\x81\x01\x80T`@\x80Q` `\x01\x84\x16\x15a\x01\x00\x02`\x00\x19\x01\x90\x93\x16\x94\x90\x94\x04`\x1f\x81\x01\x83\x90\x04\x83\x02\x85\x01\x83\x01\x90\x91R\x80\x84R\x93\x81\x01\x93\x90\x83\x01\x82\x82\x80\x15a/\xc3W\x80`\x1f\x10a/\x98Wa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a/\xc3V[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a/\xa6W\x82\x90\x03`\x1f\x16\x82\x01\x91[PPP\x91\x83RPP`\x03\x82\x01\x80T`@\x80Q` `\x02`\x01\x85\x16\x15a\x01\x00\x02`\x00\x19\x01\x90\x94\x16\x93\x90\x93\x04`\x1f\x81\x01\x84\x90\x04\x84\x02\x82\x01\x84\x01\x90\x92R\x81\x81R\x93\x82\x01\x93\x92\x91\x83\x01\x82\x82\x80\x15a0WW\x80`\x1f\x10a0,Wa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a0WV[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a0:W\x82\x90\x03`\x1f\x16\x82\x01\x91[PPP\x91\x83RPP`\x04\x82\x01\x80T`@\x80Q` `\x02`\x01\x85\x16\x15a\x01\x00\x02`\x00\x19\x01\x90\x94\x16\x93\x90\x93\x04`\x1f\x81\x01\x84\x90\x04\x84\x02\x82\x01\x84\x01\x90\x92R\x81\x81R\x93\x82\x01\x93\x92\x91\x83\x01\x82\x82\x80\x15a0\xebW\x80`\x1f\x10a0\xc0Wa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a0\xebV[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a0\xceW\x82\x90\x03`\x1f\x16\x82\x01\x91[PPPPP\x81RPP\x81R` \x01`\a\x82\x01T\x81R` \x01`\b\x82\x01T\x81R` \x01`\t\x82\x01T\x81R` \x01`\n\x82\x01\x80T\x80` \x02` \x01`@Q\x90\x81\x01`@R\x80\x92\x91\x90\x81\x81R` \x01\x82\x80T\x80\x15a1oW` \x02\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T`\x01`\x01`\xa0\x1b\x03\x16\x81R`\x01\x90\x91\x01\x90` \x01\x80\x83\x11a1QW[PPP\x91\x90\x92RPPP``\x90\x81\x01Q\x80Q` \x82\x01Q`@\x83\x01Q\x93\x83\x01Q`\x80\x90\x93\x01Q\x91\x9b\x90\x9aP\x92\x98P\x90\x96P\x94P\x92PPPV[```\x04\x80T\x80` \x02` \x01`@Q\x90\x81\x01`@R\x80\x92\x91\x90\x81\x81R` \x01\x82\x80T\x80\x15a#\xfcW` \x02\x82\x01\x91\x90`\x00R` `\x00 \x90\x81T`\x01`\x01`\xa0\x1b\x03\x16\x81R`\x01\x90\x91\x01\x90` \x01\x80\x83\x11a#\xdeWPPPPP\x90P\x90V[`\x00`F\x86Q\x11\x15a2ZW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x16`$\x82\x01Ru\t-\xce\xcc-\x8d,\x84\r\xad\xed\xcd-l\xaeD\r\x8c\xad\xcc\xee\x8d`S\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[a\v\xb8\x85Q\x11\x15a2\xb2W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x17`$\x82\x01R\u007fInvalid identity length\x00\x00\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x8c\x84Q\x11\x15a3\x02W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x16`$\x82\x01Ru\t-\xce\xcc-\x8d,\x84\x0e\xec\xacNm.\x8c\xa4\r\x8c\xad\xcc\xee\x8d`S\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x8c\x83Q\x11\x15a3PW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x14`$\x82\x01Rs\t-\xce\xcc-\x8d,\x84\f\xad\xac--\x84\r\x8c\xad\xcc\xee\x8d`c\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[a\x01\x18\x82Q\x11\x15a3\xa1W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x16`$\x82\x01Ru\t-\xce\xcc-\x8d,\x84\f\x8c\xae\x8c--\x8ed\r\x8c\xad\xcc\xee\x8d`S\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[P`\x01\x95\x94PPPPPV[h\x01\xbc\x16\xd6t\xec\x80\x00\x00\x81V[`\x00\x80a3\xc7`\x00a>gV[\x91P\x91P\x90\x91V[`\x15\x81V[3A\x14a4\x15W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\n`$\x82\x01RiMiner only`\xb0\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[C`\x00\x90\x81R`\t` \x90\x81R`@\x80\x83 \x83\x80R\x90\x91R\x90 T`\xff\x16\x15a4\x85W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x19`$\x82\x01R\u007fBlock is already rewarded\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x00T`\xff\x16a4\xcbW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[C`\x00\x90\x81R`\t` \x90\x81R`@\x80\x83 \x83\x80R\x82R\x80\x83 \x80T`\xff\x19\x16`\x01\x90\x81\x17\x90\x91U3\x80\x85R\x92R\x82 T\x90\x914\x91`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a5\x15W\xfe[\x14\x15a5\"WPPa5rV[a5-\x81`\x00a?[V[`@\x80Q\x82\x81RB` \x82\x01R\x81Q`\x01`\x01`\xa0\x1b\x03\x85\x16\x92\u007f}\xc4\xe5\xdfYQ7\b\u0723U\xb8pbs\xa5\xdf{\x81\nL\xec\x80\x19\U000a4e7b\x16j\x1a\x04\x92\x82\x90\x03\x01\x90\xa2PP[V[b\x01Q\x80\x81V[ap\x80\x81V[`\x00\x80T`\xff\x16a5\xc8W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[3`\x00`\x01`\x01`\xa0\x1b\x03\x84\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a5\xf9W\xfe[\x14\x15a6BW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x13`$\x82\x01Rr\x15\x98[\x1aY\x18]\x1b\u0708\x1b\x9b\xdd\b\x19^\x1a\\\xdd`j\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x80\x82\x16`\x00\x90\x81R`\x02` \x90\x81R`@\x80\x83 \x93\x87\x16\x83R\x92\x81R\x82\x82 `\x01\x91\x82\x90R\x92\x90\x91 \x82T\x91\x83\x01T\x90\x91\x90\x15a6\xb8W`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`#\x81R` \x01\x80aH\xd6`#\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\x00\x81\x11a7\bW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x18`$\x82\x01RwYou don't have any stake`@\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x04T`\x01\x14\x80\x15a7\x1eWPa7\x1e\x86a#RV[\x80\x15a7GWP`\x01\x82\x01Th\x01\xbc\x16\xd6t\xec\x80\x00\x00\x90a7E\x90\x83c\xff\xff\xff\xffaB\xc4\x16V[\x10[\x15a7\x83W`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`E\x81R` \x01\x80aG\xe9`E\x919``\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\n\x82\x01T`\x02\x84\x01T`\x00\x19\x90\x91\x01\x14a8`W`\n\x82\x01\x80T`\x00\x19\x81\x01\x90\x81\x10a7\xacW\xfe[\x90`\x00R` `\x00 \x01`\x00\x90T\x90a\x01\x00\n\x90\x04`\x01`\x01`\xa0\x1b\x03\x16\x82`\n\x01\x84`\x02\x01T\x81T\x81\x10a7\xddW\xfe[\x90`\x00R` `\x00 \x01`\x00a\x01\x00\n\x81T\x81`\x01`\x01`\xa0\x1b\x03\x02\x19\x16\x90\x83`\x01`\x01`\xa0\x1b\x03\x16\x02\x17\x90UP\x82`\x02\x01T`\x02`\x00\x84`\n\x01\x86`\x02\x01T\x81T\x81\x10a8'W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x90\x81\x16\x84R\x83\x82\x01\x94\x90\x94R`@\x92\x83\x01\x82 \x93\x8b\x16\x82R\x92\x90\x92R\x90 `\x02\x01U[\x81`\n\x01\x80T\x80a8mW\xfe[`\x00\x82\x81R` \x90 \x81\x01`\x00\x19\x90\x81\x01\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16\x90U\x01\x90U`\x01\x82\x01Ta8\xa4\x90\x82c\xff\xff\xff\xffaB\xc4\x16V[`\x01\x80\x84\x01\x91\x90\x91UC\x90\x84\x01U`\x00`\x02\x84\x01U`\x05Ta8\u0310\x82c\xff\xff\xff\xffaB\xc4\x16V[`\x05U`\x01\x82\x01Th\x01\xbc\x16\xd6t\xec\x80\x00\x00\x11\x15a9zW\x81T`\xff`\xa0\x1b\x19\x16`\x03`\xa0\x1b\x17\x82Ua8\xfe\x86aC\x06V[`\aT`@\x80Qc\x15\xea'\x81`\xe0\x1b\x81R`\x01`\x01`\xa0\x1b\x03\x89\x81\x16`\x04\x83\x01R\x91Q\x91\x90\x92\x16\x91c\x15\xea'\x81\x91`$\x80\x83\x01\x92` \x92\x91\x90\x82\x90\x03\x01\x81`\x00\x87\x80;\x15\x80\x15a9MW`\x00\x80\xfd[PZ\xf1\x15\x80\x15a9aW=`\x00\x80>=`\x00\xfd[PPPP`@Q=` \x81\x10\x15a9wW`\x00\x80\xfd[PP[\x85`\x01`\x01`\xa0\x1b\x03\x16\x84`\x01`\x01`\xa0\x1b\x03\x16\u007fD\x90\x02\xae\x18\xe7H\u059aU\xf3\x85\x14@\rd\xf9fI.Y>2\xd6\u9e32M\xb9\x8a\v\xc1\x83B`@Q\x80\x83\x81R` \x01\x82\x81R` \x01\x92PPP`@Q\x80\x91\x03\x90\xa3P`\x01\x95\x94PPPPPV[`\x00\x82\x82\x01\x83\x81\x10\x15a:3W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x1b`$\x82\x01R\u007fSafeMath: addition overflow\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[\x90P[\x92\x91PPV[`\x00[`\x04T\x81\x10\x15a:\x89W\x82`\x01`\x01`\xa0\x1b\x03\x16`\x04\x82\x81T\x81\x10a:`W\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x16\x14\x15a:\x81WPa\x19\x84V[`\x01\x01a:?V[P`\x04T`\x15\x11\x15a;\x1cW`\x04\x80T`\x01\x81\x01\x82U`\x00\x91\x90\x91R\u007f\x8a5\xac\xfb\xc1_\xf8\x1a9\xae}4O\xd7\t\xf2\x8e\x86\x00\xb4\xaa\x8ce\u01b6K\xfe\u007f\xe3k\u045b\x01\x80T`\x01`\x01`\xa0\x1b\x03\x84\x16`\x01`\x01`\xa0\x1b\x03\x19\x90\x91\x16\x81\x17\x90\x91U`@\x80QB\x81R\x90Q\u007f\x1e3\x10\xadh\x91\xb3\x0e\x03\x87N\xc3\xd1B*c\x86\xc5\xdac\xd9\xfa\xf5\x95\xf5\u065e\xea\xf4C\xb9\x9a\x91\x81\x90\x03` \x01\x90\xa2a\x19\x84V[`\x00`\x01`\x00`\x04`\x00\x81T\x81\x10a;0W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x81 `\x01\x90\x81\x01T\x92P[`\x04T\x81\x10\x15a;\xeeW\x82`\x01`\x00`\x04\x84\x81T\x81\x10a;yW\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x90 `\x01\x01T\x10\x15a;\xe6W`\x01`\x00`\x04\x83\x81T\x81\x10a;\xb8W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x90 `\x01\x01T\x92P\x90P\x80[`\x01\x01a;]V[P\x81\x83\x11a;\xfdWPPa\x19\x84V[`@\x80QB\x81R\x90Q`\x01`\x01`\xa0\x1b\x03\x86\x16\x91\u007f\x1e3\x10\xadh\x91\xb3\x0e\x03\x87N\xc3\xd1B*c\x86\xc5\xdac\xd9\xfa\xf5\x95\xf5\u065e\xea\xf4C\xb9\x9a\x91\x90\x81\x90\x03` \x01\x90\xa2`\x04\x81\x81T\x81\x10a<IW\xfe[`\x00\x91\x82R` \x91\x82\x90 \x01T`@\x80QB\x81R\x90Q`\x01`\x01`\xa0\x1b\x03\x90\x92\x16\x92\u007fu!\xe4EY\xc8p\xc3\x16\xe8N`\xbcG\x85\xd9\xc04\xa8\xab\x1dj\xcd\u03814\xac\x03\xf9F\xc6\ud491\x82\x90\x03\x01\x90\xa2\x83`\x04\x82\x81T\x81\x10a<\xa2W\xfe[\x90`\x00R` `\x00 \x01`\x00a\x01\x00\n\x81T\x81`\x01`\x01`\xa0\x1b\x03\x02\x19\x16\x90\x83`\x01`\x01`\xa0\x1b\x03\x16\x02\x17\x90UPPPPPV[`\x00`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a=\x06W\xfe[\x14\x80a=\x15WP`\x03T`\x01\x10\x15[\x15a=\x1fWa\x1a\x05V[`\x01`\x01`\xa0\x1b\x03\x81\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01T\x80\x15a=\xb4Wa=K\x81\x83a?[V[`\x06Ta=^\x90\x82c\xff\xff\xff\xffa9\xd9\x16V[`\x06U`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x01` R`@\x90 `\b\x01Ta=\x8d\x90\x82c\xff\xff\xff\xffa9\xd9\x16V[`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x81 `\b\x81\x01\x92\x90\x92U`\a\x90\x91\x01U[`@\x80Q\x82\x81RB` \x82\x01R\x81Q`\x01`\x01`\xa0\x1b\x03\x85\x16\x92\u007f\xe2\x94\xe9\xd7?\x8e\xee#\xe2\x1b.\x15g\x96\x06%\xa6\xb5\xd39\xcb\x12{U\xd0\u0414s\xa9\x95\x125\x92\x82\x90\x03\x01\x90\xa2PPV[`\x00`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a>*W\xfe[\x14\x15a>5Wa\x1a\x05V[`\x01`\x01`\xa0\x1b\x03\x81\x16`\x00\x90\x81R`\x01` R`@\x90 \x80T`\xff`\xa0\x1b\x19\x16`\x01`\xa2\x1b\x17\x90Ua\x1a\x05\x81aC\x06V[`\x00\x80\x80[`\x03T\x81\x10\x15a?UW`\x04`\x01`\x00`\x03\x84\x81T\x81\x10a>\x89W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x90 T`\xff`\x01`\xa0\x1b\x90\x91\x04\x16`\x04\x81\x11\x15a>\xc4W\xfe[\x14\x15\x80\x15a>\xf6WP`\x03\x81\x81T\x81\x10a>\xdaW\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x85\x81\x16\x91\x16\x14\x15[\x15a?MWa?D`\x01`\x00`\x03\x84\x81T\x81\x10a?\x0fW\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x90 `\x01\x01T\x84\x90c\xff\xff\xff\xffa9\xd9\x16V[\x92P`\x01\x90\x91\x01\x90[`\x01\x01a>lV[P\x91P\x91V[\x81a?eWa\x19\x84V[`\x00\x80a?q\x83a>gV[\x90\x92P\x90P\x80a?\x82WPPa\x19\x84V[`\x00\x80\x83aA\x0eW`\x00a?\x9c\x87\x85c\xff\xff\xff\xffaD<\x16V[\x90Pa?\xbea?\xb1\x82\x86c\xff\xff\xff\xffaD~\x16V[\x88\x90c\xff\xff\xff\xffaB\xc4\x16V[\x92P`\x00[`\x03T\x81\x10\x15a@\x9dW`\x00`\x03\x82\x81T\x81\x10a?\xdcW\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x16\x90P`\x04`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15a@#W\xfe[\x14\x15\x80\x15a@CWP\x87`\x01`\x01`\xa0\x1b\x03\x16\x81`\x01`\x01`\xa0\x1b\x03\x16\x14\x15[\x15a@\x94W`\x01`\x01`\xa0\x1b\x03\x81\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01Ta@t\x90\x84c\xff\xff\xff\xffa9\xd9\x16V[`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01U\x92P\x82[P`\x01\x01a?\xc3V[P`\x00\x83\x11\x80\x15a@\xb6WP`\x01`\x01`\xa0\x1b\x03\x82\x16\x15\x15[\x15aA\x04W`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01Ta@\u7404c\xff\xff\xff\xffa9\xd9\x16V[`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01U[PPPPPa\x19\x84V[`\x00\x80[`\x03T\x81\x10\x15aBBW`\x00`\x03\x82\x81T\x81\x10aA+W\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x16\x90P`\x04`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x01` R`@\x90 T`\x01`\xa0\x1b\x90\x04`\xff\x16`\x04\x81\x11\x15aArW\xfe[\x14\x15\x80\x15aA\x92WP\x87`\x01`\x01`\xa0\x1b\x03\x16\x81`\x01`\x01`\xa0\x1b\x03\x16\x14\x15[\x15aB9W`\x01`\x01`\xa0\x1b\x03\x81\x16`\x00\x90\x81R`\x01` \x81\x90R`@\x82 \x01TaA\u0590\x89\x90aA\u0290\x8d\x90c\xff\xff\xff\xffaD~\x16V[\x90c\xff\xff\xff\xffaD<\x16V[\x90PaA\u8102c\xff\xff\xff\xffa9\xd9\x16V[`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01T\x92\x95P\x93P\x84\x91aB\x1b\x90\x82c\xff\xff\xff\xffa9\xd9\x16V[`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01UP[P`\x01\x01aA\x12V[PaBS\x87\x82c\xff\xff\xff\xffaB\xc4\x16V[\x92P`\x00\x83\x11\x80\x15aBmWP`\x01`\x01`\xa0\x1b\x03\x82\x16\x15\x15[\x15aB\xbbW`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01TaB\x9e\x90\x84c\xff\xff\xff\xffa9\xd9\x16V[`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x01` R`@\x90 `\a\x01U[PPPPPPPV[`\x00a:3\x83\x83`@Q\x80`@\x01`@R\x80`\x1e\x81R` \x01\u007fSafeMath: subtraction overflow\x00\x00\x81RPaD\xd7V[`\x00[`\x04T\x81\x10\x80\x15aC\x1cWP`\x04T`\x01\x10[\x15a\x19\x84W`\x04\x81\x81T\x81\x10aC.W\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x83\x81\x16\x91\x16\x14\x15aD4W`\x04T`\x00\x19\x01\x81\x14aC\xc1W`\x04\x80T`\x00\x19\x81\x01\x90\x81\x10aClW\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x04\x80T`\x01`\x01`\xa0\x1b\x03\x90\x92\x16\x91\x83\x90\x81\x10aC\x92W\xfe[\x90`\x00R` `\x00 \x01`\x00a\x01\x00\n\x81T\x81`\x01`\x01`\xa0\x1b\x03\x02\x19\x16\x90\x83`\x01`\x01`\xa0\x1b\x03\x16\x02\x17\x90UP[`\x04\x80T\x80aC\xccW\xfe[`\x00\x82\x81R` \x90\x81\x90 \x82\x01`\x00\x19\x90\x81\x01\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16\x90U\x90\x91\x01\x90\x91U`@\x80QB\x81R\x90Q`\x01`\x01`\xa0\x1b\x03\x85\x16\x92\u007fu!\xe4EY\xc8p\xc3\x16\xe8N`\xbcG\x85\xd9\xc04\xa8\xab\x1dj\xcd\u03814\xac\x03\xf9F\xc6\ud482\x90\x03\x01\x90\xa2a\x19\x84V[`\x01\x01aC\tV[`\x00a:3\x83\x83`@Q\x80`@\x01`@R\x80`\x1a\x81R` \x01\u007fSafeMath: division by zero\x00\x00\x00\x00\x00\x00\x81RPaEnV[`\x00\x82aD\x8dWP`\x00a:6V[\x82\x82\x02\x82\x84\x82\x81aD\x9aW\xfe[\x04\x14a:3W`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`!\x81R` \x01\x80aH}`!\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\x00\x81\x84\x84\x11\x15aEfW`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R\x83\x81\x81Q\x81R` \x01\x91P\x80Q\x90` \x01\x90\x80\x83\x83`\x00[\x83\x81\x10\x15aE+W\x81\x81\x01Q\x83\x82\x01R` \x01aE\x13V[PPPP\x90P\x90\x81\x01\x90`\x1f\x16\x80\x15aEXW\x80\x82\x03\x80Q`\x01\x83` \x03a\x01\x00\n\x03\x19\x16\x81R` \x01\x91P[P\x92PPP`@Q\x80\x91\x03\x90\xfd[PPP\x90\x03\x90V[`\x00\x81\x83aE\xbdW`@QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01\x81\x81R\x83Q`$\x84\x01R\x83Q\x90\x92\x83\x92`D\x90\x91\x01\x91\x90\x85\x01\x90\x80\x83\x83`\x00\x83\x15aE+W\x81\x81\x01Q\x83\x82\x01R` \x01aE\x13V[P`\x00\x83\x85\x81aE\xc9W\xfe[\x04\x95\x94PPPPPV[\x82\x80T\x82\x82U\x90`\x00R` `\x00 \x90\x81\x01\x92\x82\x15aF(W\x91` \x02\x82\x01[\x82\x81\x11\x15aF(W\x82Q\x82T`\x01`\x01`\xa0\x1b\x03\x19\x16`\x01`\x01`\xa0\x1b\x03\x90\x91\x16\x17\x82U` \x90\x92\x01\x91`\x01\x90\x91\x01\x90aE\xf3V[PaF4\x92\x91PaF\xfeV[P\x90V[`@\x80Qa\x01\x00\x81\x01\x90\x91R`\x00\x80\x82R` \x82\x01\x90\x81R` \x01`\x00\x81R` \x01aFbaG\"V[\x81R` \x01`\x00\x81R` \x01`\x00\x81R` \x01`\x00\x81R` \x01``\x81RP\x90V[\x82\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x90`\x00R` `\x00 \x90`\x1f\x01` \x90\x04\x81\x01\x92\x82`\x1f\x10aF\xc5W\x80Q`\xff\x19\x16\x83\x80\x01\x17\x85UaF\xf2V[\x82\x80\x01`\x01\x01\x85U\x82\x15aF\xf2W\x91\x82\x01[\x82\x81\x11\x15aF\xf2W\x82Q\x82U\x91` \x01\x91\x90`\x01\x01\x90aF\xd7V[PaF4\x92\x91PaGQV[a$\x04\x91\x90[\x80\x82\x11\x15aF4W\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16\x81U`\x01\x01aG\x04V[`@Q\x80`\xa0\x01`@R\x80``\x81R` \x01``\x81R` \x01``\x81R` \x01``\x81R` \x01``\x81RP\x90V[a$\x04\x91\x90[\x80\x82\x11\x15aF4W`\x00\x81U`\x01\x01aGWV\xfeYou must wait enough blocks to withdraw your profits after latest withdraw of this validatorYour staking haven't unlocked yetYou can't unstake, validator list will be empty after this operation!Can't stake when you are unstakingCan't stake to a validator in abnormal statusSafeMath: multiplication overflowThe validator you want to stake must be authorized firstYou are already in unstaking statusYou are not the fee receiver of this validator\xa2dipfsX\"\x12 \x8a\xa6\u050a-\xe0jg\x02$\xf3\x03\xbd\xa2\xfa\u00f3\x06\xe53\xf0[\xcb\xf1b\xf9\xf4\x1f\x02E{ dsolcC\x00\x06\x01\x003\xf9\v\xe0\x82\xf0\x01\x80\xb9\v\xd9`\x80`@R4\x80\x15a\x00\x10W`\x00\x80\xfd[P`\x046\x10a\x01\x16W`\x005`\xe0\x1c\x80c\xbedV\x92\x11a\x00\xa2W\x80c\xdbx\xdd(\x11a\x00qW\x80c\xdbx\xdd(\x14a\x02/W\x80c\xe0\xd8\xeaS\x14a\x02TW\x80c\xear!\xa1\x14a\x02\\W\x80c\xef\xd8\xd8\xe2\x14a\x02\x82W\x80c\xf6*\xf2l\x14a\x02\x8aWa\x01\x16V[\x80c\xbedV\x92\x14a\x01\xe3W\x80c\xc9g\xf9\x0f\x14a\x01\xebW\x80c\xcb\x1e\xa7%\x14a\x02\nW\x80c\xd9=,\xb9\x14a\x02\x12Wa\x01\x16V[\x80c:\x06\x1b\xd3\x11a\x00\xe9W\x80c:\x06\x1b\xd3\x14a\x01\x9bW\x80cD\xc1\xaa\x99\x14a\x01\xa3W\x80cb3\xbe]\x14a\x01\xabW\x80cc\xe1\xd4Q\x14a\x01\xb3W\x80c\x81)\xfc\x1c\x14a\x01\xd9Wa\x01\x16V[\x80c\x15\x8e\xf9>\x14a\x01\x1bW\x80c\x1b^5\x8c\x14a\x017W\x80c(\x97\x18=\x14a\x01[W\x80c2\xf3\xc1\u007f\x14a\x01uW[`\x00\x80\xfd[a\x01#a\x02\xa7V[`@\x80Q\x91\x15\x15\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x01?a\x02\xb0V[`@\x80Q`\x01`\x01`\xa0\x1b\x03\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x01ca\x02\xb6V[`@\x80Q\x91\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x01c`\x04\x806\x03` \x81\x10\x15a\x01\x8bW`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x02\xbcV[a\x01?a\x02\xd7V[a\x01ca\x02\xddV[a\x01?a\x02\xe3V[a\x01#`\x04\x806\x03` \x81\x10\x15a\x01\xc9W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x02\xe9V[a\x01\xe1a\x05\x15V[\x00[a\x01ca\x05\x98V[a\x01\xf3a\x05\xa5V[`@\x80Qa\xff\xff\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x01ca\x05\xaaV[a\x01\xe1`\x04\x806\x03` \x81\x10\x15a\x02(W`\x00\x80\xfd[P5a\x05\xb0V[a\x027a\bSV[`@\x80Qg\xff\xff\xff\xff\xff\xff\xff\xff\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x01ca\bZV[a\x01\xe1`\x04\x806\x03` \x81\x10\x15a\x02rW`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\b`V[a\x027a\vvV[a\x01?`\x04\x806\x03` \x81\x10\x15a\x02\xa0W`\x00\x80\xfd[P5a\v|V[`\x00T`\xff\x16\x81V[a\xf0\x01\x81V[`\x03T\x81V[`\x01`\x01`\xa0\x1b\x03\x16`\x00\x90\x81R`\x05` R`@\x90 T\x90V[a\xf0\x00\x81V[`\x02T\x81V[a\xf0\x02\x81V[`\x00\x80T`\xff\x16a\x030W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[3a\xf0\x00\x14a\x03\x86W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x18`$\x82\x01R\u007fValidators contract only\x00\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x05` R`@\x90 T\x15a\x03\xbeW`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x05` R`@\x81 U[`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x05` R`@\x90 `\x02\x01T`\xff\x16\x80\x15a\x03\xeaWP`\x06T\x15\x15[\x15a\x05\rW`\x06T`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x05` R`@\x90 `\x01\x01T`\x00\x19\x90\x91\x01\x14a\x04\xb4W`\x06\x80T`\x00\x91\x90`\x00\x19\x81\x01\x90\x81\x10a\x04/W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x86\x81\x16\x84R`\x05\x90\x92R`@\x90\x92 `\x01\x01T`\x06\x80T\x92\x90\x93\x16\x93P\x83\x92\x91\x81\x10a\x04mW\xfe[`\x00\x91\x82R` \x80\x83 \x91\x90\x91\x01\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16`\x01`\x01`\xa0\x1b\x03\x94\x85\x16\x17\x90U\x85\x83\x16\x82R`\x05\x90R`@\x80\x82 `\x01\x90\x81\x01T\x94\x90\x93\x16\x82R\x90 \x01U[`\x06\x80T\x80a\x04\xbfW\xfe[`\x00\x82\x81R` \x80\x82 \x83\x01`\x00\x19\x90\x81\x01\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16\x90U\x90\x92\x01\x90\x92U`\x01`\x01`\xa0\x1b\x03\x84\x16\x82R`\x05\x90R`@\x81 `\x01\x81\x01\x91\x90\x91U`\x02\x01\x80T`\xff\x19\x16\x90U[P`\x01\x91\x90PV[`\x00T`\xff\x16\x15a\x05cW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x13`$\x82\x01Rr\x10[\x1c\x99XY\x1eH\x1a[\x9a]\x1aX[\x1a^\x99Y`j\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x04\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16a\xf0\x00\x17\x90U`\x18`\x01\x81\x81U`0`\x02U`\x03\x91\x90\x91U`\x00\x80T`\xff\x19\x16\x90\x91\x17\x90UV[h\x01\xbc\x16\xd6t\xec\x80\x00\x00\x81V[`\x15\x81V[`\x01T\x81V[3A\x14a\x05\xf1W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\n`$\x82\x01RiMiner only`\xb0\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[C`\x00\x90\x81R`\b` R`@\x90 T`\xff\x16\x15a\x06JW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x11`$\x82\x01Rp\x10[\x1c\x99XY\x1eH\x19\x19X\u0719X\\\xd9Y`z\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x00T`\xff\x16a\x06\x90W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[\x80\x80C\x81a\x06\x9aW\xfe[\x06\x15a\x06\xe0W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x10`$\x82\x01RoBlock epoch only`\x80\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[C`\x00\x90\x81R`\b` R`@\x90 \x80T`\xff\x19\x16`\x01\x17\x90U`\x06Ta\a\x06Wa\bOV[`\x00[`\x06T\x81\x10\x15a\b$W`\x03T`\x02T\x81a\a W\xfe[\x04`\x05`\x00`\x06\x84\x81T\x81\x10a\a2W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x90 T\x11\x15a\a\xe3W`\x03T`\x02T\x81a\ajW\xfe[\x04`\x05`\x00`\x06\x84\x81T\x81\x10a\a|W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x81 T`\x06\x80T\x93\x90\x91\x03\x92`\x05\x92\x91\x90\x85\x90\x81\x10a\a\xb9W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x90 Ua\b\x1cV[`\x00`\x05`\x00`\x06\x84\x81T\x81\x10a\a\xf6W\xfe[`\x00\x91\x82R` \x80\x83 \x90\x91\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R\x82\x01\x92\x90\x92R`@\x01\x90 U[`\x01\x01a\a\tV[P`@Q\u007f\x18\x1dQ\xbeT\xe8\xe8\xea\xcan\xae\x0e\xab2\xd4\x16 \x99#k\xd5\x19\xe7#\x8d\x01]\bp\xdbFA\x90`\x00\x90\xa1[PPV[b\x01Q\x80\x81V[`\x06T\x90V[3A\x14a\b\xa1W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\n`$\x82\x01RiMiner only`\xb0\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x00T`\xff\x16a\b\xe7W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\f`$\x82\x01Rk\x13\x9b\xdd\b\x1a[\x9a]\b\x1eY]`\xa2\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[C`\x00\x90\x81R`\a` R`@\x90 T`\xff\x16\x15a\t?W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x10`$\x82\x01Ro\x10[\x1c\x99XY\x1eH\x1c\x1d[\x9a\\\xda\x19Y`\x82\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[C`\x00\x90\x81R`\a` \x90\x81R`@\x80\x83 \x80T`\xff\x19\x16`\x01\x17\x90U`\x01`\x01`\xa0\x1b\x03\x84\x16\x83R`\x05\x90\x91R\x90 `\x02\x01T`\xff\x16a\t\xe8W`\x06\x80T`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x81\x81R`\x05` R`@\x81 `\x01\x80\x82\x01\x85\x90U\x80\x85\x01\x90\x95U\u007f\xf6R\"#\x13\xe2\x84YR\x8d\x92\ve\x11\\\x16\xc0O>\xfc\x82\xaa\xed\xc9{\xe5\x9f?7|\r?\x90\x93\x01\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16\x83\x17\x90UR`\x02\x01\x80T`\xff\x19\x16\x90\x91\x17\x90U[`\x01`\x01`\xa0\x1b\x03\x81\x16`\x00\x90\x81R`\x05` R`@\x90 \x80T`\x01\x01\x90\x81\x90U`\x02T\x90\x81a\n\x14W\xfe[\x06a\n\xa1W`\x04\x80T`@\x80Qc@\xa1A\xff`\xe0\x1b\x81R`\x01`\x01`\xa0\x1b\x03\x85\x81\x16\x94\x82\x01\x94\x90\x94R\x90Q\x92\x90\x91\x16\x91c@\xa1A\xff\x91`$\x80\x82\x01\x92`\x00\x92\x90\x91\x90\x82\x90\x03\x01\x81\x83\x87\x80;\x15\x80\x15a\nkW`\x00\x80\xfd[PZ\xf1\x15\x80\x15a\n\u007fW=`\x00\x80>=`\x00\xfd[PPP`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x05` R`@\x81 UPa\v4V[`\x01T`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x90\x81R`\x05` R`@\x90 T\x81a\n\xc4W\xfe[\x06a\v4W`\x04\x80T`@\x80Qc\x05\xdd\tY`\xe4\x1b\x81R`\x01`\x01`\xa0\x1b\x03\x85\x81\x16\x94\x82\x01\x94\x90\x94R\x90Q\x92\x90\x91\x16\x91c]\u0415\x90\x91`$\x80\x82\x01\x92`\x00\x92\x90\x91\x90\x82\x90\x03\x01\x81\x83\x87\x80;\x15\x80\x15a\v\x1bW`\x00\x80\xfd[PZ\xf1\x15\x80\x15a\v/W=`\x00\x80>=`\x00\xfd[PPPP[`@\x80QB\x81R\x90Q`\x01`\x01`\xa0\x1b\x03\x83\x16\x91\u007fw\x0e\f\xcaB\xc3]\x00$\t\x86\u038d>\xd48\xbe\x04f<\x91\xda\xc6WkyS}|\x18\x0f\x1e\x91\x90\x81\x90\x03` \x01\x90\xa2PV[ap\x80\x81V[`\x06\x81\x81T\x81\x10a\v\x89W\xfe[`\x00\x91\x82R` \x90\x91 \x01T`\x01`\x01`\xa0\x1b\x03\x16\x90P\x81V\xfe\xa2dipfsX\"\x12 `\xd8#\xe4\xe7t\x19\xf5\x13\x98\x11\uee0f\x06\xb7y\xeb\xe3N\xd1\u0126?-5N#\x05\x17\xfc\\dsolcC\x00\x06\x01\x003\xf9\x13\x15\x82\xf0\x02\x80\xb9\x13\x0e`\x80`@R4\x80\x15a\x00\x10W`\x00\x80\xfd[P`\x046\x10a\x01\x00W`\x005`\xe0\x1c\x80c\x82\u0133\xb2\x11a\x00\x97W\x80c\xc9g\xf9\x0f\x11a\x00fW\x80c\xc9g\xf9\x0f\x14a\x03\xfcW\x80c\xdbx\xdd(\x14a\x04\x1bW\x80c\xe8#\xc8\x14\x14a\x04@W\x80c\xef\xd8\xd8\xe2\x14a\x04HWa\x01\x00V[\x80c\x82\u0133\xb2\x14a\x03'W\x80c\xa2$\xce\xe7\x14a\x03MW\x80c\xa4\xc4\xd9\"\x14a\x03\xbdW\x80c\xbedV\x92\x14a\x03\xe2Wa\x01\x00V[\x80c\x1fO})\x11a\x00\xd3W\x80c\x1fO})\x14a\x01\xc1W\x80c2\xed[\x12\x14a\x02?W\x80c:\x06\x1b\xd3\x14a\x03\x17W\x80cb3\xbe]\x14a\x03\x1fWa\x01\x00V[\x80c\x15\x8e\xf9>\x14a\x01\x05W\x80c\x15\xea'\x81\x14a\x01!W\x80c\x1b^5\x8c\x14a\x01GW\x80c\x1d\xb5\xad\xe8\x14a\x01kW[`\x00\x80\xfd[a\x01\ra\x04PV[`@\x80Q\x91\x15\x15\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x01\r`\x04\x806\x03` \x81\x10\x15a\x017W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\x04YV[a\x01Oa\x05\x0eV[`@\x80Q`\x01`\x01`\xa0\x1b\x03\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x01\x97`\x04\x806\x03`@\x81\x10\x15a\x01\x81W`\x00\x80\xfd[P`\x01`\x01`\xa0\x1b\x03\x815\x16\x90` \x015a\x05\x14V[`@\x80Q`\x01`\x01`\xa0\x1b\x03\x90\x94\x16\x84R` \x84\x01\x92\x90\x92R\x15\x15\x82\x82\x01RQ\x90\x81\x90\x03``\x01\x90\xf3[a\x01\r`\x04\x806\x03`@\x81\x10\x15a\x01\xd7W`\x00\x80\xfd[`\x01`\x01`\xa0\x1b\x03\x825\x16\x91\x90\x81\x01\x90`@\x81\x01` \x82\x015`\x01` \x1b\x81\x11\x15a\x02\x01W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\x02\x13W`\x00\x80\xfd[\x805\x90` \x01\x91\x84`\x01\x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\x024W`\x00\x80\xfd[P\x90\x92P\x90Pa\x05MV[a\x02\\`\x04\x806\x03` \x81\x10\x15a\x02UW`\x00\x80\xfd[P5a\b'V[`@\x80Q`\x01`\x01`\xa0\x1b\x03\x80\x8a\x16\x82R\x88\x16` \x80\x83\x01\x91\x90\x91R``\x82\x01\x87\x90Ra\xff\xff\x80\x87\x16`\x80\x84\x01R\x85\x16`\xa0\x83\x01R\x83\x15\x15`\xc0\x83\x01R`\xe0\x92\x82\x01\x83\x81R\x88Q\x93\x83\x01\x93\x90\x93R\x87Q\x91\x92\x91a\x01\x00\x84\x01\x91\x89\x01\x90\x80\x83\x83`\x00[\x83\x81\x10\x15a\x02\xd6W\x81\x81\x01Q\x83\x82\x01R` \x01a\x02\xbeV[PPPP\x90P\x90\x81\x01\x90`\x1f\x16\x80\x15a\x03\x03W\x80\x82\x03\x80Q`\x01\x83` \x03a\x01\x00\n\x03\x19\x16\x81R` \x01\x91P[P\x98PPPPPPPPP`@Q\x80\x91\x03\x90\xf3[a\x01Oa\t\x06V[a\x01Oa\t\fV[a\x01\r`\x04\x806\x03` \x81\x10\x15a\x03=W`\x00\x80\xfd[P5`\x01`\x01`\xa0\x1b\x03\x16a\t\x12V[a\x03\xbb`\x04\x806\x03` \x81\x10\x15a\x03cW`\x00\x80\xfd[\x81\x01\x90` \x81\x01\x815`\x01` \x1b\x81\x11\x15a\x03}W`\x00\x80\xfd[\x82\x01\x83` \x82\x01\x11\x15a\x03\x8fW`\x00\x80\xfd[\x805\x90` \x01\x91\x84` \x83\x02\x84\x01\x11`\x01` \x1b\x83\x11\x17\x15a\x03\xb0W`\x00\x80\xfd[P\x90\x92P\x90Pa\t'V[\x00[a\x01\r`\x04\x806\x03`@\x81\x10\x15a\x03\xd3W`\x00\x80\xfd[P\x805\x90` \x015\x15\x15a\nrV[a\x03\xeaa\x11\x8fV[`@\x80Q\x91\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x04\x04a\x11\x9cV[`@\x80Qa\xff\xff\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x04#a\x11\xa1V[`@\x80Qg\xff\xff\xff\xff\xff\xff\xff\xff\x90\x92\x16\x82RQ\x90\x81\x90\x03` \x01\x90\xf3[a\x03\xeaa\x11\xa8V[a\x04#a\x11\xaeV[`\x00T`\xff\x16\x81V[`\x003a\xf0\x00\x14a\x04\xb1W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x18`$\x82\x01R\u007fValidators contract only\x00\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x01`\xa0\x1b\x03\x82\x16`\x00\x81\x81R`\x02` \x90\x81R`@\x91\x82\x90 \x80T`\xff\x19\x16\x90U\x81QB\x81R\x91Q\u007fN\v\x19\x1f\u007f\\2\xb1\xb5\xe3pKh\x87K\x1a9\x80\x14|\xae\x00\xbe\x8e\xce'\x1b\xfb[\x92\xc0z\x92\x81\x90\x03\x90\x91\x01\x90\xa2P`\x01\x91\x90PV[a\xf0\x01\x81V[`\x04` \x90\x81R`\x00\x92\x83R`@\x80\x84 \x90\x91R\x90\x82R\x90 \x80T`\x01\x82\x01T`\x02\x90\x92\x01T`\x01`\x01`\xa0\x1b\x03\x90\x91\x16\x91\x90`\xff\x16\x83V[`\x01`\x01`\xa0\x1b\x03\x83\x16`\x00\x90\x81R`\x02` R`@\x81 T`\xff\x16\x15a\x05\xa5W`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`)\x81R` \x01\x80a\x12\x8d`)\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\x003\x85\x85\x85B`@Q` \x01\x80\x86`\x01`\x01`\xa0\x1b\x03\x16`\x01`\x01`\xa0\x1b\x03\x16``\x1b\x81R`\x14\x01\x85`\x01`\x01`\xa0\x1b\x03\x16`\x01`\x01`\xa0\x1b\x03\x16``\x1b\x81R`\x14\x01\x84\x84\x80\x82\x847\x91\x90\x91\x01\x92\x83RPP`@\x80Q\x80\x83\x03\x81R` \x92\x83\x01\x90\x91R\x80Q\x91\x01 \x94PPPa\v\xb8\x85\x11\x15\x91Pa\x06`\x90PW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x10`$\x82\x01RoDetails too long`\x80\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x00\x81\x81R`\x03` \x81\x90R`@\x90\x91 \x01T\x15a\x06\xc5W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x17`$\x82\x01R\u007fProposal already exists\x00\x00\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[a\x06\xcda\x11\xb4V[3\x81R`\x01`\x01`\xa0\x1b\x03\x86\x16` \x80\x83\x01\x91\x90\x91R`@\x80Q`\x1f\x87\x01\x83\x90\x04\x83\x02\x81\x01\x83\x01\x90\x91R\x85\x81R\x90\x86\x90\x86\x90\x81\x90\x84\x01\x83\x82\x80\x82\x847`\x00\x92\x01\x82\x90RP`@\x80\x87\x01\x95\x86RB``\x88\x01R\x87\x82R`\x03` \x90\x81R\x91 \x86Q\x81T`\x01`\x01`\xa0\x1b\x03\x91\x82\x16`\x01`\x01`\xa0\x1b\x03\x19\x91\x82\x16\x17\x83U\x83\x89\x01Q`\x01\x84\x01\x80T\x91\x90\x93\x16\x91\x16\x17\x90U\x94Q\x80Q\x87\x96\x95Pa\aw\x94P`\x02\x86\x01\x93P\x91\x01\x90a\x11\xf1V[P``\x82\x01Q`\x03\x82\x01U`\x80\x82\x01Q`\x04\x90\x91\x01\x80T`\xa0\x84\x01Q`\xc0\x90\x94\x01Qa\xff\xff\x19\x90\x91\x16a\xff\xff\x93\x84\x16\x17c\xff\xff\x00\x00\x19\x16b\x01\x00\x00\x93\x90\x94\x16\x92\x90\x92\x02\x92\x90\x92\x17d\xff\x00\x00\x00\x00\x19\x16`\x01` \x1b\x91\x15\x15\x91\x90\x91\x02\x17\x90U`@\x80QB\x81R\x90Q`\x01`\x01`\xa0\x1b\x03\x88\x16\x913\x91\x85\x91\u007f\xc1\x0f/MS\xa0\xe3BSlj\xf3\xcc\xe9\xc6\xee%\xc3-\xbb25!\xce\x0e\x1dD\x94\xa3\xe3b\xe8\x91` \x91\x81\x90\x03\x91\x90\x91\x01\x90\xa4P`\x01\x95\x94PPPPPV[`\x03` \x90\x81R`\x00\x91\x82R`@\x91\x82\x90 \x80T`\x01\x80\x83\x01T`\x02\x80\x85\x01\x80T\x88Qa\x01\x00\x95\x82\x16\x15\x95\x90\x95\x02`\x00\x19\x01\x16\x91\x90\x91\x04`\x1f\x81\x01\x87\x90\x04\x87\x02\x84\x01\x87\x01\x90\x97R\x86\x83R`\x01`\x01`\xa0\x1b\x03\x93\x84\x16\x96\x93\x90\x91\x16\x94\x91\x92\x90\x91\x83\x01\x82\x82\x80\x15a\b\xd7W\x80`\x1f\x10a\b\xacWa\x01\x00\x80\x83T\x04\x02\x83R\x91` \x01\x91a\b\xd7V[\x82\x01\x91\x90`\x00R` `\x00 \x90[\x81T\x81R\x90`\x01\x01\x90` \x01\x80\x83\x11a\b\xbaW\x82\x90\x03`\x1f\x16\x82\x01\x91[PPPP`\x03\x83\x01T`\x04\x90\x93\x01T\x91\x92\x91a\xff\xff\x80\x82\x16\x92Pb\x01\x00\x00\x82\x04\x16\x90`\x01` \x1b\x90\x04`\xff\x16\x87V[a\xf0\x00\x81V[a\xf0\x02\x81V[`\x02` R`\x00\x90\x81R`@\x90 T`\xff\x16\x81V[`\x00T`\xff\x16\x15a\tuW`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x13`$\x82\x01Rr\x10[\x1c\x99XY\x1eH\x1a[\x9a]\x1aX[\x1a^\x99Y`j\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[b\t:\x80`\x01U`\x05\x80T`\x01`\x01`\xa0\x1b\x03\x19\x16a\xf0\x00\x17\x90U`\x00[\x81\x81\x10\x15a\n`W`\x00\x83\x83\x83\x81\x81\x10a\t\xa9W\xfe[\x90P` \x02\x015`\x01`\x01`\xa0\x1b\x03\x16`\x01`\x01`\xa0\x1b\x03\x16\x14\x15a\n\x15W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x19`$\x82\x01R\u007fInvalid validator address\x00\x00\x00\x00\x00\x00\x00`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x01`\x02`\x00\x85\x85\x85\x81\x81\x10a\n'W\xfe[` \x90\x81\x02\x92\x90\x92\x015`\x01`\x01`\xa0\x1b\x03\x16\x83RP\x81\x01\x91\x90\x91R`@\x01`\x00 \x80T`\xff\x19\x16\x91\x15\x15\x91\x90\x91\x17\x90U`\x01\x01a\t\x93V[PP`\x00\x80T`\xff\x19\x16`\x01\x17\x90UPV[`\x05T`@\x80Qc\x10\x15B\x87`\xe2\x1b\x81R3`\x04\x82\x01R\x90Q`\x00\x92`\x01`\x01`\xa0\x1b\x03\x16\x91c@U\n\x1c\x91`$\x80\x83\x01\x92` \x92\x91\x90\x82\x90\x03\x01\x81\x86\x80;\x15\x80\x15a\n\xbdW`\x00\x80\xfd[PZ\xfa\x15\x80\x15a\n\xd1W=`\x00\x80>=`\x00\xfd[PPPP`@Q=` \x81\x10\x15a\n\xe7W`\x00\x80\xfd[PQa\v+W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x0e`$\x82\x01RmValidator only`\x90\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[`\x00\x83\x81R`\x03` \x81\x90R`@\x90\x91 \x01Ta\v\x84W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x12`$\x82\x01Rq\x14\x1c\x9b\xdc\x1b\xdc\xd8[\b\x1b\x9b\xdd\b\x19^\x1a\\\xdd`r\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[3`\x00\x90\x81R`\x04` \x90\x81R`@\x80\x83 \x86\x84R\x90\x91R\x90 `\x01\x01T\x15a\v\xdeW`@QbF\x1b\xcd`\xe5\x1b\x81R`\x04\x01\x80\x80` \x01\x82\x81\x03\x82R`#\x81R` \x01\x80a\x12\xb6`#\x919`@\x01\x91PP`@Q\x80\x91\x03\x90\xfd[`\x01T`\x00\x84\x81R`\x03` \x81\x90R`@\x90\x91 \x01T\x01B\x10a\f;W`@\x80QbF\x1b\xcd`\xe5\x1b\x81R` `\x04\x82\x01R`\x10`$\x82\x01Ro\x14\x1c\x9b\xdc\x1b\xdc\xd8[\b\x19^\x1c\x1a\\\x99Y`\x82\x1b`D\x82\x01R\x90Q\x90\x81\x90\x03`d\x01\x90\xfd[3`\x00\x81\x81R`\x04` \x90\x81R`@\x80\x83 \x87\x84R\x82R\x91\x82\x90 B`\x01\x82\x01\x81\x90U\x81T`\x01`\x01`\xa0\x1b\x03\x19\x16\x85\x17\x82U`\x02\x90\x91\x01\x80T`\xff\x19\x16\x87\x15\x15\x90\x81\x17\x90\x91U\x83Q\x90\x81R\x91\x82\x01R\x81Q\x86\x92\u007flY\xbd\xa6\x8c\xac1\x87\x17\xc6\f|\x965\xa7\x8a\x0f\x06\x13\xf9\x88|\xc1\x8aqW\xf5tZ\x86\xd1N\x92\x82\x90\x03\x01\x90\xa3\x81\x15a\f\xe9W`\x00\x83\x81R`\x03` R`@\x90 `\x04\x01\x80Ta\xff\xff\x80\x82\x16`\x01\x01\x16a\xff\xff\x19\x90\x91\x16\x17\x90Ua\r\x1dV[`\x00\x83\x81R`\x03` R`@\x90 `\x04\x01\x80T`\x01a\xff\xffb\x01\x00\x00\x80\x84\x04\x82\x16\x92\x90\x92\x01\x16\x02c\xff\xff\x00\x00\x19\x90\x91\x16\x17\x90U[`\x00\x83\x81R`\x03` \x90\x81R`@\x80\x83 `\x01\x01T`\x01`\x01`\xa0\x1b\x03\x16\x83R`\x02\x90\x91R\x90 T`\xff\x16\x80a\rkWP`\x00\x83\x81R`\x03` R`@\x90 `\x04\x01T`\x01` \x1b\x90\x04`\xff\x16[\x15a\rxWP`\x01a\x11\x89V[`\x05T`@\x80Qc\x13\xbc\xe0K`\xe3\x1b\x81R\x90Q`\x02\x92`\x01`\x01`\xa0\x1b\x03\x16\x91c\x9d\xe7\x02X\x91`\x04\x80\x83\x01\x92`\x00\x92\x91\x90\x82\x90\x03\x01\x81\x86\x80;\x15\x80\x15a\r\xbdW`\x00\x80\xfd[PZ\xfa\x15\x80\x15a\r\xd1W=`\x00\x80>=`\x00\xfd[PPPP`@Q=`\x00\x82>`\x1f=\x90\x81\x01`\x1f\x19\x16\x82\x01`@R` \x81\x10\x15a\r\xfaW`\x00\x80\xfd[\x81\x01\x90\x80\x80Q`@Q\x93\x92\x91\x90\x84`\x01` \x1b\x82\x11\x15a\x0e\x19W`\x00\x80\xfd[\x90\x83\x01\x90` \x82\x01\x85\x81\x11\x15a\x0e.W`\x00\x80\xfd[\x82Q\x86` \x82\x02\x83\x01\x11`\x01` \x1b\x82\x11\x17\x15a\x0eJW`\x00\x80\xfd[\x82RP\x81Q` \x91\x82\x01\x92\x82\x01\x91\x02\x80\x83\x83`\x00[\x83\x81\x10\x15a\x0ewW\x81\x81\x01Q\x83\x82\x01R` \x01a\x0e_V[PPPP\x90P\x01`@RPPPQ\x81a\x0e\x8cW\xfe[`\x00\x85\x81R`\x03` R`@\x90 `\x04\x01T\x91\x90\x04`\x01\x01a\xff\xff\x90\x91\x16\x10a\x0f\xd4W`\x00\x83\x81R`\x03` \x81\x81R`@\x80\x84 `\x01\x81\x81\x01\x80T`\x01`\x01`\xa0\x1b\x03\x90\x81\x16\x88R`\x02\x86R\x84\x88 \x80T`\xff\x19\x16\x90\x93\x17\x90\x92U\x89\x87R\x94\x84R`\x04\x91\x82\x01\x80Td\xff\x00\x00\x00\x00\x19\x16`\x01` \x1b\x17\x90U`\x05T\x94T\x83QcA^\x9e\xc9`\xe1\x1b\x81R\x90\x82\x16\x92\x81\x01\x92\x90\x92R\x91Q\x93\x90\x91\x16\x93c\x82\xbd=\x92\x93`$\x80\x84\x01\x94\x93\x91\x92\x91\x83\x90\x03\x01\x90\x82\x90\x87\x80;\x15\x80\x15a\x0fLW`\x00\x80\xfd[PZ\xf1\x15\x80\x15a\x0f`W=`\x00\x80>=`\x00\xfd[PPPP`@Q=` \x81\x10\x15a\x0fvW`\x00\x80\xfd[PP`\x00\x83\x81R`\x03` \x90\x81R`@\x91\x82\x90 `\x01\x01T\x82QB\x81R\x92Q`\x01`\x01`\xa0\x1b\x03\x90\x91\x16\x92\x86\x92\u007f\xc9\xd9ma\xebb\x03\x18e\xc5#\xae\x10\u007f<\"\xf5\xedDZ\xf27ck\u0348\xbe\xa1p\\p\u0552\x91\x82\x90\x03\x01\x90\xa3P`\x01a\x11\x89V[`\x05T`@\x80Qc\x13\xbc\xe0K`\xe3\x1b\x81R\x90Q`\x02\x92`\x01`\x01`\xa0\x1b\x03\x16\x91c\x9d\xe7\x02X\x91`\x04\x80\x83\x01\x92`\x00\x92\x91\x90\x82\x90\x03\x01\x81\x86\x80;\x15\x80\x15a\x10\x19W`\x00\x80\xfd[PZ\xfa\x15\x80\x15a\x10-W=`\x00\x80>=`\x00\xfd[PPPP`@Q=`\x00\x82>`\x1f=\x90\x81\x01`\x1f\x19\x16\x82\x01`@R` \x81\x10\x15a\x10VW`\x00\x80\xfd[\x81\x01\x90\x80\x80Q`@Q\x93\x92\x91\x90\x84`\x01` \x1b\x82\x11\x15a\x10uW`\x00\x80\xfd[\x90\x83\x01\x90` \x82\x01\x85\x81\x11\x15a\x10\x8aW`\x00\x80\xfd[\x82Q\x86` \x82\x02\x83\x01\x11`\x01` \x1b\x82\x11\x17\x15a\x10\xa6W`\x00\x80\xfd[\x82RP\x81Q` \x91\x82\x01\x92\x82\x01\x91\x02\x80\x83\x83`\x00[\x83\x81\x10\x15a\x10\xd3W\x81\x81\x01Q\x83\x82\x01R` \x01a\x10\xbbV[PPPP\x90P\x01`@RPPPQ\x81a\x10\xe8W\xfe[\x04`\x01\x01`\x03`\x00\x85\x81R` \x01\x90\x81R` \x01`\x00 `\x04\x01`\x02\x90T\x90a\x01\x00\n\x90\x04a\xff\xff\x16a\xff\xff\x16\x10a\x11\x85W`\x00\x83\x81R`\x03` \x90\x81R`@\x91\x82\x90 `\x04\x81\x01\x80Td\xff\x00\x00\x00\x00\x19\x16`\x01` \x1b\x17\x90U`\x01\x01T\x82QB\x81R\x92Q`\x01`\x01`\xa0\x1b\x03\x90\x91\x16\x92\x86\x92\u007f\xec\x95]w\xe6\xe7\xd7N\x18\xb1\xc9\x19w\xef\x0fo\u0566\xd0*(\u0457\x96\x863\x9f\u64d9x%\x92\x91\x82\x90\x03\x01\x90\xa3[P`\x01[\x92\x91PPV[h\x01\xbc\x16\xd6t\xec\x80\x00\x00\x81V[`\x15\x81V[b\x01Q\x80\x81V[`\x01T\x81V[ap\x80\x81V[`@\x80Q`\xe0\x81\x01\x82R`\x00\x80\x82R` \x82\x01\x81\x90R``\x92\x82\x01\x83\x90R\x91\x81\x01\x82\x90R`\x80\x81\x01\x82\x90R`\xa0\x81\x01\x82\x90R`\xc0\x81\x01\x91\x90\x91R\x90V[\x82\x80T`\x01\x81`\x01\x16\x15a\x01\x00\x02\x03\x16`\x02\x90\x04\x90`\x00R` `\x00 \x90`\x1f\x01` \x90\x04\x81\x01\x92\x82`\x1f\x10a\x122W\x80Q`\xff\x19\x16\x83\x80\x01\x17\x85Ua\x12_V[\x82\x80\x01`\x01\x01\x85U\x82\x15a\x12_W\x91\x82\x01[\x82\x81\x11\x15a\x12_W\x82Q\x82U\x91` \x01\x91\x90`\x01\x01\x90a\x12DV[Pa\x12k\x92\x91Pa\x12oV[P\x90V[a\x12\x89\x91\x90[\x80\x82\x11\x15a\x12kW`\x00\x81U`\x01\x01a\x12uV[\x90V\xfeDst already passed, You can start stakingYou can't vote for a proposal twice\xa2dipfsX\"\x12 \xb5\xd3\xe9 @\xa6>\x98v\x84\xb1\xa2\x8eX\xe2p\x16\xbed\x89\x1c\x03h\xeb\xe3Y\xa5\xee$;\xa7\xdbdsolcC\x00\x06\x01\x003"
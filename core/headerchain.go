@@ -300,8 +300,9 @@ func (hc *HeaderChain) writeHeaders(headers []*types.Header) (result *headerWrit
 	}, nil
 }
 
-func (hc *HeaderChain) ValidateHeaderChain(chain []*types.Header, checkFreq int) (int, error) {
-	// Do a sanity check that the provided chain is actually ordered and linked
+// checkHeaderChain does a sanity check that the provided chain is actually ordered
+// and linked, and doesn't contain banned headers.
+func (hc *HeaderChain) checkHeaderChain(chain []*types.Header) (int, error) {
 	for i := 1; i < len(chain); i++ {
 		if chain[i].Number.Uint64() != chain[i-1].Number.Uint64()+1 {
 			hash := chain[i].Hash()
@@ -322,6 +323,13 @@ func (hc *HeaderChain) ValidateHeaderChain(chain []*types.Header, checkFreq int)
 			return i, ErrBannedHash
 		}
 	}
+	return 0, nil
+}
+
+func (hc *HeaderChain) ValidateHeaderChain(chain []*types.Header, checkFreq int) (int, error) {
+	if i, err := hc.checkHeaderChain(chain); err != nil {
+		return i, err
+	}
 
 	// Generate the list of seal verification requests, and start the parallel verifier
 	seals := make([]bool, len(chain))
@@ -1105,3 +1105,391 @@ func WriteViolateCasperFFGPunish(db ethdb.KeyValueStore, before *types.Attestati
 	}
 	return nil
 }
+
+// ReadAllDoubleSealEvidence retrieves the double seal evidences not punished yet.
+func ReadAllDoubleSealEvidence(db ethdb.Reader) []*types.DoubleSealEvidence {
+	blob, err := db.Get(doubleSealEvidenceKey)
+	if err != nil {
+		return nil
+	}
+	var list types.DoubleSealEvidenceList
+	if err := rlp.DecodeBytes(blob, &list); err != nil {
+		return nil
+	}
+	return list
+}
+
+// DeleteDoubleSealEvidence removes a punished double seal evidence.
+func DeleteDoubleSealEvidence(db ethdb.KeyValueStore, e *types.DoubleSealEvidence) {
+	blob, _ := db.Get(doubleSealEvidenceKey)
+	var list types.DoubleSealEvidenceList
+	if len(blob) > 0 {
+		if err := rlp.DecodeBytes(blob, &list); err != nil {
+			log.Crit("Failed to decode double seal evidences", "error", err)
+		}
+	}
+	for i, v := range list {
+		if e.Hash() == v.Hash() {
+			list = append(list[:i], list[i+1:]...)
+			break
+		}
+	}
+	data, err := rlp.EncodeToBytes(list)
+	if err != nil {
+		log.Crit("Failed to encode double seal evidences", "err", err)
+	}
+	if err := db.Put(doubleSealEvidenceKey, data); err != nil {
+		log.Crit("Failed to write double seal evidences", "err", err)
+	}
+}
+
+// WriteDoubleSealEvidence stores a double seal evidence to be punished, skipping the
+// ones that punish the same validator at the same height.
+func WriteDoubleSealEvidence(db ethdb.KeyValueStore, e *types.DoubleSealEvidence) error {
+	blob, _ := db.Get(doubleSealEvidenceKey)
+
+	var list types.DoubleSealEvidenceList
+	if len(blob) > 0 {
+		if err := rlp.DecodeBytes(blob, &list); err != nil {
+			log.Crit("Failed to decode double seal evidences", "error", err)
+		}
+	}
+	for _, v := range list {
+		if v.Hash() == e.Hash() {
+			return fmt.Errorf("skip duplicated double seal evidence %v", e.Hash().String())
+		}
+	}
+	list = append(list, e)
+	sort.Sort(sort.Reverse(list))
+	if len(list) > casperFFGPunishToKeep {
+		list = list[:casperFFGPunishToKeep]
+	}
+	data, err := rlp.EncodeToBytes(list)
+	if err != nil {
+		log.Crit("Failed to encode double seal evidences", "err", err)
+	}
+	if err := db.Put(doubleSealEvidenceKey, data); err != nil {
+		log.Crit("Failed to write double seal evidences", "err", err)
+	}
+	return nil
+}
+
+// ReadAggregateAttestation retrieves the aggregate attestation of a (source,target) pair,
+// identified by the target number and the sign hash of the pair.
+func ReadAggregateAttestation(db ethdb.Reader, number uint64, signHash common.Hash) *types.AggregateAttestation {
+	blob, err := db.Get(aggregateAttestationKey(number, signHash))
+	if err != nil || len(blob) == 0 {
+		return nil
+	}
+	a := new(types.AggregateAttestation)
+	if err := rlp.DecodeBytes(blob, a); err != nil {
+		log.Error("Invalid aggregate attestation RLP", "number", number, "signHash", signHash, "err", err)
+		return nil
+	}
+	return a
+}
+
+// ReadAggregateAttestations retrieves all the aggregate attestations targeting blocks
+// at a certain height, both canonical and reorged forks included.
+func ReadAggregateAttestations(db ethdb.Iteratee, number uint64) []*types.AggregateAttestation {
+	prefix := append(aggregateAttestationPrefix, encodeBlockNumber(number)...)
+
+	it := db.NewIterator(prefix, nil)
+	defer it.Release()
+
+	var as []*types.AggregateAttestation
+	for it.Next() {
+		if len(it.Key()) != len(prefix)+common.HashLength {
+			continue
+		}
+		a := new(types.AggregateAttestation)
+		if err := rlp.DecodeBytes(it.Value(), a); err != nil {
+			log.Error("Invalid aggregate attestation RLP", "number", number, "err", err)
+			continue
+		}
+		as = append(as, a)
+	}
+	return as
+}
+
+// WriteAggregateAttestation stores the aggregate attestation of a (source,target) pair,
+// replacing any previously stored aggregate of the same pair.
+func WriteAggregateAttestation(db ethdb.KeyValueWriter, a *types.AggregateAttestation) {
+	data, err := rlp.EncodeToBytes(a)
+	if err != nil {
+		log.Crit("Failed to encode aggregate attestation", "err", err)
+	}
+	if err := db.Put(aggregateAttestationKey(a.TargetRangeEdge.Number.Uint64(), a.SignHash()), data); err != nil {
+		log.Crit("Failed to store aggregate attestation", "err", err)
+	}
+}
+
+// DeleteAggregateAttestations removes all the aggregate attestations targeting blocks below
+// the given number.
+func DeleteAggregateAttestations(db ethdb.KeyValueStore, number uint64) {
+	it := db.NewIterator(aggregateAttestationPrefix, nil)
+	defer it.Release()
+
+	batch := db.NewBatch()
+	for it.Next() {
+		key := it.Key()
+		if len(key) != len(aggregateAttestationPrefix)+8+common.HashLength {
+			continue
+		}
+		if binary.BigEndian.Uint64(key[len(aggregateAttestationPrefix):]) >= number {
+			break
+		}
+		if err := batch.Delete(key); err != nil {
+			log.Crit("Failed to delete aggregate attestation", "err", err)
+		}
+		if batch.ValueSize() > ethdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				log.Crit("Failed to delete aggregate attestations", "err", err)
+			}
+			batch.Reset()
+		}
+	}
+	if err := batch.Write(); err != nil {
+		log.Crit("Failed to delete aggregate attestations", "err", err)
+	}
+}
+
+// ReadFinalityCertificate retrieves the finality certificate embedded in the chain for
+// the given block.
+func ReadFinalityCertificate(db ethdb.Reader, number uint64, hash common.Hash) *types.FinalityCertificate {
+	blob, err := db.Get(finalityCertificateKey(number, hash))
+	if err != nil || len(blob) == 0 {
+		return nil
+	}
+	cert := new(types.FinalityCertificate)
+	if err := rlp.DecodeBytes(blob, cert); err != nil {
+		log.Error("Invalid finality certificate RLP", "number", number, "hash", hash, "err", err)
+		return nil
+	}
+	return cert
+}
+
+// WriteFinalityCertificate stores the finality certificate of its target block.
+func WriteFinalityCertificate(db ethdb.KeyValueWriter, cert *types.FinalityCertificate) {
+	data, err := rlp.EncodeToBytes(cert)
+	if err != nil {
+		log.Crit("Failed to encode finality certificate", "err", err)
+	}
+	if err := db.Put(finalityCertificateKey(cert.TargetRangeEdge.Number.Uint64(), cert.TargetRangeEdge.Hash), data); err != nil {
+		log.Crit("Failed to store finality certificate", "err", err)
+	}
+}
+
+// ReadAllAggregateVoteEvidence retrieves the aggregate vote evidences not punished yet.
+func ReadAllAggregateVoteEvidence(db ethdb.Reader) []*types.AggregateVoteEvidence {
+	blob, err := db.Get(aggregateVoteEvidenceKey)
+	if err != nil {
+		return nil
+	}
+	var list types.AggregateVoteEvidenceList
+	if err := rlp.DecodeBytes(blob, &list); err != nil {
+		return nil
+	}
+	return list
+}
+
+// DeleteAggregateVoteEvidence removes a punished aggregate vote evidence.
+func DeleteAggregateVoteEvidence(db ethdb.KeyValueStore, e *types.AggregateVoteEvidence) {
+	blob, _ := db.Get(aggregateVoteEvidenceKey)
+	var list types.AggregateVoteEvidenceList
+	if len(blob) > 0 {
+		if err := rlp.DecodeBytes(blob, &list); err != nil {
+			log.Crit("Failed to decode aggregate vote evidences", "error", err)
+		}
+	}
+	for i, v := range list {
+		if e.Hash() == v.Hash() {
+			list = append(list[:i], list[i+1:]...)
+			break
+		}
+	}
+	data, err := rlp.EncodeToBytes(list)
+	if err != nil {
+		log.Crit("Failed to encode aggregate vote evidences", "err", err)
+	}
+	if err := db.Put(aggregateVoteEvidenceKey, data); err != nil {
+		log.Crit("Failed to write aggregate vote evidences", "err", err)
+	}
+}
+
+// WriteAggregateVoteEvidence stores an aggregate vote evidence to be punished, skipping the
+// ones that punish the same validator for the same pair of votes.
+func WriteAggregateVoteEvidence(db ethdb.KeyValueStore, e *types.AggregateVoteEvidence) error {
+	blob, _ := db.Get(aggregateVoteEvidenceKey)
+
+	var list types.AggregateVoteEvidenceList
+	if len(blob) > 0 {
+		if err := rlp.DecodeBytes(blob, &list); err != nil {
+			log.Crit("Failed to decode aggregate vote evidences", "error", err)
+		}
+	}
+	for _, v := range list {
+		if v.Hash() == e.Hash() {
+			return fmt.Errorf("skip duplicated aggregate vote evidence %v", e.Hash().String())
+		}
+	}
+	list = append(list, e)
+	sort.Sort(sort.Reverse(list))
+	if len(list) > casperFFGPunishToKeep {
+		list = list[:casperFFGPunishToKeep]
+	}
+	data, err := rlp.EncodeToBytes(list)
+	if err != nil {
+		log.Crit("Failed to encode aggregate vote evidences", "err", err)
+	}
+	if err := db.Put(aggregateVoteEvidenceKey, data); err != nil {
+		log.Crit("Failed to write aggregate vote evidences", "err", err)
+	}
+	return nil
+}
+
+// ReadOwnAttestations retrieves the votes given by a local validator, ordered by
+// their target number.
+func ReadOwnAttestations(db ethdb.Iteratee, val common.Address) types.CasperFFGHistoryList {
+	prefix := append(ownAttestationPrefix, val.Bytes()...)
+
+	it := db.NewIterator(prefix, nil)
+	defer it.Release()
+
+	var list types.CasperFFGHistoryList
+	for it.Next() {
+		if len(it.Key()) != len(prefix)+8 {
+			continue
+		}
+		h := new(types.CasperFFGHistory)
+		if err := rlp.DecodeBytes(it.Value(), h); err != nil {
+			log.Error("Invalid own attestation RLP", "validator", val, "err", err)
+			continue
+		}
+		list = append(list, h)
+	}
+	return list
+}
+
+// WriteOwnAttestation stores a vote given by a local validator.
+func WriteOwnAttestation(db ethdb.KeyValueWriter, val common.Address, h *types.CasperFFGHistory) {
+	data, err := rlp.EncodeToBytes(h)
+	if err != nil {
+		log.Crit("Failed to encode own attestation", "err", err)
+	}
+	if err := db.Put(ownAttestationKey(val, h.TargetNum.Uint64()), data); err != nil {
+		log.Crit("Failed to store own attestation", "err", err)
+	}
+}
+
+// DeleteOwnAttestation removes the vote given by a local validator for a target number.
+func DeleteOwnAttestation(db ethdb.KeyValueWriter, val common.Address, number uint64) {
+	if err := db.Delete(ownAttestationKey(val, number)); err != nil {
+		log.Crit("Failed to delete own attestation", "err", err)
+	}
+}
+
+// ReadAttestationVote retrieves the vote given by a validator for a target number.
+func ReadAttestationVote(db ethdb.KeyValueReader, val common.Address, number uint64) *types.VoteRecord {
+	data, _ := db.Get(attestationVoteKey(number, val))
+	if len(data) == 0 {
+		return nil
+	}
+	vote := new(types.VoteRecord)
+	if err := rlp.DecodeBytes(data, vote); err != nil {
+		log.Error("Invalid attestation vote RLP", "validator", val, "number", number, "err", err)
+		return nil
+	}
+	return vote
+}
+
+// ReadAttestationVotes retrieves the votes given by a validator for the target numbers
+// from the given one on, ordered by their target number.
+func ReadAttestationVotes(db ethdb.Iteratee, val common.Address, from uint64) []*types.VoteRecord {
+	it := db.NewIterator(attestationVotePrefix, encodeBlockNumber(from))
+	defer it.Release()
+
+	var votes []*types.VoteRecord
+	for it.Next() {
+		key := it.Key()
+		if len(key) != len(attestationVotePrefix)+8+common.AddressLength || common.BytesToAddress(key[len(attestationVotePrefix)+8:]) != val {
+			continue
+		}
+		vote := new(types.VoteRecord)
+		if err := rlp.DecodeBytes(it.Value(), vote); err != nil {
+			log.Error("Invalid attestation vote RLP", "validator", val, "err", err)
+			continue
+		}
+		votes = append(votes, vote)
+	}
+	return votes
+}
+
+// ReadAttestationVotesBelow retrieves the votes of all the validators for the target
+// numbers below the given one, grouped by validator and ordered by their target number.
+func ReadAttestationVotesBelow(db ethdb.Iteratee, number uint64) map[common.Address][]*types.VoteRecord {
+	it := db.NewIterator(attestationVotePrefix, nil)
+	defer it.Release()
+
+	votes := make(map[common.Address][]*types.VoteRecord)
+	for it.Next() {
+		key := it.Key()
+		if len(key) != len(attestationVotePrefix)+8+common.AddressLength {
+			continue
+		}
+		if binary.BigEndian.Uint64(key[len(attestationVotePrefix):]) >= number {
+			break
+		}
+		vote := new(types.VoteRecord)
+		if err := rlp.DecodeBytes(it.Value(), vote); err != nil {
+			log.Error("Invalid attestation vote RLP", "err", err)
+			continue
+		}
+		val := common.BytesToAddress(key[len(attestationVotePrefix)+8:])
+		votes[val] = append(votes[val], vote)
+	}
+	return votes
+}
+
+// WriteAttestationVote stores the vote given by a validator for its target number.
+func WriteAttestationVote(db ethdb.KeyValueWriter, val common.Address, vote *types.VoteRecord) {
+	data, err := rlp.EncodeToBytes(vote)
+	if err != nil {
+		log.Crit("Failed to encode attestation vote", "err", err)
+	}
+	if err := db.Put(attestationVoteKey(vote.Target, val), data); err != nil {
+		log.Crit("Failed to store attestation vote", "err", err)
+	}
+}
+
+// DeleteAttestationVote removes the vote given by a validator for a target number.
+func DeleteAttestationVote(db ethdb.KeyValueWriter, val common.Address, number uint64) {
+	if err := db.Delete(attestationVoteKey(number, val)); err != nil {
+		log.Crit("Failed to delete attestation vote", "err", err)
+	}
+}
+
+// ReadVoteSpan retrieves the span of the pruned votes of a validator.
+func ReadVoteSpan(db ethdb.KeyValueReader, val common.Address) *types.VoteSpan {
+	data, _ := db.Get(voteSpanKey(val))
+	if len(data) == 0 {
+		return nil
+	}
+	span := new(types.VoteSpan)
+	if err := rlp.DecodeBytes(data, span); err != nil {
+		log.Error("Invalid vote span RLP", "validator", val, "err", err)
+		return nil
+	}
+	return span
+}
+
+// WriteVoteSpan stores the span of the pruned votes of a validator.
+func WriteVoteSpan(db ethdb.KeyValueWriter, val common.Address, span *types.VoteSpan) {
+	data, err := rlp.EncodeToBytes(span)
+	if err != nil {
+		log.Crit("Failed to encode vote span", "err", err)
+	}
+	if err := db.Put(voteSpanKey(val), data); err != nil {
+		log.Crit("Failed to store vote span", "err", err)
+	}
+}
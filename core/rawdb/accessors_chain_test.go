@@ -955,3 +955,122 @@ func TestWriteAndReadAndDeleteAndClearViolateCasperFFGPunish(t *testing.T) {
 	pushList = ReadAllViolateCasperFFGPunish(db)
 	require.True(t, len(pushList) == 0)
 }
+
+func TestWriteAndReadAggregateAttestation(t *testing.T) {
+	db := NewMemoryDatabase()
+	source := &types.RangeEdge{Hash: common.BytesToHash([]byte{0x01}), Number: big.NewInt(1)}
+	target := &types.RangeEdge{Hash: common.BytesToHash([]byte{0x02}), Number: big.NewInt(2)}
+	fork := &types.RangeEdge{Hash: common.BytesToHash([]byte{0x03}), Number: big.NewInt(2)}
+
+	a, err := types.NewAggregateAttestation(source, target, 0, 4, make([]byte, 192))
+	require.NoError(t, err)
+	WriteAggregateAttestation(db, a)
+	b, err := types.NewAggregateAttestation(source, fork, 1, 4, make([]byte, 192))
+	require.NoError(t, err)
+	WriteAggregateAttestation(db, b)
+
+	stored := ReadAggregateAttestation(db, 2, a.SignHash())
+	require.NotNil(t, stored)
+	require.Equal(t, a.Hash(), stored.Hash())
+	require.Len(t, ReadAggregateAttestations(db, 2), 2)
+	require.Len(t, ReadAggregateAttestations(db, 1), 0)
+
+	// A larger aggregate of the same pair replaces the stored one
+	a.Signers[0] |= 0x02
+	WriteAggregateAttestation(db, a)
+	require.Equal(t, 2, ReadAggregateAttestation(db, 2, a.SignHash()).Count())
+	require.Len(t, ReadAggregateAttestations(db, 2), 2)
+}
+
+func TestDeleteAggregateAttestations(t *testing.T) {
+	db := NewMemoryDatabase()
+	source := &types.RangeEdge{Hash: common.BytesToHash([]byte{0x01}), Number: big.NewInt(1)}
+	for i := int64(2); i <= 4; i++ {
+		target := &types.RangeEdge{Hash: common.BytesToHash([]byte{byte(i)}), Number: big.NewInt(i)}
+		a, err := types.NewAggregateAttestation(source, target, 0, 4, make([]byte, 192))
+		require.NoError(t, err)
+		WriteAggregateAttestation(db, a)
+	}
+	DeleteAggregateAttestations(db, 4)
+	require.Len(t, ReadAggregateAttestations(db, 2), 0)
+	require.Len(t, ReadAggregateAttestations(db, 3), 0)
+	require.Len(t, ReadAggregateAttestations(db, 4), 1)
+}
+
+func TestWriteAndReadAndDeleteAggregateVoteEvidence(t *testing.T) {
+	db := NewMemoryDatabase()
+	source := &types.RangeEdge{Hash: common.BytesToHash([]byte{0x01}), Number: big.NewInt(1)}
+	target := &types.RangeEdge{Hash: common.BytesToHash([]byte{0x02}), Number: big.NewInt(2)}
+	fork := &types.RangeEdge{Hash: common.BytesToHash([]byte{0x03}), Number: big.NewInt(2)}
+
+	a, err := types.NewAggregateAttestation(source, target, 0, 4, make([]byte, 192))
+	require.NoError(t, err)
+	b, err := types.NewAggregateAttestation(source, fork, 0, 4, make([]byte, 192))
+	require.NoError(t, err)
+
+	e := types.NewAggregateVoteEvidence(common.HexToAddress("0x1"), a, b)
+	require.NoError(t, WriteAggregateVoteEvidence(db, e))
+	// The same pair of votes in the other order is the same evidence
+	require.Error(t, WriteAggregateVoteEvidence(db, types.NewAggregateVoteEvidence(common.HexToAddress("0x1"), b, a)))
+	require.NoError(t, WriteAggregateVoteEvidence(db, types.NewAggregateVoteEvidence(common.HexToAddress("0x2"), a, b)))
+
+	list := ReadAllAggregateVoteEvidence(db)
+	require.Len(t, list, 2)
+
+	DeleteAggregateVoteEvidence(db, e)
+	list = ReadAllAggregateVoteEvidence(db)
+	require.Len(t, list, 1)
+	require.Equal(t, common.HexToAddress("0x2"), list[0].Defendant)
+}
+
+func TestWriteAndReadAndDeleteDoubleSealEvidence(t *testing.T) {
+	db := NewMemoryDatabase()
+	coinbase := common.HexToAddress("0x1")
+
+	var evidences []*types.DoubleSealEvidence
+	for i := 1; i <= 3; i++ {
+		a := &types.Header{Number: big.NewInt(int64(i)), Coinbase: coinbase, Difficulty: big.NewInt(2), Extra: []byte("a")}
+		b := &types.Header{Number: big.NewInt(int64(i)), Coinbase: coinbase, Difficulty: big.NewInt(2), Extra: []byte("b")}
+		e := types.NewDoubleSealEvidence(a, b)
+		require.NoError(t, WriteDoubleSealEvidence(db, e))
+		evidences = append(evidences, e)
+	}
+	// A third header at the same height must not punish the validator twice
+	c := &types.Header{Number: big.NewInt(1), Coinbase: coinbase, Difficulty: big.NewInt(2), Extra: []byte("c")}
+	require.Error(t, WriteDoubleSealEvidence(db, types.NewDoubleSealEvidence(evidences[0].HeaderA, c)))
+
+	list := ReadAllDoubleSealEvidence(db)
+	require.Equal(t, 3, len(list))
+	require.Equal(t, uint64(3), list[0].Number())
+
+	DeleteDoubleSealEvidence(db, evidences[1])
+	list = ReadAllDoubleSealEvidence(db)
+	require.Equal(t, 2, len(list))
+	for _, e := range list {
+		require.NotEqual(t, evidences[1].Hash(), e.Hash())
+	}
+}
+
+func TestWriteAndReadAndDeleteOwnAttestation(t *testing.T) {
+	db := NewMemoryDatabase()
+	val := common.HexToAddress("0x1")
+	other := common.HexToAddress("0x2")
+
+	for _, n := range []int64{5, 3, 4} {
+		WriteOwnAttestation(db, val, &types.CasperFFGHistory{
+			TargetNum:  big.NewInt(n),
+			SourceNum:  big.NewInt(n - 1),
+			TargetHash: common.BigToHash(big.NewInt(n)),
+		})
+	}
+	WriteOwnAttestation(db, other, &types.CasperFFGHistory{TargetNum: big.NewInt(1), SourceNum: big.NewInt(0)})
+
+	list := ReadOwnAttestations(db, val)
+	require.Len(t, list, 3)
+	for i, h := range list {
+		require.Equal(t, uint64(i+3), h.TargetNum.Uint64())
+	}
+	DeleteOwnAttestation(db, val, 3)
+	require.Len(t, ReadOwnAttestations(db, val), 2)
+	require.Len(t, ReadOwnAttestations(db, other), 1)
+}
@@ -155,6 +155,62 @@ func WriteBloomBits(db ethdb.KeyValueWriter, bit uint, section uint64, head comm
 	}
 }
 
+// ReadValidatorPerformance retrieves the encoded validator performance counters of
+// the given section, or nil if the section wasn't indexed.
+func ReadValidatorPerformance(db ethdb.KeyValueReader, section uint64, head common.Hash) []byte {
+	data, _ := db.Get(validatorPerformanceKey(section, head))
+	return data
+}
+
+// WriteValidatorPerformance stores the encoded validator performance counters of
+// the given section.
+func WriteValidatorPerformance(db ethdb.KeyValueWriter, section uint64, head common.Hash, data []byte) {
+	if err := db.Put(validatorPerformanceKey(section, head), data); err != nil {
+		log.Crit("Failed to store validator performance", "err", err)
+	}
+}
+
+// ReadPunishmentHistory retrieves the encoded punishments executed by the blocks of the
+// given section, or nil if the section wasn't indexed.
+func ReadPunishmentHistory(db ethdb.KeyValueReader, section uint64, head common.Hash) []byte {
+	data, _ := db.Get(punishmentHistoryKey(section, head))
+	return data
+}
+
+// WritePunishmentHistory stores the encoded punishments executed by the blocks of the
+// given section.
+func WritePunishmentHistory(db ethdb.KeyValueWriter, section uint64, head common.Hash, data []byte) {
+	if err := db.Put(punishmentHistoryKey(section, head), data); err != nil {
+		log.Crit("Failed to store punishment history", "err", err)
+	}
+}
+
+// ReadValidatorSetChanges retrieves the encoded validator set changes of the checkpoints
+// starting the given epoch, both canonical and reorged forks included.
+func ReadValidatorSetChanges(db ethdb.Iteratee, epoch uint64) [][]byte {
+	prefix := append(validatorSetChangePrefix, encodeBlockNumber(epoch)...)
+
+	it := db.NewIterator(prefix, nil)
+	defer it.Release()
+
+	var changes [][]byte
+	for it.Next() {
+		if len(it.Key()) != len(prefix)+common.HashLength {
+			continue
+		}
+		changes = append(changes, common.CopyBytes(it.Value()))
+	}
+	return changes
+}
+
+// WriteValidatorSetChange stores the encoded validator set change of the checkpoint
+// with the given hash, starting the given epoch.
+func WriteValidatorSetChange(db ethdb.KeyValueWriter, epoch uint64, hash common.Hash, data []byte) {
+	if err := db.Put(validatorSetChangeKey(epoch, hash), data); err != nil {
+		log.Crit("Failed to store validator set change", "err", err)
+	}
+}
+
 // DeleteBloombits removes all compressed bloom bits vector belonging to the
 // given section range and bit index.
 func DeleteBloombits(db ethdb.Database, bit uint, from uint64, to uint64) {
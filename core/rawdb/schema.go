@@ -98,12 +98,30 @@ var (
 	casperFFGAttestationsKey  = []byte("CFA") // casperFFGAttestationsKey
 	epochCheckBpsKey          = []byte("ECB")
 	violateCasperFFGPunishKey = []byte("VCF")
+	doubleSealEvidenceKey     = []byte("DSE") // doubleSealEvidenceKey -> pending double seal evidences
+	aggregateVoteEvidenceKey  = []byte("AVE") // aggregateVoteEvidenceKey -> pending aggregate vote evidences
+
+	aggregateAttestationPrefix = []byte("AA") // aggregateAttestationPrefix + num (uint64 big endian) + sign hash -> aggregate attestation
+	finalityCertificatePrefix  = []byte("FC") // finalityCertificatePrefix + num (uint64 big endian) + hash -> finality certificate of a canonical block
+	ownAttestationPrefix       = []byte("OA") // ownAttestationPrefix + address + num (uint64 big endian) -> vote given by a local validator
+	attestationVotePrefix      = []byte("AV") // attestationVotePrefix + num (uint64 big endian) + address -> vote given by a validator, until its target is finalized
+	voteSpanPrefix             = []byte("AS") // voteSpanPrefix + address -> span of the pruned votes of a validator
+
+	validatorPerformancePrefix = []byte("VP") // validatorPerformancePrefix + section (uint64 big endian) + hash -> validator performance of the section
+	validatorSetChangePrefix   = []byte("VS") // validatorSetChangePrefix + epoch (uint64 big endian) + hash -> validator set change at the checkpoint
+	punishmentHistoryPrefix    = []byte("PH") // punishmentHistoryPrefix + section (uint64 big endian) + hash -> punishments executed by the section
+
+	SlashingBlockPrefix     = []byte("SB") // SlashingBlockPrefix + address + num (uint64 big endian) -> signing root of a header signed by a local validator
+	SlashingVotePrefix      = []byte("SV") // SlashingVotePrefix + address + target num (uint64 big endian) -> vote signed by a local validator
+	SlashingWatermarkPrefix = []byte("SW") // SlashingWatermarkPrefix + address -> low watermarks of a local validator
 
 	PreimagePrefix = []byte("secure-key-")      // PreimagePrefix + hash -> preimage
 	configPrefix   = []byte("ethereum-config-") // config prefix for the db
 
 	// Chain index prefixes (use `i` + single byte to avoid mixing data types).
-	BloomBitsIndexPrefix = []byte("iB") // BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
+	BloomBitsIndexPrefix            = []byte("iB") // BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
+	ValidatorPerformanceIndexPrefix = []byte("iV") // ValidatorPerformanceIndexPrefix is the data table of the validator performance indexer
+	PunishmentHistoryIndexPrefix    = []byte("iP") // PunishmentHistoryIndexPrefix is the data table of the punishment history indexer
 
 	preimageCounter    = metrics.NewRegisteredCounter("db/preimage/total", nil)
 	preimageHitCounter = metrics.NewRegisteredCounter("db/preimage/hits", nil)
@@ -186,6 +204,31 @@ func blockReceiptsKey(number uint64, hash common.Hash) []byte {
 	return append(append(blockReceiptsPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
 }
 
+// aggregateAttestationKey = aggregateAttestationPrefix + num (uint64 big endian) + sign hash
+func aggregateAttestationKey(number uint64, signHash common.Hash) []byte {
+	return append(append(aggregateAttestationPrefix, encodeBlockNumber(number)...), signHash.Bytes()...)
+}
+
+// finalityCertificateKey = finalityCertificatePrefix + num (uint64 big endian) + hash
+func finalityCertificateKey(number uint64, hash common.Hash) []byte {
+	return append(append(finalityCertificatePrefix, encodeBlockNumber(number)...), hash.Bytes()...)
+}
+
+// ownAttestationKey = ownAttestationPrefix + address + num (uint64 big endian)
+func ownAttestationKey(val common.Address, number uint64) []byte {
+	return append(append(ownAttestationPrefix, val.Bytes()...), encodeBlockNumber(number)...)
+}
+
+// attestationVoteKey = attestationVotePrefix + num (uint64 big endian) + address
+func attestationVoteKey(number uint64, val common.Address) []byte {
+	return append(append(attestationVotePrefix, encodeBlockNumber(number)...), val.Bytes()...)
+}
+
+// voteSpanKey = voteSpanPrefix + address
+func voteSpanKey(val common.Address) []byte {
+	return append(voteSpanPrefix, val.Bytes()...)
+}
+
 // txLookupKey = txLookupPrefix + hash
 func txLookupKey(hash common.Hash) []byte {
 	return append(txLookupPrefix, hash.Bytes()...)
@@ -216,6 +259,21 @@ func bloomBitsKey(bit uint, section uint64, hash common.Hash) []byte {
 	return key
 }
 
+// validatorPerformanceKey = validatorPerformancePrefix + section (uint64 big endian) + hash
+func validatorPerformanceKey(section uint64, hash common.Hash) []byte {
+	return append(append(validatorPerformancePrefix, encodeBlockNumber(section)...), hash.Bytes()...)
+}
+
+// validatorSetChangeKey = validatorSetChangePrefix + epoch (uint64 big endian) + hash
+func validatorSetChangeKey(epoch uint64, hash common.Hash) []byte {
+	return append(append(validatorSetChangePrefix, encodeBlockNumber(epoch)...), hash.Bytes()...)
+}
+
+// punishmentHistoryKey = punishmentHistoryPrefix + section (uint64 big endian) + hash
+func punishmentHistoryKey(section uint64, hash common.Hash) []byte {
+	return append(append(punishmentHistoryPrefix, encodeBlockNumber(section)...), hash.Bytes()...)
+}
+
 // preimageKey = PreimagePrefix + hash
 func preimageKey(hash common.Hash) []byte {
 	return append(PreimagePrefix, hash.Bytes()...)
@@ -402,8 +402,13 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 		effectiveTip = cmath.BigMin(st.gasTipCap, new(big.Int).Sub(st.gasFeeCap, st.evm.Context.BaseFee))
 	}
 	tip := new(big.Int).Mul(new(big.Int).SetUint64(st.gasUsed()), effectiveTip)
-	if st.evm.ChainConfig().Democracy != nil {
+	if democracy := st.evm.ChainConfig().Democracy; democracy != nil {
 		st.state.AddBalance(consensus.FeeRecoder, tip)
+
+		// Under a fee policy the base fee is collected to be split along with the tip
+		if london && democracy.IsFeePolicy(st.evm.Context.BlockNumber) {
+			st.state.AddBalance(consensus.FeeRecoder, new(big.Int).Mul(new(big.Int).SetUint64(st.gasUsed()), st.evm.Context.BaseFee))
+		}
 	} else {
 		st.state.AddBalance(st.evm.Context.Coinbase, tip)
 	}
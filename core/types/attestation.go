@@ -116,6 +116,23 @@ func AttestationData(source *RangeEdge, target *RangeEdge) []byte {
 	return data
 }
 
+// ParseAttestationData decodes the source and target edges from the data signed by an
+// attestation, as produced by AttestationData.
+func ParseAttestationData(data []byte) (*RangeEdge, *RangeEdge, error) {
+	if len(data) != 4*common.HashLength {
+		return nil, nil, errors.New("invalid attestation data length")
+	}
+	source := &RangeEdge{
+		Hash:   common.BytesToHash(data[:common.HashLength]),
+		Number: new(big.Int).SetBytes(data[common.HashLength*2 : common.HashLength*3]),
+	}
+	target := &RangeEdge{
+		Hash:   common.BytesToHash(data[common.HashLength : common.HashLength*2]),
+		Number: new(big.Int).SetBytes(data[common.HashLength*3:]),
+	}
+	return source, target, nil
+}
+
 type Signature struct {
 	R *big.Int
 	S *big.Int
@@ -191,9 +208,10 @@ func (p AttestationsList) Less(i, j int) bool {
 func (p AttestationsList) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
 
 const (
-	PunishNone      = 0
-	PunishMultiSig  = 1
-	PunishInclusive = 2
+	PunishNone       = 0
+	PunishMultiSig   = 1
+	PunishInclusive  = 2
+	PunishDoubleSeal = 3
 )
 
 type EpochCheckBps struct {
@@ -284,6 +302,25 @@ func (cf CasperFFGHistoryList) Less(i, j int) bool {
 }
 func (cf CasperFFGHistoryList) Swap(i, j int) { cf[i], cf[j] = cf[j], cf[i] }
 
+// VoteSpan summarizes the votes given by a validator for the blocks up to the last finalized
+// one, whose records are pruned from the vote index. Any later vote with a source lower than
+// MaxSource surrounds one of them.
+type VoteSpan struct {
+	MaxSource uint64 // Highest source number of the pruned votes
+	MaxTarget uint64 // Highest target number of the pruned votes
+}
+
+// VoteRecord is the entry of the vote index of a validator for a target number, until the
+// target is finalized. The vote itself isn't duplicated, it's kept by the attestations of its
+// target block, or by the aggregate stored for its (source,target) pair.
+type VoteRecord struct {
+	Source     uint64      // Source number of the vote
+	Target     uint64      // Target number of the vote
+	TargetHash common.Hash // Hash of the target block of the vote
+	SignHash   common.Hash // Hash of the attestation data of the vote
+	Aggregate  bool        // Whether the vote is part of a BLS aggregate
+}
+
 const (
 	AttestationPending = uint8(0)
 	AttestationStart   = uint8(1)
@@ -294,3 +331,82 @@ type StatusBlockInfo struct {
 	Number hexutil.Uint64
 	Hash   common.Hash
 }
+
+// FinalityCertificate is the aggregated set of attestations for a single (source,target)
+// pair that reached the justification threshold. It is embedded in the header extra-data
+// so that the finality of a block can be verified from the chain itself.
+type FinalityCertificate struct {
+	SourceRangeEdge *RangeEdge
+	TargetRangeEdge *RangeEdge
+	Signatures      []*CertificateSignature
+}
+
+// CertificateSignature is the signature of a single validator in a finality certificate.
+type CertificateSignature struct {
+	R *big.Int
+	S *big.Int
+	V uint8
+}
+
+// NewFinalityCertificate aggregates the given attestations into a certificate. All the
+// attestations must share the same source and target.
+func NewFinalityCertificate(attestations []*Attestation) (*FinalityCertificate, error) {
+	if len(attestations) == 0 {
+		return nil, errors.New("empty attestations")
+	}
+	first := attestations[0]
+	cert := &FinalityCertificate{
+		SourceRangeEdge: &RangeEdge{Hash: first.SourceRangeEdge.Hash, Number: new(big.Int).Set(first.SourceRangeEdge.Number)},
+		TargetRangeEdge: &RangeEdge{Hash: first.TargetRangeEdge.Hash, Number: new(big.Int).Set(first.TargetRangeEdge.Number)},
+		Signatures:      make([]*CertificateSignature, 0, len(attestations)),
+	}
+	signHash := first.SignHash()
+	for _, a := range attestations {
+		if a.SignHash() != signHash {
+			return nil, errors.New("attestations with different range edges")
+		}
+		cert.Signatures = append(cert.Signatures, &CertificateSignature{
+			R: new(big.Int).Set(a.R),
+			S: new(big.Int).Set(a.S),
+			V: a.V,
+		})
+	}
+	return cert, nil
+}
+
+// Attestations expands the certificate into the individual attestations it aggregates.
+func (fc *FinalityCertificate) Attestations() []*Attestation {
+	attestations := make([]*Attestation, 0, len(fc.Signatures))
+	for _, sig := range fc.Signatures {
+		attestations = append(attestations, &Attestation{
+			SourceRangeEdge: &RangeEdge{Hash: fc.SourceRangeEdge.Hash, Number: new(big.Int).Set(fc.SourceRangeEdge.Number)},
+			TargetRangeEdge: &RangeEdge{Hash: fc.TargetRangeEdge.Hash, Number: new(big.Int).Set(fc.TargetRangeEdge.Number)},
+			R:               sig.R,
+			S:               sig.S,
+			V:               sig.V,
+		})
+	}
+	return attestations
+}
+
+// RecoverSigners recovers the signers of the certificate in the order of its signatures,
+// failing if any signature is invalid or any validator signed more than once.
+func (fc *FinalityCertificate) RecoverSigners() ([]common.Address, error) {
+	if fc.SourceRangeEdge == nil || fc.TargetRangeEdge == nil {
+		return nil, errors.New("invalid finality certificate")
+	}
+	signers := make([]common.Address, 0, len(fc.Signatures))
+	seen := make(map[common.Address]struct{}, len(fc.Signatures))
+	for _, a := range fc.Attestations() {
+		signer, err := a.RecoverSigner()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[signer]; ok {
+			return nil, errors.New("duplicated signer in finality certificate")
+		}
+		seen[signer] = struct{}{}
+		signers = append(signers, signer)
+	}
+	return signers, nil
+}
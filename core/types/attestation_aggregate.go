@@ -0,0 +1,209 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"math/bits"
+	"sync/atomic"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+	"github.com/QEasyWeb3/QEasyChain/crypto/bls"
+)
+
+var (
+	errInvalidAggregateAttestation  = errors.New("invalid aggregate attestation")
+	errMismatchingAggregateEdges    = errors.New("aggregate attestations with different source or target")
+	errOverlappingAggregateSigners  = errors.New("aggregate attestations with overlapping signers")
+	errInvalidAggregateVoteEvidence = errors.New("invalid aggregate vote evidence")
+)
+
+// AggregateAttestation represents the BLS attestations of a set of validators on the
+// same (source,target) pair, folded into a single signature. The signers are tracked
+// by a bitfield indexed by their position in the sorted validator set of the target.
+type AggregateAttestation struct {
+	SourceRangeEdge *RangeEdge
+	TargetRangeEdge *RangeEdge
+	Signers         []byte // Bitfield of the validators that contributed to the signature
+	Signature       []byte // Aggregate BLS signature of the attestation data
+
+	// caches
+	hash atomic.Value
+}
+
+// NewAggregateAttestation creates an aggregate attestation holding the single signature
+// of the validator at the given index of a validator set of the given size.
+func NewAggregateAttestation(source *RangeEdge, target *RangeEdge, index int, validators int, sig []byte) (*AggregateAttestation, error) {
+	if len(sig) != bls.SignatureLength || index < 0 || index >= validators {
+		return nil, errInvalidAggregateAttestation
+	}
+	signers := make([]byte, (validators+7)/8)
+	signers[index/8] |= 1 << (uint(index) % 8)
+	return &AggregateAttestation{
+		SourceRangeEdge: &RangeEdge{source.Hash, source.Number},
+		TargetRangeEdge: &RangeEdge{target.Hash, target.Number},
+		Signers:         signers,
+		Signature:       common.CopyBytes(sig),
+	}, nil
+}
+
+func (a *AggregateAttestation) DeepCopy() *AggregateAttestation {
+	return &AggregateAttestation{
+		SourceRangeEdge: &RangeEdge{Hash: a.SourceRangeEdge.Hash, Number: new(big.Int).Set(a.SourceRangeEdge.Number)},
+		TargetRangeEdge: &RangeEdge{Hash: a.TargetRangeEdge.Hash, Number: new(big.Int).Set(a.TargetRangeEdge.Number)},
+		Signers:         common.CopyBytes(a.Signers),
+		Signature:       common.CopyBytes(a.Signature),
+	}
+}
+
+// Hash returns the hash of the aggregate attestation
+func (a *AggregateAttestation) Hash() common.Hash {
+	if hash := a.hash.Load(); hash != nil {
+		return hash.(common.Hash)
+	}
+
+	h := rlpHash(a)
+	a.hash.Store(h)
+	return h
+}
+
+// SignHash returns the hash of the attestation data, which identifies the (source,target) pair
+func (a *AggregateAttestation) SignHash() common.Hash {
+	return AttestationSignHash(a.SourceRangeEdge, a.TargetRangeEdge)
+}
+
+// SanityCheck makes a sanity check of the aggregate attestation
+func (a *AggregateAttestation) SanityCheck() error {
+	if a == nil || a.SourceRangeEdge == nil || a.SourceRangeEdge.Number == nil ||
+		(a.SourceRangeEdge.Hash == common.Hash{} && a.SourceRangeEdge.Number.Uint64() != 0) ||
+		a.TargetRangeEdge == nil || a.TargetRangeEdge.Number == nil || (a.TargetRangeEdge.Hash == common.Hash{}) ||
+		a.SourceRangeEdge.Number.Uint64() >= a.TargetRangeEdge.Number.Uint64() ||
+		len(a.Signature) != bls.SignatureLength || a.Count() == 0 {
+		return errInvalidAggregateAttestation
+	}
+	return nil
+}
+
+// Count returns the number of signers in the aggregate
+func (a *AggregateAttestation) Count() int {
+	count := 0
+	for _, b := range a.Signers {
+		count += bits.OnesCount8(b)
+	}
+	return count
+}
+
+// HasSigner reports whether the validator at the given index contributed to the aggregate
+func (a *AggregateAttestation) HasSigner(index int) bool {
+	if index < 0 || index/8 >= len(a.Signers) {
+		return false
+	}
+	return a.Signers[index/8]&(1<<(uint(index)%8)) != 0
+}
+
+// SignerIndexes returns the indexes of all the validators that contributed to the aggregate
+func (a *AggregateAttestation) SignerIndexes() []int {
+	var indexes []int
+	for i := 0; i < len(a.Signers)*8; i++ {
+		if a.HasSigner(i) {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// Merge folds two aggregate attestations of the same (source,target) pair with disjoint
+// signers into a new one. BLS signatures can't be subtracted, so overlapping aggregates
+// can't be merged without counting a signer twice.
+func (a *AggregateAttestation) Merge(other *AggregateAttestation) (*AggregateAttestation, error) {
+	if a.SignHash() != other.SignHash() {
+		return nil, errMismatchingAggregateEdges
+	}
+	signers := make([]byte, len(a.Signers))
+	copy(signers, a.Signers)
+	if len(other.Signers) > len(signers) {
+		signers = append(signers, make([]byte, len(other.Signers)-len(signers))...)
+	}
+	for i, b := range other.Signers {
+		if signers[i]&b != 0 {
+			return nil, errOverlappingAggregateSigners
+		}
+		signers[i] |= b
+	}
+	sig1, err := bls.SignatureFromBytes(a.Signature)
+	if err != nil {
+		return nil, err
+	}
+	sig2, err := bls.SignatureFromBytes(other.Signature)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := bls.AggregateSignatures([]*bls.Signature{sig1, sig2})
+	if err != nil {
+		return nil, err
+	}
+	merged := a.DeepCopy()
+	merged.Signers = signers
+	merged.Signature = sig.Bytes()
+	return merged, nil
+}
+
+// AggregateVoteEvidence proves that a validator signed two aggregate attestations breaking the
+// CasperFFG rules together. The aggregates are kept ordered by sign hash, so that the same pair
+// of votes always encodes to the same evidence.
+type AggregateVoteEvidence struct {
+	Defendant common.Address
+	Before    *AggregateAttestation
+	After     *AggregateAttestation
+
+	// caches
+	hash atomic.Value
+}
+
+// NewAggregateVoteEvidence creates the evidence of two conflicting aggregate attestations
+// both signed by the defendant.
+func NewAggregateVoteEvidence(defendant common.Address, a, b *AggregateAttestation) *AggregateVoteEvidence {
+	a, b = a.DeepCopy(), b.DeepCopy()
+	if bytes.Compare(a.SignHash().Bytes(), b.SignHash().Bytes()) > 0 {
+		a, b = b, a
+	}
+	return &AggregateVoteEvidence{Defendant: defendant, Before: a, After: b}
+}
+
+// Hash returns the punish hash of the evidence. It only depends on the defendant and the
+// attestation data of both votes, not on the other signers of the aggregates.
+func (e *AggregateVoteEvidence) Hash() common.Hash {
+	if hash := e.hash.Load(); hash != nil {
+		return hash.(common.Hash)
+	}
+	h := crypto.Keccak256Hash([]byte("aggregateVote"), e.Defendant.Bytes(), e.Before.SignHash().Bytes(), e.After.SignHash().Bytes())
+	e.hash.Store(h)
+	return h
+}
+
+// Number returns the highest target number of both votes.
+func (e *AggregateVoteEvidence) Number() uint64 {
+	before, after := e.Before.TargetRangeEdge.Number.Uint64(), e.After.TargetRangeEdge.Number.Uint64()
+	if before > after {
+		return before
+	}
+	return after
+}
+
+// SanityCheck makes a sanity check of the evidence, without verifying the signatures
+func (e *AggregateVoteEvidence) SanityCheck() error {
+	if e == nil || e.Before.SanityCheck() != nil || e.After.SanityCheck() != nil ||
+		bytes.Compare(e.Before.SignHash().Bytes(), e.After.SignHash().Bytes()) >= 0 {
+		return errInvalidAggregateVoteEvidence
+	}
+	return nil
+}
+
+type AggregateVoteEvidenceList []*AggregateVoteEvidence
+
+func (l AggregateVoteEvidenceList) Len() int { return len(l) }
+func (l AggregateVoteEvidenceList) Less(i, j int) bool {
+	return l[i].Number() < l[j].Number()
+}
+func (l AggregateVoteEvidenceList) Swap(i, j int) { l[i], l[j] = l[j], l[i] }
@@ -3,6 +3,8 @@ package types
 import (
 	"github.com/QEasyWeb3/QEasyChain/common"
 	"github.com/QEasyWeb3/QEasyChain/crypto"
+	"github.com/QEasyWeb3/QEasyChain/crypto/bls"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
 	"github.com/stretchr/testify/require"
 	"math/big"
 	"testing"
@@ -111,3 +113,123 @@ func TestAttestation_Hash(t *testing.T) {
 	require.True(t, a.TargetRangeEdge.Number.Uint64() == a.DeepCopy().TargetRangeEdge.Number.Uint64())
 	require.True(t, a.DeepCopy().SignHash() == a.SignHash())
 }
+
+func TestFinalityCertificate_RecoverSigners(t *testing.T) {
+	source := &RangeEdge{Hash: common.BytesToHash([]byte{0x01}), Number: big.NewInt(1)}
+	target := &RangeEdge{Hash: common.BytesToHash([]byte{0x02}), Number: big.NewInt(2)}
+
+	var (
+		attestations []*Attestation
+		signers      []common.Address
+	)
+	for i := 0; i < 3; i++ {
+		priv, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		sig, err := crypto.Sign(crypto.Keccak256(AttestationData(source, target)), priv)
+		require.NoError(t, err)
+		attestations = append(attestations, NewAttestation(source, target, sig))
+		signers = append(signers, crypto.PubkeyToAddress(priv.PublicKey))
+	}
+	cert, err := NewFinalityCertificate(attestations)
+	require.NoError(t, err)
+
+	// The certificate must survive a rlp round trip
+	enc, err := rlp.EncodeToBytes(cert)
+	require.NoError(t, err)
+	var dec FinalityCertificate
+	require.NoError(t, rlp.DecodeBytes(enc, &dec))
+
+	recovered, err := dec.RecoverSigners()
+	require.NoError(t, err)
+	require.Equal(t, signers, recovered)
+
+	// Duplicated signers are rejected
+	dec.Signatures = append(dec.Signatures, dec.Signatures[0])
+	_, err = dec.RecoverSigners()
+	require.Error(t, err)
+
+	// Attestations on different range edges can't be aggregated
+	other := attestations[0].DeepCopy()
+	other.TargetRangeEdge.Number = big.NewInt(3)
+	_, err = NewFinalityCertificate(append(attestations, other))
+	require.Error(t, err)
+}
+
+func TestAggregateAttestation_Merge(t *testing.T) {
+	source := &RangeEdge{Hash: common.HexToHash("0x01"), Number: big.NewInt(1)}
+	target := &RangeEdge{Hash: common.HexToHash("0x02"), Number: big.NewInt(2)}
+	data := AttestationData(source, target)
+
+	var (
+		pks  []*bls.PublicKey
+		aggs []*AggregateAttestation
+	)
+	for i := 0; i < 3; i++ {
+		sk, err := bls.GenerateKey()
+		require.NoError(t, err)
+		pks = append(pks, sk.PublicKey())
+		agg, err := NewAggregateAttestation(source, target, i, 10, sk.Sign(data).Bytes())
+		require.NoError(t, err)
+		aggs = append(aggs, agg)
+	}
+	_, err := NewAggregateAttestation(source, target, 0, 10, aggs[0].Signature[1:])
+	require.Error(t, err, "a truncated signature must be rejected")
+	_, err = NewAggregateAttestation(source, target, 10, 10, aggs[0].Signature)
+	require.Error(t, err, "a signer out of the validator set must be rejected")
+
+	require.NoError(t, aggs[0].SanityCheck())
+	require.Len(t, aggs[0].Signers, 2)
+
+	merged, err := aggs[0].Merge(aggs[2])
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 2}, merged.SignerIndexes())
+	require.Equal(t, 1, aggs[0].Count(), "merge must not modify the receiver")
+
+	sig, err := bls.SignatureFromBytes(merged.Signature)
+	require.NoError(t, err)
+	require.True(t, bls.FastAggregateVerify([]*bls.PublicKey{pks[0], pks[2]}, data, sig))
+
+	// Overlapping signers can't be merged
+	_, err = merged.Merge(aggs[0])
+	require.Error(t, err)
+
+	// Neither can aggregates of different targets
+	other, err := NewAggregateAttestation(source, &RangeEdge{Hash: common.HexToHash("0x03"), Number: big.NewInt(2)}, 1, 10, aggs[1].Signature)
+	require.NoError(t, err)
+	_, err = merged.Merge(other)
+	require.Error(t, err)
+
+	// The aggregate must survive an rlp round trip
+	enc, err := rlp.EncodeToBytes(merged)
+	require.NoError(t, err)
+	var dec AggregateAttestation
+	require.NoError(t, rlp.DecodeBytes(enc, &dec))
+	require.Equal(t, merged.Hash(), dec.Hash())
+	require.Equal(t, 2, dec.Count())
+}
+
+func TestParseAttestationData(t *testing.T) {
+	source := &RangeEdge{Hash: common.HexToHash("0x01"), Number: big.NewInt(10)}
+	target := &RangeEdge{Hash: common.HexToHash("0x02"), Number: big.NewInt(20)}
+
+	s, tg, err := ParseAttestationData(AttestationData(source, target))
+	require.NoError(t, err)
+	require.Equal(t, source, s)
+	require.Equal(t, target, tg)
+
+	_, _, err = ParseAttestationData(make([]byte, 10))
+	require.Error(t, err)
+}
+
+func TestParseValidatorRecordData(t *testing.T) {
+	validator := common.HexToAddress("0x01")
+	node := common.HexToHash("0x02")
+
+	v, n, err := ParseValidatorRecordData(ValidatorRecordData(validator, node))
+	require.NoError(t, err)
+	require.Equal(t, validator, v)
+	require.Equal(t, node, n)
+
+	_, _, err = ParseValidatorRecordData(AttestationData(&RangeEdge{Number: big.NewInt(1)}, &RangeEdge{Number: big.NewInt(2)}))
+	require.Error(t, err)
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"sync/atomic"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+)
+
+var errInvalidDoubleSealEvidence = errors.New("invalid double seal evidence")
+
+// DoubleSealEvidence proves that a validator sealed two different headers at the
+// same height. The headers are kept ordered by hash, so that the same pair of
+// headers always encodes to the same evidence.
+type DoubleSealEvidence struct {
+	HeaderA *Header
+	HeaderB *Header
+
+	// caches
+	hash atomic.Value
+}
+
+// NewDoubleSealEvidence creates the evidence of two conflicting sealed headers.
+func NewDoubleSealEvidence(a, b *Header) *DoubleSealEvidence {
+	a, b = CopyHeader(a), CopyHeader(b)
+	if bytes.Compare(a.Hash().Bytes(), b.Hash().Bytes()) > 0 {
+		a, b = b, a
+	}
+	return &DoubleSealEvidence{HeaderA: a, HeaderB: b}
+}
+
+// Hash returns the punish hash of the evidence. It only depends on the offending
+// validator and the height, so that a validator can't be punished twice for
+// sealing more than two headers at the same height.
+func (e *DoubleSealEvidence) Hash() common.Hash {
+	if hash := e.hash.Load(); hash != nil {
+		return hash.(common.Hash)
+	}
+	h := crypto.Keccak256Hash([]byte("doubleSeal"), e.HeaderA.Coinbase.Bytes(), common.BigToHash(e.HeaderA.Number).Bytes())
+	e.hash.Store(h)
+	return h
+}
+
+// Number returns the height both headers were sealed at.
+func (e *DoubleSealEvidence) Number() uint64 {
+	return e.HeaderA.Number.Uint64()
+}
+
+// SanityCheck makes a sanity check of the evidence, without verifying the seals.
+// Two seals of the same header content aren't a double seal, so the headers have
+// to differ once their seals are stripped.
+func (e *DoubleSealEvidence) SanityCheck() error {
+	if e == nil || e.HeaderA == nil || e.HeaderB == nil || e.HeaderA.Number == nil || e.HeaderB.Number == nil ||
+		e.HeaderA.Number.Sign() <= 0 || e.HeaderA.Number.Cmp(e.HeaderB.Number) != 0 ||
+		e.HeaderA.Coinbase != e.HeaderB.Coinbase ||
+		len(e.HeaderA.Extra) < crypto.SignatureLength || len(e.HeaderB.Extra) < crypto.SignatureLength ||
+		bytes.Compare(e.HeaderA.Hash().Bytes(), e.HeaderB.Hash().Bytes()) >= 0 ||
+		unsealedHash(e.HeaderA) == unsealedHash(e.HeaderB) {
+		return errInvalidDoubleSealEvidence
+	}
+	return nil
+}
+
+// unsealedHash returns the hash of a header without the seal at the end of its extra-data.
+func unsealedHash(h *Header) common.Hash {
+	cpy := CopyHeader(h)
+	cpy.Extra = cpy.Extra[:len(cpy.Extra)-crypto.SignatureLength]
+	return cpy.Hash()
+}
+
+type DoubleSealEvidenceList []*DoubleSealEvidence
+
+func (l DoubleSealEvidenceList) Len() int { return len(l) }
+func (l DoubleSealEvidenceList) Less(i, j int) bool {
+	return l[i].Number() < l[j].Number()
+}
+func (l DoubleSealEvidenceList) Swap(i, j int) { l[i], l[j] = l[j], l[i] }
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+)
+
+// sealedExtra returns an extra-data made of the content followed by a dummy seal.
+func sealedExtra(content string, seal byte) []byte {
+	return append([]byte(content), bytes.Repeat([]byte{seal}, crypto.SignatureLength)...)
+}
+
+func TestDoubleSealEvidence(t *testing.T) {
+	var (
+		coinbase = common.HexToAddress("0x1")
+		a        = &Header{Number: big.NewInt(10), Coinbase: coinbase, Difficulty: big.NewInt(2), Extra: sealedExtra("a", 1)}
+		b        = &Header{Number: big.NewInt(10), Coinbase: coinbase, Difficulty: big.NewInt(2), Extra: sealedExtra("b", 1)}
+	)
+	e1, e2 := NewDoubleSealEvidence(a, b), NewDoubleSealEvidence(b, a)
+	if err := e1.SanityCheck(); err != nil {
+		t.Fatalf("valid evidence rejected: %v", err)
+	}
+	if e1.HeaderA.Hash() != e2.HeaderA.Hash() || e1.Hash() != e2.Hash() {
+		t.Fatal("evidence depends on the order of the headers")
+	}
+	// The punish hash only depends on the validator and the height
+	c := &Header{Number: big.NewInt(10), Coinbase: coinbase, Difficulty: big.NewInt(2), Extra: sealedExtra("c", 1)}
+	if NewDoubleSealEvidence(a, c).Hash() != e1.Hash() {
+		t.Fatal("punish hash differs for the same validator and height")
+	}
+	blob, err := rlp.EncodeToBytes(e1)
+	if err != nil {
+		t.Fatalf("failed to encode evidence: %v", err)
+	}
+	var dec DoubleSealEvidence
+	if err := rlp.DecodeBytes(blob, &dec); err != nil {
+		t.Fatalf("failed to decode evidence: %v", err)
+	}
+	if dec.Hash() != e1.Hash() || dec.HeaderB.Hash() != e1.HeaderB.Hash() {
+		t.Fatal("evidence mismatch after round trip")
+	}
+	if err := NewDoubleSealEvidence(a, a).SanityCheck(); err == nil {
+		t.Fatal("evidence of the same header accepted")
+	}
+	resealed := CopyHeader(a)
+	resealed.Extra = sealedExtra("a", 2)
+	if err := NewDoubleSealEvidence(a, resealed).SanityCheck(); err == nil {
+		t.Fatal("evidence of two seals of the same header accepted")
+	}
+	other := &Header{Number: big.NewInt(11), Coinbase: coinbase, Difficulty: big.NewInt(2), Extra: sealedExtra("a", 1)}
+	if err := NewDoubleSealEvidence(a, other).SanityCheck(); err == nil {
+		t.Fatal("evidence of different heights accepted")
+	}
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+)
+
+// validatorRecordPrefix separates the validator record signatures from any other data
+// signed by the consensus keys.
+var validatorRecordPrefix = []byte("democracy-validator-record")
+
+// ValidatorRecordData returns the data signed by a validator to advertise the node with
+// the given id as its own in the node record.
+func ValidatorRecordData(validator common.Address, node common.Hash) []byte {
+	data := make([]byte, 0, len(validatorRecordPrefix)+common.AddressLength+common.HashLength)
+	data = append(data, validatorRecordPrefix...)
+	data = append(data, validator.Bytes()...)
+	return append(data, node.Bytes()...)
+}
+
+// ValidatorRecordSignHash returns the hash signed by a validator to advertise a node.
+func ValidatorRecordSignHash(validator common.Address, node common.Hash) common.Hash {
+	return crypto.Keccak256Hash(ValidatorRecordData(validator, node))
+}
+
+// ParseValidatorRecordData decodes the validator and the node id from the data signed
+// by a validator record, as produced by ValidatorRecordData.
+func ParseValidatorRecordData(data []byte) (common.Address, common.Hash, error) {
+	if len(data) != len(validatorRecordPrefix)+common.AddressLength+common.HashLength ||
+		!bytes.HasPrefix(data, validatorRecordPrefix) {
+		return common.Address{}, common.Hash{}, errors.New("invalid validator record data")
+	}
+	data = data[len(validatorRecordPrefix):]
+	return common.BytesToAddress(data[:common.AddressLength]), common.BytesToHash(data[common.AddressLength:]), nil
+}
@@ -0,0 +1,291 @@
+// Package bls implements BLS signatures over the BLS12-381 curve, with public keys
+// in G1 and signatures in G2, as used by the democracy engine for aggregate attestations.
+//
+// Messages are hashed to G2 with the BLS12381G2_XMD:SHA-256_SSWU_RO_ suite of RFC 9380,
+// under the domain separation tags of the proof of possession scheme of the IETF BLS
+// signature draft. Rogue public key attacks are prevented
+// by requiring a proof of possession for every registered public key, so signatures
+// over the same message can be verified with a single pairing check.
+package bls
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/QEasyWeb3/QEasyChain/crypto/bls12381"
+)
+
+const (
+	// SecretKeyLength is the length of a serialized secret key.
+	SecretKeyLength = 32
+
+	// PublicKeyLength is the length of a serialized (uncompressed G1) public key.
+	PublicKeyLength = 96
+
+	// SignatureLength is the length of a serialized (uncompressed G2) signature.
+	SignatureLength = 192
+)
+
+var (
+	// signatureDST is the domain separation tag for regular signatures.
+	signatureDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+	// possessionDST is the domain separation tag for proofs of possession.
+	possessionDST = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+)
+
+var (
+	errInvalidSecretKey = errors.New("bls: invalid secret key")
+	errInvalidPublicKey = errors.New("bls: invalid public key")
+	errInvalidSignature = errors.New("bls: invalid signature")
+	errNoSignatures     = errors.New("bls: no signatures to aggregate")
+)
+
+// SecretKey is a BLS secret key.
+type SecretKey struct {
+	x *big.Int
+}
+
+// PublicKey is a BLS public key, a point in G1.
+type PublicKey struct {
+	p *bls12381.PointG1
+}
+
+// Signature is a BLS signature, a point in G2.
+type Signature struct {
+	p *bls12381.PointG2
+}
+
+// GenerateKey creates a new random secret key.
+func GenerateKey() (*SecretKey, error) {
+	return generateKey(rand.Reader)
+}
+
+func generateKey(r io.Reader) (*SecretKey, error) {
+	order := bls12381.NewG1().Q()
+	for {
+		x, err := rand.Int(r, order)
+		if err != nil {
+			return nil, err
+		}
+		if x.Sign() > 0 {
+			return &SecretKey{x: x}, nil
+		}
+	}
+}
+
+// SecretKeyFromBytes decodes a big-endian serialized secret key.
+func SecretKeyFromBytes(b []byte) (*SecretKey, error) {
+	if len(b) != SecretKeyLength {
+		return nil, errInvalidSecretKey
+	}
+	x := new(big.Int).SetBytes(b)
+	if x.Sign() == 0 || x.Cmp(bls12381.NewG1().Q()) >= 0 {
+		return nil, errInvalidSecretKey
+	}
+	return &SecretKey{x: x}, nil
+}
+
+// Bytes returns the big-endian serialized secret key.
+func (sk *SecretKey) Bytes() []byte {
+	out := make([]byte, SecretKeyLength)
+	sk.x.FillBytes(out)
+	return out
+}
+
+// PublicKey derives the public key of the secret key.
+func (sk *SecretKey) PublicKey() *PublicKey {
+	g1 := bls12381.NewG1()
+	return &PublicKey{p: g1.MulScalar(g1.New(), g1.One(), sk.x)}
+}
+
+// Sign signs the message with the secret key.
+func (sk *SecretKey) Sign(msg []byte) *Signature {
+	return sk.sign(msg, signatureDST)
+}
+
+// ProvePossession creates a proof of possession of the secret key, which has to be
+// presented together with the public key when it's registered.
+func (sk *SecretKey) ProvePossession() *Signature {
+	return sk.sign(sk.PublicKey().Bytes(), possessionDST)
+}
+
+func (sk *SecretKey) sign(msg, dst []byte) *Signature {
+	g2 := bls12381.NewG2()
+	h := hashToG2(msg, dst)
+	return &Signature{p: g2.MulScalar(g2.New(), h, sk.x)}
+}
+
+// PublicKeyFromBytes decodes a serialized public key, rejecting the point at infinity
+// and points outside of the prime order subgroup.
+func PublicKeyFromBytes(b []byte) (*PublicKey, error) {
+	g1 := bls12381.NewG1()
+	p, err := g1.FromBytes(b)
+	if err != nil {
+		return nil, errInvalidPublicKey
+	}
+	if g1.IsZero(p) || !g1.InCorrectSubgroup(p) {
+		return nil, errInvalidPublicKey
+	}
+	return &PublicKey{p: p}, nil
+}
+
+// Bytes returns the uncompressed serialization of the public key.
+func (pk *PublicKey) Bytes() []byte {
+	return bls12381.NewG1().ToBytes(pk.p)
+}
+
+// Verify checks the signature of the message against the public key.
+func (pk *PublicKey) Verify(msg []byte, sig *Signature) bool {
+	return verify(pk.p, msg, signatureDST, sig)
+}
+
+// VerifyPossession checks a proof of possession of the public key.
+func (pk *PublicKey) VerifyPossession(proof *Signature) bool {
+	return verify(pk.p, pk.Bytes(), possessionDST, proof)
+}
+
+// SignatureFromBytes decodes a serialized signature, rejecting points outside of
+// the prime order subgroup.
+func SignatureFromBytes(b []byte) (*Signature, error) {
+	g2 := bls12381.NewG2()
+	p, err := g2.FromBytes(b)
+	if err != nil {
+		return nil, errInvalidSignature
+	}
+	if !g2.InCorrectSubgroup(p) {
+		return nil, errInvalidSignature
+	}
+	return &Signature{p: p}, nil
+}
+
+// Bytes returns the uncompressed serialization of the signature.
+func (sig *Signature) Bytes() []byte {
+	return bls12381.NewG2().ToBytes(sig.p)
+}
+
+// AggregateSignatures combines a set of signatures into a single one.
+func AggregateSignatures(sigs []*Signature) (*Signature, error) {
+	if len(sigs) == 0 {
+		return nil, errNoSignatures
+	}
+	g2 := bls12381.NewG2()
+	agg := g2.Zero()
+	for _, sig := range sigs {
+		g2.Add(agg, agg, sig.p)
+	}
+	return &Signature{p: agg}, nil
+}
+
+// AggregatePublicKeys combines a set of public keys into a single one.
+func AggregatePublicKeys(pks []*PublicKey) (*PublicKey, error) {
+	if len(pks) == 0 {
+		return nil, errInvalidPublicKey
+	}
+	g1 := bls12381.NewG1()
+	agg := g1.Zero()
+	for _, pk := range pks {
+		g1.Add(agg, agg, pk.p)
+	}
+	return &PublicKey{p: agg}, nil
+}
+
+// FastAggregateVerify checks an aggregate signature of the same message signed by
+// all the given public keys. The public keys must have been checked for a proof of
+// possession beforehand.
+func FastAggregateVerify(pks []*PublicKey, msg []byte, sig *Signature) bool {
+	agg, err := AggregatePublicKeys(pks)
+	if err != nil {
+		return false
+	}
+	return agg.Verify(msg, sig)
+}
+
+// verify checks e(pk, H(msg)) == e(g1, sig).
+func verify(pk *bls12381.PointG1, msg, dst []byte, sig *Signature) bool {
+	g1 := bls12381.NewG1()
+	engine := bls12381.NewPairingEngine()
+	engine.AddPair(pk, hashToG2(msg, dst))
+	engine.AddPairInv(g1.One(), sig.p)
+	return engine.Check()
+}
+
+// hashToG2 maps a message onto a G2 point following the BLS12381G2_XMD:SHA-256_SSWU_RO_
+// suite of RFC 9380. The message is expanded into two fp2 elements, each mapped to the
+// curve separately and added up, so that the result is indifferentiable from a random
+// oracle. Clearing the cofactor of both points before adding them up is the same as
+// clearing it once from their sum, as the cofactor multiplication is a homomorphism.
+func hashToG2(msg, dst []byte) *bls12381.PointG2 {
+	g2 := bls12381.NewG2()
+	uniform := expandMessageXMD(msg, dst, 2*2*hashToFieldL)
+	p := g2.Zero()
+	for i := 0; i < 2; i++ {
+		c0 := hashToField(uniform[(2*i)*hashToFieldL : (2*i+1)*hashToFieldL])
+		c1 := hashToField(uniform[(2*i+1)*hashToFieldL : (2*i+2)*hashToFieldL])
+
+		// The fp2 elements are serialized with their imaginary part first
+		q, err := g2.MapToCurve(append(c1, c0...))
+		if err != nil {
+			// Can't happen, every component is reduced modulo the field modulus
+			panic(err)
+		}
+		g2.Add(p, p, q)
+	}
+	return p
+}
+
+// hashToFieldL is the number of uniform bytes reduced into a single fp element, which
+// keeps the bias of the reduction negligible (RFC 9380, section 5).
+const hashToFieldL = 64
+
+// fieldModulus is the modulus of the base field of BLS12-381.
+var fieldModulus, _ = new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+
+// hashToField reduces uniform bytes into a 48 bytes big-endian fp element.
+func hashToField(uniform []byte) []byte {
+	e := new(big.Int).Mod(new(big.Int).SetBytes(uniform), fieldModulus)
+	return e.FillBytes(make([]byte, 48))
+}
+
+// expandMessageXMD derives n uniform bytes from the message and the domain separation
+// tag with SHA-256, as specified by expand_message_xmd of RFC 9380, section 5.3.1.
+func expandMessageXMD(msg, dst []byte, n int) []byte {
+	const (
+		bInBytes = sha256.Size
+		sInBytes = sha256.BlockSize
+	)
+	ell := (n + bInBytes - 1) / bInBytes
+	if ell > 255 || n > 65535 || len(dst) > 255 {
+		// Can't happen, the lengths are fixed by the package
+		panic("bls: invalid expand_message_xmd parameters")
+	}
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	h := sha256.New()
+	h.Write(make([]byte, sInBytes))
+	h.Write(msg)
+	h.Write([]byte{byte(n >> 8), byte(n), 0})
+	h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	// b_1 hashes b_0 itself, which is the xor of b_0 with the zero block
+	var (
+		out = make([]byte, 0, ell*bInBytes)
+		bi  = make([]byte, bInBytes)
+	)
+	for i := 1; i <= ell; i++ {
+		for j := range bi {
+			bi[j] ^= b0[j]
+		}
+		h.Reset()
+		h.Write(bi)
+		h.Write([]byte{byte(i)})
+		h.Write(dstPrime)
+		bi = h.Sum(nil)
+		out = append(out, bi...)
+	}
+	return out[:n]
+}
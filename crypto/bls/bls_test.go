@@ -0,0 +1,140 @@
+package bls
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/crypto/bls12381"
+)
+
+func TestSignVerify(t *testing.T) {
+	sk, err := GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	pk := sk.PublicKey()
+	msg := []byte("attestation")
+
+	sig := sk.Sign(msg)
+	if !pk.Verify(msg, sig) {
+		t.Fatal("valid signature rejected")
+	}
+	if pk.Verify([]byte("other"), sig) {
+		t.Fatal("signature of another message accepted")
+	}
+	other, _ := GenerateKey()
+	if other.PublicKey().Verify(msg, sig) {
+		t.Fatal("signature accepted with the wrong public key")
+	}
+	// A proof of possession must not be usable as a signature and vice versa
+	proof := sk.ProvePossession()
+	if !pk.VerifyPossession(proof) {
+		t.Fatal("valid proof of possession rejected")
+	}
+	if pk.Verify(pk.Bytes(), proof) {
+		t.Fatal("proof of possession accepted as a signature")
+	}
+	if pk.VerifyPossession(sk.Sign(pk.Bytes())) {
+		t.Fatal("signature accepted as a proof of possession")
+	}
+}
+
+func TestSerialization(t *testing.T) {
+	sk, _ := GenerateKey()
+	sk2, err := SecretKeyFromBytes(sk.Bytes())
+	if err != nil {
+		t.Fatalf("failed to decode secret key: %v", err)
+	}
+	if !bytes.Equal(sk.PublicKey().Bytes(), sk2.PublicKey().Bytes()) {
+		t.Fatal("secret key mismatch after round trip")
+	}
+	pkBytes := sk.PublicKey().Bytes()
+	if len(pkBytes) != PublicKeyLength {
+		t.Fatalf("public key length mismatch: have %d, want %d", len(pkBytes), PublicKeyLength)
+	}
+	pk, err := PublicKeyFromBytes(pkBytes)
+	if err != nil {
+		t.Fatalf("failed to decode public key: %v", err)
+	}
+	sigBytes := sk.Sign([]byte("msg")).Bytes()
+	if len(sigBytes) != SignatureLength {
+		t.Fatalf("signature length mismatch: have %d, want %d", len(sigBytes), SignatureLength)
+	}
+	sig, err := SignatureFromBytes(sigBytes)
+	if err != nil {
+		t.Fatalf("failed to decode signature: %v", err)
+	}
+	if !pk.Verify([]byte("msg"), sig) {
+		t.Fatal("decoded signature rejected")
+	}
+	if _, err := PublicKeyFromBytes(make([]byte, PublicKeyLength)); err == nil {
+		t.Fatal("public key at infinity accepted")
+	}
+	if _, err := SecretKeyFromBytes(make([]byte, SecretKeyLength)); err == nil {
+		t.Fatal("zero secret key accepted")
+	}
+}
+
+func TestFastAggregateVerify(t *testing.T) {
+	var (
+		msg  = []byte("attestation")
+		pks  []*PublicKey
+		sigs []*Signature
+	)
+	for i := 0; i < 4; i++ {
+		sk, _ := GenerateKey()
+		pks = append(pks, sk.PublicKey())
+		sigs = append(sigs, sk.Sign(msg))
+	}
+	agg, err := AggregateSignatures(sigs)
+	if err != nil {
+		t.Fatalf("failed to aggregate: %v", err)
+	}
+	if !FastAggregateVerify(pks, msg, agg) {
+		t.Fatal("valid aggregate signature rejected")
+	}
+	if FastAggregateVerify(pks[:3], msg, agg) {
+		t.Fatal("aggregate signature accepted with a missing signer")
+	}
+	partial, _ := AggregateSignatures(sigs[:3])
+	if FastAggregateVerify(pks, msg, partial) {
+		t.Fatal("partial aggregate accepted for all signers")
+	}
+	if _, err := AggregateSignatures(nil); err == nil {
+		t.Fatal("empty aggregate accepted")
+	}
+}
+
+// Tests the message expansion against the expand_message_xmd vectors of RFC 9380,
+// appendix K.1.
+func TestExpandMessageXMD(t *testing.T) {
+	dst := []byte("QUUX-V01-CS02-with-expander-SHA256-128")
+	tests := []struct {
+		msg  string
+		want string
+	}{
+		{"", "68a985b87eb6b46952128911f2a4412bbc302a9d759667f87f7a21d803f07235"},
+		{"abc", "d8ccab23b5985ccea865c6c97b6e5b8350e794e603b4b97902f53a8a0d605615"},
+	}
+	for _, tt := range tests {
+		if have := hex.EncodeToString(expandMessageXMD([]byte(tt.msg), dst, 32)); have != tt.want {
+			t.Errorf("msg %q: expansion mismatch: have %s, want %s", tt.msg, have, tt.want)
+		}
+	}
+}
+
+// Tests the hashing to G2 against the BLS12381G2_XMD:SHA-256_SSWU_RO_ vectors of
+// RFC 9380, appendix J.10.1.
+func TestHashToG2(t *testing.T) {
+	dst := []byte("QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_")
+	want := "05cb8437535e20ecffaef7752baddf98034139c38452458baeefab379ba13dff5bf5dd71b72418717047f5b0f37da03d" +
+		"0141ebfbdca40eb85b87142e130ab689c673cf60f1a3e98d69335266f30d9b8d4ac44c1038e9dcdd5393faf5c41fb78a" +
+		"12424ac32561493f3fe3c260708a12b7c620e7be00099a974e259ddc7d1f6395c3c811cdd19f1e8dbf3e9ecfdcbab8d6" +
+		"0503921d7f6a12805e72940b963c0cf3471c7b2a524950ca195d11062ee75ec076daf2d4bc358c4b190c0c98064fdd92"
+
+	p := hashToG2([]byte(""), dst)
+	if have := hex.EncodeToString(bls12381.NewG2().ToBytes(p)); have != want {
+		t.Fatalf("hash to G2 mismatch:\nhave %s\nwant %s", have, want)
+	}
+}
@@ -291,9 +291,14 @@ func (api *PublicDebugAPI) DumpBlock(blockNr rpc.BlockNumber) (state.Dump, error
 		return stateDb.RawDump(opts), nil
 	}
 	var block *types.Block
-	if blockNr == rpc.LatestBlockNumber {
+	switch blockNr {
+	case rpc.LatestBlockNumber:
 		block = api.eth.blockchain.CurrentBlock()
-	} else {
+	case rpc.SafeBlockNumber:
+		block = api.eth.blockchain.CurrentSafeBlock()
+	case rpc.FinalizedBlockNumber:
+		block = api.eth.blockchain.CurrentFinalizedBlock()
+	default:
 		block = api.eth.blockchain.GetBlockByNumber(uint64(blockNr))
 	}
 	if block == nil {
@@ -379,9 +384,14 @@ func (api *PublicDebugAPI) AccountRange(blockNrOrHash rpc.BlockNumberOrHash, sta
 			_, stateDb = api.eth.miner.Pending()
 		} else {
 			var block *types.Block
-			if number == rpc.LatestBlockNumber {
+			switch number {
+			case rpc.LatestBlockNumber:
 				block = api.eth.blockchain.CurrentBlock()
-			} else {
+			case rpc.SafeBlockNumber:
+				block = api.eth.blockchain.CurrentSafeBlock()
+			case rpc.FinalizedBlockNumber:
+				block = api.eth.blockchain.CurrentFinalizedBlock()
+			default:
 				block = api.eth.blockchain.GetBlockByNumber(uint64(number))
 			}
 			if block == nil {
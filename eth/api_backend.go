@@ -73,6 +73,13 @@ func (b *EthAPIBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumb
 	if number == rpc.LatestBlockNumber {
 		return b.eth.blockchain.CurrentBlock().Header(), nil
 	}
+	if number == rpc.SafeBlockNumber || number == rpc.FinalizedBlockNumber {
+		block, err := b.finalityBlock(number)
+		if err != nil {
+			return nil, err
+		}
+		return block.Header(), nil
+	}
 	return b.eth.blockchain.GetHeaderByNumber(uint64(number)), nil
 }
 
@@ -107,9 +114,27 @@ func (b *EthAPIBackend) BlockByNumber(ctx context.Context, number rpc.BlockNumbe
 	if number == rpc.LatestBlockNumber {
 		return b.eth.blockchain.CurrentBlock(), nil
 	}
+	if number == rpc.SafeBlockNumber || number == rpc.FinalizedBlockNumber {
+		return b.finalityBlock(number)
+	}
 	return b.eth.blockchain.GetBlockByNumber(uint64(number)), nil
 }
 
+// finalityBlock resolves the safe and finalized block tags through the
+// Casper-FFG block status tracked by the chain.
+func (b *EthAPIBackend) finalityBlock(number rpc.BlockNumber) (*types.Block, error) {
+	if number == rpc.SafeBlockNumber {
+		if block := b.eth.blockchain.CurrentSafeBlock(); block != nil {
+			return block, nil
+		}
+		return nil, errors.New("safe block not found")
+	}
+	if block := b.eth.blockchain.CurrentFinalizedBlock(); block != nil {
+		return block, nil
+	}
+	return nil, errors.New("finalized block not found")
+}
+
 func (b *EthAPIBackend) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
 	return b.eth.blockchain.GetBlockByHash(hash), nil
 }
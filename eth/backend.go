@@ -20,13 +20,16 @@ package eth
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"math/big"
 	"runtime"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/QEasyWeb3/QEasyChain/accounts"
+	"github.com/QEasyWeb3/QEasyChain/accounts/keystore"
 	"github.com/QEasyWeb3/QEasyChain/common"
 	"github.com/QEasyWeb3/QEasyChain/common/hexutil"
 	"github.com/QEasyWeb3/QEasyChain/consensus"
@@ -38,6 +41,7 @@ import (
 	"github.com/QEasyWeb3/QEasyChain/core/state/pruner"
 	"github.com/QEasyWeb3/QEasyChain/core/types"
 	"github.com/QEasyWeb3/QEasyChain/core/vm"
+	"github.com/QEasyWeb3/QEasyChain/crypto/bls"
 	"github.com/QEasyWeb3/QEasyChain/eth/downloader"
 	"github.com/QEasyWeb3/QEasyChain/eth/ethconfig"
 	"github.com/QEasyWeb3/QEasyChain/eth/filters"
@@ -74,6 +78,7 @@ type Ethereum struct {
 	ethDialCandidates  enode.Iterator
 	snapDialCandidates enode.Iterator
 	consDialCandidates enode.Iterator
+	validatorMesh      *validatorMesh // Connections between the current validators, if enabled
 
 	// DB interfaces
 	chainDb ethdb.Database // Block chain database
@@ -89,6 +94,9 @@ type Ethereum struct {
 	bloomIndexer      *core.ChainIndexer             // Bloom indexer operating during block imports
 	closeBloomHandler chan struct{}
 
+	performanceIndexer *core.ChainIndexer // Validator performance indexer operating during block imports, if enabled
+	punishmentIndexer  *core.ChainIndexer // Punishment history indexer operating during block imports
+
 	APIBackend *EthAPIBackend
 
 	miner     *miner.Miner
@@ -193,6 +201,9 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 			TrieTimeLimit:       config.TrieTimeout,
 			SnapshotLimit:       config.SnapshotCache,
 			Preimages:           config.Preimages,
+
+			AttestationJournal:   stack.ResolvePath(config.AttestationJournal),
+			AttestationRejournal: config.AttestationRejournal,
 		}
 	)
 	eth.blockchain, err = core.NewBlockChain(chainDb, cacheConfig, chainConfig, eth.engine, vmConfig, eth.shouldPreserve, &config.TxLookupLimit)
@@ -220,6 +231,15 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 
 		// set consensus-related transaction validator
 		eth.txPool.InitTxFilter(democracyEngine)
+
+		if config.PunishmentHistoryIndex {
+			eth.punishmentIndexer = democracy.NewPunishmentIndexer(chainDb, eth.blockchain, democracyEngine)
+			eth.punishmentIndexer.Start(eth.blockchain)
+		}
+		if config.ValidatorPerformanceIndex {
+			eth.performanceIndexer = democracy.NewPerformanceIndexer(chainDb, eth.blockchain, democracyEngine)
+			eth.performanceIndexer.Start(eth.blockchain)
+		}
 	}
 
 	// Permit the downloader to use the trie cache allowance during fast sync
@@ -228,6 +248,15 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	if checkpoint == nil {
 		checkpoint = params.TrustedCheckpoints[genesisHash]
 	}
+	syncCheckpoint := config.SyncCheckpoint
+	if syncCheckpoint == nil && chainConfig.Democracy != nil {
+		syncCheckpoint = chainConfig.Democracy.Checkpoint
+	}
+	if syncCheckpoint != nil {
+		if err := syncCheckpoint.Validate(chainConfig); err != nil {
+			return nil, err
+		}
+	}
 	if eth.handler, err = newHandler(&handlerConfig{
 		Database:   chainDb,
 		Chain:      eth.blockchain,
@@ -238,9 +267,16 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		EventMux:   eth.eventMux,
 		Checkpoint: checkpoint,
 		Whitelist:  config.Whitelist,
+
+		SyncCheckpoint: syncCheckpoint,
 	}); err != nil {
 		return nil, err
 	}
+	// Anchor the consensus snapshots to the trusted finalized block
+	if democracyEngine, ok := eth.engine.(*democracy.Democracy); ok && syncCheckpoint != nil {
+		democracyEngine.SetTrustedCheckpoint(syncCheckpoint)
+		log.Info("Configured trusted sync checkpoint", "number", syncCheckpoint.Number, "hash", syncCheckpoint.Hash)
+	}
 
 	eth.miner = miner.New(eth, &config.Miner, chainConfig, eth.EventMux(), eth.engine, eth.isLocalBlock)
 	eth.miner.SetExtra(makeExtraData(config.Miner.ExtraData))
@@ -543,12 +579,27 @@ func (s *Ethereum) StartMining(threads int) error {
 			clique.Authorize(eb, wallet.SignData)
 		}
 		if democracy, ok := s.engine.(*democracy.Democracy); ok {
-			wallet, err := s.accountManager.Find(accounts.Account{Address: eb})
+			// The validator may seal with a rotated consensus key instead of the etherbase
+			key := eb
+			if s.config.Miner.ConsensusKey != (common.Address{}) {
+				key = s.config.Miner.ConsensusKey
+			}
+			wallet, err := s.accountManager.Find(accounts.Account{Address: key})
 			if wallet == nil || err != nil {
-				log.Error("Etherbase account unavailable locally", "err", err)
+				log.Error("Consensus key account unavailable locally", "key", key, "err", err)
 				return fmt.Errorf("signer missing: %v", err)
 			}
 			democracy.Authorize(eb, wallet.SignData, wallet.SignTx)
+			democracy.AuthorizeConsensusKey(key)
+			if file := s.config.Miner.BLSKeyFile; file != "" {
+				key, err := loadBLSKey(file, s.config.Miner.BLSPasswordFile)
+				if err != nil {
+					log.Error("Cannot load BLS key", "file", file, "err", err)
+					return fmt.Errorf("bls key missing: %v", err)
+				}
+				democracy.AuthorizeBLS(key)
+			}
+			democracy.StartDoppelgangerDetection(s.config.Miner.DoppelgangerBlocks)
 		}
 		// If mining is started, we can disable the transaction rejection mechanism
 		// introduced to speed sync times.
@@ -560,6 +611,24 @@ func (s *Ethereum) StartMining(threads int) error {
 	return nil
 }
 
+// loadBLSKey decrypts the BLS key of the given keystore file with the passphrase held
+// by the password file, if any.
+func loadBLSKey(file string, passwordFile string) (*bls.SecretKey, error) {
+	keyjson, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var passphrase string
+	if passwordFile != "" {
+		content, err := ioutil.ReadFile(passwordFile)
+		if err != nil {
+			return nil, err
+		}
+		passphrase = strings.TrimRight(string(content), "\r\n")
+	}
+	return keystore.DecryptBLSKey(keyjson, passphrase)
+}
+
 // StopMining terminates the miner, both at the consensus engine level as well as
 // at the block creation level.
 func (s *Ethereum) StopMining() {
@@ -639,9 +708,38 @@ func (s *Ethereum) Start() error {
 	}
 	// Start the networking layer and the light server if requested
 	s.handler.Start(maxPeers)
+
+	// Keep the current validators connected to each other if requested
+	if engine, ok := s.engine.(*democracy.Democracy); ok && s.config.ValidatorMesh {
+		iter, err := s.validatorCandidates()
+		if err != nil {
+			return err
+		}
+		s.validatorMesh = newValidatorMesh(s.blockchain, engine, s.p2pServer, iter)
+		s.validatorMesh.start()
+	}
 	return nil
 }
 
+// validatorCandidates returns an iterator over the nodes found by every discovery
+// mechanism of the node, to look up the validator records from.
+func (s *Ethereum) validatorCandidates() (enode.Iterator, error) {
+	mix := enode.NewFairMix(5 * time.Second)
+	if discv4 := s.p2pServer.DiscoveryV4(); discv4 != nil {
+		mix.AddSource(discv4.RandomNodes())
+	}
+	if s.p2pServer.DiscV5 != nil {
+		mix.AddSource(s.p2pServer.DiscV5.RandomNodes())
+	}
+	dns, err := dnsdisc.NewClient(dnsdisc.Config{}).NewIterator(s.config.ConsDiscoveryURLs...)
+	if err != nil {
+		mix.Close()
+		return nil, err
+	}
+	mix.AddSource(dns)
+	return mix, nil
+}
+
 // Stop implements node.Lifecycle, terminating all internal goroutines used by the
 // Ethereum protocol.
 func (s *Ethereum) Stop() error {
@@ -649,11 +747,20 @@ func (s *Ethereum) Stop() error {
 	s.ethDialCandidates.Close()
 	s.snapDialCandidates.Close()
 	s.consDialCandidates.Close()
+	if s.validatorMesh != nil {
+		s.validatorMesh.stop()
+	}
 	s.handler.Stop()
 
 	// Then stop everything else.
 	s.bloomIndexer.Close()
 	close(s.closeBloomHandler)
+	if s.performanceIndexer != nil {
+		s.performanceIndexer.Close()
+	}
+	if s.punishmentIndexer != nil {
+		s.punishmentIndexer.Close()
+	}
 	s.txPool.Stop()
 	s.miner.Close()
 	s.blockchain.Stop()
@@ -61,6 +61,9 @@ var (
 	fsHeaderForceVerify    = 24              // Number of headers to verify before and after the pivot to accept it
 	fsHeaderContCheck      = 3 * time.Second // Time interval to check for header continuations during state download
 	fsMinFullBlocks        = 64              // Number of blocks to retrieve fully even in fast sync
+	fsMaxFinalizedLag      = 120             // Max distance of a finalized pivot behind the remote head, while its state is served
+	fsCertificateWait      = time.Minute     // Time to wait for the certificate of a finalized pivot once its state is synced
+	fsCheckpointLinks      = 2048            // Interval of the header hashes retained while linking the chain to the checkpoint
 )
 
 var (
@@ -89,10 +92,11 @@ type Downloader struct {
 	mode uint32         // Synchronisation mode defining the strategy used (per sync cycle), use d.getMode() to get the SyncMode
 	mux  *event.TypeMux // Event multiplexer to announce sync operation events
 
-	checkpoint uint64   // Checkpoint block number to enforce head against (e.g. fast sync)
-	genesis    uint64   // Genesis block number to limit sync to (e.g. light client CHT)
-	queue      *queue   // Scheduler for selecting the hashes to download
-	peers      *peerSet // Set of active peers from which download can proceed
+	checkpoint     uint64      // Checkpoint block number to enforce head against (e.g. fast sync)
+	checkpointHash common.Hash // Checkpoint block hash the synced header chain has to link to (optional)
+	genesis        uint64      // Genesis block number to limit sync to (e.g. light client CHT)
+	queue          *queue      // Scheduler for selecting the hashes to download
+	peers          *peerSet    // Set of active peers from which download can proceed
 
 	stateDB    ethdb.Database  // Database to state sync into (and deduplicate via)
 	stateBloom *trie.SyncBloom // Bloom filter for fast trie node and contract code existence checks
@@ -107,7 +111,9 @@ type Downloader struct {
 	blockchain BlockChain
 
 	// Callbacks
-	dropPeer peerDropFn // Drops a peer for misbehaving
+	dropPeer  peerDropFn  // Drops a peer for misbehaving
+	finality  finalityFn  // Retrieves the finalized block to anchor the pivot on (optional)
+	certified certifiedFn // Checks whether a finalized block is certified by the header chain (optional)
 
 	// Status
 	synchroniseMock func(id string, hash common.Hash) error // Replacement for synchronise during testing
@@ -125,8 +131,10 @@ type Downloader struct {
 	headerProcCh  chan []*types.Header // Channel to feed the header processor new tasks
 
 	// State sync
-	pivotHeader *types.Header // Pivot block header to dynamically push the syncing state root
-	pivotLock   sync.RWMutex  // Lock protecting pivot header reads from updates
+	pivotHeader    *types.Header // Pivot block header to dynamically push the syncing state root
+	pivotFinalized bool          // Whether the pivot is anchored to a finalized block
+	pivotCertified bool          // Whether the header chain certifies the finality of the anchored pivot
+	pivotLock      sync.RWMutex  // Lock protecting pivot header reads from updates
 
 	snapSync       bool         // Whether to run state sync over the snap protocol
 	SnapSyncer     *snap.Syncer // TODO(karalabe): make private! hack for now
@@ -199,12 +207,19 @@ type BlockChain interface {
 	// InsertReceiptChain inserts a batch of receipts into the local chain.
 	InsertReceiptChain(types.Blocks, []types.Receipts, uint64) (int, error)
 
+	// InsertTrustedHeaderChain inserts a batch of already authenticated headers into
+	// the local chain without verifying them.
+	InsertTrustedHeaderChain([]*types.Header) (int, error)
+
 	// Snapshots returns the blockchain snapshot tree to paused it during sync.
 	Snapshots() *snapshot.Tree
 }
 
-// New creates a new downloader to fetch hashes and blocks from remote peers.
-func New(checkpoint uint64, stateDb ethdb.Database, stateBloom *trie.SyncBloom, mux *event.TypeMux, chain BlockChain, lightchain LightChain, dropPeer peerDropFn) *Downloader {
+// New creates a new downloader to fetch hashes and blocks from remote peers. If the
+// finality callbacks are set, the fast sync pivot is anchored to the finalized block
+// returned by the network instead of trailing the remote head, once the header chain
+// certifies it.
+func New(checkpoint uint64, checkpointHash common.Hash, stateDb ethdb.Database, stateBloom *trie.SyncBloom, mux *event.TypeMux, chain BlockChain, lightchain LightChain, dropPeer peerDropFn, finality finalityFn, certified certifiedFn) *Downloader {
 	if lightchain == nil {
 		lightchain = chain
 	}
@@ -213,11 +228,14 @@ func New(checkpoint uint64, stateDb ethdb.Database, stateBloom *trie.SyncBloom,
 		stateBloom:     stateBloom,
 		mux:            mux,
 		checkpoint:     checkpoint,
+		checkpointHash: checkpointHash,
 		queue:          newQueue(blockCacheMaxItems, blockCacheInitialItems),
 		peers:          newPeerSet(),
 		blockchain:     chain,
 		lightchain:     lightchain,
 		dropPeer:       dropPeer,
+		finality:       finality,
+		certified:      certified,
 		headerCh:       make(chan dataPack, 1),
 		bodyCh:         make(chan dataPack, 1),
 		receiptCh:      make(chan dataPack, 1),
@@ -470,6 +488,26 @@ func (d *Downloader) syncWithPeer(p *peerConnection, hash common.Hash, td *big.I
 		// nil panics on an access.
 		pivot = d.blockchain.CurrentBlock().Header()
 	}
+	// Anchor the pivot to the finalized block reported by the network if it's recent
+	// enough, so that the state isn't synced on a fork reorged later by CasperFFG. The
+	// reports aren't authenticated, the pivot is only committed once a verified header
+	// certifies it.
+	finalized := false
+	if mode == FastSync && latest.Number.Uint64() > uint64(fsMinFullBlocks) && d.finality != nil && d.certified != nil {
+		if number, hash := d.finality(); number != 0 {
+			switch {
+			case number > latest.Number.Uint64():
+				return fmt.Errorf("%w: remote head %d below finalized block %d", errUnsyncedPeer, latest.Number, number)
+			case number+uint64(fsMaxFinalizedLag) < latest.Number.Uint64():
+				log.Warn("Finalized block too old to anchor pivot", "number", number, "hash", hash, "head", latest.Number)
+			default:
+				if pivot, err = d.fetchFinalized(p, number, hash); err != nil {
+					return err
+				}
+				finalized = true
+			}
+		}
+	}
 	height := latest.Number.Uint64()
 
 	origin, err := d.findAncestor(p, latest)
@@ -497,6 +535,14 @@ func (d *Downloader) syncWithPeer(p *peerConnection, hash common.Hash, td *big.I
 			rawdb.WriteLastPivotNumber(d.stateDB, pivotNumber)
 		}
 	}
+	// Link the headers leading to the checkpoint to it before downloading them, so that
+	// they can be imported without verifying them against the consensus rules
+	var links map[uint64]common.Hash
+	if mode == FastSync && d.checkpointHash != (common.Hash{}) && origin < d.checkpoint {
+		if links, err = d.linkCheckpoint(p, origin); err != nil {
+			return err
+		}
+	}
 	d.committed = 1
 	if mode == FastSync && pivot.Number.Uint64() != 0 {
 		d.committed = 0
@@ -549,11 +595,13 @@ func (d *Downloader) syncWithPeer(p *peerConnection, hash common.Hash, td *big.I
 		func() error { return d.fetchHeaders(p, origin+1) }, // Headers are always retrieved
 		func() error { return d.fetchBodies(origin + 1) },   // Bodies are retrieved during normal and fast sync
 		func() error { return d.fetchReceipts(origin + 1) }, // Receipts are retrieved during fast sync
-		func() error { return d.processHeaders(origin+1, td) },
+		func() error { return d.processHeaders(origin+1, td, links) },
 	}
 	if mode == FastSync {
 		d.pivotLock.Lock()
 		d.pivotHeader = pivot
+		d.pivotFinalized = finalized
+		d.pivotCertified = false
 		d.pivotLock.Unlock()
 
 		fetchers = append(fetchers, func() error { return d.processFastSyncContent() })
@@ -699,6 +747,120 @@ func (d *Downloader) fetchHead(p *peerConnection) (head *types.Header, pivot *ty
 	}
 }
 
+// fetchFinalized retrieves the header of the finalized block anchoring the pivot from
+// a remote peer, making sure the block is part of its canonical chain.
+func (d *Downloader) fetchFinalized(p *peerConnection, number uint64, hash common.Hash) (*types.Header, error) {
+	p.log.Debug("Retrieving finalized pivot", "number", number, "hash", hash)
+	go p.peer.RequestHeadersByNumber(number, 1, 0, false)
+
+	ttl := d.peers.rates.TargetTimeout()
+	timeout := time.After(ttl)
+	for {
+		select {
+		case <-d.cancelCh:
+			return nil, errCanceled
+
+		case packet := <-d.headerCh:
+			// Discard anything not from the origin peer
+			if packet.PeerId() != p.id {
+				log.Debug("Received headers from incorrect peer", "peer", packet.PeerId())
+				break
+			}
+			headers := packet.(*headerPack).headers
+			if len(headers) == 0 {
+				return nil, fmt.Errorf("%w: finalized pivot %d unavailable", errUnsyncedPeer, number)
+			}
+			if len(headers) > 1 || headers[0].Number.Uint64() != number {
+				return nil, fmt.Errorf("%w: returned headers invalid for finalized pivot %d", errBadPeer, number)
+			}
+			if headers[0].Hash() != hash {
+				return nil, fmt.Errorf("%w: finalized pivot %d hash %x != %x", errInvalidChain, number, headers[0].Hash(), hash)
+			}
+			p.log.Debug("Finalized pivot identified", "number", number, "hash", hash)
+			return headers[0], nil
+
+		case <-timeout:
+			p.log.Debug("Waiting for finalized pivot timed out", "elapsed", ttl)
+			return nil, errTimeout
+
+		case <-d.bodyCh:
+		case <-d.receiptCh:
+			// Out of bounds delivery, ignore
+		}
+	}
+}
+
+// linkCheckpoint retrieves the headers between the common ancestor and the checkpoint
+// from a remote peer in reverse, making sure their hash chain links the checkpoint to
+// the local chain. As the headers are downloaded again later on, only the hashes of
+// every fsCheckpointLinks-th header and of the checkpoint are retained to authenticate
+// them when they're imported.
+func (d *Downloader) linkCheckpoint(p *peerConnection, origin uint64) (map[uint64]common.Hash, error) {
+	p.log.Debug("Linking checkpoint to local chain", "origin", origin, "number", d.checkpoint, "hash", d.checkpointHash)
+
+	var (
+		links  = map[uint64]common.Hash{d.checkpoint: d.checkpointHash}
+		number = d.checkpoint
+		hash   = d.checkpointHash
+	)
+	for number > origin {
+		amount := MaxHeaderFetch
+		if number-origin < uint64(amount) {
+			amount = int(number - origin)
+		}
+		go p.peer.RequestHeadersByHash(hash, amount, 0, true)
+
+		ttl := d.peers.rates.TargetTimeout()
+		timeout := time.After(ttl)
+
+		var headers []*types.Header
+		for headers == nil {
+			select {
+			case <-d.cancelCh:
+				return nil, errCanceled
+
+			case packet := <-d.headerCh:
+				// Discard anything not from the origin peer
+				if packet.PeerId() != p.id {
+					log.Debug("Received headers from incorrect peer", "peer", packet.PeerId())
+					break
+				}
+				headers = packet.(*headerPack).headers
+				if len(headers) == 0 {
+					return nil, fmt.Errorf("%w: header %d [%x] leading to checkpoint unavailable", errInvalidChain, number, hash)
+				}
+				if len(headers) > amount {
+					return nil, fmt.Errorf("%w: returned headers %d > requested %d", errBadPeer, len(headers), amount)
+				}
+
+			case <-timeout:
+				p.log.Debug("Waiting for checkpoint link timed out", "elapsed", ttl)
+				return nil, errTimeout
+
+			case <-d.bodyCh:
+			case <-d.receiptCh:
+				// Out of bounds delivery, ignore
+			}
+		}
+		// Make sure the headers are the ancestors of the last linked one
+		for _, header := range headers {
+			if header.Number.Uint64() != number || header.Hash() != hash {
+				return nil, fmt.Errorf("%w: header %d [%x] not linked to checkpoint, want %d [%x]", errInvalidChain, header.Number, header.Hash(), number, hash)
+			}
+			if number%uint64(fsCheckpointLinks) == 0 {
+				links[number] = hash
+			}
+			number, hash = number-1, header.ParentHash
+		}
+	}
+	// The peer's chain has to link the checkpoint to our own one
+	if local := d.lightchain.GetHeaderByHash(hash); local == nil || local.Number.Uint64() != origin {
+		return nil, fmt.Errorf("%w: checkpoint not linked to local block %d", errInvalidChain, origin)
+	}
+	p.log.Debug("Checkpoint linked to local chain", "origin", origin, "number", d.checkpoint, "hash", d.checkpointHash)
+	return links, nil
+}
+
 // calculateRequestSpan calculates what headers to request from a peer when trying to determine the
 // common ancestor.
 // It returns parameters to be used for peer.RequestHeadersByNumber:
@@ -1004,6 +1166,7 @@ func (d *Downloader) fetchHeaders(p *peerConnection, from uint64) error {
 	// Create a timeout timer, and the associated header fetcher
 	skeleton := true            // Skeleton assembly phase or finishing up
 	pivoting := false           // Whether the next request is pivot verification
+	finalized := common.Hash{}  // Hash of the finalized block requested as next pivot
 	request := time.Now()       // time of the last skeleton fetch request
 	timeout := time.NewTimer(0) // timer to dump a non-responsive active peer
 	<-timeout.C                 // timeout channel should be initially empty
@@ -1033,8 +1196,19 @@ func (d *Downloader) fetchHeaders(p *peerConnection, from uint64) error {
 
 		d.pivotLock.RLock()
 		pivot := d.pivotHeader.Number.Uint64()
+		anchored := d.pivotFinalized
 		d.pivotLock.RUnlock()
 
+		// A finalized pivot moves onto a newer finalized block, or falls back to trailing
+		// the head if finality stalls long enough for the pivot state to be pruned
+		if anchored {
+			if number, hash := d.finality(); number >= pivot+uint64(fsMinFullBlocks) {
+				finalized = hash
+				p.log.Trace("Fetching next finalized pivot header", "number", number, "hash", hash)
+				go p.peer.RequestHeadersByNumber(number, 1, 0, false)
+				return
+			}
+		}
 		p.log.Trace("Fetching next pivot header", "number", pivot+uint64(fsMinFullBlocks))
 		go p.peer.RequestHeadersByNumber(pivot+uint64(fsMinFullBlocks), 2, fsMinFullBlocks-9, false) // move +64 when it's 2x64-8 deep
 	}
@@ -1067,7 +1241,26 @@ func (d *Downloader) fetchHeaders(p *peerConnection, from uint64) error {
 			d.pivotLock.RUnlock()
 
 			if pivoting {
-				if packet.Items() == 2 {
+				if finalized != (common.Hash{}) {
+					// Move onto the newer finalized block if the peer already has it
+					if packet.Items() == 1 {
+						header := packet.(*headerPack).headers[0]
+						if header.Hash() != finalized {
+							log.Warn("Peer sent invalid finalized pivot", "number", header.Number, "have", header.Hash(), "want", finalized)
+							return fmt.Errorf("%w: next finalized pivot %d hash %x != %x", errInvalidChain, header.Number, header.Hash(), finalized)
+						}
+						log.Info("Moving pivot to newer finalized block", "old", pivot, "new", header.Number)
+						pivot = header.Number.Uint64()
+
+						d.pivotLock.Lock()
+						d.pivotHeader = header
+						d.pivotCertified = false
+						d.pivotLock.Unlock()
+
+						rawdb.WriteLastPivotNumber(d.stateDB, pivot)
+					}
+					finalized = common.Hash{}
+				} else if packet.Items() == 2 {
 					// Retrieve the headers and do some sanity checks, just in case
 					headers := packet.(*headerPack).headers
 
@@ -1083,7 +1276,11 @@ func (d *Downloader) fetchHeaders(p *peerConnection, from uint64) error {
 					pivot = headers[0].Number.Uint64()
 
 					d.pivotLock.Lock()
+					if d.pivotFinalized {
+						log.Warn("Finalized pivot became stale, trailing the head", "number", pivot)
+					}
 					d.pivotHeader = headers[0]
+					d.pivotFinalized = false
 					d.pivotLock.Unlock()
 
 					// Write out the pivot into the database so a rollback beyond
@@ -1155,8 +1352,11 @@ func (d *Downloader) fetchHeaders(p *peerConnection, from uint64) error {
 					if head < ancestor {
 						head = ancestor
 					}
-					// If the head is way older than this batch, delay the last few headers
-					if head+uint64(reorgProtThreshold) < headers[n-1].Number.Uint64() {
+					// If the head is way older than this batch, delay the last few headers. The
+					// ones leading to the checkpoint can't be reorged, and as they're held back
+					// until linked, the head doesn't move meanwhile.
+					linked := d.checkpointHash != (common.Hash{}) && headers[n-1].Number.Uint64() <= d.checkpoint
+					if head+uint64(reorgProtThreshold) < headers[n-1].Number.Uint64() && !linked {
 						delay := reorgProtHeaderDelay
 						if delay > n {
 							delay = n
@@ -1182,6 +1382,9 @@ func (d *Downloader) fetchHeaders(p *peerConnection, from uint64) error {
 				} else {
 					getHeaders(from)
 				}
+			} else if skeleton && pivot > 0 {
+				// The skeleton filler already delivered the whole batch, check pivot staleness
+				getNextPivot()
 			} else {
 				// No headers delivered, or all of them being delayed, sleep a bit and retry
 				p.log.Trace("All headers delayed, waiting")
@@ -1509,13 +1712,15 @@ func (d *Downloader) fetchParts(deliveryCh chan dataPack, deliver func(dataPack)
 
 // processHeaders takes batches of retrieved headers from an input channel and
 // keeps processing and scheduling them into the header chain and downloader's
-// queue until the stream ends or a failure occurs.
-func (d *Downloader) processHeaders(origin uint64, td *big.Int) error {
+// queue until the stream ends or a failure occurs. If the headers leading to the
+// checkpoint were linked to it, they're held back until authenticated by the links.
+func (d *Downloader) processHeaders(origin uint64, td *big.Int, links map[uint64]common.Hash) error {
 	// Keep a count of uncertain headers to roll back
 	var (
 		rollback    uint64 // Zero means no rollback (fine as you can't unroll the genesis)
 		rollbackErr error
 		mode        = d.getMode()
+		pending     []*types.Header // Headers leading to the checkpoint not authenticated yet
 	)
 	defer func() {
 		if rollback > 0 {
@@ -1551,6 +1756,11 @@ func (d *Downloader) processHeaders(origin uint64, td *big.Int) error {
 		case headers := <-d.headerProcCh:
 			// Terminate header processing if we synced up
 			if len(headers) == 0 {
+				// The checkpoint is below the remote head, the headers leading to it have to be
+				// authenticated before the stream ends
+				if len(pending) > 0 {
+					return fmt.Errorf("%w: headers %d-%d not linked to checkpoint", errStallingPeer, pending[0].Number, pending[len(pending)-1].Number)
+				}
 				// Notify everyone that headers are fully processed
 				for _, ch := range []chan bool{d.bodyWakeCh, d.receiptWakeCh} {
 					select {
@@ -1595,6 +1805,15 @@ func (d *Downloader) processHeaders(origin uint64, td *big.Int) error {
 			}
 			// Otherwise split the chunk of headers into batches and process them
 			gotHeaders = true
+
+			var trusted int // Number of leading headers authenticated by the checkpoint
+			if links != nil {
+				var err error
+				if headers, trusted, pending, err = d.linkHeaders(links, pending, headers); err != nil {
+					rollbackErr = err
+					return err
+				}
+			}
 			for len(headers) > 0 {
 				// Terminate if something failed in between processing chunks
 				select {
@@ -1608,24 +1827,49 @@ func (d *Downloader) processHeaders(origin uint64, td *big.Int) error {
 				if limit > len(headers) {
 					limit = len(headers)
 				}
+				if trusted > 0 && limit > trusted {
+					limit = trusted
+				}
 				chunk := headers[:limit]
 
 				// In case of header only syncing, validate the chunk immediately
 				if mode == FastSync || mode == LightSync {
 					// If we're importing pure headers, verify based on their recentness
-					var pivot uint64
-
+					var (
+						pivot     uint64
+						pivotHash common.Hash
+						anchored  bool
+					)
 					d.pivotLock.RLock()
 					if d.pivotHeader != nil {
-						pivot = d.pivotHeader.Number.Uint64()
+						pivot, pivotHash, anchored = d.pivotHeader.Number.Uint64(), d.pivotHeader.Hash(), d.pivotFinalized
 					}
 					d.pivotLock.RUnlock()
 
+					// A pivot anchored to a finalized block has to be part of the header chain
+					first := chunk[0].Number.Uint64()
+					if anchored && first <= pivot && pivot < first+uint64(len(chunk)) {
+						if hash := chunk[pivot-first].Hash(); hash != pivotHash {
+							rollbackErr = fmt.Errorf("header %d [%x] conflicts with finalized pivot [%x]", pivot, hash, pivotHash)
+							log.Warn("Header chain conflicts with finalized pivot", "number", pivot, "hash", hash, "finalized", pivotHash)
+							return fmt.Errorf("%w: %v", errInvalidChain, rollbackErr)
+						}
+					}
 					frequency := fsHeaderCheckFrequency
 					if chunk[len(chunk)-1].Number.Uint64()+uint64(fsHeaderForceVerify) > pivot {
 						frequency = 1
 					}
-					if n, err := d.lightchain.InsertHeaderChain(chunk, frequency); err != nil {
+					// The headers authenticated by the checkpoint aren't verified again
+					var (
+						n   int
+						err error
+					)
+					if trusted > 0 {
+						n, err = d.blockchain.InsertTrustedHeaderChain(chunk)
+					} else {
+						n, err = d.lightchain.InsertHeaderChain(chunk, frequency)
+					}
+					if err != nil {
 						rollbackErr = err
 
 						// If some headers were inserted, track them as uncertain
@@ -1665,6 +1909,9 @@ func (d *Downloader) processHeaders(origin uint64, td *big.Int) error {
 				}
 				headers = headers[limit:]
 				origin += uint64(limit)
+				if trusted > 0 {
+					trusted -= limit
+				}
 			}
 			// Update the highest block number we know if a higher one is found.
 			d.syncStatsLock.Lock()
@@ -1684,6 +1931,44 @@ func (d *Downloader) processHeaders(origin uint64, td *big.Int) error {
 	}
 }
 
+// linkHeaders appends a batch of headers to the ones pending to be linked to the
+// checkpoint, and authenticates them up to the last link among them by their hash
+// chain. It returns the headers ready to be imported along with the number of the
+// authenticated ones leading them, and the headers still pending.
+func (d *Downloader) linkHeaders(links map[uint64]common.Hash, pending []*types.Header, headers []*types.Header) ([]*types.Header, int, []*types.Header, error) {
+	headers = append(pending, headers...)
+
+	last := -1
+	for i, header := range headers {
+		number := header.Number.Uint64()
+		if number > d.checkpoint {
+			break
+		}
+		if _, ok := links[number]; ok {
+			last = i
+		}
+	}
+	if last < 0 {
+		// Either all headers are past the checkpoint or none of them is linked yet
+		if headers[0].Number.Uint64() > d.checkpoint {
+			return headers, 0, nil, nil
+		}
+		return nil, 0, headers, nil
+	}
+	want := links[headers[last].Number.Uint64()]
+	for i := last; i >= 0; i-- {
+		if hash := headers[i].Hash(); hash != want {
+			log.Warn("Header chain conflicts with checkpoint", "number", headers[i].Number, "hash", hash, "want", want, "checkpoint", d.checkpoint)
+			return nil, 0, nil, fmt.Errorf("%w: header %d [%x] not linked to checkpoint", errInvalidChain, headers[i].Number, hash)
+		}
+		want = headers[i].ParentHash
+	}
+	if headers[last].Number.Uint64() == d.checkpoint {
+		return headers, last + 1, nil, nil
+	}
+	return headers[:last+1], last + 1, headers[last+1:], nil
+}
+
 // processFullSyncContent takes fetch results from the queue and imports them into the chain.
 func (d *Downloader) processFullSyncContent() error {
 	for {
@@ -1761,8 +2046,9 @@ func (d *Downloader) processFastSyncContent() error {
 	// To cater for moving pivot points, track the pivot block and subsequently
 	// accumulated download results separately.
 	var (
-		oldPivot *fetchResult   // Locked in pivot block, might change eventually
-		oldTail  []*fetchResult // Downloaded content after the pivot
+		oldPivot     *fetchResult   // Locked in pivot block, might change eventually
+		oldTail      []*fetchResult // Downloaded content after the pivot
+		certDeadline time.Time      // Deadline for the certificate of a synced finalized pivot
 	)
 	for {
 		// Wait for the next batch of downloaded data to be available, and if the pivot
@@ -1787,7 +2073,7 @@ func (d *Downloader) processFastSyncContent() error {
 		// If we haven't downloaded the pivot block yet, check pivot staleness
 		// notifications from the header downloader
 		d.pivotLock.RLock()
-		pivot := d.pivotHeader
+		pivot, uncertified := d.pivotHeader, d.pivotFinalized && !d.pivotCertified
 		d.pivotLock.RUnlock()
 
 		if oldPivot == nil {
@@ -1816,7 +2102,9 @@ func (d *Downloader) processFastSyncContent() error {
 
 				d.pivotLock.Lock()
 				d.pivotHeader = pivot
+				d.pivotFinalized = false
 				d.pivotLock.Unlock()
+				uncertified = false
 
 				// Write out the pivot into the database so a rollback beyond it will
 				// reenable fast sync
@@ -1834,7 +2122,7 @@ func (d *Downloader) processFastSyncContent() error {
 				sync = d.syncState(P.Header.Root)
 
 				go closeOnErr(sync)
-				oldPivot = P
+				oldPivot, certDeadline = P, time.Time{}
 			}
 			// Wait for completion, occasionally checking for pivot staleness
 			select {
@@ -1842,6 +2130,52 @@ func (d *Downloader) processFastSyncContent() error {
 				if sync.err != nil {
 					return sync.err
 				}
+				// A pivot anchored to a finalized block is only committed once the header
+				// chain certifies it, if it doesn't in time, fall back to trailing the head
+				if uncertified && d.certified(P.Header.Number.Uint64(), P.Header.Hash()) {
+					d.pivotLock.Lock()
+					if d.pivotHeader == pivot {
+						d.pivotCertified = true
+					}
+					d.pivotLock.Unlock()
+					log.Info("Finalized pivot certified", "number", P.Header.Number, "hash", P.Header.Hash())
+					uncertified = false
+				}
+				if uncertified {
+					if certDeadline.IsZero() {
+						certDeadline = time.Now().Add(fsCertificateWait)
+					}
+					d.syncStatsLock.RLock()
+					trailing := d.syncStatsChainHeight - uint64(fsMinFullBlocks)
+					d.syncStatsLock.RUnlock()
+
+					number := P.Header.Number.Uint64()
+					if time.Now().Before(certDeadline) || (trailing > number && trailing-number > uint64(len(afterP))) {
+						// Wait for the certificate, or for the trailing pivot to be downloaded
+						time.Sleep(time.Second)
+						oldTail = afterP
+						continue
+					}
+					d.pivotLock.Lock()
+					if d.pivotHeader != pivot {
+						// The header fetcher moved the pivot meanwhile, sync onto it
+						d.pivotLock.Unlock()
+						oldTail = afterP
+						continue
+					}
+					d.pivotFinalized = false
+					if trailing > number {
+						log.Warn("Finalized pivot not certified, moving", "old", number, "new", trailing)
+						d.pivotHeader = afterP[trailing-number-1].Header
+						d.pivotLock.Unlock()
+
+						rawdb.WriteLastPivotNumber(d.stateDB, trailing)
+						oldTail = afterP
+						continue
+					}
+					d.pivotLock.Unlock()
+					log.Warn("Finalized pivot not certified, trailing the head", "number", number)
+				}
 				if err := d.commitPivotBlock(P); err != nil {
 					return err
 				}
@@ -43,6 +43,7 @@ func init() {
 	lightMaxForkAncestry = 10000
 	blockCacheMaxItems = 1024
 	fsHeaderContCheck = 500 * time.Millisecond
+	fsCertificateWait = 500 * time.Millisecond
 }
 
 // downloadTester is a test simulator for mocking out local block chain.
@@ -65,6 +66,8 @@ type downloadTester struct {
 	ancientReceipts map[common.Hash]types.Receipts // Ancient receipts belonging to the tester
 	ancientChainTd  map[common.Hash]*big.Int       // Ancient total difficulties of the blocks in the local chain
 
+	trustedHeaders int // Number of headers imported without verification
+
 	lock sync.RWMutex
 }
 
@@ -89,7 +92,7 @@ func newTester() *downloadTester {
 	tester.stateDb = rawdb.NewMemoryDatabase()
 	tester.stateDb.Put(testGenesis.Root().Bytes(), []byte{0x00})
 
-	tester.downloader = New(0, tester.stateDb, trie.NewSyncBloom(1, tester.stateDb), new(event.TypeMux), tester, nil, tester.dropPeer)
+	tester.downloader = New(0, common.Hash{}, tester.stateDb, trie.NewSyncBloom(1, tester.stateDb), new(event.TypeMux), tester, nil, tester.dropPeer, nil, nil)
 	return tester
 }
 
@@ -289,6 +292,18 @@ func (dl *downloadTester) InsertHeaderChain(headers []*types.Header, checkFreq i
 	return len(headers), nil
 }
 
+// InsertTrustedHeaderChain injects a new batch of authenticated headers into the
+// simulated chain, counting them.
+func (dl *downloadTester) InsertTrustedHeaderChain(headers []*types.Header) (int, error) {
+	n, err := dl.InsertHeaderChain(headers, 0)
+
+	dl.lock.Lock()
+	dl.trustedHeaders += n
+	dl.lock.Unlock()
+
+	return n, err
+}
+
 // InsertChain injects a new batch of blocks into the simulated chain.
 func (dl *downloadTester) InsertChain(blocks types.Blocks) (i int, err error) {
 	dl.lock.Lock()
@@ -1620,3 +1635,317 @@ func testCheckpointEnforcement(t *testing.T, protocol uint, mode SyncMode) {
 		assertOwnChain(t, tester, chain.len())
 	}
 }
+
+// Tests that the fast sync pivot is anchored to the finalized block reported by the
+// network, and that peers whose chain doesn't include it are rejected.
+func TestFinalizedPivot66(t *testing.T) {
+	t.Parallel()
+
+	chain := testChainBase.shorten(blockCacheMaxItems - 15)
+	number := uint64(chain.len() - 20)
+	hash := chain.chain[number]
+
+	tests := []struct {
+		number uint64
+		hash   common.Hash
+		err    error
+	}{
+		{number, hash, nil},                               // Finalized block part of the chain
+		{number, common.Hash{0x01}, errInvalidChain},      // Finalized block conflicting with the chain
+		{uint64(chain.len()) + 10, hash, errUnsyncedPeer}, // Remote head below the finalized block
+		{uint64(fsMinFullBlocks), common.Hash{0x01}, nil}, // Finalized block too old to anchor the pivot
+	}
+	for i, tt := range tests {
+		tester := newTester()
+		tester.downloader.finality = func() (uint64, common.Hash) { return tt.number, tt.hash }
+		tester.downloader.certified = certifiedBy(tester, chain)
+		tester.newPeer("peer", eth.ETH66, chain)
+
+		if err := tester.sync("peer", nil, FastSync); !errors.Is(err, tt.err) {
+			t.Fatalf("test %d: sync error mismatch: have %v, want %v", i, err, tt.err)
+		}
+		if tt.err == nil && tt.number == number {
+			if pivot := rawdb.ReadLastPivotNumber(tester.stateDb); pivot == nil || *pivot != number {
+				t.Errorf("test %d: pivot mismatch: have %v, want %d", i, pivot, number)
+			}
+			assertOwnChain(t, tester, chain.len())
+		}
+		tester.terminate()
+	}
+}
+
+// Tests that a finalized pivot falls back to trailing the head if finality stalls until
+// the state of the pivot ages out of the network.
+func TestStaleFinalizedPivot66(t *testing.T) {
+	t.Parallel()
+
+	chain := testChainBase.shorten(blockCacheMaxItems - 15)
+	head := uint64(chain.len() - 1)
+	number := head - uint64(fsMaxFinalizedLag)
+
+	tester := newTester()
+	defer tester.terminate()
+
+	// Leave the pivot uncertified, so it's not committed before the header fetcher moves it
+	tester.downloader.finality = func() (uint64, common.Hash) { return number, chain.chain[number] }
+	tester.downloader.certified = func(uint64, common.Hash) bool { return false }
+	tester.newPeer("peer", eth.ETH66, chain)
+
+	if err := tester.sync("peer", nil, FastSync); err != nil {
+		t.Fatalf("failed to synchronise blocks: %v", err)
+	}
+	if pivot := rawdb.ReadLastPivotNumber(tester.stateDb); pivot == nil || *pivot != number+uint64(fsMinFullBlocks) {
+		t.Errorf("pivot mismatch: have %v, want %d", pivot, number+uint64(fsMinFullBlocks))
+	}
+	if tester.downloader.pivotFinalized {
+		t.Errorf("stale pivot still marked finalized")
+	}
+	assertOwnChain(t, tester, chain.len())
+}
+
+// Tests that a finalized pivot isn't committed unless the header chain certifies it,
+// falling back to trailing the head instead.
+func TestUncertifiedFinalizedPivot66(t *testing.T) {
+	t.Parallel()
+
+	chain := testChainBase.shorten(blockCacheMaxItems - 15)
+	head := uint64(chain.len() - 1)
+	number := head - 100
+
+	tester := newTester()
+	defer tester.terminate()
+
+	tester.downloader.finality = func() (uint64, common.Hash) { return number, chain.chain[number] }
+	tester.downloader.certified = func(uint64, common.Hash) bool { return false }
+	tester.newPeer("peer", eth.ETH66, chain)
+
+	if err := tester.sync("peer", nil, FastSync); err != nil {
+		t.Fatalf("failed to synchronise blocks: %v", err)
+	}
+	want := head - uint64(fsMinFullBlocks)
+	if pivot := rawdb.ReadLastPivotNumber(tester.stateDb); pivot == nil || *pivot != want {
+		t.Errorf("pivot mismatch: have %v, want %d", pivot, want)
+	}
+	if tester.downloader.pivotFinalized {
+		t.Errorf("uncertified pivot still marked finalized")
+	}
+	assertOwnChain(t, tester, chain.len())
+}
+
+// Tests that the header fetcher moves a finalized pivot onto a newer finalized block
+// reported by the network while syncing.
+func TestMovingFinalizedPivot66(t *testing.T) {
+	t.Parallel()
+
+	chain := testChainBase.shorten(blockCacheMaxItems - 15)
+	head := uint64(chain.len() - 1)
+	number, next := head-100, head-30
+
+	tester := newTester()
+	defer tester.terminate()
+
+	var calls int32
+	tester.downloader.finality = func() (uint64, common.Hash) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			return number, chain.chain[number]
+		}
+		return next, chain.chain[next]
+	}
+	// Only certify the newer finalized block, so the first one isn't committed before the
+	// header fetcher moves the pivot
+	certified := certifiedBy(tester, chain)
+	tester.downloader.certified = func(number uint64, hash common.Hash) bool {
+		return number == next && certified(number, hash)
+	}
+	tester.newPeer("peer", eth.ETH66, chain)
+
+	if err := tester.sync("peer", nil, FastSync); err != nil {
+		t.Fatalf("failed to synchronise blocks: %v", err)
+	}
+	if pivot := rawdb.ReadLastPivotNumber(tester.stateDb); pivot == nil || *pivot != next {
+		t.Errorf("pivot mismatch: have %v, want %d", pivot, next)
+	}
+	if !tester.downloader.pivotFinalized || !tester.downloader.pivotCertified {
+		t.Errorf("newer finalized pivot not anchored and certified")
+	}
+	assertOwnChain(t, tester, chain.len())
+}
+
+// certifiedBy returns a certification callback accepting the blocks of the chain once
+// the tester imported the header following them, as if it embedded their certificate.
+func certifiedBy(tester *downloadTester, chain *testChain) certifiedFn {
+	return func(number uint64, hash common.Hash) bool {
+		if number+1 >= uint64(chain.len()) || chain.chain[number] != hash {
+			return false
+		}
+		return tester.HasHeader(chain.chain[number+1], number+1)
+	}
+}
+
+// Tests that a fast synced header chain has to link to the checkpoint hash, and that
+// the headers leading to a conflicting checkpoint are rolled back.
+func TestCheckpointHash66(t *testing.T) {
+	t.Parallel()
+
+	chain := testChainBase.shorten(blockCacheMaxItems - 15)
+	number := uint64(fsMinFullBlocks) + 256
+
+	tests := []struct {
+		hash common.Hash
+		err  error
+	}{
+		{chain.chain[number], nil},           // Checkpoint part of the chain
+		{common.Hash{0x01}, errInvalidChain}, // Checkpoint conflicting with the chain
+	}
+	for i, tt := range tests {
+		tester := newTester()
+		tester.downloader.checkpoint = number
+		tester.downloader.checkpointHash = tt.hash
+		tester.newPeer("peer", eth.ETH66, chain)
+
+		if err := tester.sync("peer", nil, FastSync); !errors.Is(err, tt.err) {
+			t.Fatalf("test %d: sync error mismatch: have %v, want %v", i, err, tt.err)
+		}
+		if tt.err == nil {
+			assertOwnChain(t, tester, chain.len())
+			if tester.trustedHeaders != int(number) {
+				t.Errorf("test %d: unverified headers mismatch: have %d, want %d", i, tester.trustedHeaders, number)
+			}
+		} else if head := tester.CurrentHeader().Number.Uint64(); head != 0 {
+			t.Errorf("test %d: headers below conflicting checkpoint kept: head %d", i, head)
+		}
+		tester.terminate()
+	}
+}
+
+// Tests that the headers leading to the checkpoint aren't imported if the peer serves
+// another chain than the one it linked to the checkpoint.
+func TestCheckpointForkedPeer66(t *testing.T) {
+	t.Parallel()
+
+	chain := testChainBase.shorten(blockCacheMaxItems - 15)
+	fork := chain.shorten(200).makeFork(chain.len()-200, false, 1)
+	number := uint64(fsMinFullBlocks) + 256
+
+	tester := newTester()
+	defer tester.terminate()
+
+	tester.downloader.checkpoint = number
+	tester.downloader.checkpointHash = chain.chain[number]
+	tester.newPeer("peer", eth.ETH66, chain)
+
+	// Switch the peer onto the fork once the checkpoint was linked
+	tester.downloader.syncInitHook = func(uint64, uint64) {
+		tester.lock.Lock()
+		tester.peers["peer"].chain = fork
+		tester.lock.Unlock()
+	}
+	if err := tester.sync("peer", nil, FastSync); !errors.Is(err, errInvalidChain) {
+		t.Fatalf("sync error mismatch: have %v, want %v", err, errInvalidChain)
+	}
+	if head := tester.CurrentHeader().Number.Uint64(); head != 0 {
+		t.Errorf("headers of the fork imported: head %d", head)
+	}
+	if tester.trustedHeaders != 0 {
+		t.Errorf("unverified headers mismatch: have %d, want 0", tester.trustedHeaders)
+	}
+}
+
+// Tests that the sync fails if the header stream ends before the headers leading to
+// the checkpoint were linked to it.
+func TestCheckpointUnlinkedHeaders66(t *testing.T) {
+	t.Parallel()
+
+	chain := testChainBase.shorten(blockCacheMaxItems - 15)
+	number := uint64(fsMinFullBlocks) + 256
+
+	tester := newTester()
+	defer tester.terminate()
+
+	tester.downloader.checkpoint = number
+	tester.downloader.checkpointHash = chain.chain[number]
+	tester.newPeer("peer", eth.ETH66, chain)
+
+	// Cut the peer's chain short of the checkpoint once it was linked
+	tester.downloader.syncInitHook = func(uint64, uint64) {
+		tester.lock.Lock()
+		tester.peers["peer"].chain = chain.shorten(int(number) - 20)
+		tester.lock.Unlock()
+	}
+	if err := tester.sync("peer", nil, FastSync); !errors.Is(err, errStallingPeer) {
+		t.Fatalf("sync error mismatch: have %v, want %v", err, errStallingPeer)
+	}
+	if head := tester.CurrentHeader().Number.Uint64(); head != 0 {
+		t.Errorf("unlinked headers imported: head %d", head)
+	}
+}
+
+// Tests that a checkpoint below the local head isn't linked again, and that the
+// headers above it are verified.
+func TestCheckpointBelowHead66(t *testing.T) {
+	t.Parallel()
+
+	chain := testChainBase.shorten(blockCacheMaxItems - 15)
+	number := uint64(fsMinFullBlocks) + 256
+
+	tester := newTester()
+	defer tester.terminate()
+
+	tester.downloader.checkpoint = number
+	tester.downloader.checkpointHash = chain.chain[number]
+
+	// Sync past the checkpoint, authenticating the headers leading to it
+	tester.newPeer("short", eth.ETH66, chain.shorten(int(number)+200))
+	if err := tester.sync("short", nil, FastSync); err != nil {
+		t.Fatalf("failed to synchronise blocks past the checkpoint: %v", err)
+	}
+	if tester.trustedHeaders != int(number) {
+		t.Fatalf("unverified headers mismatch: have %d, want %d", tester.trustedHeaders, number)
+	}
+	// Sync the rest of the chain, the checkpoint being below the local head
+	tester.newPeer("peer", eth.ETH66, chain)
+	if err := tester.sync("peer", nil, FastSync); err != nil {
+		t.Fatalf("failed to synchronise blocks: %v", err)
+	}
+	assertOwnChain(t, tester, chain.len())
+	if tester.trustedHeaders != int(number) {
+		t.Errorf("unverified headers mismatch: have %d, want %d", tester.trustedHeaders, number)
+	}
+}
+
+// Tests that the headers leading to the checkpoint are only imported once they are
+// authenticated by the links retained while linking the chain to it.
+func TestCheckpointLinks(t *testing.T) {
+	chain := testChainBase.shorten(16)
+	headers := make([]*types.Header, 0, chain.len()-1)
+	for i := 1; i < chain.len(); i++ {
+		headers = append(headers, chain.headerm[chain.chain[i]])
+	}
+	forged := types.CopyHeader(headers[2])
+	forged.Extra = []byte("forged")
+
+	d := &Downloader{checkpoint: 12}
+	links := map[uint64]common.Hash{8: chain.chain[8], 12: chain.chain[12]}
+
+	// Headers before the first link are held back
+	ready, trusted, pending, err := d.linkHeaders(links, nil, headers[:5])
+	if err != nil || len(ready) != 0 || trusted != 0 || len(pending) != 5 {
+		t.Fatalf("unlinked batch: ready %d, trusted %d, pending %d, err %v", len(ready), trusted, len(pending), err)
+	}
+	// Reaching a link releases the headers up to it
+	ready, trusted, pending, err = d.linkHeaders(links, pending, headers[5:10])
+	if err != nil || len(ready) != 8 || trusted != 8 || len(pending) != 2 {
+		t.Fatalf("linked batch: ready %d, trusted %d, pending %d, err %v", len(ready), trusted, len(pending), err)
+	}
+	// Reaching the checkpoint releases the following headers too, unauthenticated
+	ready, trusted, pending, err = d.linkHeaders(links, pending, headers[10:])
+	if err != nil || len(ready) != 7 || trusted != 4 || len(pending) != 0 {
+		t.Fatalf("checkpoint batch: ready %d, trusted %d, pending %d, err %v", len(ready), trusted, len(pending), err)
+	}
+	// A header not linked to the checkpoint is rejected
+	batch := append(append([]*types.Header{}, headers[:2]...), forged)
+	batch = append(batch, headers[3:8]...)
+	if _, _, _, err := d.linkHeaders(links, nil, batch); !errors.Is(err, errInvalidChain) {
+		t.Fatalf("forged header: error mismatch: have %v, want %v", err, errInvalidChain)
+	}
+}
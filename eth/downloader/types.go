@@ -19,12 +19,21 @@ package downloader
 import (
 	"fmt"
 
+	"github.com/QEasyWeb3/QEasyChain/common"
 	"github.com/QEasyWeb3/QEasyChain/core/types"
 )
 
 // peerDropFn is a callback type for dropping a peer detected as malicious.
 type peerDropFn func(id string)
 
+// finalityFn is a callback type for retrieving the highest finalized block known to
+// the network, anchoring the fast sync pivot. A zero number means none is known.
+type finalityFn func() (uint64, common.Hash)
+
+// certifiedFn is a callback type for checking whether the local header chain embeds a
+// verified finality certificate of the given block, accepting it as the fast sync pivot.
+type certifiedFn func(number uint64, hash common.Hash) bool
+
 // dataPack is a data message returned by a peer for some query.
 type dataPack interface {
 	PeerId() string
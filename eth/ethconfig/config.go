@@ -97,6 +97,8 @@ var Defaults = Config{
 	TrieCleanCache:          154,
 	TrieCleanCacheJournal:   "triecache",
 	TrieCleanCacheRejournal: 60 * time.Minute,
+	AttestationJournal:      "attestations.rlp",
+	AttestationRejournal:    10 * time.Minute,
 	TrieDirtyCache:          256,
 	TrieTimeout:             60 * time.Minute,
 	SnapshotCache:           102,
@@ -151,14 +153,25 @@ type Config struct {
 	SnapDiscoveryURLs []string
 	ConsDiscoveryURLs []string
 
+	// ValidatorMesh advertises the local validator in the node record, and keeps
+	// protected direct connections to the validators of the current epoch.
+	ValidatorMesh bool `toml:",omitempty"`
+
 	NoPruning  bool // Whether to disable pruning and flush everything to disk
 	NoPrefetch bool // Whether to disable prefetching and only load state on demand
 
 	TxLookupLimit uint64 `toml:",omitempty"` // The maximum number of blocks from head whose tx indices are reserved.
 
+	ValidatorPerformanceIndex bool `toml:",omitempty"` // Whether to index the validator performance in the background
+	PunishmentHistoryIndex    bool `toml:",omitempty"` // Whether to index the executed punishments in the background
+
 	// Whitelist of required block number -> hash values to accept
 	Whitelist map[uint64]common.Hash `toml:"-"`
 
+	// SyncCheckpoint is the trusted finalized block to sync from, overriding the one
+	// of the chain config.
+	SyncCheckpoint *params.SyncCheckpoint `toml:",omitempty"`
+
 	// Light client options
 	LightServ          int  `toml:",omitempty"` // Maximum percentage of time allowed for serving LES requests
 	LightIngress       int  `toml:",omitempty"` // Incoming bandwidth limit for light servers
@@ -187,6 +200,10 @@ type Config struct {
 	SnapshotCache           int
 	Preimages               bool
 
+	// Attestation pool options
+	AttestationJournal   string        `toml:",omitempty"` // Disk journal for the attestation pool to survive node restarts
+	AttestationRejournal time.Duration `toml:",omitempty"` // Time interval to regenerate the attestation journal
+
 	// Mining options
 	Miner miner.Config
 
@@ -18,48 +18,54 @@ import (
 // MarshalTOML marshals as TOML.
 func (c Config) MarshalTOML() (interface{}, error) {
 	type Config struct {
-		Genesis                 *core.Genesis `toml:",omitempty"`
-		NetworkId               uint64
-		SyncMode                downloader.SyncMode
-		EthDiscoveryURLs        []string
-		SnapDiscoveryURLs       []string
-		NoPruning               bool
-		NoPrefetch              bool
-		TxLookupLimit           uint64                 `toml:",omitempty"`
-		Whitelist               map[uint64]common.Hash `toml:"-"`
-		LightServ               int                    `toml:",omitempty"`
-		LightIngress            int                    `toml:",omitempty"`
-		LightEgress             int                    `toml:",omitempty"`
-		LightPeers              int                    `toml:",omitempty"`
-		LightNoPrune            bool                   `toml:",omitempty"`
-		LightNoSyncServe        bool                   `toml:",omitempty"`
-		SyncFromCheckpoint      bool                   `toml:",omitempty"`
-		UltraLightServers       []string               `toml:",omitempty"`
-		UltraLightFraction      int                    `toml:",omitempty"`
-		UltraLightOnlyAnnounce  bool                   `toml:",omitempty"`
-		SkipBcVersionCheck      bool                   `toml:"-"`
-		DatabaseHandles         int                    `toml:"-"`
-		DatabaseCache           int
-		DatabaseFreezer         string
-		TrieCleanCache          int
-		TrieCleanCacheJournal   string        `toml:",omitempty"`
-		TrieCleanCacheRejournal time.Duration `toml:",omitempty"`
-		TrieDirtyCache          int
-		TrieTimeout             time.Duration
-		SnapshotCache           int
-		Preimages               bool
-		Miner                   miner.Config
-		Ethash                  ethash.Config
-		TxPool                  core.TxPoolConfig
-		GPO                     gasprice.Config
-		EnablePreimageRecording bool
-		DocRoot                 string `toml:"-"`
-		RPCGasCap               uint64
-		RPCEVMTimeout           time.Duration
-		RPCTxFeeCap             float64
-		Checkpoint              *params.TrustedCheckpoint      `toml:",omitempty"`
-		CheckpointOracle        *params.CheckpointOracleConfig `toml:",omitempty"`
-		OverrideArrowGlacier    *big.Int                       `toml:",omitempty"`
+		Genesis                   *core.Genesis `toml:",omitempty"`
+		NetworkId                 uint64
+		SyncMode                  downloader.SyncMode
+		EthDiscoveryURLs          []string
+		SnapDiscoveryURLs         []string
+		ValidatorMesh             bool `toml:",omitempty"`
+		NoPruning                 bool
+		NoPrefetch                bool
+		TxLookupLimit             uint64                 `toml:",omitempty"`
+		ValidatorPerformanceIndex bool                   `toml:",omitempty"`
+		PunishmentHistoryIndex    bool                   `toml:",omitempty"`
+		Whitelist                 map[uint64]common.Hash `toml:"-"`
+		SyncCheckpoint            *params.SyncCheckpoint `toml:",omitempty"`
+		LightServ                 int                    `toml:",omitempty"`
+		LightIngress              int                    `toml:",omitempty"`
+		LightEgress               int                    `toml:",omitempty"`
+		LightPeers                int                    `toml:",omitempty"`
+		LightNoPrune              bool                   `toml:",omitempty"`
+		LightNoSyncServe          bool                   `toml:",omitempty"`
+		SyncFromCheckpoint        bool                   `toml:",omitempty"`
+		UltraLightServers         []string               `toml:",omitempty"`
+		UltraLightFraction        int                    `toml:",omitempty"`
+		UltraLightOnlyAnnounce    bool                   `toml:",omitempty"`
+		SkipBcVersionCheck        bool                   `toml:"-"`
+		DatabaseHandles           int                    `toml:"-"`
+		DatabaseCache             int
+		DatabaseFreezer           string
+		TrieCleanCache            int
+		TrieCleanCacheJournal     string        `toml:",omitempty"`
+		TrieCleanCacheRejournal   time.Duration `toml:",omitempty"`
+		TrieDirtyCache            int
+		TrieTimeout               time.Duration
+		SnapshotCache             int
+		Preimages                 bool
+		AttestationJournal        string        `toml:",omitempty"`
+		AttestationRejournal      time.Duration `toml:",omitempty"`
+		Miner                     miner.Config
+		Ethash                    ethash.Config
+		TxPool                    core.TxPoolConfig
+		GPO                       gasprice.Config
+		EnablePreimageRecording   bool
+		DocRoot                   string `toml:"-"`
+		RPCGasCap                 uint64
+		RPCEVMTimeout             time.Duration
+		RPCTxFeeCap               float64
+		Checkpoint                *params.TrustedCheckpoint      `toml:",omitempty"`
+		CheckpointOracle          *params.CheckpointOracleConfig `toml:",omitempty"`
+		OverrideArrowGlacier      *big.Int                       `toml:",omitempty"`
 	}
 	var enc Config
 	enc.Genesis = c.Genesis
@@ -67,10 +73,14 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.SyncMode = c.SyncMode
 	enc.EthDiscoveryURLs = c.EthDiscoveryURLs
 	enc.SnapDiscoveryURLs = c.SnapDiscoveryURLs
+	enc.ValidatorMesh = c.ValidatorMesh
 	enc.NoPruning = c.NoPruning
 	enc.NoPrefetch = c.NoPrefetch
 	enc.TxLookupLimit = c.TxLookupLimit
+	enc.ValidatorPerformanceIndex = c.ValidatorPerformanceIndex
+	enc.PunishmentHistoryIndex = c.PunishmentHistoryIndex
 	enc.Whitelist = c.Whitelist
+	enc.SyncCheckpoint = c.SyncCheckpoint
 	enc.LightServ = c.LightServ
 	enc.LightIngress = c.LightIngress
 	enc.LightEgress = c.LightEgress
@@ -92,6 +102,8 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.TrieTimeout = c.TrieTimeout
 	enc.SnapshotCache = c.SnapshotCache
 	enc.Preimages = c.Preimages
+	enc.AttestationJournal = c.AttestationJournal
+	enc.AttestationRejournal = c.AttestationRejournal
 	enc.Miner = c.Miner
 	enc.Ethash = c.Ethash
 	enc.TxPool = c.TxPool
@@ -110,48 +122,54 @@ func (c Config) MarshalTOML() (interface{}, error) {
 // UnmarshalTOML unmarshals from TOML.
 func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	type Config struct {
-		Genesis                 *core.Genesis `toml:",omitempty"`
-		NetworkId               *uint64
-		SyncMode                *downloader.SyncMode
-		EthDiscoveryURLs        []string
-		SnapDiscoveryURLs       []string
-		NoPruning               *bool
-		NoPrefetch              *bool
-		TxLookupLimit           *uint64                `toml:",omitempty"`
-		Whitelist               map[uint64]common.Hash `toml:"-"`
-		LightServ               *int                   `toml:",omitempty"`
-		LightIngress            *int                   `toml:",omitempty"`
-		LightEgress             *int                   `toml:",omitempty"`
-		LightPeers              *int                   `toml:",omitempty"`
-		LightNoPrune            *bool                  `toml:",omitempty"`
-		LightNoSyncServe        *bool                  `toml:",omitempty"`
-		SyncFromCheckpoint      *bool                  `toml:",omitempty"`
-		UltraLightServers       []string               `toml:",omitempty"`
-		UltraLightFraction      *int                   `toml:",omitempty"`
-		UltraLightOnlyAnnounce  *bool                  `toml:",omitempty"`
-		SkipBcVersionCheck      *bool                  `toml:"-"`
-		DatabaseHandles         *int                   `toml:"-"`
-		DatabaseCache           *int
-		DatabaseFreezer         *string
-		TrieCleanCache          *int
-		TrieCleanCacheJournal   *string        `toml:",omitempty"`
-		TrieCleanCacheRejournal *time.Duration `toml:",omitempty"`
-		TrieDirtyCache          *int
-		TrieTimeout             *time.Duration
-		SnapshotCache           *int
-		Preimages               *bool
-		Miner                   *miner.Config
-		Ethash                  *ethash.Config
-		TxPool                  *core.TxPoolConfig
-		GPO                     *gasprice.Config
-		EnablePreimageRecording *bool
-		DocRoot                 *string `toml:"-"`
-		RPCGasCap               *uint64
-		RPCEVMTimeout           *time.Duration
-		RPCTxFeeCap             *float64
-		Checkpoint              *params.TrustedCheckpoint      `toml:",omitempty"`
-		CheckpointOracle        *params.CheckpointOracleConfig `toml:",omitempty"`
-		OverrideArrowGlacier    *big.Int                       `toml:",omitempty"`
+		Genesis                   *core.Genesis `toml:",omitempty"`
+		NetworkId                 *uint64
+		SyncMode                  *downloader.SyncMode
+		EthDiscoveryURLs          []string
+		SnapDiscoveryURLs         []string
+		ValidatorMesh             *bool `toml:",omitempty"`
+		NoPruning                 *bool
+		NoPrefetch                *bool
+		TxLookupLimit             *uint64                `toml:",omitempty"`
+		ValidatorPerformanceIndex *bool                  `toml:",omitempty"`
+		PunishmentHistoryIndex    *bool                  `toml:",omitempty"`
+		Whitelist                 map[uint64]common.Hash `toml:"-"`
+		SyncCheckpoint            *params.SyncCheckpoint `toml:",omitempty"`
+		LightServ                 *int                   `toml:",omitempty"`
+		LightIngress              *int                   `toml:",omitempty"`
+		LightEgress               *int                   `toml:",omitempty"`
+		LightPeers                *int                   `toml:",omitempty"`
+		LightNoPrune              *bool                  `toml:",omitempty"`
+		LightNoSyncServe          *bool                  `toml:",omitempty"`
+		SyncFromCheckpoint        *bool                  `toml:",omitempty"`
+		UltraLightServers         []string               `toml:",omitempty"`
+		UltraLightFraction        *int                   `toml:",omitempty"`
+		UltraLightOnlyAnnounce    *bool                  `toml:",omitempty"`
+		SkipBcVersionCheck        *bool                  `toml:"-"`
+		DatabaseHandles           *int                   `toml:"-"`
+		DatabaseCache             *int
+		DatabaseFreezer           *string
+		TrieCleanCache            *int
+		TrieCleanCacheJournal     *string        `toml:",omitempty"`
+		TrieCleanCacheRejournal   *time.Duration `toml:",omitempty"`
+		TrieDirtyCache            *int
+		TrieTimeout               *time.Duration
+		SnapshotCache             *int
+		Preimages                 *bool
+		AttestationJournal        *string        `toml:",omitempty"`
+		AttestationRejournal      *time.Duration `toml:",omitempty"`
+		Miner                     *miner.Config
+		Ethash                    *ethash.Config
+		TxPool                    *core.TxPoolConfig
+		GPO                       *gasprice.Config
+		EnablePreimageRecording   *bool
+		DocRoot                   *string `toml:"-"`
+		RPCGasCap                 *uint64
+		RPCEVMTimeout             *time.Duration
+		RPCTxFeeCap               *float64
+		Checkpoint                *params.TrustedCheckpoint      `toml:",omitempty"`
+		CheckpointOracle          *params.CheckpointOracleConfig `toml:",omitempty"`
+		OverrideArrowGlacier      *big.Int                       `toml:",omitempty"`
 	}
 	var dec Config
 	if err := unmarshal(&dec); err != nil {
@@ -172,6 +190,9 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.SnapDiscoveryURLs != nil {
 		c.SnapDiscoveryURLs = dec.SnapDiscoveryURLs
 	}
+	if dec.ValidatorMesh != nil {
+		c.ValidatorMesh = *dec.ValidatorMesh
+	}
 	if dec.NoPruning != nil {
 		c.NoPruning = *dec.NoPruning
 	}
@@ -181,9 +202,18 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.TxLookupLimit != nil {
 		c.TxLookupLimit = *dec.TxLookupLimit
 	}
+	if dec.ValidatorPerformanceIndex != nil {
+		c.ValidatorPerformanceIndex = *dec.ValidatorPerformanceIndex
+	}
+	if dec.PunishmentHistoryIndex != nil {
+		c.PunishmentHistoryIndex = *dec.PunishmentHistoryIndex
+	}
 	if dec.Whitelist != nil {
 		c.Whitelist = dec.Whitelist
 	}
+	if dec.SyncCheckpoint != nil {
+		c.SyncCheckpoint = dec.SyncCheckpoint
+	}
 	if dec.LightServ != nil {
 		c.LightServ = *dec.LightServ
 	}
@@ -247,6 +277,12 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.Preimages != nil {
 		c.Preimages = *dec.Preimages
 	}
+	if dec.AttestationJournal != nil {
+		c.AttestationJournal = *dec.AttestationJournal
+	}
+	if dec.AttestationRejournal != nil {
+		c.AttestationRejournal = *dec.AttestationRejournal
+	}
 	if dec.Miner != nil {
 		c.Miner = *dec.Miner
 	}
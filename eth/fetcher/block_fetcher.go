@@ -99,6 +99,7 @@ type chainInsertFn func(types.Blocks) (int, error)
 type peerDropFn func(id string)
 
 // continousInturnFn is a callback type for getting continous block in turn number
+// on top of the given block of the local chain
 type continousInturnFn func(blockNumber *big.Int) uint64
 
 // blockAnnounce is the hash notification of the availability of a new block in the
@@ -191,7 +192,7 @@ type BlockFetcher struct {
 	insertHeaders   headersInsertFn    // Injects a batch of headers into the chain
 	insertChain     chainInsertFn      // Injects a batch of blocks into the chain
 	dropPeer        peerDropFn         // Drops a peer for misbehaving
-	continousInturn uint64             // Gets continous blocks in turn number
+	continousInturn continousInturnFn  // Gets continous blocks in turn number
 
 	// Testing hooks
 	announceChangeHook func(common.Hash, bool)           // Method to call upon adding or deleting a hash from the blockAnnounce list
@@ -202,7 +203,7 @@ type BlockFetcher struct {
 }
 
 // NewBlockFetcher creates a block fetcher to retrieve blocks based on hash announcements.
-func NewBlockFetcher(light bool, getHeader HeaderRetrievalFn, getBlock blockRetrievalFn, verifyHeader headerVerifierFn, broadcastBlock blockBroadcasterFn, chainHeight chainHeightFn, insertHeaders headersInsertFn, insertChain chainInsertFn, dropPeer peerDropFn, continousInturn uint64) *BlockFetcher {
+func NewBlockFetcher(light bool, getHeader HeaderRetrievalFn, getBlock blockRetrievalFn, verifyHeader headerVerifierFn, broadcastBlock blockBroadcasterFn, chainHeight chainHeightFn, insertHeaders headersInsertFn, insertChain chainInsertFn, dropPeer peerDropFn, continousInturn continousInturnFn) *BlockFetcher {
 	return &BlockFetcher{
 		light:           light,
 		notify:          make(chan *blockAnnounce),
@@ -364,7 +365,7 @@ func (f *BlockFetcher) loop() {
 			// If too high up the chain or phase, continue later
 			number := op.number()
 			if number > height+1 {
-				if !f.hasParent(op) || number > height+f.continousInturn+1 {
+				if !f.hasParent(op) || number > height+f.continousInturn(new(big.Int).SetUint64(height))+1 {
 					f.queue.Push(op, -int64(number))
 					if f.queueChangeHook != nil {
 						f.queueChangeHook(hash, true)
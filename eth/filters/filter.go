@@ -145,6 +145,21 @@ func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
 	if f.end == -1 {
 		end = head
 	}
+	// Resolve the safe and finalized tags through the block status of the chain
+	if f.begin == rpc.SafeBlockNumber.Int64() || f.begin == rpc.FinalizedBlockNumber.Int64() {
+		header, err := f.backend.HeaderByNumber(ctx, rpc.BlockNumber(f.begin))
+		if header == nil {
+			return nil, err
+		}
+		f.begin = header.Number.Int64()
+	}
+	if f.end == rpc.SafeBlockNumber.Int64() || f.end == rpc.FinalizedBlockNumber.Int64() {
+		header, err := f.backend.HeaderByNumber(ctx, rpc.BlockNumber(f.end))
+		if header == nil {
+			return nil, err
+		}
+		end = header.Number.Uint64()
+	}
 
 	if (int64(end) - f.begin) > maxFilterBlockRange {
 		return nil, fmt.Errorf("exceed maximum block range: %d", maxFilterBlockRange)
@@ -167,6 +167,12 @@ func (oracle *Oracle) resolveBlockRange(ctx context.Context, lastBlock rpc.Block
 	}
 	if lastBlock == rpc.LatestBlockNumber {
 		lastBlock = headBlock
+	} else if lastBlock == rpc.SafeBlockNumber || lastBlock == rpc.FinalizedBlockNumber {
+		header, err := oracle.backend.HeaderByNumber(ctx, lastBlock)
+		if header == nil {
+			return nil, nil, 0, 0, err
+		}
+		lastBlock = rpc.BlockNumber(header.Number.Uint64())
 	} else if pendingBlock == nil && lastBlock > headBlock {
 		return nil, nil, 0, 0, fmt.Errorf("%w: requested %d, head %d", errRequestBeyondHead, lastBlock, headBlock)
 	}
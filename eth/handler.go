@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
 	"github.com/QEasyWeb3/QEasyChain/core"
 	"github.com/QEasyWeb3/QEasyChain/core/forkid"
 	"github.com/QEasyWeb3/QEasyChain/core/types"
@@ -92,6 +93,8 @@ type handlerConfig struct {
 	EventMux   *event.TypeMux            // Legacy event mux, deprecate for `feed`
 	Checkpoint *params.TrustedCheckpoint // Hard coded checkpoint for sync challenges
 	Whitelist  map[uint64]common.Hash    // Hard coded whitelist for sync challenged
+
+	SyncCheckpoint *params.SyncCheckpoint // Trusted finalized block the peers' chains must include
 }
 
 type handler struct {
@@ -123,6 +126,8 @@ type handler struct {
 	reannoTxsSub  event.Subscription
 	naCh          chan core.NewAttestationEvent
 	naSub         event.Subscription
+	naaCh         chan core.NewAggregateAttestationEvent
+	naaSub        event.Subscription
 	njfCh         chan core.NewJustifiedOrFinalizedBlockEvent
 	njfSub        event.Subscription
 	minedBlockSub *event.TypeMuxSubscription
@@ -185,6 +190,11 @@ func newHandler(config *handlerConfig) (*handler, error) {
 		h.checkpointNumber = (config.Checkpoint.SectionIndex+1)*params.CHTFrequency - 1
 		h.checkpointHash = config.Checkpoint.SectionHead
 	}
+	// A more recent trusted finalized block takes over the sync challenges
+	if cp := config.SyncCheckpoint; cp != nil && cp.Number > h.checkpointNumber {
+		h.checkpointNumber = cp.Number
+		h.checkpointHash = cp.Hash
+	}
 	// Construct the downloader (long sync) and its backing state bloom if fast
 	// sync is requested. The downloader is responsible for deallocating the state
 	// bloom when it's done.
@@ -195,7 +205,7 @@ func newHandler(config *handlerConfig) (*handler, error) {
 	if atomic.LoadUint32(&h.fastSync) == 1 && atomic.LoadUint32(&h.snapSync) == 0 {
 		h.stateBloom = trie.NewSyncBloom(config.BloomCache, config.Database)
 	}
-	h.downloader = downloader.New(h.checkpointNumber, config.Database, h.stateBloom, h.eventMux, h.chain, nil, h.removePeer)
+	h.downloader = downloader.New(h.checkpointNumber, h.checkpointHash, config.Database, h.stateBloom, h.eventMux, h.chain, nil, h.removePeer, h.finalityAnchor, h.finalityCertified)
 
 	// Construct the fetcher (short sync)
 	validator := func(header *types.Header) error {
@@ -230,7 +240,20 @@ func newHandler(config *handlerConfig) (*handler, error) {
 		}
 		return n, err
 	}
-	h.blockFetcher = fetcher.NewBlockFetcher(false, nil, h.chain.GetBlockByHash, validator, h.BroadcastBlock, heighter, nil, inserter, h.removePeer, params.ContinousInturn)
+	continousInturn := func(number *big.Int) uint64 {
+		// The in-turn validator seals several blocks in a row, so the blocks up to the end
+		// of its turn may be propagated before the ones they build on
+		democracy, ok := h.chain.Engine().(consensus.Democracy)
+		if !ok {
+			return params.ContinousInturn
+		}
+		header := h.chain.GetHeaderByNumber(number.Uint64())
+		if header == nil {
+			header = h.chain.CurrentHeader()
+		}
+		return democracy.ContinuousInturn(h.chain, header)
+	}
+	h.blockFetcher = fetcher.NewBlockFetcher(false, nil, h.chain.GetBlockByHash, validator, h.BroadcastBlock, heighter, nil, inserter, h.removePeer, continousInturn)
 
 	fetchTx := func(peer string, hashes []common.Hash) error {
 		p := h.peers.peer(peer)
@@ -288,9 +311,10 @@ func (h *handler) runEthPeer(peer *eth.Peer, handler eth.Handler) error {
 			}
 		}
 	}
-	// Ignore maxPeers if this is a trusted peer
-	if !peer.Peer.Info().Network.Trusted {
-		if reject || h.peers.len() >= h.maxPeers {
+	// Ignore maxPeers if this is a trusted or protected peer, the protected
+	// ones don't count toward it either
+	if !peer.Peer.Info().Network.Trusted && !peer.Peer.Protected() {
+		if reject || h.peers.len()-h.peers.protectedLen() >= h.maxPeers {
 			return p2p.DiscTooManyPeers
 		}
 	}
@@ -380,9 +404,28 @@ func (h *handler) runConsExtension(peer *cons.Peer, handler cons.Handler) error
 		peer.Log().Error("Cons extension registration failed", "err", err)
 		return err
 	}
+	// Announce the last finalized block, letting a syncing peer anchor its pivot on it
+	if bs := h.lastFinalized(); bs != nil {
+		peer.AsyncSendNewJustifiedOrFinalizedBlock(bs)
+	}
 	return handler(peer)
 }
 
+// lastFinalized returns the status of the last finalized block of the local chain, or
+// nil if none is finalized yet. Justifying a block finalizes its parent.
+func (h *handler) lastFinalized() *types.BlockStatus {
+	last, err := h.chain.LastValidJustifiedOrFinalized()
+	if err != nil {
+		return nil
+	}
+	for number := last.Number.Uint64(); number > 0 && number+1 >= last.Number.Uint64(); number-- {
+		if status, hash := h.chain.GetBlockStatusByNum(number); status == types.BasFinalized {
+			return &types.BlockStatus{BlockNumber: new(big.Int).SetUint64(number), Hash: hash, Status: status}
+		}
+	}
+	return nil
+}
+
 // removePeer requests disconnection of a peer.
 func (h *handler) removePeer(id string) {
 	peer := h.peers.peer(id)
@@ -448,6 +491,12 @@ func (h *handler) Start(maxPeers int) {
 	h.naSub = h.chain.SubscribeNewAttestationEvent(h.naCh)
 	go h.newAttestationBroadcastLoop()
 
+	// broadcast aggregate attestations
+	h.wg.Add(1)
+	h.naaCh = make(chan core.NewAggregateAttestationEvent, naChanSize)
+	h.naaSub = h.chain.SubscribeNewAggregateAttestationEvent(h.naaCh)
+	go h.newAggregateAttestationBroadcastLoop()
+
 	// broadcast justified or finalized block
 	h.wg.Add(1)
 	h.njfCh = make(chan core.NewJustifiedOrFinalizedBlockEvent, njfChanSize)
@@ -464,6 +513,7 @@ func (h *handler) Stop() {
 	h.reannoTxsSub.Unsubscribe()  // quits txReannounceLoop
 	h.minedBlockSub.Unsubscribe() // quits blockBroadcastLoop
 	h.naSub.Unsubscribe()         // quits newAttestationBroadcastLoop
+	h.naaSub.Unsubscribe()        // quits newAggregateAttestationBroadcastLoop
 	h.njfSub.Unsubscribe()        // quits newJustifiedOrFinalizedBlockBroadcastLoop
 
 	// Quit chainSync and txsync64.
@@ -577,15 +627,34 @@ func (h *handler) ReannounceTransactions(txs types.Transactions) {
 		"announce packs", peersCount, "announced hashes", peersCount*uint(len(hashes)))
 }
 
+// BroadcastAttestationToOtherNodes propagates an attestation to the peers which don't have it yet.
+// It's sent in full to a square root of them, the `cons/1` peers included, and announced to the
+// remaining `cons/2` peers, which retrieve it on demand.
 func (h *handler) BroadcastAttestationToOtherNodes(a *types.Attestation) {
-	peers := h.peers.peersWithoutAttestation(a.Hash())
-	//log.Debug("BroadcastAttestationToOtherNodes", "peers", len(peers),
-	//"hash", a.TargetRangeEdge.Hash, "number", a.TargetRangeEdge.Number.Uint64())
-	// Send the attestation to a subset of our peers
-	transfer := peers //[:int(math.Sqrt(float64(len(peers))))]
-	for _, peer := range transfer {
-		log.Info("metric", "method", "BroadcastAttestationToOtherNodes", "peer", peer.ID(), "hash", a.TargetRangeEdge.Hash.String(), "number", a.TargetRangeEdge.Number.Uint64())
-		peer.AsyncSendNewAttestation(a)
+	var (
+		peers  = h.peers.peersWithoutAttestation(a.Hash())
+		direct = int(math.Sqrt(float64(len(peers))))
+		sent   int
+		ann    = cons.AttestationAnnouncement{Hash: a.Hash(), Number: a.TargetRangeEdge.Number, Target: a.TargetRangeEdge.Hash}
+	)
+	for _, peer := range peers {
+		if peer.Version() < cons.CONS2 || sent < direct {
+			log.Info("metric", "method", "BroadcastAttestationToOtherNodes", "peer", peer.ID(), "hash", a.TargetRangeEdge.Hash.String(), "number", a.TargetRangeEdge.Number.Uint64())
+			peer.AsyncSendNewAttestation(a)
+			sent++
+			continue
+		}
+		peer.AsyncSendAttestationHashes([]cons.AttestationAnnouncement{ann})
+	}
+}
+
+// BroadcastAggregateAttestationToOtherNodes propagates an aggregate attestation to the
+// `cons/2` peers which don't have it yet, `cons/1` doesn't carry aggregates.
+func (h *handler) BroadcastAggregateAttestationToOtherNodes(a *types.AggregateAttestation) {
+	for _, peer := range h.peers.peersWithoutAttestation(a.Hash()) {
+		if peer.Version() >= cons.CONS2 {
+			peer.AsyncSendNewAggregateAttestation(a)
+		}
 	}
 }
 
@@ -649,6 +718,18 @@ func (h *handler) newAttestationBroadcastLoop() {
 	}
 }
 
+func (h *handler) newAggregateAttestationBroadcastLoop() {
+	defer h.wg.Done()
+	for {
+		select {
+		case naa := <-h.naaCh:
+			h.BroadcastAggregateAttestationToOtherNodes(naa.A)
+		case <-h.naaSub.Err():
+			return
+		}
+	}
+}
+
 func (h *handler) newJustifiedOrFinalizedBlockBroadcastLoop() {
 	defer h.wg.Done()
 	for {
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
 	"github.com/QEasyWeb3/QEasyChain/core"
 	"github.com/QEasyWeb3/QEasyChain/core/types"
 	"github.com/QEasyWeb3/QEasyChain/eth/protocols/eth"
@@ -200,6 +201,10 @@ func (h *ethHandler) handleBlockAnnounces(peer *eth.Peer, hashes []common.Hash,
 // handleBlockBroadcast is invoked from a peer's message handler when it transmits a
 // block broadcast for the local node to process.
 func (h *ethHandler) handleBlockBroadcast(peer *eth.Peer, block *types.Block, td *big.Int) error {
+	// Look for conflicting headers sealed by the same validator
+	if democracy, ok := h.chain.Engine().(consensus.Democracy); ok {
+		democracy.CheckDoubleSeal(h.chain, block.Header())
+	}
 	// Schedule the block for import
 	h.blockFetcher.Enqueue(peer.ID(), block)
 
@@ -77,7 +77,8 @@ func (p *snapPeer) info() *snapPeerInfo {
 // consPeerInfo represents a short summary of the `cons` sub-protocol metadata known
 // about a connected peer.
 type consPeerInfo struct {
-	Version uint `json:"version"` // cons protocol version negotiated
+	Version uint  `json:"version"` // cons protocol version negotiated
+	Score   int64 `json:"score"`   // Misbehaviour score, the peer is banned when it drops too low
 }
 
 // consPeer is a wrapper around cons.Peer to maintain a few extra metadata.
@@ -89,5 +90,6 @@ type consPeer struct {
 func (p *consPeer) info() *consPeerInfo {
 	return &consPeerInfo{
 		Version: p.Version(),
+		Score:   p.Score(),
 	}
 }
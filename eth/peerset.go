@@ -22,6 +22,7 @@ import (
 	"sync"
 
 	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
 	"github.com/QEasyWeb3/QEasyChain/eth/protocols/cons"
 	"github.com/QEasyWeb3/QEasyChain/eth/protocols/eth"
 	"github.com/QEasyWeb3/QEasyChain/eth/protocols/snap"
@@ -251,6 +252,20 @@ func (ps *peerSet) peersWithoutJustifiedOrFinalizedBlock(hash common.Hash) []*co
 	return list
 }
 
+// finalizedReports retrieves the recent finalized blocks reported by every `cons` peer.
+func (ps *peerSet) finalizedReports() [][]*types.BlockStatus {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	reports := make([][]*types.BlockStatus, 0, len(ps.peers))
+	for _, p := range ps.peers {
+		if p.consExt != nil {
+			reports = append(reports, p.consExt.Finalized())
+		}
+	}
+	return reports
+}
+
 // peersWithoutTransaction retrieves a list of peers that do not have a given
 // transaction in their set of known hashes.
 func (ps *peerSet) peersWithoutTransaction(hash common.Hash) []*ethPeer {
@@ -284,6 +299,21 @@ func (ps *peerSet) snapLen() int {
 	return ps.snapPeers
 }
 
+// protectedLen returns the number of protected peers in the set, which don't count
+// toward the peer limits.
+func (ps *peerSet) protectedLen() int {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	count := 0
+	for _, p := range ps.peers {
+		if p.Peer.Peer.Protected() {
+			count++
+		}
+	}
+	return count
+}
+
 // peerWithHighestTD retrieves the known peer with the currently highest total
 // difficulty.
 func (ps *peerSet) peerWithHighestTD() *eth.Peer {
@@ -33,6 +33,23 @@ func (p *Peer) broadcastAttestationsLoop() {
 	}
 }
 
+func (p *Peer) broadcastAggregateAttestationsLoop() {
+	for {
+		select {
+		case a := <-p.queuedAggregateAttestations:
+			if err := p.SendNewAggregateAttestation(a); err != nil {
+				p.Log().Trace(err.Error())
+				return
+			}
+			p.Log().Trace("Propagated aggregate attestation", "number",
+				a.TargetRangeEdge.Number.Uint64(), "hash", a.TargetRangeEdge.Hash, "signers", a.Count())
+
+		case <-p.term:
+			return
+		}
+	}
+}
+
 func (p *Peer) broadcastJustifiedOrFinalizedBlockLoop() {
 	for {
 		select {
@@ -48,3 +65,55 @@ func (p *Peer) broadcastJustifiedOrFinalizedBlockLoop() {
 		}
 	}
 }
+
+// announceAttestationsLoop is a write loop that schedules attestation announcements
+// to be sent to a `cons/2` peer. The announcements are batched up to the packet
+// limit, so a burst of new attestations takes a single round trip.
+func (p *Peer) announceAttestationsLoop() {
+	var (
+		queue []AttestationAnnouncement // Queue of announcements to send to the peer
+		done  chan struct{}             // Non-nil if background announcer is running
+		fail  = make(chan error, 1)     // Channel used to receive network error
+	)
+	for {
+		// If there's no in-flight announce running, check if a new one is needed
+		if done == nil && len(queue) > 0 {
+			count := len(queue)
+			if count > maxAttestationAnnounces {
+				count = maxAttestationAnnounces
+			}
+			pending := make([]AttestationAnnouncement, count)
+			copy(pending, queue)
+			queue = queue[:copy(queue, queue[count:])]
+
+			done = make(chan struct{})
+			go func() {
+				if err := p.sendAttestationHashes(pending); err != nil {
+					fail <- err
+					return
+				}
+				close(done)
+				p.Log().Trace("Sent attestation announcements", "count", len(pending))
+			}()
+		}
+		// Transfer goroutine may or may not have been started, listen for events
+		select {
+		case anns := <-p.attestationAnnounce:
+			// New batch of announcements to be sent, queue them (with cap)
+			queue = append(queue, anns...)
+			if len(queue) > maxQueuedAttestationAnns {
+				// Fancy copy and resize to ensure buffer doesn't grow indefinitely
+				queue = queue[:copy(queue, queue[len(queue)-maxQueuedAttestationAnns:])]
+			}
+
+		case <-done:
+			done = nil
+
+		case <-fail:
+			return
+
+		case <-p.term:
+			return
+		}
+	}
+}
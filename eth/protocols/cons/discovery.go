@@ -17,6 +17,7 @@
 package cons
 
 import (
+	"github.com/QEasyWeb3/QEasyChain/common"
 	"github.com/QEasyWeb3/QEasyChain/rlp"
 )
 
@@ -30,3 +31,19 @@ type enrEntry struct {
 func (e enrEntry) ENRKey() string {
 	return "cons"
 }
+
+// ValidatorEntry is the ENR entry which advertises a node as run by a validator. It's
+// signed by the consensus key of the validator over the validator and the node id, so
+// that other validators can authenticate the node before peering with it directly.
+type ValidatorEntry struct {
+	Validator common.Address // Validator running the node
+	Signature []byte         // Signature of the consensus key, see types.ValidatorRecordData
+
+	// Ignore additional fields (for forward compatibility).
+	Rest []rlp.RawValue `rlp:"tail"`
+}
+
+// ENRKey implements enr.Entry.
+func (e ValidatorEntry) ENRKey() string {
+	return "val"
+}
@@ -48,6 +48,22 @@ const (
 	// If we spend too much time, then it's a fairly high chance of timing out
 	// at the remote side, which means all the work is in vain.
 	maxTrieNodeTimeSpent = 5 * time.Second
+
+	// maxAttestationAnnounces is the maximum number of attestations announced by, or
+	// requested with, a single `cons/2` packet.
+	maxAttestationAnnounces = 64
+
+	// maxAttestationRange is the maximum number of blocks whose attestations are
+	// retrieved by a single range request.
+	maxAttestationRange = 128
+
+	// softRangeResponseLimit is the target maximum size of attestation range replies.
+	softRangeResponseLimit = 512 * 1024
+
+	// estAttestationSize and estAggregateSize are the approximate encoded sizes of an
+	// attestation and of an aggregate attestation, used to cap the range replies.
+	estAttestationSize = 160
+	estAggregateSize   = 256
 )
 
 // Handler is a callback to invoke from an outside runner after the boilerplate
@@ -87,6 +103,11 @@ func MakeProtocols(backend Backend, dnsDisc enode.Iterator) []p2p.Protocol {
 		return n.Load(&cons) == nil
 	})
 
+	var (
+		scheduler = newAttestationScheduler(backend)
+		bans      = newBanList()
+	)
+
 	protocols := make([]p2p.Protocol, len(ProtocolVersions))
 	for i, version := range ProtocolVersions {
 		version := version // Closure
@@ -96,11 +117,11 @@ func MakeProtocols(backend Backend, dnsDisc enode.Iterator) []p2p.Protocol {
 			Version: version,
 			Length:  protocolLengths[version],
 			Run: func(p *p2p.Peer, rw p2p.MsgReadWriter) error {
-				peer := newPeer(version, p, rw)
+				peer := newPeer(version, p, rw, scheduler)
 				defer peer.Close()
 
 				return backend.RunPeer(peer, func(peer *Peer) error {
-					return handle(backend, peer)
+					return handle(backend, peer, bans)
 				})
 			},
 			NodeInfo: func() interface{} {
@@ -126,13 +147,23 @@ func nodeInfo(chain *core.BlockChain) *NodeInfo {
 }
 
 // handle is the callback invoked to manage the life cycle of a `cons` peer.
-// When this function terminates, the peer is disconnected.
-func handle(backend Backend, peer *Peer) error {
+// When this function terminates, the peer is disconnected. Peers whose score
+// drops to the ban score are disconnected and refused until their ban expires.
+func handle(backend Backend, peer *Peer, bans *banList) error {
+	if bans.banned(peer.Peer.ID()) {
+		peer.Log().Debug("Refusing banned peer")
+		return errPeerBanned
+	}
 	for {
 		if err := handleMessage(backend, peer); err != nil {
 			peer.Log().Error("Message handling failed in `cons`", "err", err)
 			return err
 		}
+		if score := peer.Score(); score <= banScore {
+			peer.Log().Warn("Banning peer for invalid consensus messages", "score", score)
+			bans.ban(peer.Peer.ID())
+			return errPeerBanned
+		}
 	}
 }
 
@@ -149,6 +180,19 @@ var consHandle = map[uint64]msgHandler{
 	AttestationsMsg:                 handleAttestations,
 }
 
+var cons2Handle = map[uint64]msgHandler{
+	NewAttestationMsg:               handleNewAttestation,
+	NewJustifiedOrFinalizedBlockMsg: handleNewJustifiedOrFinalizedBlock,
+	GetAttestationsMsg:              handleGetAttestations,
+	AttestationsMsg:                 handleAttestations,
+	NewAggregateAttestationMsg:      handleNewAggregateAttestation,
+	NewAttestationHashesMsg:         handleNewAttestationHashes,
+	GetPooledAttestationsMsg:        handleGetPooledAttestations,
+	PooledAttestationsMsg:           handlePooledAttestations,
+	GetAttestationRangeMsg:          handleGetAttestationRange,
+	AttestationRangeMsg:             handleAttestationRange,
+}
+
 // handleMessage is invoked whenever an inbound message is received from a remote
 // peer. The remote connection is torn down upon returning any error.
 func handleMessage(backend Backend, peer *Peer) error {
@@ -158,12 +202,23 @@ func handleMessage(backend Backend, peer *Peer) error {
 		peer.Log().Error("ReadMsg error:", "err", err.Error())
 		return err
 	}
-	if msg.Size > maxMessageSize {
-		return fmt.Errorf("%w: %v > %v", errMsgTooLarge, msg.Size, maxMessageSize)
+	limit := uint32(maxMessageSize)
+	if msg.Code == PooledAttestationsMsg || msg.Code == AttestationRangeMsg {
+		limit = maxResponseSize
+	}
+	if msg.Size > limit {
+		return fmt.Errorf("%w: %v > %v", errMsgTooLarge, msg.Size, limit)
 	}
 	defer msg.Discard()
 
+	if !peer.score.allow(msg.Code) {
+		peer.penalize(rateLimitPenalty, "rate limit exceeded")
+		return nil
+	}
 	var handlers = consHandle
+	if peer.Version() >= CONS2 {
+		handlers = cons2Handle
+	}
 
 	// Track the amount of time it takes to serve the request and run the handler
 	if metrics.Enabled {
@@ -37,6 +37,20 @@ func handleNewAttestation(backend Backend, msg Decoder, peer *Peer) error {
 	err := backend.Chain().HandleAttestation(a)
 	if err != nil {
 		log.Warn(err.Error())
+		peer.penalize(attestationPenalty(err), err.Error())
+	}
+	return nil
+}
+
+func handleNewAggregateAttestation(backend Backend, msg Decoder, peer *Peer) error {
+	a := new(types.AggregateAttestation)
+	if err := msg.Decode(a); err != nil {
+		return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+	}
+	peer.knownAttestations.Add(a.Hash())
+	if err := backend.Chain().HandleAggregateAttestation(a); err != nil {
+		log.Warn(err.Error())
+		peer.penalize(attestationPenalty(err), err.Error())
 	}
 	return nil
 }
@@ -47,14 +61,29 @@ func handleNewJustifiedOrFinalizedBlock(backend Backend, msg Decoder, peer *Peer
 		return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
 	}
 	if bs.Status != types.BasJustified && bs.Status != types.BasFinalized {
-		return fmt.Errorf("status is error  %d", bs.Status)
+		peer.penalize(invalidStatusPenalty, fmt.Sprintf("status is error  %d", bs.Status))
+		return nil
 	}
 	status, hash := backend.Chain().GetBlockStatusByNum(bs.BlockNumber.Uint64())
+	if status != types.BasUnknown && hash != bs.Hash { // Not in theory
+		peer.penalize(contradictingStatusPenalty, fmt.Sprintf("hash inequality %v: %v", hash.String(), bs.Hash.String()))
+		return nil
+	}
+	if bs.Status == types.BasFinalized {
+		peer.markFinalized(&bs)
+	}
 	if status == types.BasUnknown { // not found
+		// cons/2 peers backfill the whole range of blocks since the last local justified one
+		if peer.Version() >= CONS2 {
+			if from, to, ok := attestationBackfillRange(backend, bs.BlockNumber.Uint64()); ok {
+				if peer.pendingRange() {
+					return nil
+				}
+				return peer.RequestAttestationRange(from, to)
+			}
+		}
 		// need to request the current block
 		return p2p.Send(peer.rw, GetAttestationsMsg, &types.RequestAttestation{BlockNumber: new(big.Int).Set(bs.BlockNumber), Hash: bs.Hash})
-	} else if hash != bs.Hash { // Not in theory
-		return fmt.Errorf("hash inequality %v: %v", hash.String(), bs.Hash.String())
 	}
 	if bs.Status == types.BasFinalized && status == types.BasJustified {
 		// need to request the next block
@@ -94,7 +123,157 @@ func handleAttestations(backend Backend, msg Decoder, peer *Peer) error {
 		if !peer.knownAttestations.Contains(a.Hash()) {
 			peer.knownAttestations.Add(a.Hash())
 		}
-		_ = backend.Chain().HandleAttestation(a.DeepCopy())
+		if err := backend.Chain().HandleAttestation(a.DeepCopy()); err != nil {
+			peer.penalize(attestationPenalty(err), err.Error())
+		}
+	}
+	return nil
+}
+
+// attestationBackfillRange returns the range of local blocks to retrieve the attestations of
+// when a peer justified the given block, starting after the last locally justified block.
+// The range is capped to the blocks whose state can still be settled, and is only returned
+// if it spans several blocks.
+func attestationBackfillRange(backend Backend, number uint64) (uint64, uint64, bool) {
+	chain := backend.Chain()
+	to := chain.CurrentBlock().NumberU64()
+	if number < to {
+		to = number
+	}
+	from := to
+	if last, err := chain.LastValidJustifiedOrFinalized(); err == nil {
+		from = last.Number.Uint64() + 1
+	}
+	if to >= maxAttestationRange && from <= to-maxAttestationRange {
+		from = to - maxAttestationRange + 1
+	}
+	return from, to, from < to
+}
+
+func handleNewAttestationHashes(backend Backend, msg Decoder, peer *Peer) error {
+	// New attestation announcement arrived, make sure we have
+	// a valid and fresh chain to handle them
+	if !backend.AcceptAttestation() {
+		return nil
+	}
+	var anns NewAttestationHashesPacket
+	if err := msg.Decode(&anns); err != nil {
+		return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+	}
+	if len(anns) > maxAttestationAnnounces {
+		return fmt.Errorf("%w: %d attestation announcements", errBadRequest, len(anns))
+	}
+	for _, ann := range anns {
+		if ann.Number == nil {
+			return fmt.Errorf("%w: announcement without target number", errDecode)
+		}
+		peer.knownAttestations.Add(ann.Hash)
+	}
+	return peer.scheduler.schedule(peer, anns)
+}
+
+func handleGetPooledAttestations(backend Backend, msg Decoder, peer *Peer) error {
+	var query GetPooledAttestationsPacket
+	if err := msg.Decode(&query); err != nil {
+		return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+	}
+	if len(query.Announcements) > maxAttestationAnnounces {
+		return fmt.Errorf("%w: %d attestations requested", errBadRequest, len(query.Announcements))
+	}
+	attestations := make([]*types.Attestation, 0, len(query.Announcements))
+	for _, ann := range query.Announcements {
+		if ann.Number == nil {
+			return fmt.Errorf("%w: announcement without target number", errDecode)
+		}
+		if a, err := backend.Chain().GetHistoryOneAttestation(ann.Number, ann.Target, ann.Hash); err == nil {
+			attestations = append(attestations, a)
+		}
+	}
+	return peer.ReplyPooledAttestations(query.RequestId, attestations)
+}
+
+func handlePooledAttestations(backend Backend, msg Decoder, peer *Peer) error {
+	var res PooledAttestationsPacket
+	if err := msg.Decode(&res); err != nil {
+		return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+	}
+	req, ok := peer.fulfilRequest(res.RequestId, PooledAttestationsMsg)
+	if !ok {
+		peer.penalize(unsolicitedResponsePenalty, "unsolicited pooled attestations")
+		return nil
+	}
+	if len(res.Attestations) > maxAttestationAnnounces {
+		return fmt.Errorf("%w: %d attestations delivered", errBadRequest, len(res.Attestations))
+	}
+	for _, a := range res.Attestations {
+		// Only the requested attestations are accepted, each of them once
+		hash := a.Hash()
+		if _, ok := req.hashes[hash]; !ok {
+			peer.penalize(unsolicitedResponsePenalty, fmt.Sprintf("unrequested pooled attestation %x", hash))
+			continue
+		}
+		delete(req.hashes, hash)
+
+		peer.knownAttestations.Add(hash)
+		peer.scheduler.delivered(hash)
+		if err := backend.Chain().HandleAttestation(a); err != nil {
+			log.Debug("Failed to handle pooled attestation", "err", err)
+			peer.penalize(attestationPenalty(err), err.Error())
+		}
+	}
+	return nil
+}
+
+func handleGetAttestationRange(backend Backend, msg Decoder, peer *Peer) error {
+	var query GetAttestationRangePacket
+	if err := msg.Decode(&query); err != nil {
+		return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+	}
+	if query.From > query.To || query.To-query.From >= maxAttestationRange {
+		return fmt.Errorf("%w: attestation range %d-%d", errBadRequest, query.From, query.To)
+	}
+	var (
+		chain        = backend.Chain()
+		attestations []*types.Attestation
+		aggregates   []*types.AggregateAttestation
+		bytes        int
+	)
+	for number := query.From; number <= query.To && bytes < softRangeResponseLimit; number++ {
+		header := chain.GetHeaderByNumber(number)
+		if header == nil {
+			break
+		}
+		// Attestations evicted from the caches are served from the certificates in the chain
+		as, aggs := chain.GetBlockAttestations(header.Number, header.Hash())
+		attestations = append(attestations, as...)
+		aggregates = append(aggregates, aggs...)
+		bytes += len(as)*estAttestationSize + len(aggs)*estAggregateSize
+	}
+	return peer.ReplyAttestationRange(query.RequestId, attestations, aggregates)
+}
+
+func handleAttestationRange(backend Backend, msg Decoder, peer *Peer) error {
+	var res AttestationRangePacket
+	if err := msg.Decode(&res); err != nil {
+		return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+	}
+	if _, ok := peer.fulfilRequest(res.RequestId, AttestationRangeMsg); !ok {
+		peer.penalize(unsolicitedResponsePenalty, "unsolicited attestation range")
+		return nil
+	}
+	for _, a := range res.Attestations {
+		peer.knownAttestations.Add(a.Hash())
+		if err := backend.Chain().HandleBackfillAttestation(a); err != nil {
+			log.Debug("Failed to handle backfilled attestation", "err", err)
+			peer.penalize(attestationPenalty(err), err.Error())
+		}
+	}
+	for _, a := range res.Aggregates {
+		peer.knownAttestations.Add(a.Hash())
+		if err := backend.Chain().HandleBackfillAggregateAttestation(a); err != nil {
+			log.Debug("Failed to handle backfilled aggregate attestation", "err", err)
+			peer.penalize(attestationPenalty(err), err.Error())
+		}
 	}
 	return nil
 }
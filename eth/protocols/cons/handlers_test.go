@@ -0,0 +1,211 @@
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package cons
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus/ethash"
+	"github.com/QEasyWeb3/QEasyChain/core"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/core/vm"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+	"github.com/QEasyWeb3/QEasyChain/crypto/bls"
+	"github.com/QEasyWeb3/QEasyChain/ethdb"
+	"github.com/QEasyWeb3/QEasyChain/p2p"
+	"github.com/QEasyWeb3/QEasyChain/p2p/enode"
+	"github.com/QEasyWeb3/QEasyChain/params"
+	"github.com/QEasyWeb3/QEasyChain/rlp"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// testBackend is a mock backend serving the attestations of a small chain, without
+// any peer maintenance.
+type testBackend struct {
+	db    ethdb.Database
+	chain *core.BlockChain
+}
+
+// newTestBackend creates a chain of the given number of blocks, with the attestation
+// caches of the democracy engine, and wraps it into a mock backend.
+func newTestBackend(t *testing.T, blocks int) *testBackend {
+	db := rawdb.NewMemoryDatabase()
+	(&core.Genesis{Config: params.TestChainConfig}).MustCommit(db)
+
+	chain, err := core.NewBlockChain(db, nil, params.TestChainConfig, ethash.NewFaker(), vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	bs, _ := core.GenerateChain(params.TestChainConfig, chain.Genesis(), ethash.NewFaker(), db, blocks, nil)
+	if _, err := chain.InsertChain(bs); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	chain.HistoryAttessCache, _ = lru.New(64)
+	return &testBackend{db: db, chain: chain}
+}
+
+func (b *testBackend) Chain() *core.BlockChain                   { return b.chain }
+func (b *testBackend) RunPeer(peer *Peer, handler Handler) error { return handler(peer) }
+func (b *testBackend) PeerInfo(enode.ID) interface{}             { return nil }
+func (b *testBackend) Handle(*Peer, Packet) error                { return nil }
+func (b *testBackend) AcceptAttestation() bool                   { return true }
+
+// newTestPeer creates a `cons/2` peer connected to the returned remote end.
+func newTestPeer(backend Backend) (*Peer, *p2p.MsgPipeRW) {
+	app, net := p2p.MsgPipe()
+	return newPeer(CONS2, p2p.NewPeer(enode.ID{1}, "peer", nil), net, newAttestationScheduler(backend)), app
+}
+
+// newTestMsg encodes a packet into a message received from the network.
+func newTestMsg(t *testing.T, code uint64, packet interface{}) p2p.Msg {
+	size, r, err := rlp.EncodeToReader(packet)
+	if err != nil {
+		t.Fatalf("failed to encode packet: %v", err)
+	}
+	return p2p.Msg{Code: code, Size: uint32(size), Payload: r, ReceivedAt: time.Now()}
+}
+
+// signTestAttestation signs an attestation of the (source,target) pair with a new key.
+func signTestAttestation(t *testing.T, source, target *types.RangeEdge) *types.Attestation {
+	key, _ := crypto.GenerateKey()
+	sig, err := crypto.Sign(types.AttestationSignHash(source, target).Bytes(), key)
+	if err != nil {
+		t.Fatalf("failed to sign attestation: %v", err)
+	}
+	return types.NewAttestation(source, target, sig)
+}
+
+// Tests that the attestations of a range are served from the certificates embedded in
+// the chain once evicted from the caches, together with the aggregates of the blocks.
+func TestHandleGetAttestationRange(t *testing.T) {
+	backend := newTestBackend(t, 4)
+	defer backend.chain.Stop()
+
+	peer, remote := newTestPeer(backend)
+	defer peer.Close()
+
+	edge := func(number uint64) *types.RangeEdge {
+		header := backend.chain.GetHeaderByNumber(number)
+		return &types.RangeEdge{Hash: header.Hash(), Number: header.Number}
+	}
+	// Block 2 is certified in the chain, block 3 only has an aggregate
+	certified := []*types.Attestation{signTestAttestation(t, edge(1), edge(2)), signTestAttestation(t, edge(1), edge(2))}
+	cert, err := types.NewFinalityCertificate(certified)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	rawdb.WriteFinalityCertificate(backend.db, cert)
+
+	sk, _ := bls.GenerateKey()
+	aggregate, err := types.NewAggregateAttestation(edge(2), edge(3), 0, 4, sk.Sign(types.AttestationData(edge(2), edge(3))).Bytes())
+	if err != nil {
+		t.Fatalf("failed to create aggregate: %v", err)
+	}
+	rawdb.WriteAggregateAttestation(backend.db, aggregate)
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- handleGetAttestationRange(backend, newTestMsg(t, GetAttestationRangeMsg, &GetAttestationRangePacket{RequestId: 7, From: 1, To: 4}), peer)
+	}()
+	msg, err := remote.ReadMsg()
+	if err != nil {
+		t.Fatalf("failed to read reply: %v", err)
+	}
+	var res AttestationRangePacket
+	if err := msg.Decode(&res); err != nil {
+		t.Fatalf("failed to decode reply: %v", err)
+	}
+	if err := <-errc; err != nil {
+		t.Fatalf("failed to handle request: %v", err)
+	}
+	if res.RequestId != 7 {
+		t.Fatalf("request id mismatch: have %d, want 7", res.RequestId)
+	}
+	if len(res.Attestations) != len(certified) {
+		t.Fatalf("attestations mismatch: have %d, want %d", len(res.Attestations), len(certified))
+	}
+	for i, a := range res.Attestations {
+		if a.Hash() != certified[i].Hash() {
+			t.Errorf("attestation %d mismatch", i)
+		}
+	}
+	if len(res.Aggregates) != 1 || res.Aggregates[0].Hash() != aggregate.Hash() {
+		t.Fatalf("aggregates mismatch: have %d, want 1", len(res.Aggregates))
+	}
+	// Ranges over the limit are refused
+	if err := handleGetAttestationRange(backend, newTestMsg(t, GetAttestationRangeMsg, &GetAttestationRangePacket{From: 1, To: maxAttestationRange + 1}), peer); err == nil {
+		t.Fatalf("oversized range served")
+	}
+}
+
+// Tests that only the requested attestations of a pooled delivery are handled, and that
+// the peer is penalized for the other ones.
+func TestHandlePooledAttestations(t *testing.T) {
+	backend := newTestBackend(t, 4)
+	defer backend.chain.Stop()
+
+	peer, remote := newTestPeer(backend)
+	defer peer.Close()
+
+	// Attestations of far future blocks are dropped by the chain without verification
+	source := &types.RangeEdge{Hash: common.Hash{0x1}, Number: big.NewInt(990)}
+	requested := signTestAttestation(t, source, &types.RangeEdge{Hash: common.Hash{0x2}, Number: big.NewInt(1000)})
+	unrequested := signTestAttestation(t, source, &types.RangeEdge{Hash: common.Hash{0x3}, Number: big.NewInt(1000)})
+
+	go func() {
+		if msg, err := remote.ReadMsg(); err == nil {
+			msg.Discard()
+		}
+	}()
+	if err := peer.RequestPooledAttestations([]AttestationAnnouncement{{Hash: requested.Hash(), Number: requested.TargetRangeEdge.Number, Target: requested.TargetRangeEdge.Hash}}); err != nil {
+		t.Fatalf("failed to request attestations: %v", err)
+	}
+	var id uint64 // Id of the single pending request
+	for id = range peer.requests {
+	}
+	peer.scheduler.fetching[requested.Hash()] = time.Now()
+	peer.scheduler.fetching[unrequested.Hash()] = time.Now()
+
+	// The requested attestation is only accepted once
+	res := &PooledAttestationsPacket{RequestId: id, Attestations: []*types.Attestation{requested, unrequested, requested}}
+	if err := handlePooledAttestations(backend, newTestMsg(t, PooledAttestationsMsg, res), peer); err != nil {
+		t.Fatalf("failed to handle delivery: %v", err)
+	}
+	if have, want := peer.Score(), int64(-2*unsolicitedResponsePenalty); have != want {
+		t.Fatalf("score mismatch: have %d, want %d", have, want)
+	}
+	if !peer.KnownAttestation(requested.Hash()) || peer.KnownAttestation(unrequested.Hash()) {
+		t.Fatalf("known attestations mismatch")
+	}
+	if _, ok := peer.scheduler.fetching[requested.Hash()]; ok {
+		t.Fatalf("requested attestation still being retrieved")
+	}
+	if _, ok := peer.scheduler.fetching[unrequested.Hash()]; !ok {
+		t.Fatalf("unrequested attestation marked delivered")
+	}
+	// Deliveries of fulfilled requests are unsolicited
+	if err := handlePooledAttestations(backend, newTestMsg(t, PooledAttestationsMsg, res), peer); err != nil {
+		t.Fatalf("failed to handle delivery: %v", err)
+	}
+	if have, want := peer.Score(), int64(-3*unsolicitedResponsePenalty); have != want {
+		t.Fatalf("score mismatch: have %d, want %d", have, want)
+	}
+}
@@ -17,6 +17,10 @@
 package cons
 
 import (
+	"math/rand"
+	"sync"
+	"time"
+
 	"github.com/QEasyWeb3/QEasyChain/common"
 	"github.com/QEasyWeb3/QEasyChain/core/types"
 	"github.com/QEasyWeb3/QEasyChain/log"
@@ -27,8 +31,21 @@ import (
 const (
 	maxKnownAttestationHash            = 1024
 	maxQueuedAttestations              = 100
+	maxQueuedAggregateAttestations     = 100
 	maxQueuedJustifiedOrFinalizedBlock = 100
 	maxKnownJustifiedOrFinalizedBlock  = 100
+
+	// maxTrackedFinalized is the number of recent finalized blocks reported by a peer
+	// to remember, to anchor the snap sync pivot on.
+	maxTrackedFinalized = 32
+
+	// maxQueuedAttestationAnns is the maximum number of attestation announcements to
+	// queue up before dropping older announcements.
+	maxQueuedAttestationAnns = 4096
+
+	// requestTimeout is the time after which a request of a `cons/2` peer is considered
+	// lost, so that its late response is dropped as unsolicited.
+	requestTimeout = 30 * time.Second
 )
 
 // Peer is a collection of relevant information we have about a `cons` peer.
@@ -44,15 +61,35 @@ type Peer struct {
 	knownAttestations  *knownCache             // Set of attestation hashes known to be known by this peer
 	queuedAttestations chan *types.Attestation // Queue of attestation to broadcast to the peer
 
+	queuedAggregateAttestations chan *types.AggregateAttestation // Queue of aggregate attestations to broadcast to the peer
+
 	knownJustifiedOrFinalizedBlock  *knownCache
 	queuedJustifiedOrFinalizedBlock chan *types.BlockStatus
 
+	finalized []*types.BlockStatus // Recent finalized blocks reported by the peer, oldest first
+	finLock   sync.RWMutex         // Mutex protecting the reported finalized blocks
+
+	attestationAnnounce chan []AttestationAnnouncement // Channel used to queue attestation announcement requests
+	scheduler           *AttestationScheduler          // Scheduler deduplicating the retrievals of announced attestations
+
+	score *peerScore // Misbehaviour score and inbound rate limits of the peer
+
+	requests map[uint64]*pendingRequest // Requests sent to the peer, waiting for their response
+	reqLock  sync.Mutex                 // Mutex protecting the pending requests
+
 	term chan struct{} // Termination channel to stop the broadcasters
 }
 
+// pendingRequest is a request sent to a `cons/2` peer, waiting for its response.
+type pendingRequest struct {
+	code   uint64                   // Message code of the expected response
+	time   time.Time                // Timestamp when the request was sent
+	hashes map[common.Hash]struct{} // Hashes of the requested attestations, if retrieved by hash
+}
+
 // newPeer create a wrapper for a network connection and negotiated  protocol
 // version.
-func newPeer(version uint, p *p2p.Peer, rw p2p.MsgReadWriter) *Peer {
+func newPeer(version uint, p *p2p.Peer, rw p2p.MsgReadWriter, scheduler *AttestationScheduler) *Peer {
 	id := p.ID().String()
 	peer := &Peer{
 		id:                              id,
@@ -62,13 +99,22 @@ func newPeer(version uint, p *p2p.Peer, rw p2p.MsgReadWriter) *Peer {
 		logger:                          log.New("peer", id[:8]),
 		knownAttestations:               newKnownCache(maxKnownAttestationHash),
 		queuedAttestations:              make(chan *types.Attestation, maxQueuedAttestations),
+		queuedAggregateAttestations:     make(chan *types.AggregateAttestation, maxQueuedAggregateAttestations),
 		knownJustifiedOrFinalizedBlock:  newKnownCache(maxKnownJustifiedOrFinalizedBlock),
 		queuedJustifiedOrFinalizedBlock: make(chan *types.BlockStatus, maxQueuedJustifiedOrFinalizedBlock),
+		attestationAnnounce:             make(chan []AttestationAnnouncement),
+		scheduler:                       scheduler,
+		score:                           newPeerScore(),
+		requests:                        make(map[uint64]*pendingRequest),
 		term:                            make(chan struct{}),
 	}
 	// Start up all the broadcasters
 	go peer.broadcastAttestationsLoop()
 	go peer.broadcastJustifiedOrFinalizedBlockLoop()
+	if version >= CONS2 {
+		go peer.broadcastAggregateAttestationsLoop()
+		go peer.announceAttestationsLoop()
+	}
 	return peer
 }
 
@@ -89,6 +135,21 @@ func (p *Peer) Version() uint {
 	return p.version
 }
 
+// Score retrieves the misbehaviour score of the peer, zero for a well-behaved peer and
+// decreasing with the invalid consensus messages it sent.
+func (p *Peer) Score() int64 {
+	return p.score.value()
+}
+
+// penalize lowers the score of the peer for a misbehaviour.
+func (p *Peer) penalize(penalty int64, reason string) {
+	if penalty == 0 {
+		return
+	}
+	score := p.score.penalize(penalty)
+	p.Log().Debug("Penalized peer", "penalty", penalty, "score", score, "reason", reason)
+}
+
 // Log overrides the P2P logget with the higher level one containing only the id.
 func (p *Peer) Log() log.Logger {
 	return p.logger
@@ -144,6 +205,31 @@ func (p *Peer) KnownJustifiedOrFinalizedBlock(hash common.Hash) bool {
 	return p.knownJustifiedOrFinalizedBlock.Contains(hash)
 }
 
+// Finalized retrieves the recent finalized blocks reported by the peer, oldest first.
+func (p *Peer) Finalized() []*types.BlockStatus {
+	p.finLock.RLock()
+	defer p.finLock.RUnlock()
+
+	finalized := make([]*types.BlockStatus, len(p.finalized))
+	copy(finalized, p.finalized)
+	return finalized
+}
+
+// markFinalized records a finalized block reported by the peer, discarding the oldest
+// ones if the limit is reached. Reports not newer than the last one are ignored.
+func (p *Peer) markFinalized(bs *types.BlockStatus) {
+	p.finLock.Lock()
+	defer p.finLock.Unlock()
+
+	if n := len(p.finalized); n > 0 && p.finalized[n-1].BlockNumber.Cmp(bs.BlockNumber) >= 0 {
+		return
+	}
+	if len(p.finalized) >= maxTrackedFinalized {
+		p.finalized = p.finalized[1:]
+	}
+	p.finalized = append(p.finalized, bs.DeepCopy())
+}
+
 func (p *Peer) SendNewAttestation(a *types.Attestation) error {
 	// Mark all the block hash as known, but ensure we don't overflow our limits
 	p.knownAttestations.Add(a.Hash())
@@ -169,6 +255,23 @@ func (p *Peer) AsyncSendNewAttestation(a *types.Attestation) {
 	}
 }
 
+func (p *Peer) SendNewAggregateAttestation(a *types.AggregateAttestation) error {
+	// Mark the aggregate as known, but ensure we don't overflow our limits
+	p.knownAttestations.Add(a.Hash())
+	return p2p.Send(p.rw, NewAggregateAttestationMsg, a)
+}
+
+func (p *Peer) AsyncSendNewAggregateAttestation(a *types.AggregateAttestation) {
+	select {
+	case p.queuedAggregateAttestations <- a.DeepCopy():
+		// Mark the aggregate as known, but ensure we don't overflow our limits
+		p.knownAttestations.Add(a.Hash())
+	default:
+		p.Log().Debug("Dropping aggregate attestation propagation", "number",
+			a.TargetRangeEdge.Number.Uint64(), "hash", a.TargetRangeEdge.Hash)
+	}
+}
+
 func (p *Peer) SendNewJustifiedOrFinalizedBlock(bs *types.BlockStatus) error {
 	// Mark all the block hash as known, but ensure we don't overflow our limits
 	p.knownJustifiedOrFinalizedBlock.Add(bs.CacheHash())
@@ -185,3 +288,124 @@ func (p *Peer) AsyncSendNewJustifiedOrFinalizedBlock(bs *types.BlockStatus) {
 			bs.BlockNumber.Uint64(), "hash", bs.Hash)
 	}
 }
+
+// sendAttestationHashes sends attestation announcements to the peer and includes
+// the hashes in its attestation hash set for future reference.
+//
+// This method is a helper used by the async attestation announcer. Don't call it
+// directly as the queueing (memory) and transmission (bandwidth) costs should
+// not be managed directly.
+func (p *Peer) sendAttestationHashes(anns []AttestationAnnouncement) error {
+	for _, ann := range anns {
+		p.knownAttestations.Add(ann.Hash)
+	}
+	packet := NewAttestationHashesPacket(anns)
+	return p2p.Send(p.rw, NewAttestationHashesMsg, &packet)
+}
+
+// AsyncSendAttestationHashes queues a list of attestation announcements to propagate
+// to a remote `cons/2` peer. If the peer's announce queue is full, the oldest
+// announcements are dropped.
+func (p *Peer) AsyncSendAttestationHashes(anns []AttestationAnnouncement) {
+	select {
+	case p.attestationAnnounce <- anns:
+		// Mark all the attestations as known, but ensure we don't overflow our limits
+		for _, ann := range anns {
+			p.knownAttestations.Add(ann.Hash)
+		}
+	case <-p.term:
+		p.Log().Debug("Dropping attestation announcement", "count", len(anns))
+	}
+}
+
+// ReplyPooledAttestations is the response to RequestPooledAttestations.
+func (p *Peer) ReplyPooledAttestations(id uint64, attestations []*types.Attestation) error {
+	for _, a := range attestations {
+		p.knownAttestations.Add(a.Hash())
+	}
+	return p2p.Send(p.rw, PooledAttestationsMsg, &PooledAttestationsPacket{
+		RequestId:    id,
+		Attestations: attestations,
+	})
+}
+
+// ReplyAttestationRange is the response to RequestAttestationRange.
+func (p *Peer) ReplyAttestationRange(id uint64, attestations []*types.Attestation, aggregates []*types.AggregateAttestation) error {
+	return p2p.Send(p.rw, AttestationRangeMsg, &AttestationRangePacket{
+		RequestId:    id,
+		Attestations: attestations,
+		Aggregates:   aggregates,
+	})
+}
+
+// RequestPooledAttestations fetches a batch of announced attestations from a remote node.
+func (p *Peer) RequestPooledAttestations(anns []AttestationAnnouncement) error {
+	p.Log().Debug("Fetching batch of announced attestations", "count", len(anns))
+	hashes := make(map[common.Hash]struct{}, len(anns))
+	for _, ann := range anns {
+		hashes[ann.Hash] = struct{}{}
+	}
+	id := p.trackRequest(GetPooledAttestationsMsg, PooledAttestationsMsg, hashes)
+	return p2p.Send(p.rw, GetPooledAttestationsMsg, &GetPooledAttestationsPacket{
+		RequestId:     id,
+		Announcements: anns,
+	})
+}
+
+// RequestAttestationRange fetches the attestations of the canonical blocks between from
+// and to (inclusive) from a remote node.
+func (p *Peer) RequestAttestationRange(from, to uint64) error {
+	p.Log().Debug("Fetching attestations of a block range", "from", from, "to", to)
+	id := p.trackRequest(GetAttestationRangeMsg, AttestationRangeMsg, nil)
+	return p2p.Send(p.rw, GetAttestationRangeMsg, &GetAttestationRangePacket{
+		RequestId: id,
+		From:      from,
+		To:        to,
+	})
+}
+
+// trackRequest registers a new request waiting for a response with the given code,
+// dropping the requests which timed out, and returns its id.
+func (p *Peer) trackRequest(reqCode, resCode uint64, hashes map[common.Hash]struct{}) uint64 {
+	p.reqLock.Lock()
+	defer p.reqLock.Unlock()
+
+	for id, req := range p.requests {
+		if time.Since(req.time) > requestTimeout {
+			delete(p.requests, id)
+		}
+	}
+	id := rand.Uint64()
+	p.requests[id] = &pendingRequest{code: resCode, time: time.Now(), hashes: hashes}
+	requestTracker.Track(p.id, p.version, reqCode, resCode, id)
+	return id
+}
+
+// fulfilRequest checks whether a response answers a pending request of the peer,
+// marking the request fulfilled, and returns the request answered.
+func (p *Peer) fulfilRequest(id uint64, code uint64) (*pendingRequest, bool) {
+	p.reqLock.Lock()
+	defer p.reqLock.Unlock()
+
+	req, ok := p.requests[id]
+	if !ok || req.code != code || time.Since(req.time) > requestTimeout {
+		return nil, false
+	}
+	delete(p.requests, id)
+	requestTracker.Fulfil(p.id, p.version, code, id)
+	return req, true
+}
+
+// pendingRange checks whether an attestation range request to the peer is still
+// waiting for its response.
+func (p *Peer) pendingRange() bool {
+	p.reqLock.Lock()
+	defer p.reqLock.Unlock()
+
+	for _, req := range p.requests {
+		if req.code == AttestationRangeMsg && time.Since(req.time) <= requestTimeout {
+			return true
+		}
+	}
+	return false
+}
@@ -2,13 +2,15 @@ package cons
 
 import (
 	"errors"
+	"github.com/QEasyWeb3/QEasyChain/common"
 	"github.com/QEasyWeb3/QEasyChain/core/types"
 	"math/big"
 )
 
 // Constants to match up protocol versions and messages
 const (
-	cons1 = 1
+	CONS1 = 1
+	CONS2 = 2
 )
 
 // ProtocolName is the official short name of the `cons` protocol used during
@@ -17,24 +19,36 @@ const ProtocolName = "cons"
 
 // ProtocolVersions are the supported versions of the `cons` protocol (first
 // is primary).
-var ProtocolVersions = []uint{cons1}
+var ProtocolVersions = []uint{CONS2, CONS1}
 
 // protocolLengths are the number of implemented message corresponding to
 // different protocol versions.
 // The length here refers to the code of the message, or the largest type, rather than the length occupied by the data of the message
 // Specific view code p2p/peer.go 「msg.Code >= rw.Length」
 // If you need to support new types, remember to increase this value
-var protocolLengths = map[uint]uint64{cons1: 4}
+var protocolLengths = map[uint]uint64{CONS2: 10, CONS1: 4}
 
 // maxMessageSize is the maximum cap on the size of a protocol message.
 // A single attestation packet is about 110 bytes.
 const maxMessageSize = 8 * 1024
 
+// maxResponseSize is the maximum cap on the size of the responses retrieving
+// announced attestations or the attestations of a range of blocks.
+const maxResponseSize = 1024 * 1024
+
 const (
 	NewAttestationMsg               = 0x00 // A single attestation of a block
 	NewJustifiedOrFinalizedBlockMsg = 0x01 // The current node tells other nodes that it has a block with state Justified or Finalized
 	GetAttestationsMsg              = 0x02 // Request to get all attestations of a given block
 	AttestationsMsg                 = 0x03 // Response of the GetAttestationsMsg
+
+	// Protocol messages introduced in cons/2
+	NewAggregateAttestationMsg = 0x04 // An aggregate BLS attestation of a block (after the Jupiter fork)
+	NewAttestationHashesMsg    = 0x05 // Announcement of attestations available at the remote peer
+	GetPooledAttestationsMsg   = 0x06 // Request to get the attestations of a list of announcements
+	PooledAttestationsMsg      = 0x07 // Response of the GetPooledAttestationsMsg
+	GetAttestationRangeMsg     = 0x08 // Request to get the attestations of a range of blocks
+	AttestationRangeMsg        = 0x09 // Response of the GetAttestationRangeMsg
 )
 
 var (
@@ -61,3 +75,56 @@ type NewAttestationPacket struct {
 
 func (*NewAttestationPacket) Name() string { return "NewAttestation" }
 func (*NewAttestationPacket) Kind() byte   { return NewAttestationMsg }
+
+// AttestationAnnouncement is the announcement of an attestation, carrying the target
+// block needed to look it up locally beside its hash.
+type AttestationAnnouncement struct {
+	Hash   common.Hash // Hash of the announced attestation
+	Number *big.Int    // Number of the block targeted by the attestation
+	Target common.Hash // Hash of the block targeted by the attestation
+}
+
+// NewAttestationHashesPacket is the network packet for the attestation announcements.
+type NewAttestationHashesPacket []AttestationAnnouncement
+
+// GetPooledAttestationsPacket represents an attestation retrieval request.
+type GetPooledAttestationsPacket struct {
+	RequestId     uint64
+	Announcements []AttestationAnnouncement
+}
+
+// PooledAttestationsPacket is the network packet for attestation retrieval responses.
+type PooledAttestationsPacket struct {
+	RequestId    uint64
+	Attestations []*types.Attestation
+}
+
+// GetAttestationRangePacket represents a request of the attestations targeting the
+// canonical blocks between From and To (inclusive).
+type GetAttestationRangePacket struct {
+	RequestId uint64
+	From      uint64
+	To        uint64
+}
+
+// AttestationRangePacket is the network packet for attestation range responses.
+type AttestationRangePacket struct {
+	RequestId    uint64
+	Attestations []*types.Attestation
+	Aggregates   []*types.AggregateAttestation
+}
+
+func (*NewAttestationHashesPacket) Name() string { return "NewAttestationHashes" }
+func (*NewAttestationHashesPacket) Kind() byte   { return NewAttestationHashesMsg }
+
+func (*GetPooledAttestationsPacket) Name() string { return "GetPooledAttestations" }
+func (*GetPooledAttestationsPacket) Kind() byte   { return GetPooledAttestationsMsg }
+
+func (*PooledAttestationsPacket) Name() string { return "PooledAttestations" }
+func (*PooledAttestationsPacket) Kind() byte   { return PooledAttestationsMsg }
+
+func (*GetAttestationRangePacket) Name() string { return "GetAttestationRange" }
+func (*GetAttestationRangePacket) Kind() byte   { return GetAttestationRangeMsg }
+
+func (*AttestationRangePacket) Name() string { return "AttestationRange" }
+func (*AttestationRangePacket) Kind() byte   { return AttestationRangeMsg }
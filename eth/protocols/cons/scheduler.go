@@ -1,7 +1,78 @@
 package cons
 
+import (
+	"sync"
+	"time"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+)
+
+// fetchTimeout is the time after which an announced attestation requested from a peer
+// is considered lost, and may be requested again from another announcer.
+const fetchTimeout = 5 * time.Second
+
 // AttestationScheduler schedule attestation processing task,
 // it's quite like the role of blockFetcher to blocks.
+//
+// It keeps track of the announced attestations being retrieved, so that an attestation
+// announced by several `cons/2` peers is only requested from the first announcer,
+// unless that request times out.
 type AttestationScheduler struct {
 	backend Backend
+
+	fetching map[common.Hash]time.Time // Announced attestations being retrieved, with the request time
+	lock     sync.Mutex                // Mutex protecting the retrievals
+}
+
+// newAttestationScheduler creates a scheduler retrieving the announced attestations
+// missing from the backend's chain.
+func newAttestationScheduler(backend Backend) *AttestationScheduler {
+	return &AttestationScheduler{
+		backend:  backend,
+		fetching: make(map[common.Hash]time.Time),
+	}
+}
+
+// schedule requests from the announcing peer the announced attestations which are
+// neither known locally nor being retrieved from another peer.
+func (s *AttestationScheduler) schedule(peer *Peer, anns []AttestationAnnouncement) error {
+	chain := s.backend.Chain()
+	head := chain.CurrentBlock().NumberU64()
+
+	s.lock.Lock()
+	now := time.Now()
+	for hash, requested := range s.fetching {
+		if now.Sub(requested) > fetchTimeout {
+			delete(s.fetching, hash)
+		}
+	}
+	var request []AttestationAnnouncement
+	for _, ann := range anns {
+		if _, ok := s.fetching[ann.Hash]; ok {
+			continue
+		}
+		// Attestations of blocks outside the gossip window would be dropped anyway
+		if !chain.VerifyValidLimit(ann.Number.Uint64(), head) {
+			continue
+		}
+		if a, _ := chain.GetHistoryOneAttestation(ann.Number, ann.Target, ann.Hash); a != nil {
+			continue
+		}
+		s.fetching[ann.Hash] = now
+		request = append(request, ann)
+	}
+	s.lock.Unlock()
+
+	if len(request) == 0 {
+		return nil
+	}
+	return peer.RequestPooledAttestations(request)
+}
+
+// delivered marks an announced attestation as retrieved.
+func (s *AttestationScheduler) delivered(hash common.Hash) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.fetching, hash)
 }
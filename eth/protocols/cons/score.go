@@ -0,0 +1,183 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package cons
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/QEasyWeb3/QEasyChain/core"
+	"github.com/QEasyWeb3/QEasyChain/p2p/enode"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// Score penalties of the misbehaviours of a peer
+	invalidAttestationPenalty  = 20 // Malformed attestation or invalid signature
+	invalidStatusPenalty       = 20 // Justified or finalized block status which isn't one
+	contradictingStatusPenalty = 10 // Justified or finalized block contradicting the local chain
+	unsolicitedResponsePenalty = 5  // Response to a request never sent, or already timed out
+	rateLimitPenalty           = 1  // Message dropped for exceeding the rate limits
+
+	// scoreRecoveryInterval is the time it takes a penalized peer to recover one point.
+	scoreRecoveryInterval = 6 * time.Second
+
+	// banScore is the score at which a peer is disconnected and banned.
+	banScore = -100
+
+	// banDuration is the time during which a banned peer is refused.
+	banDuration = 30 * time.Minute
+
+	// maxTrackedBans is the number of bans above which the expired ones are dropped.
+	maxTrackedBans = 1024
+
+	// Inbound message rate limits of a peer, in messages per second, and their bursts.
+	// Gossip covers the broadcasts and announcements, requests the data retrievals.
+	gossipRateLimit   = 100
+	gossipBurstLimit  = 400
+	requestRateLimit  = 10
+	requestBurstLimit = 20
+)
+
+var errPeerBanned = errors.New("peer banned for invalid consensus messages")
+
+// peerScore tracks the misbehaviours of a peer. The score starts at zero, drops with
+// every penalty and recovers over time, so that only peers repeatedly sending invalid
+// messages reach the ban score.
+type peerScore struct {
+	score   int64     // Current score, zero or negative
+	updated time.Time // Time the score was last updated at, to recover it
+	lock    sync.Mutex
+
+	gossipLimiter  *rate.Limiter // Rate limiter of the broadcast and announcement messages
+	requestLimiter *rate.Limiter // Rate limiter of the data retrieval requests
+}
+
+// newPeerScore creates a neutral score with full rate limit buckets.
+func newPeerScore() *peerScore {
+	return &peerScore{
+		updated:        time.Now(),
+		gossipLimiter:  rate.NewLimiter(gossipRateLimit, gossipBurstLimit),
+		requestLimiter: rate.NewLimiter(requestRateLimit, requestBurstLimit),
+	}
+}
+
+// recover brings the score back towards zero for the time elapsed since the last update.
+// The caller must hold the lock.
+func (s *peerScore) recover(now time.Time) {
+	if points := int64(now.Sub(s.updated) / scoreRecoveryInterval); points > 0 {
+		s.score += points
+		if s.score > 0 {
+			s.score = 0
+		}
+		s.updated = s.updated.Add(time.Duration(points) * scoreRecoveryInterval)
+	}
+	if s.score == 0 {
+		s.updated = now
+	}
+}
+
+// value returns the current score.
+func (s *peerScore) value() int64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.recover(time.Now())
+	return s.score
+}
+
+// penalize lowers the score, returning the new one.
+func (s *peerScore) penalize(penalty int64) int64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.recover(time.Now())
+	s.score -= penalty
+	return s.score
+}
+
+// allow checks whether a message with the given code fits in the rate limits. Responses
+// aren't limited, they're dropped unless they answer a pending request.
+func (s *peerScore) allow(code uint64) bool {
+	switch code {
+	case GetAttestationsMsg, GetPooledAttestationsMsg, GetAttestationRangeMsg:
+		return s.requestLimiter.Allow()
+	case AttestationsMsg, PooledAttestationsMsg, AttestationRangeMsg:
+		return true
+	default:
+		return s.gossipLimiter.Allow()
+	}
+}
+
+// attestationPenalty returns the penalty of the peer relaying an attestation rejected
+// by the chain with the given error, zero if the error isn't the peer's fault.
+//
+// Only the malformed attestations and the signatures which can't be recovered are the
+// fault of the peer, every node checking them before relaying. The other rejections
+// depend on the local view of the chain, which the peer may not share: the validators
+// not known yet after a restart or on an epoch switch, blocks not imported yet, or keys
+// rotated since.
+func attestationPenalty(err error) int64 {
+	if errors.Is(err, core.ErrInvalidAttestation) {
+		return invalidAttestationPenalty
+	}
+	return 0
+}
+
+// banList keeps the peers banned for repeatedly sending invalid consensus messages,
+// so that they're refused if they reconnect before their ban expires.
+type banList struct {
+	bans map[enode.ID]time.Time // Banned peers and the expiration of their bans
+	lock sync.Mutex
+}
+
+// newBanList creates an empty ban list.
+func newBanList() *banList {
+	return &banList{
+		bans: make(map[enode.ID]time.Time),
+	}
+}
+
+// ban refuses the given peer for the ban duration.
+func (b *banList) ban(id enode.ID) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := time.Now()
+	if len(b.bans) >= maxTrackedBans {
+		for banned, expiry := range b.bans {
+			if now.After(expiry) {
+				delete(b.bans, banned)
+			}
+		}
+	}
+	b.bans[id] = now.Add(banDuration)
+}
+
+// banned checks whether the given peer is still banned.
+func (b *banList) banned(id enode.ID) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	expiry, ok := b.bans[id]
+	if ok && time.Now().After(expiry) {
+		delete(b.bans, id)
+		return false
+	}
+	return ok
+}
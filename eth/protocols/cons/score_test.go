@@ -0,0 +1,105 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package cons
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/QEasyWeb3/QEasyChain/core"
+	"github.com/QEasyWeb3/QEasyChain/p2p/enode"
+)
+
+// Tests that penalties lower the score of a peer, and that it recovers over time
+// without ever turning positive.
+func TestPeerScoreRecovery(t *testing.T) {
+	score := newPeerScore()
+	if have := score.penalize(invalidAttestationPenalty); have != -invalidAttestationPenalty {
+		t.Fatalf("score mismatch: have %d, want %d", have, -invalidAttestationPenalty)
+	}
+	score.lock.Lock()
+	score.updated = score.updated.Add(-5 * scoreRecoveryInterval)
+	score.lock.Unlock()
+	if have, want := score.value(), int64(5-invalidAttestationPenalty); have != want {
+		t.Fatalf("recovered score mismatch: have %d, want %d", have, want)
+	}
+	score.lock.Lock()
+	score.updated = score.updated.Add(-100 * scoreRecoveryInterval)
+	score.lock.Unlock()
+	if have := score.value(); have != 0 {
+		t.Fatalf("fully recovered score mismatch: have %d, want 0", have)
+	}
+}
+
+// Tests that the penalties only punish the attestation errors caused by the peer.
+func TestAttestationPenalty(t *testing.T) {
+	tests := []struct {
+		err  error
+		want int64
+	}{
+		{nil, 0},
+		{fmt.Errorf("%w: bad signature", core.ErrInvalidAttestation), invalidAttestationPenalty},
+		{core.ErrUnauthorizedAttester, 0},
+		{core.ErrAttestationBlockMismatch, 0},
+		{fmt.Errorf("%w: unknown block", core.ErrUnverifiableAttestation), 0},
+		{fmt.Errorf("surround vote"), 0},
+	}
+	for i, tt := range tests {
+		if have := attestationPenalty(tt.err); have != tt.want {
+			t.Errorf("test %d: penalty mismatch: have %d, want %d", i, have, tt.want)
+		}
+	}
+}
+
+// Tests that the request rate limit doesn't affect the gossip nor the responses.
+func TestPeerScoreRateLimits(t *testing.T) {
+	score := newPeerScore()
+	for i := 0; i < requestBurstLimit; i++ {
+		if !score.allow(GetAttestationRangeMsg) {
+			t.Fatalf("request %d denied within burst", i)
+		}
+	}
+	if score.allow(GetPooledAttestationsMsg) {
+		t.Fatalf("request allowed over burst")
+	}
+	if !score.allow(NewAttestationMsg) {
+		t.Fatalf("gossip denied by the request limit")
+	}
+	if !score.allow(AttestationRangeMsg) {
+		t.Fatalf("response denied by the request limit")
+	}
+}
+
+// Tests that bans expire.
+func TestBanList(t *testing.T) {
+	var (
+		bans = newBanList()
+		id   = enode.ID{1}
+	)
+	if bans.banned(id) {
+		t.Fatalf("peer banned before ban")
+	}
+	bans.ban(id)
+	if !bans.banned(id) {
+		t.Fatalf("peer not banned after ban")
+	}
+	bans.bans[id] = time.Now().Add(-time.Second)
+	if bans.banned(id) {
+		t.Fatalf("peer still banned after expiry")
+	}
+}
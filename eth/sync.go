@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
 	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
 	"github.com/QEasyWeb3/QEasyChain/core/types"
 	"github.com/QEasyWeb3/QEasyChain/eth/downloader"
@@ -32,6 +33,15 @@ import (
 const (
 	forceSyncCycle      = 10 * time.Second // Time interval to force syncs, even if few peers are available
 	defaultMinSyncPeers = 5                // Amount of peers desired to start syncing
+
+	// minFinalityPeers is the number of peers which have to report a finalized block
+	// for it to be tried as the snap sync pivot. The reports aren't authenticated, the
+	// pivot is only accepted once the synced header chain certifies it.
+	minFinalityPeers = 3
+
+	// finalityCertificateGap is the max distance between a finalized block and the
+	// header embedding its finality certificate, as enforced by the consensus engine.
+	finalityCertificateGap = 256
 )
 
 // syncTransactions starts sending all currently pending transactions to the given peer.
@@ -60,6 +70,82 @@ func (h *handler) syncTransactions(p *eth.Peer) {
 	p.AsyncSendPooledTransactionHashes(hashes)
 }
 
+// finalityAnchor returns the highest finalized block reported by at least minFinalityPeers
+// `cons` peers, as a candidate to anchor the snap sync pivot on. Heights reported finalized
+// with different hashes by enough peers are skipped. A zero number is returned if none
+// qualifies.
+//
+// The reports can't be authenticated: a syncing node has no validator set to check the
+// attestations of a finalized block against before it has the state. The downloader only
+// commits the anchored pivot once finalityCertified confirms it, and falls back to trailing
+// the head otherwise.
+func (h *handler) finalityAnchor() (uint64, common.Hash) {
+	votes := make(map[uint64]map[common.Hash]int)
+	for _, finalized := range h.peers.finalizedReports() {
+		for _, bs := range finalized {
+			number := bs.BlockNumber.Uint64()
+			if votes[number] == nil {
+				votes[number] = make(map[common.Hash]int)
+			}
+			votes[number][bs.Hash]++
+		}
+	}
+	var (
+		anchor uint64
+		hash   common.Hash
+	)
+	for number, hashes := range votes {
+		if number <= anchor {
+			continue
+		}
+		var (
+			found common.Hash
+			count int
+		)
+		for candidate, n := range hashes {
+			if n >= minFinalityPeers {
+				found = candidate
+				count++
+			}
+		}
+		if count == 1 {
+			anchor, hash = number, found
+		}
+	}
+	return anchor, hash
+}
+
+// finalityCertified reports whether a header of the local chain embeds a finality
+// certificate targeting the given block. The headers are verified by the consensus
+// engine when imported, or authenticated by the checkpoint, so the certificate is
+// signed by the validators of the target.
+func (h *handler) finalityCertified(number uint64, hash common.Hash) bool {
+	democracy, ok := h.chain.Engine().(consensus.Democracy)
+	if !ok {
+		return false
+	}
+	if header := h.chain.GetHeaderByNumber(number); header == nil || header.Hash() != hash {
+		return false
+	}
+	head := h.chain.CurrentHeader().Number.Uint64()
+	for n := number + 1; n <= number+finalityCertificateGap && n <= head; n++ {
+		header := h.chain.GetHeaderByNumber(n)
+		if header == nil {
+			return false
+		}
+		certs, err := democracy.FinalityCertificates(header)
+		if err != nil {
+			continue
+		}
+		for _, cert := range certs {
+			if cert.TargetRangeEdge.Number.Uint64() == number && cert.TargetRangeEdge.Hash == hash {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // chainSyncer coordinates blockchain sync components.
 type chainSyncer struct {
 	handler     *handler
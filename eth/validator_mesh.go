@@ -0,0 +1,278 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package eth
+
+import (
+	"sync"
+	"time"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/core"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/eth/protocols/cons"
+	"github.com/QEasyWeb3/QEasyChain/log"
+	"github.com/QEasyWeb3/QEasyChain/p2p"
+	"github.com/QEasyWeb3/QEasyChain/p2p/enode"
+)
+
+const (
+	// validatorLookupTimeout is the time spent looking up the records of the validators
+	// missing from the mesh before pausing the lookup.
+	validatorLookupTimeout = time.Minute
+
+	// validatorLookupRetry is the pause between two lookups of the validators missing
+	// from the mesh, as some validators may not advertise their node at all.
+	validatorLookupRetry = 10 * time.Minute
+)
+
+// meshEngine is the consensus engine backing the validator mesh, resolving the validators
+// and signing and authenticating their records.
+type meshEngine interface {
+	Validators(chain consensus.ChainHeaderReader, hash common.Hash, number uint64) ([]common.Address, error)
+	CurrentValidator() common.Address
+	CurrentConsensusKey() common.Address
+	SignValidatorRecord(node common.Hash) (common.Address, []byte, error)
+	VerifyValidatorRecord(chain consensus.ChainHeaderReader, hash common.Hash, number uint64, validator common.Address, node common.Hash, sig []byte) error
+}
+
+// validatorMesh keeps direct connections between the validators of the current epoch.
+// Validators advertise their node with a `val` entry of the node record, signed by their
+// consensus key. The authenticated nodes of the current validators are protected by the
+// p2p server: they're kept connected and never take the slot of another peer. The set is
+// recomputed whenever the validators change, at epoch change.
+type validatorMesh struct {
+	chain  *core.BlockChain
+	engine meshEngine
+	server *p2p.Server
+	iter   enode.Iterator // Discovery iterator of the nodes advertising a validator
+
+	validators    map[common.Address]struct{}    // Validators of the current epoch
+	protected     map[common.Address]*enode.Node // Authenticated nodes of the current validators
+	advertised    common.Address                 // Validator advertised in the local node record
+	advertisedKey common.Address                 // Consensus key signing the local node record
+	lock          sync.Mutex
+
+	wake chan struct{} // Notification of validators missing from the mesh
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newValidatorMesh creates a validator mesh looking up the validator records from the
+// given discovery iterator.
+func newValidatorMesh(chain *core.BlockChain, engine meshEngine, server *p2p.Server, iter enode.Iterator) *validatorMesh {
+	return &validatorMesh{
+		chain:  chain,
+		engine: engine,
+		server: server,
+		iter: enode.Filter(iter, func(n *enode.Node) bool {
+			var entry cons.ValidatorEntry
+			return n.Load(&entry) == nil
+		}),
+		protected: make(map[common.Address]*enode.Node),
+		wake:      make(chan struct{}, 1),
+		quit:      make(chan struct{}),
+	}
+}
+
+// start launches the loops following the validator set and looking up the validators.
+func (m *validatorMesh) start() {
+	m.wg.Add(2)
+	go m.headLoop()
+	go m.lookupLoop()
+}
+
+// stop terminates the mesh maintenance, leaving the current connections untouched.
+func (m *validatorMesh) stop() {
+	m.iter.Close()
+	close(m.quit)
+	m.wg.Wait()
+}
+
+// headLoop follows the chain head to recompute the mesh when the validator set changes.
+func (m *validatorMesh) headLoop() {
+	defer m.wg.Done()
+
+	heads := make(chan core.ChainHeadEvent, 10)
+	sub := m.chain.SubscribeChainHeadEvent(heads)
+	defer sub.Unsubscribe()
+
+	m.update(m.chain.CurrentHeader())
+	for {
+		select {
+		case ev := <-heads:
+			m.update(ev.Block.Header())
+		case <-sub.Err():
+			return
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// update recomputes the mesh if the validators at the given head differ from the current
+// ones, and advertises the local validator once it's authorized.
+func (m *validatorMesh) update(head *types.Header) {
+	validators, err := m.engine.Validators(m.chain, head.Hash(), head.Number.Uint64())
+	if err != nil {
+		log.Debug("Failed to retrieve validators for the mesh", "number", head.Number, "err", err)
+		return
+	}
+	m.lock.Lock()
+	changed := len(validators) != len(m.validators)
+	for _, validator := range validators {
+		if _, ok := m.validators[validator]; !ok {
+			changed = true
+		}
+	}
+	var removed []*enode.Node
+	if changed {
+		m.validators = make(map[common.Address]struct{}, len(validators))
+		for _, validator := range validators {
+			m.validators[validator] = struct{}{}
+		}
+		for validator, node := range m.protected {
+			if _, ok := m.validators[validator]; !ok {
+				removed = append(removed, node)
+				delete(m.protected, validator)
+			}
+		}
+	}
+	m.lock.Unlock()
+
+	m.advertise()
+	if !changed {
+		return
+	}
+	for _, node := range removed {
+		m.server.RemoveProtectedPeer(node)
+	}
+	// Pick the records of the connected peers before looking up the missing ones
+	for _, peer := range m.server.Peers() {
+		m.learn(peer.Node())
+	}
+	log.Info("Updated validator mesh", "number", head.Number, "validators", len(validators), "removed", len(removed))
+	if m.missing() {
+		select {
+		case m.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// advertise signs the record of the local validator, if authorized, and sets it in the
+// local node record. The record is signed again whenever the local consensus key changes.
+func (m *validatorMesh) advertise() {
+	validator, key := m.engine.CurrentValidator(), m.engine.CurrentConsensusKey()
+	if validator == (common.Address{}) {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if validator == m.advertised && key == m.advertisedKey {
+		return
+	}
+	ln := m.server.LocalNode()
+	validator, sig, err := m.engine.SignValidatorRecord(common.Hash(ln.ID()))
+	if err != nil {
+		log.Warn("Failed to sign validator record", "validator", validator, "err", err)
+		return
+	}
+	ln.Set(&cons.ValidatorEntry{Validator: validator, Signature: sig})
+	m.advertised, m.advertisedKey = validator, key
+	log.Info("Advertising validator in node record", "validator", validator, "key", key, "id", ln.ID())
+}
+
+// learn authenticates the validator record of a node, and protects the node if it's run
+// by a validator of the current epoch.
+func (m *validatorMesh) learn(node *enode.Node) {
+	var entry cons.ValidatorEntry
+	if node.Load(&entry) != nil || node.ID() == m.server.LocalNode().ID() {
+		return
+	}
+	m.lock.Lock()
+	_, ok := m.validators[entry.Validator]
+	old := m.protected[entry.Validator]
+	m.lock.Unlock()
+
+	// Only the records of the current validators are kept, and only the newest one
+	if !ok || (old != nil && old.ID() == node.ID() && old.Seq() >= node.Seq()) {
+		return
+	}
+	head := m.chain.CurrentHeader()
+	if err := m.engine.VerifyValidatorRecord(m.chain, head.Hash(), head.Number.Uint64(), entry.Validator, common.Hash(node.ID()), entry.Signature); err != nil {
+		log.Debug("Discarding invalid validator record", "id", node.ID(), "validator", entry.Validator, "err", err)
+		return
+	}
+	m.lock.Lock()
+	if _, ok := m.validators[entry.Validator]; !ok || m.protected[entry.Validator] != old {
+		m.lock.Unlock()
+		return
+	}
+	m.protected[entry.Validator] = node
+	m.lock.Unlock()
+
+	if old != nil && old.ID() != node.ID() {
+		m.server.RemoveProtectedPeer(old)
+	}
+	m.server.AddProtectedPeer(node)
+	log.Debug("Protected validator node", "validator", entry.Validator, "id", node.ID())
+}
+
+// missing checks whether the nodes of some validators, the local one aside, are unknown.
+func (m *validatorMesh) missing() bool {
+	local := m.engine.CurrentValidator()
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for validator := range m.validators {
+		if _, ok := m.protected[validator]; !ok && validator != local {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupLoop looks up the records of the validators missing from the mesh.
+func (m *validatorMesh) lookupLoop() {
+	defer m.wg.Done()
+
+	for {
+		if m.missing() {
+			deadline := time.Now().Add(validatorLookupTimeout)
+			for m.missing() && time.Now().Before(deadline) {
+				if !m.iter.Next() {
+					return
+				}
+				m.learn(m.iter.Node())
+			}
+		}
+		// Wait for the validator set to change, or retry the lookup later
+		var retry <-chan time.Time
+		if m.missing() {
+			retry = time.After(validatorLookupRetry)
+		}
+		select {
+		case <-m.wake:
+		case <-retry:
+		case <-m.quit:
+			return
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package eth
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/consensus/ethash"
+	"github.com/QEasyWeb3/QEasyChain/core"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/vm"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+	"github.com/QEasyWeb3/QEasyChain/eth/protocols/cons"
+	"github.com/QEasyWeb3/QEasyChain/p2p"
+	"github.com/QEasyWeb3/QEasyChain/p2p/enode"
+	"github.com/QEasyWeb3/QEasyChain/params"
+)
+
+// testMeshEngine is a mock consensus engine whose validator records are "signed" with
+// the bare address of the consensus key of their validator.
+type testMeshEngine struct {
+	validators []common.Address                  // Validators of the current epoch
+	keys       map[common.Address]common.Address // Consensus keys of the validators
+	validator  common.Address                    // Local validator
+	signed     int                               // Number of records signed
+}
+
+func (e *testMeshEngine) Validators(chain consensus.ChainHeaderReader, hash common.Hash, number uint64) ([]common.Address, error) {
+	return e.validators, nil
+}
+
+func (e *testMeshEngine) CurrentValidator() common.Address    { return e.validator }
+func (e *testMeshEngine) CurrentConsensusKey() common.Address { return e.keys[e.validator] }
+
+func (e *testMeshEngine) SignValidatorRecord(node common.Hash) (common.Address, []byte, error) {
+	e.signed++
+	return e.validator, e.keys[e.validator].Bytes(), nil
+}
+
+func (e *testMeshEngine) VerifyValidatorRecord(chain consensus.ChainHeaderReader, hash common.Hash, number uint64, validator common.Address, node common.Hash, sig []byte) error {
+	if key, ok := e.keys[validator]; !ok || !bytes.Equal(sig, key.Bytes()) {
+		return errors.New("invalid record")
+	}
+	return nil
+}
+
+// newTestMesh creates a validator mesh over a small chain and a p2p server which doesn't
+// connect to anyone.
+func newTestMesh(t *testing.T, engine *testMeshEngine) (*validatorMesh, func()) {
+	db := rawdb.NewMemoryDatabase()
+	(&core.Genesis{Config: params.TestChainConfig}).MustCommit(db)
+	chain, err := core.NewBlockChain(db, nil, params.TestChainConfig, ethash.NewFaker(), vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	key, _ := crypto.GenerateKey()
+	server := &p2p.Server{Config: p2p.Config{PrivateKey: key, MaxPeers: 10, NoDiscovery: true}}
+	if err := server.Start(); err != nil {
+		t.Fatalf("failed to start server: %v", err)
+	}
+	return newValidatorMesh(chain, engine, server, enode.IterNodes(nil)), func() {
+		server.Stop()
+		chain.Stop()
+	}
+}
+
+// newTestValidatorNode creates a node advertising the given validator with the record
+// signature.
+func newTestValidatorNode(t *testing.T, validator common.Address, sig []byte) *enode.Node {
+	db, _ := enode.OpenDB("")
+	defer db.Close()
+
+	key, _ := crypto.GenerateKey()
+	ln := enode.NewLocalNode(db, key)
+	ln.Set(&cons.ValidatorEntry{Validator: validator, Signature: sig})
+	return ln.Node()
+}
+
+// Tests that the local record is signed again when the local consensus key changes, even
+// if the validators don't.
+func TestValidatorMeshAdvertise(t *testing.T) {
+	validator := common.Address{0x1}
+	engine := &testMeshEngine{
+		validators: []common.Address{validator},
+		keys:       map[common.Address]common.Address{validator: {0xa}},
+		validator:  validator,
+	}
+	mesh, stop := newTestMesh(t, engine)
+	defer stop()
+
+	advertised := func() cons.ValidatorEntry {
+		var entry cons.ValidatorEntry
+		if err := mesh.server.LocalNode().Node().Load(&entry); err != nil {
+			t.Fatalf("local node record without validator: %v", err)
+		}
+		return entry
+	}
+	head := mesh.chain.CurrentHeader()
+	for i := 0; i < 2; i++ {
+		mesh.update(head)
+	}
+	if engine.signed != 1 {
+		t.Fatalf("records signed mismatch: have %d, want 1", engine.signed)
+	}
+	if entry := advertised(); entry.Validator != validator || !bytes.Equal(entry.Signature, common.Address{0xa}.Bytes()) {
+		t.Fatalf("advertised record mismatch: have %x/%x", entry.Validator, entry.Signature)
+	}
+	// Rotating the local key signs the record again
+	engine.keys[validator] = common.Address{0xb}
+	mesh.update(head)
+	if engine.signed != 2 {
+		t.Fatalf("records signed mismatch: have %d, want 2", engine.signed)
+	}
+	if entry := advertised(); !bytes.Equal(entry.Signature, common.Address{0xb}.Bytes()) {
+		t.Fatalf("advertised record signature mismatch: have %x", entry.Signature)
+	}
+}
+
+// Tests that only the authenticated records of the current validators are protected, and
+// that they stop being protected once their validator leaves the set.
+func TestValidatorMeshLearn(t *testing.T) {
+	local, remote, outsider := common.Address{0x1}, common.Address{0x2}, common.Address{0x3}
+	engine := &testMeshEngine{
+		validators: []common.Address{local, remote},
+		keys:       map[common.Address]common.Address{local: {0xa}, remote: {0xb}, outsider: {0xc}},
+		validator:  local,
+	}
+	mesh, stop := newTestMesh(t, engine)
+	defer stop()
+
+	head := mesh.chain.CurrentHeader()
+	mesh.update(head)
+	if !mesh.missing() {
+		t.Fatalf("remote validator not missing")
+	}
+	mesh.learn(newTestValidatorNode(t, remote, common.Address{0xa}.Bytes()))
+	mesh.learn(newTestValidatorNode(t, outsider, common.Address{0xc}.Bytes()))
+	if len(mesh.protected) != 0 {
+		t.Fatalf("unauthenticated records protected: %v", mesh.protected)
+	}
+	node := newTestValidatorNode(t, remote, common.Address{0xb}.Bytes())
+	mesh.learn(node)
+	if mesh.protected[remote] != node || mesh.missing() {
+		t.Fatalf("validator record not protected")
+	}
+	// The validators leaving the set aren't protected anymore
+	engine.validators = []common.Address{local}
+	mesh.update(head)
+	if len(mesh.protected) != 0 {
+		t.Fatalf("former validator still protected: %v", mesh.protected)
+	}
+}
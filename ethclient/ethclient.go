@@ -81,7 +81,8 @@ func (ec *Client) BlockByHash(ctx context.Context, hash common.Hash) (*types.Blo
 }
 
 // BlockByNumber returns a block from the current canonical chain. If number is nil, the
-// latest known block is returned.
+// latest known block is returned. The rpc.SafeBlockNumber and rpc.FinalizedBlockNumber
+// values select the last justified and finalized blocks.
 //
 // Note that loading full blocks requires two requests. Use HeaderByNumber
 // if you don't need all transactions or uncle headers.
@@ -214,7 +215,8 @@ func (ec *Client) HeaderByHash(ctx context.Context, hash common.Hash) (*types.He
 }
 
 // HeaderByNumber returns a block header from the current canonical chain. If number is
-// nil, the latest known header is returned.
+// nil, the latest known header is returned. The rpc.SafeBlockNumber and
+// rpc.FinalizedBlockNumber values select the last justified and finalized headers.
 func (ec *Client) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
 	var head *types.Header
 	err := ec.c.CallContext(ctx, &head, "eth_getBlockByNumber", toBlockNumArg(number), false)
@@ -572,6 +574,14 @@ func toBlockNumArg(number *big.Int) string {
 	if number.Cmp(pending) == 0 {
 		return "pending"
 	}
+	if number.IsInt64() {
+		switch rpc.BlockNumber(number.Int64()) {
+		case rpc.SafeBlockNumber:
+			return "safe"
+		case rpc.FinalizedBlockNumber:
+			return "finalized"
+		}
+	}
 	return hexutil.EncodeBig(number)
 }
 
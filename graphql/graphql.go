@@ -49,6 +49,15 @@ func (b *Long) UnmarshalGraphQL(input interface{}) error {
 	var err error
 	switch input := input.(type) {
 	case string:
+		// support the block tags backed by the block status of the chain
+		switch input {
+		case "safe":
+			*b = Long(rpc.SafeBlockNumber)
+			return nil
+		case "finalized":
+			*b = Long(rpc.FinalizedBlockNumber)
+			return nil
+		}
 		// uncomment to support hex values
 		//if strings.HasPrefix(input, "0x") {
 		//	// apply leniency and support hex representations of longs.
@@ -1058,10 +1067,10 @@ func (r *Resolver) Block(ctx context.Context, args struct {
 }) (*Block, error) {
 	var block *Block
 	if args.Number != nil {
-		if *args.Number < 0 {
+		number := rpc.BlockNumber(*args.Number)
+		if number < 0 && number != rpc.SafeBlockNumber && number != rpc.FinalizedBlockNumber {
 			return nil, nil
 		}
-		number := rpc.BlockNumber(*args.Number)
 		numberOrHash := rpc.BlockNumberOrHashWithNumber(number)
 		block = &Block{
 			backend:      r.backend,
@@ -116,6 +116,39 @@ web3._extend({
 			call: 'democracy_getValidatorsAtHash',
 			params: 1
 		}),
+		new web3._extend.Method({
+			name: 'getFinalityProof',
+			call: 'democracy_getFinalityProof',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getValidatorPerformance',
+			call: 'democracy_getValidatorPerformance',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getPendingPunishments',
+			call: 'democracy_getPendingPunishments'
+		}),
+		new web3._extend.Method({
+			name: 'getPunishmentHistory',
+			call: 'democracy_getPunishmentHistory',
+			params: 3,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getValidatorSetHistory',
+			call: 'democracy_getValidatorSetHistory',
+			params: 2
+		}),
+		new web3._extend.Method({
+			name: 'getRewardSchedule',
+			call: 'democracy_getRewardSchedule',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
 	]
 });
 `
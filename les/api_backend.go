@@ -48,18 +48,18 @@ type LesApiBackend struct {
 }
 
 func (b *LesApiBackend) BlockPredictStatus(ctx context.Context, hash common.Hash, number rpc.BlockNumber) (uint8, error) {
-	//TODO implement me
-	panic("implement me")
+	return b.eth.blockchain.GetBlockPredictStatus(ctx, hash, uint64(number))
 }
 
 func (b *LesApiBackend) LastFinalizedBlockNumber(ctx context.Context) uint64 {
-	//TODO implement me
-	panic("implement me")
+	if number, err := b.eth.blockchain.UpdateLastFinalized(ctx); err == nil {
+		return number
+	}
+	return b.eth.blockchain.GetLastFinalizedBlockNumber()
 }
 
 func (b *LesApiBackend) SubscribeBlockPredictStatusEvent(ch chan<- core.NewJustifiedOrFinalizedBlockEvent) event.Subscription {
-	//TODO implement me
-	panic("implement me")
+	return b.eth.blockchain.SubscribeNewJustifiedOrFinalizedBlockEvent(ch)
 }
 
 func (b *LesApiBackend) ChainConfig() *params.ChainConfig {
@@ -85,6 +85,14 @@ func (b *LesApiBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumb
 	if number == rpc.LatestBlockNumber {
 		return b.eth.blockchain.CurrentHeader(), nil
 	}
+	// The light client doesn't follow the attestations gossip, so the latest
+	// finalized block is the safe one as well
+	if number == rpc.SafeBlockNumber || number == rpc.FinalizedBlockNumber {
+		if _, ok := b.eth.engine.(consensus.Democracy); !ok {
+			return nil, errors.New("safe and finalized blocks are only tracked by the democracy consensus engine")
+		}
+		return b.eth.blockchain.GetHeaderByNumberOdr(ctx, b.LastFinalizedBlockNumber(ctx))
+	}
 	return b.eth.blockchain.GetHeaderByNumberOdr(ctx, uint64(number))
 }
 
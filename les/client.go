@@ -18,6 +18,7 @@
 package les
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -26,9 +27,11 @@ import (
 	"github.com/QEasyWeb3/QEasyChain/common/hexutil"
 	"github.com/QEasyWeb3/QEasyChain/common/mclock"
 	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy"
 	"github.com/QEasyWeb3/QEasyChain/core"
 	"github.com/QEasyWeb3/QEasyChain/core/bloombits"
 	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/state"
 	"github.com/QEasyWeb3/QEasyChain/core/types"
 	"github.com/QEasyWeb3/QEasyChain/eth/ethconfig"
 	"github.com/QEasyWeb3/QEasyChain/eth/filters"
@@ -142,6 +145,14 @@ func New(stack *node.Node, config *ethconfig.Config) (*LightEthereum, error) {
 		return nil, err
 	}
 	leth.chainReader = leth.blockchain
+
+	// The BLS keys verifying aggregate attestations are registered in the state, which
+	// is retrieved on demand. The retrievals are aborted when the odr is stopped.
+	if democracyEngine, ok := leth.engine.(*democracy.Democracy); ok {
+		democracyEngine.SetHeaderStateFn(func(header *types.Header) (*state.StateDB, error) {
+			return light.NewState(context.Background(), header, leth.odr), nil
+		})
+	}
 	leth.txPool = light.NewTxPool(leth.chainConfig, leth.blockchain, leth.relay)
 
 	// Set up checkpoint oracle.
@@ -363,9 +374,44 @@ func (s *LightEthereum) Start() error {
 	s.startBloomHandlers(params.BloomBitsBlocksClient)
 	s.handler.start()
 
+	// Track the finalized blocks if the chain is sealed by validators attesting it
+	if _, ok := s.engine.(consensus.Democracy); ok {
+		s.wg.Add(1)
+		go s.finalityLoop()
+	}
+
 	return nil
 }
 
+// finalityUpdateTimeout is the maximum time allowed to retrieve the attestations
+// of the recent blocks when looking for a newly finalized block.
+const finalityUpdateTimeout = 5 * time.Second
+
+// finalityLoop looks for a newly finalized block whenever the chain head is
+// updated, so that the finality subscriptions are notified without polling.
+func (s *LightEthereum) finalityLoop() {
+	defer s.wg.Done()
+
+	headCh := make(chan core.ChainHeadEvent, 1)
+	headSub := s.blockchain.SubscribeChainHeadEvent(headCh)
+	defer headSub.Unsubscribe()
+
+	for {
+		select {
+		case <-headCh:
+			ctx, cancel := context.WithTimeout(context.Background(), finalityUpdateTimeout)
+			if _, err := s.blockchain.UpdateLastFinalized(ctx); err != nil {
+				log.Debug("Failed to update finalized block", "err", err)
+			}
+			cancel()
+		case <-headSub.Err():
+			return
+		case <-s.closeCh:
+			return
+		}
+	}
+}
+
 // Stop implements node.Lifecycle, terminating all internal goroutines used by the
 // Ethereum protocol.
 func (s *LightEthereum) Stop() error {
@@ -342,6 +342,22 @@ func (h *clientHandler) handleMsg(p *serverPeer) error {
 			ReqID:   resp.ReqID,
 			Obj:     resp.Status,
 		}
+	case msg.Code == AttestationsMsg && p.version >= lpv5:
+		p.Log().Trace("Received block attestations response")
+		var resp struct {
+			ReqID, BV    uint64
+			Attestations []*light.BlockAttestations
+		}
+		if err := msg.Decode(&resp); err != nil {
+			return errResp(ErrDecode, "msg %v: %v", msg, err)
+		}
+		p.fcServer.ReceivedReply(resp.ReqID, resp.BV)
+		p.answeredRequest(resp.ReqID)
+		deliverMsg = &Msg{
+			MsgType: MsgAttestations,
+			ReqID:   resp.ReqID,
+			Obj:     resp.Attestations,
+		}
 	case msg.Code == StopMsg && p.version >= lpv3:
 		p.freeze()
 		h.backend.retriever.frozen(p)
@@ -93,6 +93,7 @@ const (
 	MsgProofsV2
 	MsgHelperTrieProofs
 	MsgTxStatus
+	MsgAttestations
 )
 
 // Msg encodes a LES message that delivers reply data for a request
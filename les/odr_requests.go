@@ -43,6 +43,9 @@ var (
 	errCHTHashMismatch     = errors.New("cht hash mismatch")
 	errCHTNumberMismatch   = errors.New("cht number mismatch")
 	errUselessNodes        = errors.New("useless nodes in merkle proof nodeset")
+
+	errInvalidAttestation        = errors.New("invalid attestation")
+	errAttestationTargetMismatch = errors.New("attestation target mismatch")
 )
 
 type LesOdrRequest interface {
@@ -68,6 +71,8 @@ func LesRequest(req light.OdrRequest) LesOdrRequest {
 		return (*BloomRequest)(r)
 	case *light.TxStatusRequest:
 		return (*TxStatusRequest)(r)
+	case *light.AttestationsRequest:
+		return (*AttestationsRequest)(r)
 	default:
 		return nil
 	}
@@ -513,6 +518,74 @@ func (r *TxStatusRequest) Validate(db ethdb.Database, msg *Msg) error {
 	return nil
 }
 
+// AttestationsRequest is the ODR request type for retrieving block attestations
+type AttestationsRequest light.AttestationsRequest
+
+// GetCost returns the cost of the given ODR request according to the serving
+// peer's cost table (implementation of LesOdrRequest)
+func (r *AttestationsRequest) GetCost(peer *serverPeer) uint64 {
+	return peer.getRequestCost(GetAttestationsMsg, len(r.Blocks))
+}
+
+// CanSend tells if a certain peer is suitable for serving the given request
+func (r *AttestationsRequest) CanSend(peer *serverPeer) bool {
+	return peer.version >= lpv5
+}
+
+// Request sends an ODR request to the LES network (implementation of LesOdrRequest)
+func (r *AttestationsRequest) Request(reqID uint64, peer *serverPeer) error {
+	peer.Log().Debug("Requesting block attestations", "count", len(r.Blocks))
+	return peer.requestAttestations(reqID, r.Blocks)
+}
+
+// Validate processes an ODR request reply message from the LES network
+// returns true and stores results in memory if the message was a valid reply
+// to the request (implementation of LesOdrRequest)
+func (r *AttestationsRequest) Validate(db ethdb.Database, msg *Msg) error {
+	log.Debug("Validating block attestations", "count", len(r.Blocks))
+
+	if msg.MsgType != MsgAttestations {
+		return errInvalidMessageType
+	}
+	attestations := msg.Obj.([]*light.BlockAttestations)
+	if len(attestations) != len(r.Blocks) {
+		return errInvalidEntryCount
+	}
+	// Verify that the attestations target the requested blocks and are signed
+	for i, block := range r.Blocks {
+		if attestations[i] == nil {
+			return errInvalidAttestation
+		}
+		for _, a := range attestations[i].Attestations {
+			if a == nil || a.SourceRangeEdge == nil || a.TargetRangeEdge == nil {
+				return errInvalidAttestation
+			}
+			if !attestationTargets(a.TargetRangeEdge, block) {
+				return errAttestationTargetMismatch
+			}
+			if _, err := a.RecoverSigner(); err != nil {
+				return errInvalidAttestation
+			}
+		}
+		// The aggregate signatures are checked against the validator set by the caller
+		for _, a := range attestations[i].Aggregates {
+			if err := a.SanityCheck(); err != nil {
+				return errInvalidAttestation
+			}
+			if !attestationTargets(a.TargetRangeEdge, block) {
+				return errAttestationTargetMismatch
+			}
+		}
+	}
+	r.Attestations = attestations
+	return nil
+}
+
+// attestationTargets reports whether an attestation target is the requested block.
+func attestationTargets(target *types.RangeEdge, block *types.RangeEdge) bool {
+	return target.Hash == block.Hash && target.Number != nil && target.Number.Cmp(block.Number) == 0
+}
+
 // readTraceDB stores the keys of database reads. We use this to check that received node
 // sets contain only the trie nodes necessary to make proofs pass.
 type readTraceDB struct {
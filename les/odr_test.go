@@ -33,6 +33,8 @@ import (
 	"github.com/QEasyWeb3/QEasyChain/core/state"
 	"github.com/QEasyWeb3/QEasyChain/core/types"
 	"github.com/QEasyWeb3/QEasyChain/core/vm"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+	"github.com/QEasyWeb3/QEasyChain/crypto/bls"
 	"github.com/QEasyWeb3/QEasyChain/ethdb"
 	"github.com/QEasyWeb3/QEasyChain/light"
 	"github.com/QEasyWeb3/QEasyChain/params"
@@ -436,3 +438,41 @@ func randomHash() common.Hash {
 	}
 	return hash
 }
+
+// Tests that a reply to an attestations request is only accepted if every attestation,
+// single or aggregate, targets the requested block.
+func TestAttestationsRequestValidate(t *testing.T) {
+	source := &types.RangeEdge{Hash: common.Hash{0x1}, Number: big.NewInt(1)}
+	target := &types.RangeEdge{Hash: common.Hash{0x2}, Number: big.NewInt(2)}
+	other := &types.RangeEdge{Hash: common.Hash{0x3}, Number: big.NewInt(2)}
+
+	key, _ := crypto.GenerateKey()
+	sig, _ := crypto.Sign(types.AttestationSignHash(source, target).Bytes(), key)
+	single := types.NewAttestation(source, target, sig)
+
+	sk, _ := bls.GenerateKey()
+	aggregate := func(target *types.RangeEdge) *types.AggregateAttestation {
+		a, err := types.NewAggregateAttestation(source, target, 0, 4, sk.Sign(types.AttestationData(source, target)).Bytes())
+		if err != nil {
+			t.Fatalf("failed to create aggregate: %v", err)
+		}
+		return a
+	}
+	tests := []struct {
+		reply []*light.BlockAttestations
+		err   error
+	}{
+		{[]*light.BlockAttestations{{Attestations: []*types.Attestation{single}, Aggregates: []*types.AggregateAttestation{aggregate(target)}}}, nil},
+		{[]*light.BlockAttestations{{}}, nil},
+		{[]*light.BlockAttestations{{Aggregates: []*types.AggregateAttestation{aggregate(other)}}}, errAttestationTargetMismatch},
+		{[]*light.BlockAttestations{{Aggregates: []*types.AggregateAttestation{{SourceRangeEdge: source, TargetRangeEdge: target}}}}, errInvalidAttestation},
+		{[]*light.BlockAttestations{nil}, errInvalidAttestation},
+		{[]*light.BlockAttestations{}, errInvalidEntryCount},
+	}
+	for i, tt := range tests {
+		req := &AttestationsRequest{Blocks: []*types.RangeEdge{target}}
+		if err := req.Validate(nil, &Msg{MsgType: MsgAttestations, Obj: tt.reply}); err != tt.err {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, tt.err)
+		}
+	}
+}
@@ -481,6 +481,12 @@ func (p *serverPeer) requestTxStatus(reqID uint64, txHashes []common.Hash) error
 	return p.sendRequest(GetTxStatusMsg, reqID, txHashes, len(txHashes))
 }
 
+// requestAttestations fetches the attestations of a batch of blocks from a remote node.
+func (p *serverPeer) requestAttestations(reqID uint64, blocks []*types.RangeEdge) error {
+	p.Log().Debug("Requesting block attestations", "count", len(blocks))
+	return p.sendRequest(GetAttestationsMsg, reqID, blocks, len(blocks))
+}
+
 // sendTxs creates a reply with a batch of transactions to be added to the remote transaction pool.
 func (p *serverPeer) sendTxs(reqID uint64, amount int, txs rlp.RawValue) error {
 	p.Log().Debug("Sending batch of transactions", "amount", amount, "size", len(txs))
@@ -666,6 +672,10 @@ func (p *serverPeer) Handshake(genesis common.Hash, forkid forkid.ID, forkFilter
 
 		if !p.onlyAnnounce {
 			for msgCode := range reqAvgTimeCost {
+				// Messages of later protocol versions aren't priced by older servers
+				if msgCode >= ProtocolLengths[uint(p.version)] {
+					continue
+				}
 				if p.fcCosts[msgCode] == nil {
 					return errResp(ErrUselessPeer, "peer does not support message %d", msgCode)
 				}
@@ -924,6 +934,12 @@ func (p *clientPeer) replyTxStatus(reqID uint64, stats []light.TxStatus) *reply
 	return &reply{p.rw, TxStatusMsg, reqID, data}
 }
 
+// replyAttestations creates a reply with the attestations of a batch of blocks, corresponding to the ones requested.
+func (p *clientPeer) replyAttestations(reqID uint64, attestations []*light.BlockAttestations) *reply {
+	data, _ := rlp.EncodeToBytes(attestations)
+	return &reply{p.rw, AttestationsMsg, reqID, data}
+}
+
 // sendAnnounce announces the availability of a number of blocks through
 // a hash notification.
 func (p *clientPeer) sendAnnounce(request announceData) error {
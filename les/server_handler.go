@@ -50,6 +50,7 @@ const (
 	MaxHelperTrieProofsFetch = 64  // Amount of helper tries to be fetched per retrieval request
 	MaxTxSend                = 64  // Amount of transactions to be send per request
 	MaxTxStatus              = 256 // Amount of transactions to queried per request
+	MaxAttestationsFetch     = 32  // Amount of block attestations to be fetched per retrieval request
 )
 
 var (
@@ -145,6 +145,16 @@ var Les3 = map[uint64]RequestType{
 		ServingTimeMeter: miscServingTimeTxStatusTimer,
 		Handle:           handleGetTxStatus,
 	},
+	GetAttestationsMsg: {
+		Name:             "block attestations request",
+		MaxCount:         MaxAttestationsFetch,
+		InPacketsMeter:   miscInAttestationPacketsMeter,
+		InTrafficMeter:   miscInAttestationTrafficMeter,
+		OutPacketsMeter:  miscOutAttestationPacketsMeter,
+		OutTrafficMeter:  miscOutAttestationTrafficMeter,
+		ServingTimeMeter: miscServingTimeAttestationTimer,
+		Handle:           handleGetAttestations,
+	},
 }
 
 // handleGetBlockHeaders handles a block header request
@@ -551,6 +561,30 @@ func handleGetTxStatus(msg Decoder) (serveRequestFn, uint64, uint64, error) {
 	}, r.ReqID, uint64(len(r.Hashes)), nil
 }
 
+// handleGetAttestations handles a block attestations request
+func handleGetAttestations(msg Decoder) (serveRequestFn, uint64, uint64, error) {
+	var r GetAttestationsPacket
+	if err := msg.Decode(&r); err != nil {
+		return nil, 0, 0, err
+	}
+	return func(backend serverBackend, p *clientPeer, waitOrStop func() bool) *reply {
+		bc := backend.BlockChain()
+		attestations := make([]*light.BlockAttestations, len(r.Blocks))
+		for i, block := range r.Blocks {
+			if i != 0 && !waitOrStop() {
+				return nil
+			}
+			attestations[i] = new(light.BlockAttestations)
+			if block == nil || block.Number == nil {
+				continue
+			}
+			// Unknown blocks are answered with empty attestation lists
+			attestations[i].Attestations, attestations[i].Aggregates = bc.GetBlockAttestations(block.Number, block.Hash)
+		}
+		return p.replyAttestations(r.ReqID, attestations)
+	}, r.ReqID, uint64(len(r.Blocks)), nil
+}
+
 // txStatus returns the status of a specified transaction.
 func txStatus(b serverBackend, hash common.Hash) light.TxStatus {
 	var stat light.TxStatus
@@ -0,0 +1,234 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package light
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus"
+	"github.com/QEasyWeb3/QEasyChain/core"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/event"
+	"github.com/QEasyWeb3/QEasyChain/log"
+)
+
+const (
+	// unableSureBlockStateInterval is the distance from the head after which a
+	// canonical block is considered finalized, same as the full node does.
+	unableSureBlockStateInterval = 100
+
+	// maxFinalityProbe is the maximum number of recent blocks whose attestations
+	// are retrieved at once when looking for a newly finalized block.
+	maxFinalityProbe = 32
+)
+
+// errNotDemocracy is returned if finality is queried on a chain which isn't
+// sealed by the Democracy consensus engine.
+var errNotDemocracy = errors.New("finality is only tracked by the democracy consensus engine")
+
+// GetBlockPredictStatus retrieves the justified or finalized status of a block.
+// The attestations of the block and its canonical child are fetched from the
+// network and verified against the validator set of the checkpoint headers.
+func (lc *LightChain) GetBlockPredictStatus(ctx context.Context, hash common.Hash, number uint64) (uint8, error) {
+	head := lc.CurrentHeader().Number.Uint64()
+	if number > head {
+		return types.BasUnknown, nil
+	}
+	if number <= lc.GetLastFinalizedBlockNumber() {
+		if rawdb.ReadCanonicalHash(lc.chainDb, number) == hash {
+			return types.BasFinalized, nil
+		}
+		return types.BasReorged, nil
+	}
+	header := lc.GetHeader(hash, number)
+	if header == nil {
+		return types.BasUnknown, nil
+	}
+	headers := []*types.Header{header}
+	if child := lc.GetHeaderByNumber(number + 1); child != nil && child.ParentHash == hash {
+		headers = append(headers, child)
+	}
+	justified, err := lc.justified(ctx, headers)
+	if err != nil {
+		return types.BasUnknown, err
+	}
+	switch {
+	case !justified[0]:
+		return types.BasUnknown, nil
+	case len(justified) > 1 && justified[1]:
+		lc.setLastFinalized(header)
+		return types.BasFinalized, nil
+	default:
+		lc.setLastJustified(header)
+		return types.BasJustified, nil
+	}
+}
+
+// GetLastFinalizedBlockNumber retrieves the number of the latest block known to be finalized.
+func (lc *LightChain) GetLastFinalizedBlockNumber() uint64 {
+	number := atomic.LoadUint64(&lc.lastFinalized)
+	if head := lc.CurrentHeader().Number.Uint64(); head > unableSureBlockStateInterval {
+		if deep := head - unableSureBlockStateInterval; number < deep {
+			return deep
+		}
+	}
+	return number
+}
+
+// UpdateLastFinalized looks for a newly finalized block among the recent canonical
+// blocks, retrieving their attestations from the network in a single request.
+func (lc *LightChain) UpdateLastFinalized(ctx context.Context) (uint64, error) {
+	democracy, ok := lc.engine.(consensus.Democracy)
+	if !ok {
+		return 0, errNotDemocracy
+	}
+	var (
+		last   = lc.GetLastFinalizedBlockNumber()
+		header = lc.CurrentHeader()
+		head   = header.Number.Uint64()
+		delay  = democracy.AttestationDelay(lc, header)
+	)
+	// Only the blocks older than the attestation delay may be attested already
+	if head <= last+delay+1 {
+		return last, nil
+	}
+	to := head - delay
+	from := last + 1
+	if to-from+1 > maxFinalityProbe {
+		from = to - maxFinalityProbe + 1
+	}
+	headers := make([]*types.Header, 0, to-from+1)
+	for number := from; number <= to; number++ {
+		header := lc.GetHeaderByNumber(number)
+		if header == nil {
+			break
+		}
+		headers = append(headers, header)
+	}
+	justified, err := lc.justified(ctx, headers)
+	if err != nil {
+		return last, err
+	}
+	for i := len(justified) - 2; i >= 0; i-- {
+		if justified[i] && justified[i+1] {
+			lc.setLastFinalized(headers[i])
+			return headers[i].Number.Uint64(), nil
+		}
+	}
+	return last, nil
+}
+
+// SubscribeNewJustifiedOrFinalizedBlockEvent registers a subscription of the block
+// statuses learned by the light client.
+func (lc *LightChain) SubscribeNewJustifiedOrFinalizedBlockEvent(ch chan<- core.NewJustifiedOrFinalizedBlockEvent) event.Subscription {
+	return lc.scope.Track(lc.blockStatusFeed.Subscribe(ch))
+}
+
+// setLastFinalized records a canonical block as the latest finalized one.
+func (lc *LightChain) setLastFinalized(header *types.Header) {
+	number := header.Number.Uint64()
+	for {
+		last := atomic.LoadUint64(&lc.lastFinalized)
+		if number <= last {
+			return
+		}
+		if atomic.CompareAndSwapUint64(&lc.lastFinalized, last, number) {
+			break
+		}
+	}
+	rawdb.WriteLastFinalizedBlockNumber(lc.chainDb, new(big.Int).SetUint64(number))
+	lc.blockStatusFeed.Send(core.NewJustifiedOrFinalizedBlockEvent{
+		JF: &types.BlockStatus{BlockNumber: header.Number, Hash: header.Hash(), Status: types.BasFinalized},
+	})
+}
+
+// setLastJustified announces a canonical block as justified, unless a block at the
+// same height or above was announced already.
+func (lc *LightChain) setLastJustified(header *types.Header) {
+	number := header.Number.Uint64()
+	for {
+		last := atomic.LoadUint64(&lc.lastJustified)
+		if number <= last {
+			return
+		}
+		if atomic.CompareAndSwapUint64(&lc.lastJustified, last, number) {
+			break
+		}
+	}
+	lc.blockStatusFeed.Send(core.NewJustifiedOrFinalizedBlockEvent{
+		JF: &types.BlockStatus{BlockNumber: header.Number, Hash: header.Hash(), Status: types.BasJustified},
+	})
+}
+
+// justified retrieves the attestations of a batch of headers, and reports which of
+// them are attested by enough validators with the same source, counting both the
+// single attestations and the signers of the aggregate ones.
+func (lc *LightChain) justified(ctx context.Context, headers []*types.Header) ([]bool, error) {
+	democracy, ok := lc.engine.(consensus.Democracy)
+	if !ok {
+		return nil, errNotDemocracy
+	}
+	blocks := make([]*types.RangeEdge, len(headers))
+	for i, header := range headers {
+		blocks[i] = &types.RangeEdge{Hash: header.Hash(), Number: header.Number}
+	}
+	attestations, err := GetAttestations(ctx, lc.odr, blocks)
+	if err != nil {
+		return nil, err
+	}
+	justified := make([]bool, len(headers))
+	for i, header := range headers {
+		threshold, err := democracy.AttestationThreshold(lc, header.Hash(), header.Number.Uint64())
+		if err != nil {
+			return nil, err
+		}
+		signers := make(map[common.Hash]map[common.Address]struct{})
+		attest := func(source common.Hash, validators ...common.Address) {
+			if signers[source] == nil {
+				signers[source] = make(map[common.Address]struct{})
+			}
+			for _, validator := range validators {
+				signers[source][validator] = struct{}{}
+			}
+			if len(signers[source]) >= threshold {
+				justified[i] = true
+			}
+		}
+		for _, a := range attestations[i].Attestations {
+			signer, _, err := democracy.VerifyAttestation(lc, a)
+			if err != nil {
+				log.Debug("Dropped invalid attestation", "number", header.Number, "hash", header.Hash(), "err", err)
+				continue
+			}
+			attest(a.SourceRangeEdge.Hash, signer)
+		}
+		for _, a := range attestations[i].Aggregates {
+			validators, _, err := democracy.VerifyAggregateAttestation(lc, a)
+			if err != nil {
+				log.Debug("Dropped invalid aggregate attestation", "number", header.Number, "hash", header.Hash(), "err", err)
+				continue
+			}
+			attest(a.SourceRangeEdge.Hash, validators...)
+		}
+	}
+	return justified, nil
+}
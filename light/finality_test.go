@@ -0,0 +1,156 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package light
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/QEasyWeb3/QEasyChain/common"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy"
+	"github.com/QEasyWeb3/QEasyChain/core"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
+	"github.com/QEasyWeb3/QEasyChain/crypto"
+	"github.com/QEasyWeb3/QEasyChain/ethdb"
+	"github.com/QEasyWeb3/QEasyChain/params"
+)
+
+// attestationsOdr serves the attestations of the blocks it was given.
+type attestationsOdr struct {
+	dummyOdr
+	attestations map[common.Hash]*BlockAttestations
+}
+
+func (odr *attestationsOdr) Retrieve(ctx context.Context, req OdrRequest) error {
+	if req, ok := req.(*AttestationsRequest); ok {
+		req.Attestations = make([]*BlockAttestations, len(req.Blocks))
+		for i, block := range req.Blocks {
+			if req.Attestations[i] = odr.attestations[block.Hash]; req.Attestations[i] == nil {
+				req.Attestations[i] = new(BlockAttestations)
+			}
+		}
+	}
+	return nil
+}
+
+// newTestFinalityChain creates a light chain of n headers sealed by a single validator.
+func newTestFinalityChain(t *testing.T, n int) (*LightChain, *attestationsOdr, []*types.Header) {
+	key, _ := crypto.GenerateKey()
+	validator := crypto.PubkeyToAddress(key.PublicKey)
+
+	// The genesis is written directly, the system contracts aren't needed by a light client
+	db := rawdb.NewMemoryDatabase()
+	config := &params.ChainConfig{ChainID: big.NewInt(1), Democracy: &params.DemocracyConfig{Period: 3, Epoch: 200}}
+	genesis := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(0), Difficulty: big.NewInt(2), Time: 1,
+		Extra: append(append(make([]byte, 32), validator.Bytes()...), make([]byte, crypto.SignatureLength)...)})
+	rawdb.WriteBlock(db, genesis)
+	writeTestHeader(db, genesis.Header())
+
+	headers := []*types.Header{genesis.Header()}
+	for i := 1; i <= n; i++ {
+		header := &types.Header{Number: big.NewInt(int64(i)), ParentHash: headers[i-1].Hash(), Coinbase: validator,
+			Difficulty: big.NewInt(2), Time: genesis.Time() + uint64(i)*3, Extra: make([]byte, 32+crypto.SignatureLength)}
+		sig, err := crypto.Sign(democracy.SealHash(header).Bytes(), key)
+		if err != nil {
+			t.Fatalf("failed to seal header: %v", err)
+		}
+		copy(header.Extra[32:], sig)
+		writeTestHeader(db, header)
+		headers = append(headers, header)
+	}
+	rawdb.WriteHeadHeaderHash(db, headers[n].Hash())
+
+	odr := &attestationsOdr{dummyOdr: dummyOdr{db: db}, attestations: make(map[common.Hash]*BlockAttestations)}
+	lc, err := NewLightChain(odr, config, democracy.New(config, db), nil)
+	if err != nil {
+		t.Fatalf("failed to create light chain: %v", err)
+	}
+	// Attest every block with the key of the validator
+	for i := 1; i <= n; i++ {
+		source := &types.RangeEdge{Hash: headers[i-1].Hash(), Number: headers[i-1].Number}
+		target := &types.RangeEdge{Hash: headers[i].Hash(), Number: headers[i].Number}
+		sig, err := crypto.Sign(types.AttestationSignHash(source, target).Bytes(), key)
+		if err != nil {
+			t.Fatalf("failed to sign attestation: %v", err)
+		}
+		odr.attestations[target.Hash] = &BlockAttestations{Attestations: []*types.Attestation{types.NewAttestation(source, target, sig)}}
+	}
+	return lc, odr, headers
+}
+
+func writeTestHeader(db ethdb.Database, header *types.Header) {
+	rawdb.WriteHeader(db, header)
+	rawdb.WriteTd(db, header.Hash(), header.Number.Uint64(), new(big.Int).Add(header.Number, common.Big1))
+	rawdb.WriteCanonicalHash(db, header.Hash(), header.Number.Uint64())
+}
+
+// Tests that the status of a block is computed from the attestations retrieved on demand,
+// and that each status change is announced only once however often it's queried.
+func TestBlockPredictStatus(t *testing.T) {
+	lc, odr, headers := newTestFinalityChain(t, 4)
+
+	events := make(chan core.NewJustifiedOrFinalizedBlockEvent, 10)
+	sub := lc.SubscribeNewJustifiedOrFinalizedBlockEvent(events)
+	defer sub.Unsubscribe()
+
+	// The last block is attested, but its child isn't known yet
+	last := headers[4]
+	for i := 0; i < 3; i++ {
+		status, err := lc.GetBlockPredictStatus(context.Background(), last.Hash(), 4)
+		if err != nil {
+			t.Fatalf("failed to retrieve status: %v", err)
+		}
+		if status != types.BasJustified {
+			t.Fatalf("status mismatch: have %d, want %d", status, types.BasJustified)
+		}
+	}
+	// A block with an attested child is finalized
+	status, err := lc.GetBlockPredictStatus(context.Background(), headers[2].Hash(), 2)
+	if err != nil || status != types.BasFinalized {
+		t.Fatalf("status mismatch: have %d (%v), want %d", status, err, types.BasFinalized)
+	}
+	if number := lc.GetLastFinalizedBlockNumber(); number != 2 {
+		t.Fatalf("last finalized mismatch: have %d, want 2", number)
+	}
+	// A block without attestations isn't justified
+	delete(odr.attestations, headers[3].Hash())
+	if status, err := lc.GetBlockPredictStatus(context.Background(), headers[3].Hash(), 3); err != nil || status != types.BasUnknown {
+		t.Fatalf("status mismatch: have %d (%v), want %d", status, err, types.BasUnknown)
+	}
+	want := []*types.BlockStatus{
+		{BlockNumber: last.Number, Hash: last.Hash(), Status: types.BasJustified},
+		{BlockNumber: headers[2].Number, Hash: headers[2].Hash(), Status: types.BasFinalized},
+	}
+	for i, status := range want {
+		select {
+		case ev := <-events:
+			if ev.JF.Hash != status.Hash || ev.JF.Status != status.Status {
+				t.Fatalf("event %d mismatch: have %x/%d, want %x/%d", i, ev.JF.Hash, ev.JF.Status, status.Hash, status.Status)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("event %d missing", i)
+		}
+	}
+	select {
+	case ev := <-events:
+		t.Fatalf("unexpected event: %x/%d", ev.JF.Hash, ev.JF.Status)
+	default:
+	}
+}
@@ -49,6 +49,10 @@ var (
 // headers, downloading block bodies and receipts on demand through an ODR
 // interface. It only does header validation during chain insertion.
 type LightChain struct {
+	lastFinalized   uint64     // Number of the latest finalized block (accessed atomically, first for 64 bit alignment)
+	lastJustified   uint64     // Number of the latest justified block announced on the feed (accessed atomically)
+	blockStatusFeed event.Feed // Feed of the justified or finalized blocks learned from the network
+
 	hc            *core.HeaderChain
 	indexerConfig *IndexerConfig
 	chainDb       ethdb.Database
@@ -163,6 +167,8 @@ func (lc *LightChain) loadLastState() error {
 			lc.hc.SetCurrentHeader(header)
 		}
 	}
+	atomic.StoreUint64(&lc.lastFinalized, rawdb.LastFinalizedBlockNumber(lc.chainDb).Uint64())
+
 	// Issue a status log and return
 	header := lc.hc.CurrentHeader()
 	headerTd := lc.GetTd(header.Hash(), header.Number.Uint64())
@@ -191,3 +191,20 @@ type TxStatusRequest struct {
 
 // StoreResult stores the retrieved data in local database
 func (req *TxStatusRequest) StoreResult(db ethdb.Database) {}
+
+// BlockAttestations is the set of attestations known for a block, the single ones
+// and the BLS aggregates of the validators after the Jupiter hard-fork
+type BlockAttestations struct {
+	Attestations []*types.Attestation
+	Aggregates   []*types.AggregateAttestation
+}
+
+// AttestationsRequest is the ODR request type for retrieving the attestations
+// collected for a batch of blocks
+type AttestationsRequest struct {
+	Blocks       []*types.RangeEdge
+	Attestations []*BlockAttestations
+}
+
+// StoreResult stores the retrieved data in local database
+func (req *AttestationsRequest) StoreResult(db ethdb.Database) {}
@@ -292,3 +292,14 @@ func GetTransaction(ctx context.Context, odr OdrBackend, txHash common.Hash) (*t
 	}
 	return body.Transactions[pos.Index], pos.BlockHash, pos.BlockIndex, pos.Index, nil
 }
+
+// GetAttestations retrieves the attestations collected for a batch of blocks. The
+// attestations are guaranteed to target the requested blocks and to be well formed,
+// but the signers are not checked against the validator set.
+func GetAttestations(ctx context.Context, odr OdrBackend, blocks []*types.RangeEdge) ([]*BlockAttestations, error) {
+	r := &AttestationsRequest{Blocks: blocks}
+	if err := odr.Retrieve(ctx, r); err != nil {
+		return nil, err
+	}
+	return r.Attestations, nil
+}
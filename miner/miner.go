@@ -43,15 +43,19 @@ type Backend interface {
 
 // Config is the configuration parameters of mining.
 type Config struct {
-	Etherbase  common.Address `toml:",omitempty"` // Public address for block mining rewards (default = first account)
-	Notify     []string       `toml:",omitempty"` // HTTP URL list to be notified of new work packages (only useful in ethash).
-	NotifyFull bool           `toml:",omitempty"` // Notify with pending block headers instead of work packages
-	ExtraData  hexutil.Bytes  `toml:",omitempty"` // Block extra data set by the miner
-	GasFloor   uint64         // Target gas floor for mined blocks.
-	GasCeil    uint64         // Target gas ceiling for mined blocks.
-	GasPrice   *big.Int       // Minimum gas price for mining a transaction
-	Recommit   time.Duration  // The time interval for miner to re-create mining work.
-	Noverify   bool           // Disable remote mining solution verification(only useful in ethash).
+	Etherbase          common.Address `toml:",omitempty"` // Public address for block mining rewards (default = first account)
+	Notify             []string       `toml:",omitempty"` // HTTP URL list to be notified of new work packages (only useful in ethash).
+	NotifyFull         bool           `toml:",omitempty"` // Notify with pending block headers instead of work packages
+	ExtraData          hexutil.Bytes  `toml:",omitempty"` // Block extra data set by the miner
+	GasFloor           uint64         // Target gas floor for mined blocks.
+	GasCeil            uint64         // Target gas ceiling for mined blocks.
+	GasPrice           *big.Int       // Minimum gas price for mining a transaction
+	Recommit           time.Duration  // The time interval for miner to re-create mining work.
+	Noverify           bool           // Disable remote mining solution verification(only useful in ethash).
+	BLSKeyFile         string         `toml:",omitempty"` // Encrypted BLS key file for aggregate attestations (only useful in democracy).
+	BLSPasswordFile    string         `toml:",omitempty"` // Passphrase file of the BLS key (only useful in democracy).
+	DoppelgangerBlocks uint64         `toml:",omitempty"` // Blocks to watch for the validator active elsewhere before signing (only useful in democracy).
+	ConsensusKey       common.Address `toml:",omitempty"` // Rotated key to seal and attest with (only useful in democracy, default = etherbase)
 }
 
 // Miner creates blocks and searches for proof-of-work values.
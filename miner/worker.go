@@ -802,7 +802,7 @@ func (w *worker) commitTransactions(txs *types.TransactionsByPriceAndNonce, coin
 	gasLimit := w.current.header.GasLimit
 	if w.current.gasPool == nil {
 		if w.isDemocracy {
-			w.current.gasPool = new(core.GasPool).AddGas(w.democracy.CalculateGasPool(w.current.header))
+			w.current.gasPool = new(core.GasPool).AddGas(w.democracy.CalculateGasPool(w.chain, w.current.header))
 		} else {
 			w.current.gasPool = new(core.GasPool).AddGas(gasLimit)
 		}
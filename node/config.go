@@ -26,6 +26,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/QEasyWeb3/QEasyChain/accounts/web3signer"
 	"github.com/QEasyWeb3/QEasyChain/common"
 	"github.com/QEasyWeb3/QEasyChain/crypto"
 	"github.com/QEasyWeb3/QEasyChain/log"
@@ -80,6 +81,9 @@ type Config struct {
 	// ExternalSigner specifies an external URI for a clef-type signer
 	ExternalSigner string `toml:",omitempty"`
 
+	// RemoteSigner specifies a Web3Signer-compatible HTTP signer holding the validator keys
+	RemoteSigner *web3signer.Config `toml:",omitempty"`
+
 	// UseLightweightKDF lowers the memory and CPU requirements of the key store
 	// scrypt KDF at the expense of security.
 	UseLightweightKDF bool `toml:",omitempty"`
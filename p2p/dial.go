@@ -101,6 +101,7 @@ type dialScheduler struct {
 	doneCh      chan *dialTask
 	addStaticCh chan *enode.Node
 	remStaticCh chan *enode.Node
+	addProtCh   chan *enode.Node
 	addPeerCh   chan *conn
 	remPeerCh   chan *conn
 
@@ -113,7 +114,7 @@ type dialScheduler struct {
 	// The static map tracks all static dial tasks. The subset of usable static dial tasks
 	// (i.e. those passing checkDial) is kept in staticPool. The scheduler prefers
 	// launching random static tasks from the pool over launching dynamic dials from the
-	// iterator.
+	// iterator. Protected tasks are static tasks launched regardless of the free slots.
 	static     map[enode.ID]*dialTask
 	staticPool []*dialTask
 
@@ -171,6 +172,7 @@ func newDialScheduler(config dialConfig, it enode.Iterator, setupFunc dialSetupF
 		nodesIn:     make(chan *enode.Node),
 		addStaticCh: make(chan *enode.Node),
 		remStaticCh: make(chan *enode.Node),
+		addProtCh:   make(chan *enode.Node),
 		addPeerCh:   make(chan *conn),
 		remPeerCh:   make(chan *conn),
 	}
@@ -204,6 +206,15 @@ func (d *dialScheduler) removeStatic(n *enode.Node) {
 	}
 }
 
+// addProtected adds a protected dial candidate, dialed regardless of the free slots.
+// Protected candidates are removed with removeStatic.
+func (d *dialScheduler) addProtected(n *enode.Node) {
+	select {
+	case d.addProtCh <- n:
+	case <-d.ctx.Done():
+	}
+}
+
 // peerAdded updates the peer set.
 func (d *dialScheduler) peerAdded(c *conn) {
 	select {
@@ -230,6 +241,7 @@ func (d *dialScheduler) loop(it enode.Iterator) {
 loop:
 	for {
 		// Launch new dials if slots are available.
+		d.startProtectedDials()
 		slots := d.freeDialSlots()
 		slots -= d.startStaticDials(slots)
 		if slots > 0 {
@@ -255,7 +267,7 @@ loop:
 			d.doneSinceLastLog++
 
 		case c := <-d.addPeerCh:
-			if c.is(dynDialedConn) || c.is(staticDialedConn) {
+			if (c.is(dynDialedConn) || c.is(staticDialedConn)) && !c.is(protectedConn) {
 				d.dialPeers++
 			}
 			id := c.node.ID()
@@ -268,7 +280,7 @@ loop:
 			// TODO: cancel dials to connected peers
 
 		case c := <-d.remPeerCh:
-			if c.is(dynDialedConn) || c.is(staticDialedConn) {
+			if (c.is(dynDialedConn) || c.is(staticDialedConn)) && !c.is(protectedConn) {
 				d.dialPeers--
 			}
 			delete(d.peers, c.node.ID())
@@ -287,6 +299,25 @@ loop:
 				d.addToStaticPool(task)
 			}
 
+		case node := <-d.addProtCh:
+			id := node.ID()
+			old := d.static[id]
+			d.log.Trace("Adding protected node", "id", id, "ip", node.IP(), "added", old == nil)
+			if old != nil {
+				if old.flags&protectedConn != 0 {
+					continue loop
+				}
+				// Replace the static task, it may be running and can't be updated
+				if old.staticPoolIndex >= 0 {
+					d.removeFromStaticPool(old.staticPoolIndex)
+				}
+			}
+			task := newDialTask(node, staticDialedConn|protectedConn)
+			d.static[id] = task
+			if d.checkDial(node) == nil {
+				d.addToStaticPool(task)
+			}
+
 		case node := <-d.remStaticCh:
 			id := node.ID()
 			task := d.static[id]
@@ -410,6 +441,16 @@ func (d *dialScheduler) checkDial(n *enode.Node) error {
 	return nil
 }
 
+// startProtectedDials starts all the protected dial tasks of the static pool.
+func (d *dialScheduler) startProtectedDials() {
+	for idx := len(d.staticPool) - 1; idx >= 0; idx-- {
+		if task := d.staticPool[idx]; task.flags&protectedConn != 0 {
+			d.startDial(task)
+			d.removeFromStaticPool(idx)
+		}
+	}
+}
+
 // startStaticDials starts n static dial tasks.
 func (d *dialScheduler) startStaticDials(n int) (started int) {
 	for started = 0; started < n && len(d.staticPool) > 0; started++ {
@@ -226,6 +226,56 @@ func TestDialSchedStaticDial(t *testing.T) {
 	})
 }
 
+// This test checks that protected nodes are dialed regardless of the free slots,
+// and that their connections don't take the slot of another peer.
+func TestDialSchedProtectedDial(t *testing.T) {
+	t.Parallel()
+
+	config := dialConfig{
+		maxActiveDials: 5,
+		maxDialPeers:   1,
+	}
+	runDialTest(t, config, []dialTestRound{
+		// The only dial slot is taken, so the static node isn't dialed,
+		// the protected ones are.
+		{
+			peersAdded: []*conn{
+				{flags: dynDialedConn, node: newNode(uintID(0x01), "127.0.0.1:30303")},
+			},
+			update: func(d *dialScheduler) {
+				d.addStatic(newNode(uintID(0x02), "127.0.0.2:30303"))
+				d.addProtected(newNode(uintID(0x03), "127.0.0.3:30303"))
+				d.addProtected(newNode(uintID(0x04), "127.0.0.4:30303"))
+			},
+			wantNewDials: []*enode.Node{
+				newNode(uintID(0x03), "127.0.0.3:30303"),
+				newNode(uintID(0x04), "127.0.0.4:30303"),
+			},
+		},
+		// Dial to 0x03 completes without taking a slot, 0x04 fails.
+		{
+			succeeded: []enode.ID{
+				uintID(0x03),
+			},
+			failed: []enode.ID{
+				uintID(0x04),
+			},
+			wantResolves: map[enode.ID]*enode.Node{
+				uintID(0x04): nil,
+			},
+		},
+		// Peer 0x01 drops, freeing the slot for the static node.
+		{
+			peersRemoved: []enode.ID{
+				uintID(0x01),
+			},
+			wantNewDials: []*enode.Node{
+				newNode(uintID(0x02), "127.0.0.2:30303"),
+			},
+		},
+	})
+}
+
 // This test checks that removing static nodes stops connecting to them.
 func TestDialSchedRemoveStatic(t *testing.T) {
 	t.Parallel()
@@ -193,6 +193,8 @@ type Server struct {
 	quit                    chan struct{}
 	addtrusted              chan *enode.Node
 	removetrusted           chan *enode.Node
+	addprotected            chan *enode.Node
+	removeprotected         chan *enode.Node
 	peerOp                  chan peerOpFunc
 	peerOpDone              chan struct{}
 	delpeer                 chan peerDrop
@@ -218,6 +220,7 @@ const (
 	staticDialedConn
 	inboundConn
 	trustedConn
+	protectedConn
 )
 
 // conn wraps a network connection with information gathered
@@ -260,6 +263,9 @@ func (f connFlag) String() string {
 	if f&trustedConn != 0 {
 		s += "-trusted"
 	}
+	if f&protectedConn != 0 {
+		s += "-protected"
+	}
 	if f&dynDialedConn != 0 {
 		s += "-dyndial"
 	}
@@ -374,6 +380,30 @@ func (srv *Server) RemoveTrustedPeer(node *enode.Node) {
 	}
 }
 
+// AddProtectedPeer adds the given node to the protected set. Protected nodes are kept
+// connected like static ones and accepted like trusted ones, but their connections
+// don't count toward the peer limits, so they never take the slot of another peer.
+func (srv *Server) AddProtectedPeer(node *enode.Node) {
+	select {
+	case srv.addprotected <- node:
+	case <-srv.quit:
+	}
+}
+
+// RemoveProtectedPeer removes the given node from the protected set. It stops being
+// dialed, and its connection is dropped as it would otherwise exceed the peer limits.
+func (srv *Server) RemoveProtectedPeer(node *enode.Node) {
+	select {
+	case srv.removeprotected <- node:
+	case <-srv.quit:
+	}
+}
+
+// DiscoveryV4 returns the discovery v4 instance, if configured.
+func (srv *Server) DiscoveryV4() *discover.UDPv4 {
+	return srv.ntab
+}
+
 // SubscribeEvents subscribes the given channel to peer events
 func (srv *Server) SubscribeEvents(ch chan *PeerEvent) event.Subscription {
 	return srv.peerFeed.Subscribe(ch)
@@ -471,6 +501,8 @@ func (srv *Server) Start() (err error) {
 	srv.checkpointAddPeer = make(chan *conn)
 	srv.addtrusted = make(chan *enode.Node)
 	srv.removetrusted = make(chan *enode.Node)
+	srv.addprotected = make(chan *enode.Node)
+	srv.removeprotected = make(chan *enode.Node)
 	srv.peerOp = make(chan peerOpFunc)
 	srv.peerOpDone = make(chan struct{})
 
@@ -706,6 +738,7 @@ func (srv *Server) run() {
 		peers        = make(map[enode.ID]*Peer)
 		inboundCount = 0
 		trusted      = make(map[enode.ID]bool, len(srv.TrustedNodes))
+		protected    = make(map[enode.ID]bool)
 	)
 	// Put trusted nodes into a map to speed up checks.
 	// Trusted peers are loaded on startup or added via AddTrustedPeer RPC.
@@ -738,6 +771,24 @@ running:
 				p.rw.set(trustedConn, false)
 			}
 
+		case n := <-srv.addprotected:
+			// This channel is used by AddProtectedPeer to add a node
+			// to the protected node set. An existing connection keeps
+			// counting toward the limits until it's re-established.
+			srv.log.Trace("Adding protected node", "node", n)
+			protected[n.ID()] = true
+			srv.dialsched.addProtected(n)
+
+		case n := <-srv.removeprotected:
+			// This channel is used by RemoveProtectedPeer to remove a node
+			// from the protected node set.
+			srv.log.Trace("Removing protected node", "node", n)
+			delete(protected, n.ID())
+			srv.dialsched.removeStatic(n)
+			if p, ok := peers[n.ID()]; ok && p.rw.is(protectedConn) {
+				p.Disconnect(DiscRequested)
+			}
+
 		case op := <-srv.peerOp:
 			// This channel is used by Peers and PeerCount.
 			op(peers)
@@ -750,6 +801,11 @@ running:
 				// Ensure that the trusted flag is set before checking against MaxPeers.
 				c.flags |= trustedConn
 			}
+			if protected[c.node.ID()] {
+				c.flags |= protectedConn
+			} else {
+				c.flags &^= protectedConn
+			}
 			// TODO: track in-progress inbound node IDs (pre-Peer) to avoid dialing them.
 			c.cont <- srv.postHandshakeChecks(peers, inboundCount, c)
 
@@ -763,7 +819,7 @@ running:
 				peers[c.node.ID()] = p
 				srv.log.Debug("Adding p2p peer", "peercount", len(peers), "id", p.ID(), "conn", c.flags, "addr", p.RemoteAddr(), "name", p.Name())
 				srv.dialsched.peerAdded(c)
-				if p.Inbound() {
+				if p.Inbound() && !c.is(protectedConn) {
 					inboundCount++
 				}
 			}
@@ -775,7 +831,7 @@ running:
 			delete(peers, pd.ID())
 			srv.log.Debug("Removing p2p peer", "peercount", len(peers), "id", pd.ID(), "duration", d, "req", pd.requested, "err", pd.err)
 			srv.dialsched.peerRemoved(pd.rw)
-			if pd.Inbound() {
+			if pd.Inbound() && !pd.rw.is(protectedConn) {
 				inboundCount--
 			}
 		}
@@ -806,9 +862,9 @@ running:
 
 func (srv *Server) postHandshakeChecks(peers map[enode.ID]*Peer, inboundCount int, c *conn) error {
 	switch {
-	case !c.is(trustedConn) && len(peers) >= srv.MaxPeers:
+	case !c.is(trustedConn|protectedConn) && countedPeers(peers) >= srv.MaxPeers:
 		return DiscTooManyPeers
-	case !c.is(trustedConn) && c.is(inboundConn) && inboundCount >= srv.maxInboundConns():
+	case !c.is(trustedConn|protectedConn) && c.is(inboundConn) && inboundCount >= srv.maxInboundConns():
 		return DiscTooManyPeers
 	case peers[c.node.ID()] != nil:
 		return DiscAlreadyConnected
@@ -819,6 +875,18 @@ func (srv *Server) postHandshakeChecks(peers map[enode.ID]*Peer, inboundCount in
 	}
 }
 
+// countedPeers returns the number of peers counting toward the peer limits, that is
+// all of them except the protected ones.
+func countedPeers(peers map[enode.ID]*Peer) int {
+	count := len(peers)
+	for _, p := range peers {
+		if p.rw.is(protectedConn) {
+			count--
+		}
+	}
+	return count
+}
+
 func (srv *Server) addPeerChecks(peers map[enode.ID]*Peer, inboundCount int, c *conn) error {
 	// Drop connections with no matching protocols.
 	if len(srv.Protocols) > 0 && countMatchingProtocols(srv.Protocols, c.caps) == 0 {
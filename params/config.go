@@ -18,6 +18,7 @@ package params
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -57,6 +58,11 @@ var (
 		BerlinBlock:         big.NewInt(0),
 		LondonBlock:         big.NewInt(0),
 		EarthBlock:          nil,
+		MarsBlock:           nil,
+		JupiterBlock:        nil,
+		SaturnBlock:         nil,
+		UranusBlock:         nil,
+		NeptuneBlock:        nil,
 		Democracy: &DemocracyConfig{
 			Period:                3,
 			Epoch:                 200,
@@ -82,6 +88,11 @@ var (
 		BerlinBlock:         big.NewInt(0),
 		LondonBlock:         big.NewInt(0),
 		EarthBlock:          nil,
+		MarsBlock:           nil,
+		JupiterBlock:        nil,
+		SaturnBlock:         nil,
+		UranusBlock:         nil,
+		NeptuneBlock:        nil,
 		Democracy: &DemocracyConfig{
 			Period:                3,
 			Epoch:                 200,
@@ -114,21 +125,23 @@ var (
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, new(EthashConfig), nil, nil}
+	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, nil, nil, new(EthashConfig), nil, nil}
 
 	// AllCliqueProtocolChanges contains every protocol change (EIPs) introduced
 	// and accepted by the Ethereum core developers into the Clique consensus.
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllCliqueProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, &CliqueConfig{Period: 0, Epoch: 30000}, nil}
+	AllCliqueProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, nil, nil, nil, nil, &CliqueConfig{Period: 0, Epoch: 30000}, nil}
 
-	AllDemocracyProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, big.NewInt(2), nil, nil, &DemocracyConfig{Period: 3, Epoch: 200, AttestationDelay: 2}}
+	AllDemocracyProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, nil, nil, big.NewInt(2), nil, nil, &DemocracyConfig{Period: 3, Epoch: 200, AttestationDelay: 2}}
 
-	TestChainConfig = &ChainConfig{big.NewInt(9528), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, new(EthashConfig), nil, nil}
+	TestChainConfig = &ChainConfig{big.NewInt(9528), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, nil, nil, new(EthashConfig), nil, nil}
 )
 
 var (
+	// ContinousInturn is the initial number of continuous blocks sealed by an in-turn
+	// validator, after the Uranus hard-fork it is governed by the OnChainDao contract.
 	ContinousInturn = uint64(4)
 )
 
@@ -172,6 +185,37 @@ func (c *TrustedCheckpoint) Empty() bool {
 	return c.SectionHead == (common.Hash{}) || c.CHTRoot == (common.Hash{}) || c.BloomRoot == (common.Hash{})
 }
 
+// SyncCheckpoint is a trusted finalized block, guarding the nodes syncing a long-lived
+// chain against long-range attacks. The peers whose chain doesn't include it are rejected,
+// and the headers up to it are authenticated by the hash chain leading to it instead of
+// being verified from genesis. It must be an epoch checkpoint block.
+type SyncCheckpoint struct {
+	Number uint64      `json:"number"`
+	Hash   common.Hash `json:"hash"`
+}
+
+// String implements the fmt.Stringer interface, in the <number>:<hash> format.
+func (c *SyncCheckpoint) String() string {
+	return fmt.Sprintf("%d:%s", c.Number, c.Hash.Hex())
+}
+
+// Validate checks whether the checkpoint can anchor the given chain: it can't be the
+// genesis, and before the Uranus hard-fork it has to be an epoch checkpoint block.
+// After the fork the epoch is governed on chain, so the consensus engine checks the
+// block announces consensus parameters when it's synced instead.
+func (c *SyncCheckpoint) Validate(config *ChainConfig) error {
+	if c.Number == 0 {
+		return errors.New("genesis can't be a sync checkpoint")
+	}
+	if config.Democracy == nil || config.IsUranus(new(big.Int).SetUint64(c.Number)) {
+		return nil
+	}
+	if c.Number%config.Democracy.Epoch != 0 {
+		return fmt.Errorf("sync checkpoint %d isn't an epoch checkpoint block (epoch %d)", c.Number, config.Democracy.Epoch)
+	}
+	return nil
+}
+
 // CheckpointOracleConfig represents a set of checkpoint contract(which acts as an oracle)
 // config which used for light client checkpoint syncing.
 type CheckpointOracleConfig struct {
@@ -209,6 +253,11 @@ type ChainConfig struct {
 	LondonBlock         *big.Int `json:"londonBlock,omitempty"`         // London switch block (nil = no fork, 0 = already on london)
 	ArrowGlacierBlock   *big.Int `json:"arrowGlacierBlock,omitempty"`   // Eip-4345 (bomb delay) switch block (nil = no fork, 0 = already activated)
 	EarthBlock          *big.Int `json:"earthBlock,omitempty"`          // TODO
+	MarsBlock           *big.Int `json:"marsBlock,omitempty"`           // Mars switch block (nil = no fork, 0 = already on mars)
+	JupiterBlock        *big.Int `json:"jupiterBlock,omitempty"`        // Jupiter switch block (nil = no fork, 0 = already on jupiter)
+	SaturnBlock         *big.Int `json:"saturnBlock,omitempty"`         // Saturn switch block (nil = no fork, 0 = already on saturn)
+	UranusBlock         *big.Int `json:"uranusBlock,omitempty"`         // Uranus switch block (nil = no fork, 0 = already on uranus)
+	NeptuneBlock        *big.Int `json:"neptuneBlock,omitempty"`        // Neptune switch block (nil = no fork, 0 = already on neptune)
 	// TerminalTotalDifficulty is the amount of total difficulty reached by
 	// the network that triggers the consensus upgrade.
 	TerminalTotalDifficulty *big.Int `json:"terminalTotalDifficulty,omitempty"`
@@ -239,6 +288,8 @@ func (c *CliqueConfig) String() string {
 }
 
 // DemocracyConfig is the consensus engine configs for proof-of-stake-authority based sealing.
+// Period, Epoch and AttestationDelay are the initial values of the consensus parameters,
+// after the Uranus hard-fork they are governed by the OnChainDao contract.
 type DemocracyConfig struct {
 	Period uint64 `json:"period"` // Number of seconds between blocks to enforce
 	Epoch  uint64 `json:"epoch"`  // Epoch length to reset votes and checkpoint
@@ -248,6 +299,128 @@ type DemocracyConfig struct {
 	// That is: only attest to a block which height is ≤ `currentHead - AttestationDelay`
 	AttestationDelay uint64         `json:"attestationDelay"`
 	SysContractAdmin common.Address `json:"sysContractAdmin,omitempty"` // admin address of system contracts for a private chain, ONLY used by develop or private chain.
+
+	RewardSchedule *RewardSchedule `json:"rewardSchedule,omitempty"` // Emission schedule of the block reward (nil = constant legacy reward)
+	FeePolicy      *FeePolicy      `json:"feePolicy,omitempty"`      // Split of the block fees (nil = priority fees to the validators, base fees burned)
+	InactivityLeak *InactivityLeak `json:"inactivityLeak,omitempty"` // Penalties of the validators not attesting while finality stalls (nil = no penalties)
+	Checkpoint     *SyncCheckpoint `json:"checkpoint,omitempty"`     // Trusted finalized block new nodes sync from (nil = verify the chain from genesis)
+}
+
+// RewardSchedule is the emission schedule of the block reward credited on top of the
+// transaction fees. The reward starts at Initial on the activation block, and decreases
+// by Decay percent every Interval blocks (a Decay of 50 halves it). The total reward
+// emitted since the activation block never exceeds the optional Cap.
+type RewardSchedule struct {
+	Block    *big.Int `json:"block"`              // Activation block of the schedule
+	Initial  *big.Int `json:"initial"`            // Block reward in wei on the activation block
+	Interval uint64   `json:"interval,omitempty"` // Number of blocks between two reward decreases (0 = constant reward)
+	Decay    uint64   `json:"decay,omitempty"`    // Percentage the reward decreases by every interval (0 = constant reward)
+	Cap      *big.Int `json:"cap,omitempty"`      // Max total reward emitted by the schedule in wei (nil = unlimited)
+}
+
+// FeePolicy splits the fees of every block between the validators, the community pool
+// and a burn from its activation block on. The priority fees and the base fees are split
+// separately, the base fees being collected by the transactions instead of burned.
+type FeePolicy struct {
+	Block       *big.Int  `json:"block"`       // Activation block of the policy
+	PriorityFee FeeShares `json:"priorityFee"` // Split of the priority fees paid to the block
+	BaseFee     FeeShares `json:"baseFee"`     // Split of the base fees paid to the block
+}
+
+// FeeShares are the weights of the destinations of a fee. Rounding leftovers of the
+// priority fees go to the validators, the ones of the base fees are burned. Without
+// any weight the priority fees go to the validators, and the base fees are burned.
+type FeeShares struct {
+	Validators    uint64 `json:"validators,omitempty"`    // Weight of the validator set
+	CommunityPool uint64 `json:"communityPool,omitempty"` // Weight of the community pool contract
+	Burn          uint64 `json:"burn,omitempty"`          // Weight of the burn
+}
+
+// InactivityLeak penalizes the validators which don't attest while finality stalls. Once
+// no finality certificate made it on chain for Epochs epochs, every checkpoint punishes
+// the validators none of the certificates of the ending epoch was signed by, and ejects
+// the ones which stayed inactive for EjectAfter consecutive leaking epochs, so that the
+// remaining validators can regain the attestation threshold. The leak is only active after
+// the Neptune hard-fork, which ships the punishment methods of the system contract.
+type InactivityLeak struct {
+	Block      *big.Int `json:"block"`      // Activation block of the leak
+	Epochs     uint64   `json:"epochs"`     // Number of epochs without finality before the leak starts
+	EjectAfter uint64   `json:"ejectAfter"` // Number of consecutive inactive leaking epochs before ejection (0 = never)
+}
+
+// activation returns the activation block of the leak, or nil if there's none.
+func (l *InactivityLeak) activation() *big.Int {
+	if l == nil {
+		return nil
+	}
+	return l.Block
+}
+
+// IsFeePolicy returns whether num is either equal to the activation block of the fee
+// policy or greater.
+func (c *DemocracyConfig) IsFeePolicy(num *big.Int) bool {
+	return isForked(c.FeePolicy.activation(), num)
+}
+
+// check verifies that the policy is activated at a known block.
+func (p *FeePolicy) check() error {
+	if p.Block == nil {
+		return errors.New("fee policy without activation block")
+	}
+	return nil
+}
+
+// activation returns the activation block of the policy, or nil if there's none.
+func (p *FeePolicy) activation() *big.Int {
+	if p == nil {
+		return nil
+	}
+	return p.Block
+}
+
+// activation returns the activation block of the schedule, or nil if there's none.
+func (s *RewardSchedule) activation() *big.Int {
+	if s == nil {
+		return nil
+	}
+	return s.Block
+}
+
+// check verifies that the schedule emits a non-negative reward decreasing over time.
+func (s *RewardSchedule) check() error {
+	switch {
+	case s.Initial == nil:
+		return errors.New("reward schedule without initial reward")
+	case s.Initial.Sign() < 0:
+		return fmt.Errorf("negative initial reward %v", s.Initial)
+	case s.Decay > 100:
+		return fmt.Errorf("reward decay of %d%% exceeds 100%%", s.Decay)
+	case s.Cap != nil && s.Cap.Sign() < 0:
+		return fmt.Errorf("negative reward cap %v", s.Cap)
+	}
+	return nil
+}
+
+// equal checks whether two schedules emit the same rewards.
+func (s *RewardSchedule) equal(other *RewardSchedule) bool {
+	return configNumEqual(s.Block, other.Block) && configNumEqual(s.Initial, other.Initial) &&
+		s.Interval == other.Interval && s.Decay == other.Decay && configNumEqual(s.Cap, other.Cap)
+}
+
+// check verifies the economics of the engine, so that they can't fail while blocks are
+// processed.
+func (c *DemocracyConfig) check() error {
+	if c.RewardSchedule != nil {
+		if err := c.RewardSchedule.check(); err != nil {
+			return fmt.Errorf("invalid democracy rewardSchedule: %v", err)
+		}
+	}
+	if c.FeePolicy != nil {
+		if err := c.FeePolicy.check(); err != nil {
+			return fmt.Errorf("invalid democracy feePolicy: %v", err)
+		}
+	}
+	return nil
 }
 
 // String implements the stringer interface, returning the consensus engine details.
@@ -268,7 +441,7 @@ func (c *ChainConfig) String() string {
 	default:
 		engine = "unknown"
 	}
-	return fmt.Sprintf("{ChainID: %v Homestead: %v DAO: %v DAOSupport: %v EIP150: %v EIP155: %v EIP158: %v Byzantium: %v Constantinople: %v Petersburg: %v Istanbul: %v, Muir Glacier: %v, Berlin: %v, London: %v, Earth: %v, Engine: %v}",
+	return fmt.Sprintf("{ChainID: %v Homestead: %v DAO: %v DAOSupport: %v EIP150: %v EIP155: %v EIP158: %v Byzantium: %v Constantinople: %v Petersburg: %v Istanbul: %v, Muir Glacier: %v, Berlin: %v, London: %v, Earth: %v, Mars: %v, Jupiter: %v, Saturn: %v, Uranus: %v, Neptune: %v, Engine: %v}",
 		c.ChainID,
 		c.HomesteadBlock,
 		c.DAOForkBlock,
@@ -284,6 +457,11 @@ func (c *ChainConfig) String() string {
 		c.BerlinBlock,
 		c.LondonBlock,
 		c.EarthBlock,
+		c.MarsBlock,
+		c.JupiterBlock,
+		c.SaturnBlock,
+		c.UranusBlock,
+		c.NeptuneBlock,
 		engine,
 	)
 }
@@ -360,6 +538,31 @@ func (c *ChainConfig) IsEarth(num *big.Int) bool {
 	return isForked(c.EarthBlock, num)
 }
 
+// IsMars returns whether num is either equal to the Mars fork block or greater.
+func (c *ChainConfig) IsMars(num *big.Int) bool {
+	return isForked(c.MarsBlock, num)
+}
+
+// IsJupiter returns whether num is either equal to the Jupiter fork block or greater.
+func (c *ChainConfig) IsJupiter(num *big.Int) bool {
+	return isForked(c.JupiterBlock, num)
+}
+
+// IsSaturn returns whether num is either equal to the Saturn fork block or greater.
+func (c *ChainConfig) IsSaturn(num *big.Int) bool {
+	return isForked(c.SaturnBlock, num)
+}
+
+// IsUranus returns whether num is either equal to the Uranus fork block or greater.
+func (c *ChainConfig) IsUranus(num *big.Int) bool {
+	return isForked(c.UranusBlock, num)
+}
+
+// IsNeptune returns whether num is either equal to the Neptune fork block or greater.
+func (c *ChainConfig) IsNeptune(num *big.Int) bool {
+	return isForked(c.NeptuneBlock, num)
+}
+
 // IsTerminalPoWBlock returns whether the given block is the last block of PoW stage.
 func (c *ChainConfig) IsTerminalPoWBlock(parentTotalDiff *big.Int, totalDiff *big.Int) bool {
 	if c.TerminalTotalDifficulty == nil {
@@ -387,7 +590,8 @@ func (c *ChainConfig) CheckCompatible(newcfg *ChainConfig, height uint64) *Confi
 }
 
 // CheckConfigForkOrder checks that we don't "skip" any forks, geth isn't pluggable enough
-// to guarantee that forks can be implemented in a different order than on official networks
+// to guarantee that forks can be implemented in a different order than on official networks.
+// The economics of the democracy engine are checked too, as they are loaded along.
 func (c *ChainConfig) CheckConfigForkOrder() error {
 	type fork struct {
 		name     string
@@ -428,6 +632,9 @@ func (c *ChainConfig) CheckConfigForkOrder() error {
 			lastFork = cur
 		}
 	}
+	if c.Democracy != nil {
+		return c.Democracy.check()
+	}
 	return nil
 }
 
@@ -481,10 +688,53 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *Confi
 	if isForkIncompatible(c.ArrowGlacierBlock, newcfg.ArrowGlacierBlock, head) {
 		return newCompatError("Arrow Glacier fork block", c.ArrowGlacierBlock, newcfg.ArrowGlacierBlock)
 	}
+	if isForkIncompatible(c.MarsBlock, newcfg.MarsBlock, head) {
+		return newCompatError("Mars fork block", c.MarsBlock, newcfg.MarsBlock)
+	}
+	if isForkIncompatible(c.JupiterBlock, newcfg.JupiterBlock, head) {
+		return newCompatError("Jupiter fork block", c.JupiterBlock, newcfg.JupiterBlock)
+	}
+	if isForkIncompatible(c.SaturnBlock, newcfg.SaturnBlock, head) {
+		return newCompatError("Saturn fork block", c.SaturnBlock, newcfg.SaturnBlock)
+	}
+	if isForkIncompatible(c.UranusBlock, newcfg.UranusBlock, head) {
+		return newCompatError("Uranus fork block", c.UranusBlock, newcfg.UranusBlock)
+	}
+	if isForkIncompatible(c.NeptuneBlock, newcfg.NeptuneBlock, head) {
+		return newCompatError("Neptune fork block", c.NeptuneBlock, newcfg.NeptuneBlock)
+	}
+	if c.Democracy != nil && newcfg.Democracy != nil {
+		storedSchedule, newSchedule := c.Democracy.RewardSchedule, newcfg.Democracy.RewardSchedule
+		if isForkIncompatible(storedSchedule.activation(), newSchedule.activation(), head) {
+			return newCompatError("Reward schedule block", storedSchedule.activation(), newSchedule.activation())
+		}
+		if storedSchedule != nil && newSchedule != nil && isForked(storedSchedule.Block, head) && !storedSchedule.equal(newSchedule) {
+			return newCompatError("Reward schedule", storedSchedule.Block, newSchedule.Block)
+		}
+		storedPolicy, newPolicy := c.Democracy.FeePolicy, newcfg.Democracy.FeePolicy
+		if isForkIncompatible(storedPolicy.activation(), newPolicy.activation(), head) {
+			return newCompatError("Fee policy block", storedPolicy.activation(), newPolicy.activation())
+		}
+		if storedPolicy != nil && newPolicy != nil && isForked(storedPolicy.Block, head) &&
+			(storedPolicy.PriorityFee != newPolicy.PriorityFee || storedPolicy.BaseFee != newPolicy.BaseFee) {
+			return newCompatError("Fee policy", storedPolicy.Block, newPolicy.Block)
+		}
+		storedLeak, newLeak := c.Democracy.InactivityLeak, newcfg.Democracy.InactivityLeak
+		if isForkIncompatible(storedLeak.activation(), newLeak.activation(), head) {
+			return newCompatError("Inactivity leak block", storedLeak.activation(), newLeak.activation())
+		}
+		if storedLeak != nil && newLeak != nil && isForked(storedLeak.Block, head) &&
+			(storedLeak.Epochs != newLeak.Epochs || storedLeak.EjectAfter != newLeak.EjectAfter) {
+			return newCompatError("Inactivity leak", storedLeak.Block, newLeak.Block)
+		}
+	}
 	return nil
 }
 
-// IsDemocracyCompatible checks whether consensus config of Democracy is compatible
+// IsDemocracyCompatible checks whether consensus config of Democracy is compatible.
+// The consensus parameters can't be overridden locally, since they define the history
+// until the first checkpoint after the Uranus hard-fork, and are governed by the
+// OnChainDao contract from then on.
 func (c *ChainConfig) IsDemocracyCompatible(newcfg *ChainConfig) bool {
 	if c.Democracy != nil && newcfg.Democracy != nil {
 		return c.Democracy.Period == newcfg.Democracy.Period && c.Democracy.Epoch == newcfg.Democracy.Epoch &&
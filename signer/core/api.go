@@ -31,6 +31,7 @@ import (
 	"github.com/QEasyWeb3/QEasyChain/accounts/usbwallet"
 	"github.com/QEasyWeb3/QEasyChain/common"
 	"github.com/QEasyWeb3/QEasyChain/common/hexutil"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/slashing"
 	"github.com/QEasyWeb3/QEasyChain/internal/ethapi"
 	"github.com/QEasyWeb3/QEasyChain/log"
 	"github.com/QEasyWeb3/QEasyChain/signer/core/apitypes"
@@ -41,7 +42,7 @@ const (
 	// numberOfAccountsToDerive For hardware wallets, the number of accounts to derive
 	numberOfAccountsToDerive = 10
 	// ExternalAPIVersion -- see extapi_changelog.md
-	ExternalAPIVersion = "6.1.0"
+	ExternalAPIVersion = "6.2.0"
 	// InternalAPIVersion -- see intapi_changelog.md
 	InternalAPIVersion = "7.0.1"
 )
@@ -116,6 +117,7 @@ type SignerAPI struct {
 	validator   Validator
 	rejectMode  bool
 	credentials storage.Storage
+	slashing    *slashing.Database // Slashing-protection of the Democracy validators, nil if disabled
 }
 
 // Metadata about a request
@@ -239,6 +241,8 @@ type (
 		Callinfo    []apitypes.ValidationInfo `json:"call_info"`
 		Hash        hexutil.Bytes             `json:"hash"`
 		Meta        Metadata                  `json:"meta"`
+
+		democracy *democracySigning // Position of a Democracy header or attestation, if one is signed
 	}
 	SignDataResponse struct {
 		Approved bool `json:"approved"`
@@ -283,12 +287,19 @@ func NewSignerAPI(am *accounts.Manager, chainID int64, noUSB bool, ui UIClientAP
 	if advancedMode {
 		log.Info("Clef is in advanced mode: will warn instead of reject")
 	}
-	signer := &SignerAPI{big.NewInt(chainID), am, ui, validator, !advancedMode, credentials}
+	signer := &SignerAPI{big.NewInt(chainID), am, ui, validator, !advancedMode, credentials, nil}
 	if !noUSB {
 		signer.startUSBListener()
 	}
 	return signer
 }
+
+// EnableSlashingProtection makes the signer refuse Democracy headers and attestations
+// conflicting with the ones signed before, as recorded in the given database.
+func (api *SignerAPI) EnableSlashingProtection(db *slashing.Database) {
+	api.slashing = db
+}
+
 func (api *SignerAPI) openTrezor(url accounts.URL) {
 	resp, err := api.UI.OnInputRequired(UserInputRequest{
 		Prompt: "Pin required to open Trezor wallet\n" +
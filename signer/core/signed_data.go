@@ -36,6 +36,7 @@ import (
 	"github.com/QEasyWeb3/QEasyChain/common/hexutil"
 	"github.com/QEasyWeb3/QEasyChain/common/math"
 	"github.com/QEasyWeb3/QEasyChain/consensus/clique"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy"
 	"github.com/QEasyWeb3/QEasyChain/core/types"
 	"github.com/QEasyWeb3/QEasyChain/crypto"
 	"github.com/QEasyWeb3/QEasyChain/rlp"
@@ -60,6 +61,18 @@ var (
 		accounts.MimetypeClique,
 		0x02,
 	}
+	ApplicationDemocracy = SigFormat{
+		accounts.MimetypeDemocracy,
+		0x03,
+	}
+	ApplicationDemocracyAttestation = SigFormat{
+		accounts.MimetypeDemocracyAttestation,
+		0x04,
+	}
+	ApplicationDemocracyRecord = SigFormat{
+		accounts.MimetypeDemocracyRecord,
+		0x05,
+	}
 	TextPlain = SigFormat{
 		accounts.MimetypeTextPlain,
 		0x45,
@@ -150,6 +163,10 @@ func (api *SignerAPI) sign(req *SignDataRequest, legacyV bool) (hexutil.Bytes, e
 	if err != nil {
 		return nil, err
 	}
+	// Never sign anything that could get a validator punished
+	if err := api.checkSlashingProtection(req); err != nil {
+		return nil, err
+	}
 	// Sign the data with the wallet
 	signature, err := wallet.SignDataWithPassphrase(account, pw, req.ContentType, req.Rawdata)
 	if err != nil {
@@ -261,6 +278,116 @@ func (api *SignerAPI) determineSignatureFormat(ctx context.Context, contentType
 		// Clique uses V on the form 0 or 1
 		useEthereumV = false
 		req = &SignDataRequest{ContentType: mediaType, Rawdata: cliqueRlp, Messages: messages, Hash: sighash}
+	case ApplicationDemocracy.Mime:
+		// Democracy headers are sealed like the clique ones
+		stringData, ok := data.(string)
+		if !ok {
+			return nil, useEthereumV, fmt.Errorf("input for %v must be an hex-encoded string", ApplicationDemocracy.Mime)
+		}
+		democracyData, err := hexutil.Decode(stringData)
+		if err != nil {
+			return nil, useEthereumV, err
+		}
+		header := &types.Header{}
+		if err := rlp.DecodeBytes(democracyData, header); err != nil {
+			return nil, useEthereumV, err
+		}
+		// The incoming header is sent to us with the signature already truncated from the extradata
+		newExtra := make([]byte, len(header.Extra)+crypto.SignatureLength)
+		copy(newExtra, header.Extra)
+		header.Extra = newExtra
+
+		// Get back the rlp data, encoded by us
+		sighash := democracy.SealHash(header)
+		messages := []*NameValueType{
+			{
+				Name:  "Democracy header",
+				Typ:   "democracy",
+				Value: fmt.Sprintf("democracy header %d [0x%x]", header.Number, sighash),
+			},
+			{
+				Name:  "Validator",
+				Typ:   "address",
+				Value: header.Coinbase.String(),
+			},
+			{
+				Name:  "Block number",
+				Typ:   "uint64",
+				Value: header.Number.Uint64(),
+			},
+			{
+				Name:  "Parent hash",
+				Typ:   "hash",
+				Value: header.ParentHash.String(),
+			},
+		}
+		// Democracy uses V on the form 0 or 1
+		useEthereumV = false
+		req = &SignDataRequest{ContentType: mediaType, Rawdata: democracy.DemocracyRLP(header), Messages: messages, Hash: sighash.Bytes()}
+		req.democracy = &democracySigning{number: header.Number.Uint64()}
+	case ApplicationDemocracyAttestation.Mime:
+		// Democracy attestations vote for a (source,target) pair of blocks
+		stringData, ok := data.(string)
+		if !ok {
+			return nil, useEthereumV, fmt.Errorf("input for %v must be an hex-encoded string", ApplicationDemocracyAttestation.Mime)
+		}
+		attestationData, err := hexutil.Decode(stringData)
+		if err != nil {
+			return nil, useEthereumV, err
+		}
+		source, target, err := types.ParseAttestationData(attestationData)
+		if err != nil {
+			return nil, useEthereumV, err
+		}
+		if source.Number.Cmp(target.Number) >= 0 {
+			return nil, useEthereumV, fmt.Errorf("attestation source %d not before target %d", source.Number, target.Number)
+		}
+		sighash := types.AttestationSignHash(source, target)
+		messages := []*NameValueType{
+			{
+				Name:  "Democracy attestation",
+				Typ:   "democracy-attestation",
+				Value: fmt.Sprintf("attestation from %d [0x%x] to %d [0x%x]", source.Number, source.Hash, target.Number, target.Hash),
+			},
+			{
+				Name:  "Source block number",
+				Typ:   "uint64",
+				Value: source.Number.Uint64(),
+			},
+			{
+				Name:  "Target block number",
+				Typ:   "uint64",
+				Value: target.Number.Uint64(),
+			},
+		}
+		// Democracy uses V on the form 0 or 1
+		useEthereumV = false
+		req = &SignDataRequest{ContentType: mediaType, Rawdata: attestationData, Messages: messages, Hash: sighash.Bytes()}
+		req.democracy = &democracySigning{vote: true, source: source.Number.Uint64(), number: target.Number.Uint64()}
+	case ApplicationDemocracyRecord.Mime:
+		// Democracy validator records advertise the node run by a validator
+		stringData, ok := data.(string)
+		if !ok {
+			return nil, useEthereumV, fmt.Errorf("input for %v must be an hex-encoded string", ApplicationDemocracyRecord.Mime)
+		}
+		recordData, err := hexutil.Decode(stringData)
+		if err != nil {
+			return nil, useEthereumV, err
+		}
+		validator, node, err := types.ParseValidatorRecordData(recordData)
+		if err != nil {
+			return nil, useEthereumV, err
+		}
+		messages := []*NameValueType{
+			{
+				Name:  "Democracy validator record",
+				Typ:   "democracy-validator-record",
+				Value: fmt.Sprintf("validator %v runs node 0x%x", validator, node),
+			},
+		}
+		// Democracy uses V on the form 0 or 1
+		useEthereumV = false
+		req = &SignDataRequest{ContentType: mediaType, Rawdata: recordData, Messages: messages, Hash: types.ValidatorRecordSignHash(validator, node).Bytes()}
 	default: // also case TextPlain.Mime:
 		// Calculates an Ethereum ECDSA signature for:
 		// hash = keccak256("\x19${byteVersion}Ethereum Signed Message:\n${message length}${message}")
@@ -288,6 +415,31 @@ func (api *SignerAPI) determineSignatureFormat(ctx context.Context, contentType
 	return req, useEthereumV, nil
 }
 
+// democracySigning is the position of a Democracy header or attestation to be signed,
+// checked against the slashing-protection database before signing.
+type democracySigning struct {
+	vote   bool   // Whether an attestation is signed, or a header
+	source uint64 // Source block number of the attestation
+	number uint64 // Number of the header, or target block number of the attestation
+}
+
+// checkSlashingProtection refuses to sign a Democracy header or attestation that
+// conflicts with anything signed before, and records it otherwise.
+func (api *SignerAPI) checkSlashingProtection(req *SignDataRequest) error {
+	if api.slashing == nil || req.democracy == nil {
+		return nil
+	}
+	var (
+		val  = req.Address.Address()
+		root = common.BytesToHash(req.Hash)
+	)
+	if req.democracy.vote {
+		return api.slashing.CheckAndRecordVote(val, req.democracy.source, req.democracy.number, root)
+	}
+	// Every sealing attempt of the node is signed, only one of them gets released
+	return api.slashing.CheckAndReplaceBlock(val, req.democracy.number, root)
+}
+
 // SignTextWithValidator signs the given message which can be further recovered
 // with the given validator.
 // hash = keccak256("\x19\x00"${address}${data}).
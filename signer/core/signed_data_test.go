@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"path"
 	"strings"
 	"testing"
@@ -30,6 +31,10 @@ import (
 	"github.com/QEasyWeb3/QEasyChain/common"
 	"github.com/QEasyWeb3/QEasyChain/common/hexutil"
 	"github.com/QEasyWeb3/QEasyChain/common/math"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy"
+	"github.com/QEasyWeb3/QEasyChain/consensus/democracy/slashing"
+	"github.com/QEasyWeb3/QEasyChain/core/rawdb"
+	"github.com/QEasyWeb3/QEasyChain/core/types"
 	"github.com/QEasyWeb3/QEasyChain/crypto"
 	"github.com/QEasyWeb3/QEasyChain/signer/core"
 )
@@ -231,6 +236,79 @@ func TestSignData(t *testing.T) {
 	}
 }
 
+// Tests that Democracy headers and attestations are signed with V on the 0/1 form, and
+// that the slashing protection refuses the conflicting ones.
+func TestSignDemocracyData(t *testing.T) {
+	api, control := setup(t)
+	api.EnableSlashingProtection(slashing.New(rawdb.NewMemoryDatabase()))
+	createAccount(control, api, t)
+	control.approveCh <- "A"
+	list, err := api.List(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := common.NewMixedcaseAddress(list[0])
+
+	signData := func(mime string, data []byte) ([]byte, error) {
+		control.approveCh <- "Y"
+		control.inputCh <- "a_long_password"
+		return api.SignData(context.Background(), mime, a, hexutil.Encode(data))
+	}
+	verify := func(signature []byte, hash common.Hash) {
+		t.Helper()
+		if len(signature) != 65 || signature[64] > 1 {
+			t.Fatalf("invalid signature %x", signature)
+		}
+		pubkey, err := crypto.SigToPub(hash.Bytes(), signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if signer := crypto.PubkeyToAddress(*pubkey); signer != list[0] {
+			t.Fatalf("signer mismatch: have %x, want %x", signer, list[0])
+		}
+	}
+	// Headers are signed over their seal hash, the later sealing attempts replacing the former
+	header := &types.Header{Number: big.NewInt(10), Difficulty: big.NewInt(2), Extra: make([]byte, 32+65)}
+	for _, time := range []uint64{1, 2} {
+		header.Time = time
+		signature, err := signData(core.ApplicationDemocracy.Mime, democracy.DemocracyRLP(header))
+		if err != nil {
+			t.Fatalf("failed to sign header: %v", err)
+		}
+		verify(signature, democracy.SealHash(header))
+	}
+	header.Number = big.NewInt(11)
+	if _, err := signData(core.ApplicationDemocracy.Mime, democracy.DemocracyRLP(header)); err != nil {
+		t.Fatalf("failed to sign header: %v", err)
+	}
+	header.Number, header.Time = big.NewInt(10), 3
+	if _, err := signData(core.ApplicationDemocracy.Mime, democracy.DemocracyRLP(header)); err != slashing.ErrDoubleSeal {
+		t.Fatalf("double seal error mismatch: have %v, want %v", err, slashing.ErrDoubleSeal)
+	}
+	// Attestations are signed over their sign hash
+	edge := func(number int64) *types.RangeEdge {
+		return &types.RangeEdge{Hash: common.Hash{byte(number)}, Number: big.NewInt(number)}
+	}
+	signature, err := signData(core.ApplicationDemocracyAttestation.Mime, types.AttestationData(edge(1), edge(5)))
+	if err != nil {
+		t.Fatalf("failed to sign attestation: %v", err)
+	}
+	verify(signature, types.AttestationSignHash(edge(1), edge(5)))
+
+	if _, err := signData(core.ApplicationDemocracyAttestation.Mime, types.AttestationData(edge(2), edge(5))); err != slashing.ErrDoubleVote {
+		t.Fatalf("double vote error mismatch: have %v, want %v", err, slashing.ErrDoubleVote)
+	}
+	if _, err := signData(core.ApplicationDemocracyAttestation.Mime, types.AttestationData(edge(2), edge(4))); err != slashing.ErrSurroundVote {
+		t.Fatalf("surround vote error mismatch: have %v, want %v", err, slashing.ErrSurroundVote)
+	}
+	// Malformed attestations are refused before being shown to the user
+	for _, data := range [][]byte{types.AttestationData(edge(5), edge(5)), make([]byte, 32)} {
+		if _, err := api.SignData(context.Background(), core.ApplicationDemocracyAttestation.Mime, a, hexutil.Encode(data)); err == nil {
+			t.Fatalf("malformed attestation %x signed", data)
+		}
+	}
+}
+
 func TestDomainChainId(t *testing.T) {
 	withoutChainID := core.TypedData{
 		Types: core.Types{